@@ -19,8 +19,10 @@ package sqltypes
 import (
 	"bytes"
 	"fmt"
+	"math"
 
 	"strconv"
+	"strings"
 
 	querypb "github.com/xsec-lab/go/vt/proto/query"
 	vtrpcpb "github.com/xsec-lab/go/vt/proto/vtrpc"
@@ -208,6 +210,118 @@ func NullsafeCompare(v1, v2 Value) (int, error) {
 	return 0, fmt.Errorf("types are not comparable: %v vs %v", v1.Type(), v2.Type())
 }
 
+// NullsafeHashcode returns a key for v such that any two values of the
+// same category for which NullsafeCompare returns 0 produce the same key.
+// Numeric values are normalized before hashing, which means that 1, 1.0
+// and an unsigned 1 produce the same key. Decimal values are hashed by
+// their exact value, not by their float approximation. Binary and
+// date/time values are hashed by their bytes. NULL produces an empty key.
+// Values that cannot be compared, like text, return an error.
+func NullsafeHashcode(v Value) (string, error) {
+	if v.IsNull() {
+		return "", nil
+	}
+	if v.Type() == Decimal {
+		return hashDecimal(v.ToString())
+	}
+	if isNumber(v.Type()) {
+		n, err := newNumeric(v)
+		if err != nil {
+			return "", err
+		}
+		return hashNumeric(n), nil
+	}
+	if isByteComparable(v) {
+		return "b" + string(v.ToBytes()), nil
+	}
+	return "", fmt.Errorf("types are not hashable: %v", v.Type())
+}
+
+// hashNumeric converts integral values into a common representation
+// so that they hash to the same key irrespective of their type.
+// Fractional floats hash like the decimal of their shortest
+// representation.
+func hashNumeric(n numeric) string {
+	switch n.typ {
+	case Int64:
+		return "i" + strconv.FormatInt(n.ival, 10)
+	case Uint64:
+		if n.uval <= math.MaxInt64 {
+			return "i" + strconv.FormatInt(int64(n.uval), 10)
+		}
+		return "u" + strconv.FormatUint(n.uval, 10)
+	}
+	if n.fval == math.Trunc(n.fval) && n.fval >= math.MinInt64 && n.fval < math.MaxInt64 {
+		return "i" + strconv.FormatInt(int64(n.fval), 10)
+	}
+	if key, err := hashDecimal(strconv.FormatFloat(n.fval, 'f', -1, 64)); err == nil {
+		return key
+	}
+	return "f" + strconv.FormatFloat(n.fval, 'g', -1, 64)
+}
+
+// hashDecimal normalizes the decimal str by removing its leading and
+// trailing zeros. Integral decimals hash like the equal integer.
+func hashDecimal(str string) (string, error) {
+	sign, digits := "", str
+	switch {
+	case strings.HasPrefix(digits, "-"):
+		sign, digits = "-", digits[1:]
+	case strings.HasPrefix(digits, "+"):
+		digits = digits[1:]
+	}
+	intPart, fracPart := digits, ""
+	if i := strings.IndexByte(digits, '.'); i != -1 {
+		intPart, fracPart = digits[:i], digits[i+1:]
+	}
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "could not parse decimal: %s", str)
+	}
+	intPart = strings.TrimLeft(intPart, "0")
+	fracPart = strings.TrimRight(fracPart, "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	if intPart == "0" && fracPart == "" {
+		sign = ""
+	}
+	if fracPart != "" {
+		return "d" + sign + intPart + "." + fracPart, nil
+	}
+	if ival, err := strconv.ParseInt(sign+intPart, 10, 64); err == nil {
+		return "i" + strconv.FormatInt(ival, 10), nil
+	}
+	if sign == "" {
+		if uval, err := strconv.ParseUint(intPart, 10, 64); err == nil {
+			return "u" + strconv.FormatUint(uval, 10), nil
+		}
+	}
+	return "d" + sign + intPart, nil
+}
+
+// isDigits returns true if str only contains decimal digits.
+func isDigits(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// IsHashable returns true if NullsafeHashcode can hash the values
+// of type t, which are numbers, binary and date/time values.
+func IsHashable(t querypb.Type) bool {
+	if isNumber(t) || IsBinary(t) {
+		return true
+	}
+	switch t {
+	case Timestamp, Date, Time, Datetime:
+		return true
+	}
+	return false
+}
+
 // isByteComparable returns true if the type is binary or date/time.
 func isByteComparable(v Value) bool {
 	if v.IsBinary() {
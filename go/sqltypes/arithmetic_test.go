@@ -622,6 +622,143 @@ func TestNullsafeCompare(t *testing.T) {
 	}
 }
 
+func TestNullsafeHashcode(t *testing.T) {
+	tcases := []struct {
+		v1, v2 Value
+		equal  bool
+		err    string
+	}{{
+		v1:    NULL,
+		v2:    NULL,
+		equal: true,
+	}, {
+		v1:    NewInt64(1),
+		v2:    NewUint64(1),
+		equal: true,
+	}, {
+		v1:    NewInt64(1),
+		v2:    NewFloat64(1.0),
+		equal: true,
+	}, {
+		v1:    TestValue(Decimal, "1.00"),
+		v2:    NewInt64(1),
+		equal: true,
+	}, {
+		v1:    NewFloat64(1.5),
+		v2:    TestValue(Decimal, "1.50"),
+		equal: true,
+	}, {
+		v1:    TestValue(Decimal, "12345678901234567.1"),
+		v2:    TestValue(Decimal, "12345678901234567.2"),
+		equal: false,
+	}, {
+		v1:    TestValue(Decimal, "12345678901234567.0"),
+		v2:    NewInt64(12345678901234567),
+		equal: true,
+	}, {
+		v1:    TestValue(Decimal, "12345678901234567.1"),
+		v2:    NewInt64(12345678901234567),
+		equal: false,
+	}, {
+		v1:    TestValue(Decimal, "18446744073709551615.000"),
+		v2:    NewUint64(18446744073709551615),
+		equal: true,
+	}, {
+		v1:    TestValue(Decimal, "-00123456789012345678901234.5600"),
+		v2:    TestValue(Decimal, "-123456789012345678901234.56"),
+		equal: true,
+	}, {
+		v1:    TestValue(Decimal, "123456789012345678901234"),
+		v2:    TestValue(Decimal, "123456789012345678901235"),
+		equal: false,
+	}, {
+		v1:    TestValue(Decimal, "-0.00"),
+		v2:    NewInt64(0),
+		equal: true,
+	}, {
+		v1:    TestValue(Decimal, ".5"),
+		v2:    NewFloat64(0.5),
+		equal: true,
+	}, {
+		v1:    NewFloat64(0.1),
+		v2:    TestValue(Decimal, "0.10"),
+		equal: true,
+	}, {
+		v1:    NewInt64(1),
+		v2:    NewInt64(2),
+		equal: false,
+	}, {
+		v1:    NewInt64(-1),
+		v2:    NewUint64(18446744073709551615),
+		equal: false,
+	}, {
+		v1:    TestValue(VarBinary, "abcd"),
+		v2:    TestValue(Binary, "abcd"),
+		equal: true,
+	}, {
+		v1:    TestValue(VarBinary, "abcd"),
+		v2:    TestValue(VarBinary, "ABCD"),
+		equal: false,
+	}, {
+		v1:    TestValue(Datetime, "1000-01-01 00:00:00"),
+		v2:    TestValue(Binary, "1000-01-01 00:00:00"),
+		equal: true,
+	}, {
+		v1:  TestValue(VarChar, "abcd"),
+		v2:  TestValue(VarChar, "abcd"),
+		err: "types are not hashable: VARCHAR",
+	}, {
+		v1:  TestValue(Int64, "1.2"),
+		v2:  NewInt64(1),
+		err: "strconv.ParseInt: parsing \"1.2\": invalid syntax",
+	}, {
+		v1:  TestValue(Decimal, "1.2e3"),
+		v2:  NewInt64(1),
+		err: "could not parse decimal: 1.2e3",
+	}}
+	for _, tcase := range tcases {
+		h1, err := NullsafeHashcode(tcase.v1)
+		if err != nil {
+			if err.Error() != tcase.err {
+				t.Errorf("NullsafeHashcode(%v) error: %v, want %s", printValue(tcase.v1), err, tcase.err)
+			}
+			continue
+		}
+		if tcase.err != "" {
+			t.Errorf("NullsafeHashcode(%v) error: nil, want %s", printValue(tcase.v1), tcase.err)
+			continue
+		}
+		h2, err := NullsafeHashcode(tcase.v2)
+		if err != nil {
+			t.Errorf("NullsafeHashcode(%v) error: %v", printValue(tcase.v2), err)
+			continue
+		}
+		if got := h1 == h2; got != tcase.equal {
+			t.Errorf("NullsafeHashcode(%v) == NullsafeHashcode(%v): %v, want %v", printValue(tcase.v1), printValue(tcase.v2), got, tcase.equal)
+		}
+	}
+}
+
+func TestIsHashable(t *testing.T) {
+	for _, typ := range []querypb.Type{Int64, Uint32, Float64, Decimal, VarBinary, Blob, Datetime} {
+		if !IsHashable(typ) {
+			t.Errorf("IsHashable(%v): false, want true", typ)
+		}
+		v := TestValue(typ, "1")
+		if typ == Datetime {
+			v = TestValue(typ, "2019-01-01 00:00:00")
+		}
+		if _, err := NullsafeHashcode(v); err != nil {
+			t.Errorf("NullsafeHashcode(%v): %v", printValue(v), err)
+		}
+	}
+	for _, typ := range []querypb.Type{VarChar, Text, Char, Null, Expression} {
+		if IsHashable(typ) {
+			t.Errorf("IsHashable(%v): true, want false", typ)
+		}
+	}
+}
+
 func TestCast(t *testing.T) {
 	tcases := []struct {
 		typ querypb.Type
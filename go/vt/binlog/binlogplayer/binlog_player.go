@@ -81,6 +81,9 @@ type Stats struct {
 	History             *history.History
 
 	State sync2.AtomicString
+
+	copyProgressMu sync.Mutex
+	copyProgress   map[string]CopyProgress
 }
 
 // SetLastPosition sets the last replication position.
@@ -97,6 +100,42 @@ func (bps *Stats) LastPosition() mysql.Position {
 	return bps.lastPosition
 }
 
+// SetCopyProgress replaces the copy progress of all the tables.
+func (bps *Stats) SetCopyProgress(progress map[string]CopyProgress) {
+	bps.copyProgressMu.Lock()
+	defer bps.copyProgressMu.Unlock()
+	bps.copyProgress = progress
+}
+
+// UpdateCopyProgress sets the copy progress of a table.
+func (bps *Stats) UpdateCopyProgress(table string, progress CopyProgress) {
+	bps.copyProgressMu.Lock()
+	defer bps.copyProgressMu.Unlock()
+	if bps.copyProgress == nil {
+		bps.copyProgress = make(map[string]CopyProgress)
+	}
+	bps.copyProgress[table] = progress
+}
+
+// DeleteCopyProgress removes a table whose copy is complete.
+func (bps *Stats) DeleteCopyProgress(table string) {
+	bps.copyProgressMu.Lock()
+	defer bps.copyProgressMu.Unlock()
+	delete(bps.copyProgress, table)
+}
+
+// CopyProgress returns the copy progress of the tables
+// that remain to be copied.
+func (bps *Stats) CopyProgress() map[string]CopyProgress {
+	bps.copyProgressMu.Lock()
+	defer bps.copyProgressMu.Unlock()
+	result := make(map[string]CopyProgress, len(bps.copyProgress))
+	for table, progress := range bps.copyProgress {
+		result[table] = progress
+	}
+	return result
+}
+
 // MessageHistory gets all the messages, we store 3 at a time
 func (bps *Stats) MessageHistory() []string {
 	strs := make([]string, 0, 3)
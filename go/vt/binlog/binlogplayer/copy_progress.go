@@ -0,0 +1,127 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binlogplayer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CopyProgress is the progress of the copy of a table by a
+// vreplication stream, as recorded in _vt.copy_state.
+// The estimates come from the information_schema of the source,
+// which means that they can be inaccurate.
+type CopyProgress struct {
+	RowsEstimated  int64
+	BytesEstimated int64
+	RowsCopied     int64
+	BytesCopied    int64
+	// TimeStarted and TimeUpdated are the unix times at which
+	// the first and the last rows were copied. TimeStarted is
+	// zero if the copy of the table hasn't started yet.
+	TimeStarted int64
+	TimeUpdated int64
+}
+
+// Rate returns the number of rows copied per second.
+// It's zero if it can't be computed yet.
+func (cp CopyProgress) Rate() float64 {
+	elapsed := cp.TimeUpdated - cp.TimeStarted
+	if cp.TimeStarted == 0 || elapsed <= 0 {
+		return 0
+	}
+	return float64(cp.RowsCopied) / float64(elapsed)
+}
+
+// RowsRemaining returns the estimated number of rows
+// that remain to be copied.
+func (cp CopyProgress) RowsRemaining() int64 {
+	if cp.RowsCopied >= cp.RowsEstimated {
+		return 0
+	}
+	return cp.RowsEstimated - cp.RowsCopied
+}
+
+// Percent returns the estimated percentage of rows copied.
+// It's zero if there is no estimate.
+func (cp CopyProgress) Percent() float64 {
+	if cp.RowsEstimated <= 0 {
+		return 0
+	}
+	if cp.RowsCopied >= cp.RowsEstimated {
+		return 100
+	}
+	return float64(cp.RowsCopied) * 100 / float64(cp.RowsEstimated)
+}
+
+// Add combines the progress of two copies of the same table that
+// run in parallel, like the chunks of a table or the streams of a
+// workflow. The combined rate is the total number of rows copied
+// over the time elapsed since the first copy started.
+func (cp CopyProgress) Add(other CopyProgress) CopyProgress {
+	result := CopyProgress{
+		RowsEstimated:  cp.RowsEstimated + other.RowsEstimated,
+		BytesEstimated: cp.BytesEstimated + other.BytesEstimated,
+		RowsCopied:     cp.RowsCopied + other.RowsCopied,
+		BytesCopied:    cp.BytesCopied + other.BytesCopied,
+		TimeStarted:    cp.TimeStarted,
+		TimeUpdated:    cp.TimeUpdated,
+	}
+	if result.TimeStarted == 0 || (other.TimeStarted != 0 && other.TimeStarted < result.TimeStarted) {
+		result.TimeStarted = other.TimeStarted
+	}
+	if other.TimeUpdated > result.TimeUpdated {
+		result.TimeUpdated = other.TimeUpdated
+	}
+	return result
+}
+
+// String returns a summary of the progress.
+func (cp CopyProgress) String() string {
+	var buf strings.Builder
+	if cp.RowsEstimated > 0 {
+		fmt.Fprintf(&buf, "%d/%d rows (%.1f%%)", cp.RowsCopied, cp.RowsEstimated, cp.Percent())
+	} else {
+		fmt.Fprintf(&buf, "%d rows", cp.RowsCopied)
+	}
+	if rate := cp.Rate(); rate > 0 {
+		fmt.Fprintf(&buf, ", %.0f rows/s", rate)
+	}
+	if eta := CopyETA([]CopyProgress{cp}); eta > 0 {
+		fmt.Fprintf(&buf, ", ETA %v", eta)
+	}
+	return buf.String()
+}
+
+// CopyETA returns the estimated time needed to copy the remaining
+// rows of the tables of a stream. The tables that are being copied
+// are assumed to keep their rates, which add up if a stream copies
+// several tables in parallel. CopyETA returns zero if the time can't
+// be estimated.
+func CopyETA(tables []CopyProgress) time.Duration {
+	var rate float64
+	var remaining int64
+	for _, cp := range tables {
+		rate += cp.Rate()
+		remaining += cp.RowsRemaining()
+	}
+	if rate == 0 {
+		return 0
+	}
+	return time.Duration(float64(remaining)/rate) * time.Second
+}
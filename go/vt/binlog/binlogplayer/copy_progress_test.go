@@ -0,0 +1,116 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binlogplayer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCopyProgress(t *testing.T) {
+	testcases := []struct {
+		in      CopyProgress
+		rate    float64
+		percent float64
+		out     string
+	}{{
+		// Not started.
+		in:  CopyProgress{RowsEstimated: 100},
+		out: "0/100 rows (0.0%)",
+	}, {
+		// No estimate.
+		in:   CopyProgress{RowsCopied: 20, TimeStarted: 100, TimeUpdated: 110},
+		rate: 2,
+		out:  "20 rows, 2 rows/s",
+	}, {
+		in:      CopyProgress{RowsEstimated: 100, RowsCopied: 20, TimeStarted: 100, TimeUpdated: 110},
+		rate:    2,
+		percent: 20,
+		out:     "20/100 rows (20.0%), 2 rows/s, ETA 40s",
+	}, {
+		// The estimate was too low.
+		in:      CopyProgress{RowsEstimated: 100, RowsCopied: 150, TimeStarted: 100, TimeUpdated: 110},
+		rate:    15,
+		percent: 100,
+		out:     "150/100 rows (100.0%), 15 rows/s",
+	}}
+	for _, tcase := range testcases {
+		if got := tcase.in.Rate(); got != tcase.rate {
+			t.Errorf("%+v.Rate(): %v, want %v", tcase.in, got, tcase.rate)
+		}
+		if got := tcase.in.Percent(); got != tcase.percent {
+			t.Errorf("%+v.Percent(): %v, want %v", tcase.in, got, tcase.percent)
+		}
+		if got := tcase.in.String(); got != tcase.out {
+			t.Errorf("%+v.String(): %q, want %q", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func TestCopyETA(t *testing.T) {
+	tables := []CopyProgress{
+		{RowsEstimated: 100, RowsCopied: 20, TimeStarted: 100, TimeUpdated: 110},
+		{RowsEstimated: 120},
+	}
+	if got, want := CopyETA(tables), 100*time.Second; got != want {
+		t.Errorf("CopyETA: %v, want %v", got, want)
+	}
+	if got := CopyETA(tables[1:]); got != 0 {
+		t.Errorf("CopyETA without a rate: %v, want 0", got)
+	}
+	// Tables that are copied in parallel add up their rates.
+	tables[1] = CopyProgress{RowsEstimated: 120, RowsCopied: 20, TimeStarted: 100, TimeUpdated: 110}
+	if got, want := CopyETA(tables), 45*time.Second; got != want {
+		t.Errorf("CopyETA in parallel: %v, want %v", got, want)
+	}
+}
+
+func TestCopyProgressAdd(t *testing.T) {
+	left := CopyProgress{RowsEstimated: 100, BytesEstimated: 1000, RowsCopied: 20, BytesCopied: 200, TimeStarted: 110, TimeUpdated: 120}
+	right := CopyProgress{RowsEstimated: 50, BytesEstimated: 500, RowsCopied: 10, BytesCopied: 100, TimeStarted: 100, TimeUpdated: 115}
+	want := CopyProgress{RowsEstimated: 150, BytesEstimated: 1500, RowsCopied: 30, BytesCopied: 300, TimeStarted: 100, TimeUpdated: 120}
+	if got := left.Add(right); got != want {
+		t.Errorf("Add: %+v, want %+v", got, want)
+	}
+	// A copy that hasn't started doesn't change the start time.
+	want = CopyProgress{RowsEstimated: 200, BytesEstimated: 2000, RowsCopied: 20, BytesCopied: 200, TimeStarted: 110, TimeUpdated: 120}
+	if got := left.Add(CopyProgress{RowsEstimated: 100, BytesEstimated: 1000}); got != want {
+		t.Errorf("Add: %+v, want %+v", got, want)
+	}
+	if got := (CopyProgress{}).Add(left); got != left {
+		t.Errorf("Add: %+v, want %+v", got, left)
+	}
+}
+
+func TestStatsCopyProgress(t *testing.T) {
+	bps := NewStats()
+	bps.SetCopyProgress(map[string]CopyProgress{
+		"t1": {RowsEstimated: 10},
+		"t2": {RowsEstimated: 20},
+	})
+	bps.UpdateCopyProgress("t1", CopyProgress{RowsEstimated: 10, RowsCopied: 5})
+	bps.DeleteCopyProgress("t2")
+	got := bps.CopyProgress()
+	if len(got) != 1 || got["t1"].RowsCopied != 5 {
+		t.Errorf("CopyProgress: %v, want only t1 with 5 rows copied", got)
+	}
+	// The result is a copy.
+	got["t3"] = CopyProgress{}
+	if len(bps.CopyProgress()) != 1 {
+		t.Errorf("CopyProgress was modified by the caller")
+	}
+}
@@ -86,6 +86,13 @@ const (
 	// GTIDs.
 	VEventType_VGTID   VEventType = 15
 	VEventType_JOURNAL VEventType = 16
+	// LASTPK is generated by VTGate's VStream while it copies the
+	// existing rows of a table. It contains the last primary key
+	// that was copied.
+	VEventType_LASTPK VEventType = 17
+	// COPY_COMPLETED is generated by VTGate's VStream after all the
+	// tables of a shard have been copied.
+	VEventType_COPY_COMPLETED VEventType = 18
 )
 
 var VEventType_name = map[int32]string{
@@ -106,26 +113,30 @@ var VEventType_name = map[int32]string{
 	14: "HEARTBEAT",
 	15: "VGTID",
 	16: "JOURNAL",
+	17: "LASTPK",
+	18: "COPY_COMPLETED",
 }
 
 var VEventType_value = map[string]int32{
-	"UNKNOWN":   0,
-	"GTID":      1,
-	"BEGIN":     2,
-	"COMMIT":    3,
-	"ROLLBACK":  4,
-	"DDL":       5,
-	"INSERT":    6,
-	"REPLACE":   7,
-	"UPDATE":    8,
-	"DELETE":    9,
-	"SET":       10,
-	"OTHER":     11,
-	"ROW":       12,
-	"FIELD":     13,
-	"HEARTBEAT": 14,
-	"VGTID":     15,
-	"JOURNAL":   16,
+	"UNKNOWN":        0,
+	"GTID":           1,
+	"BEGIN":          2,
+	"COMMIT":         3,
+	"ROLLBACK":       4,
+	"DDL":            5,
+	"INSERT":         6,
+	"REPLACE":        7,
+	"UPDATE":         8,
+	"DELETE":         9,
+	"SET":            10,
+	"OTHER":          11,
+	"ROW":            12,
+	"FIELD":          13,
+	"HEARTBEAT":      14,
+	"VGTID":          15,
+	"JOURNAL":        16,
+	"LASTPK":         17,
+	"COPY_COMPLETED": 18,
 }
 
 func (x VEventType) String() string {
@@ -751,7 +762,11 @@ type BinlogSource struct {
 	ExternalMysql string `protobuf:"bytes,8,opt,name=external_mysql,json=externalMysql,proto3" json:"external_mysql,omitempty"`
 	// StopAfterCopy specifies if vreplication should be stopped
 	// after copying is done.
-	StopAfterCopy        bool     `protobuf:"varint,9,opt,name=stop_after_copy,json=stopAfterCopy,proto3" json:"stop_after_copy,omitempty"`
+	StopAfterCopy bool `protobuf:"varint,9,opt,name=stop_after_copy,json=stopAfterCopy,proto3" json:"stop_after_copy,omitempty"`
+	// CopyConcurrency is the number of tables, or chunks of tables,
+	// that are copied in parallel. The tables are copied one at a
+	// time if it's less than 2.
+	CopyConcurrency      int64    `protobuf:"varint,10,opt,name=copy_concurrency,json=copyConcurrency,proto3" json:"copy_concurrency,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -845,6 +860,13 @@ func (m *BinlogSource) GetStopAfterCopy() bool {
 	return false
 }
 
+func (m *BinlogSource) GetCopyConcurrency() int64 {
+	if m != nil {
+		return m.CopyConcurrency
+	}
+	return 0
+}
+
 // RowChange represents one row change.
 // If Before is set and not After, it's a delete.
 // If After is set and not Before, it's an insert.
@@ -998,12 +1020,16 @@ func (m *FieldEvent) GetFields() []*query.Field {
 // of a shard. It's also used in a Journal to indicate the
 // list of targets and shard positions to migrate to.
 type ShardGtid struct {
-	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
-	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
-	Gtid                 string   `protobuf:"bytes,3,opt,name=gtid,proto3" json:"gtid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Gtid     string `protobuf:"bytes,3,opt,name=gtid,proto3" json:"gtid,omitempty"`
+	// Tablepks is the list of tables of the shard that are yet to be
+	// copied, along with the last primary key copied so far. It's used
+	// by VTGate's VStream to resume the copy of the tables.
+	Tablepks             []*TableLastPK `protobuf:"bytes,4,rep,name=tablepks,proto3" json:"tablepks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *ShardGtid) Reset()         { *m = ShardGtid{} }
@@ -1052,6 +1078,13 @@ func (m *ShardGtid) GetGtid() string {
 	return ""
 }
 
+func (m *ShardGtid) GetTablepks() []*TableLastPK {
+	if m != nil {
+		return m.Tablepks
+	}
+	return nil
+}
+
 // A VGtid is a list of ShardGtids.
 type VGtid struct {
 	ShardGtids           []*ShardGtid `protobuf:"bytes,1,rep,name=shard_gtids,json=shardGtids,proto3" json:"shard_gtids,omitempty"`
@@ -1275,10 +1308,13 @@ type VEvent struct {
 	Dml string `protobuf:"bytes,9,opt,name=dml,proto3" json:"dml,omitempty"`
 	// CurrentTime specifies the current time when the message was sent.
 	// This can be used to compenssate for clock skew.
-	CurrentTime          int64    `protobuf:"varint,20,opt,name=current_time,json=currentTime,proto3" json:"current_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CurrentTime int64 `protobuf:"varint,20,opt,name=current_time,json=currentTime,proto3" json:"current_time,omitempty"`
+	// LastpkEvent is set if the event type is LASTPK.
+	// This event is only generated by VTGate's VStream function.
+	LastpkEvent          *LastPKEvent `protobuf:"bytes,21,opt,name=lastpk_event,json=lastpkEvent,proto3" json:"lastpk_event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *VEvent) Reset()         { *m = VEvent{} }
@@ -1376,6 +1412,13 @@ func (m *VEvent) GetCurrentTime() int64 {
 	return 0
 }
 
+func (m *VEvent) GetLastpkEvent() *LastPKEvent {
+	if m != nil {
+		return m.LastpkEvent
+	}
+	return nil
+}
+
 // VStreamRequest is the payload for VStreamer
 type VStreamRequest struct {
 	EffectiveCallerId    *vtrpc.CallerID       `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
@@ -1754,6 +1797,299 @@ func (m *VStreamResultsResponse) GetRows() []*query.Row {
 	return nil
 }
 
+// TableLastPK is the last primary key copied from a table.
+// A nil Lastpk means that no rows have been copied yet.
+type TableLastPK struct {
+	TableName            string             `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Lastpk               *query.QueryResult `protobuf:"bytes,2,opt,name=lastpk,proto3" json:"lastpk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TableLastPK) Reset()         { *m = TableLastPK{} }
+func (m *TableLastPK) String() string { return proto.CompactTextString(m) }
+func (*TableLastPK) ProtoMessage()    {}
+func (*TableLastPK) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fd02bcb2e350dad, []int{23}
+}
+
+func (m *TableLastPK) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TableLastPK.Unmarshal(m, b)
+}
+func (m *TableLastPK) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TableLastPK.Marshal(b, m, deterministic)
+}
+func (m *TableLastPK) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableLastPK.Merge(m, src)
+}
+func (m *TableLastPK) XXX_Size() int {
+	return xxx_messageInfo_TableLastPK.Size(m)
+}
+func (m *TableLastPK) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableLastPK.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableLastPK proto.InternalMessageInfo
+
+func (m *TableLastPK) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *TableLastPK) GetLastpk() *query.QueryResult {
+	if m != nil {
+		return m.Lastpk
+	}
+	return nil
+}
+
+// LastPKEvent reports the progress of the copy of a table.
+// Completed is set once all the rows of the table have been copied.
+type LastPKEvent struct {
+	TableLastpk          *TableLastPK `protobuf:"bytes,1,opt,name=table_lastpk,json=tableLastpk,proto3" json:"table_lastpk,omitempty"`
+	Completed            bool         `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *LastPKEvent) Reset()         { *m = LastPKEvent{} }
+func (m *LastPKEvent) String() string { return proto.CompactTextString(m) }
+func (*LastPKEvent) ProtoMessage()    {}
+func (*LastPKEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fd02bcb2e350dad, []int{24}
+}
+
+func (m *LastPKEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LastPKEvent.Unmarshal(m, b)
+}
+func (m *LastPKEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LastPKEvent.Marshal(b, m, deterministic)
+}
+func (m *LastPKEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastPKEvent.Merge(m, src)
+}
+func (m *LastPKEvent) XXX_Size() int {
+	return xxx_messageInfo_LastPKEvent.Size(m)
+}
+func (m *LastPKEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastPKEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastPKEvent proto.InternalMessageInfo
+
+func (m *LastPKEvent) GetTableLastpk() *TableLastPK {
+	if m != nil {
+		return m.TableLastpk
+	}
+	return nil
+}
+
+func (m *LastPKEvent) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+// MinimalTable is the definition of a table, as recorded in the
+// schema version history.
+type MinimalTable struct {
+	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fields               []*query.Field `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	PKColumns            []int64        `protobuf:"varint,3,rep,packed,name=p_k_columns,json=pKColumns,proto3" json:"p_k_columns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MinimalTable) Reset()         { *m = MinimalTable{} }
+func (m *MinimalTable) String() string { return proto.CompactTextString(m) }
+func (*MinimalTable) ProtoMessage()    {}
+func (*MinimalTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fd02bcb2e350dad, []int{25}
+}
+
+func (m *MinimalTable) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MinimalTable.Unmarshal(m, b)
+}
+func (m *MinimalTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MinimalTable.Marshal(b, m, deterministic)
+}
+func (m *MinimalTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinimalTable.Merge(m, src)
+}
+func (m *MinimalTable) XXX_Size() int {
+	return xxx_messageInfo_MinimalTable.Size(m)
+}
+func (m *MinimalTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinimalTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinimalTable proto.InternalMessageInfo
+
+func (m *MinimalTable) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MinimalTable) GetFields() []*query.Field {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *MinimalTable) GetPKColumns() []int64 {
+	if m != nil {
+		return m.PKColumns
+	}
+	return nil
+}
+
+// MinimalSchema is the definition of all the tables of a tablet
+// as of a position, as recorded in _vt.schema_version.
+type MinimalSchema struct {
+	Tables               []*MinimalTable `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MinimalSchema) Reset()         { *m = MinimalSchema{} }
+func (m *MinimalSchema) String() string { return proto.CompactTextString(m) }
+func (*MinimalSchema) ProtoMessage()    {}
+func (*MinimalSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fd02bcb2e350dad, []int{26}
+}
+
+func (m *MinimalSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MinimalSchema.Unmarshal(m, b)
+}
+func (m *MinimalSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MinimalSchema.Marshal(b, m, deterministic)
+}
+func (m *MinimalSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinimalSchema.Merge(m, src)
+}
+func (m *MinimalSchema) XXX_Size() int {
+	return xxx_messageInfo_MinimalSchema.Size(m)
+}
+func (m *MinimalSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinimalSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinimalSchema proto.InternalMessageInfo
+
+func (m *MinimalSchema) GetTables() []*MinimalTable {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+// GetSchemaRequest is the payload for GetSchema.
+type GetSchemaRequest struct {
+	EffectiveCallerId    *vtrpc.CallerID       `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId    *query.VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target               *query.Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetSchemaRequest) Reset()         { *m = GetSchemaRequest{} }
+func (m *GetSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaRequest) ProtoMessage()    {}
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fd02bcb2e350dad, []int{27}
+}
+
+func (m *GetSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaRequest.Unmarshal(m, b)
+}
+func (m *GetSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaRequest.Merge(m, src)
+}
+func (m *GetSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaRequest.Size(m)
+}
+func (m *GetSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaRequest proto.InternalMessageInfo
+
+func (m *GetSchemaRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *GetSchemaRequest) GetImmediateCallerId() *query.VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *GetSchemaRequest) GetTarget() *query.Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+// GetSchemaResponse is the response from GetSchema. It contains
+// the tables currently loaded by the tablet's schema engine.
+type GetSchemaResponse struct {
+	Tables               []*MinimalTable `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetSchemaResponse) Reset()         { *m = GetSchemaResponse{} }
+func (m *GetSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaResponse) ProtoMessage()    {}
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fd02bcb2e350dad, []int{28}
+}
+
+func (m *GetSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaResponse.Unmarshal(m, b)
+}
+func (m *GetSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaResponse.Merge(m, src)
+}
+func (m *GetSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaResponse.Size(m)
+}
+func (m *GetSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaResponse proto.InternalMessageInfo
+
+func (m *GetSchemaResponse) GetTables() []*MinimalTable {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("binlogdata.OnDDLAction", OnDDLAction_name, OnDDLAction_value)
 	proto.RegisterEnum("binlogdata.VEventType", VEventType_name, VEventType_value)
@@ -1784,117 +2120,138 @@ func init() {
 	proto.RegisterType((*VStreamRowsResponse)(nil), "binlogdata.VStreamRowsResponse")
 	proto.RegisterType((*VStreamResultsRequest)(nil), "binlogdata.VStreamResultsRequest")
 	proto.RegisterType((*VStreamResultsResponse)(nil), "binlogdata.VStreamResultsResponse")
+	proto.RegisterType((*TableLastPK)(nil), "binlogdata.TableLastPK")
+	proto.RegisterType((*LastPKEvent)(nil), "binlogdata.LastPKEvent")
+	proto.RegisterType((*MinimalTable)(nil), "binlogdata.MinimalTable")
+	proto.RegisterType((*MinimalSchema)(nil), "binlogdata.MinimalSchema")
+	proto.RegisterType((*GetSchemaRequest)(nil), "binlogdata.GetSchemaRequest")
+	proto.RegisterType((*GetSchemaResponse)(nil), "binlogdata.GetSchemaResponse")
 }
 
 func init() { proto.RegisterFile("binlogdata.proto", fileDescriptor_5fd02bcb2e350dad) }
 
 var fileDescriptor_5fd02bcb2e350dad = []byte{
-	// 1709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x73, 0x23, 0x49,
-	0x11, 0x9e, 0xd6, 0x5b, 0xd9, 0xb6, 0xdc, 0x2e, 0x3f, 0x10, 0x13, 0x2c, 0xe1, 0xed, 0x60, 0x76,
-	0xbc, 0x8e, 0x40, 0x06, 0x01, 0xc3, 0x69, 0x59, 0xf4, 0x68, 0x7b, 0x34, 0xd3, 0x92, 0x3c, 0xa5,
-	0x1e, 0x0f, 0xb1, 0x97, 0x8e, 0xb6, 0x54, 0xf6, 0x34, 0xee, 0xd7, 0x74, 0x97, 0xec, 0xd5, 0x0f,
-	0x20, 0xf8, 0x01, 0xfc, 0x0a, 0xce, 0x5c, 0xe1, 0xca, 0x9d, 0x3b, 0x57, 0x4e, 0x9c, 0xf8, 0x07,
-	0x44, 0x3d, 0xba, 0xd5, 0xad, 0x59, 0x76, 0x3c, 0x1b, 0xc1, 0x01, 0x2e, 0x8a, 0xac, 0xec, 0xcc,
-	0xac, 0xcc, 0x2f, 0x1f, 0x55, 0x25, 0xd0, 0xae, 0xdc, 0xc0, 0x0b, 0x6f, 0x16, 0x0e, 0x75, 0x3a,
-	0x51, 0x1c, 0xd2, 0x10, 0xc1, 0x9a, 0xf3, 0x58, 0xbd, 0xa3, 0x71, 0x34, 0x17, 0x1f, 0x1e, 0xab,
-	0xef, 0x96, 0x24, 0x5e, 0xc9, 0x45, 0x8b, 0x86, 0x51, 0xb8, 0xd6, 0xd2, 0xc7, 0x50, 0x1f, 0xbc,
-	0x75, 0xe2, 0x84, 0x50, 0x74, 0x08, 0xb5, 0xb9, 0xe7, 0x92, 0x80, 0xb6, 0x95, 0x23, 0xe5, 0xb8,
-	0x8a, 0xe5, 0x0a, 0x21, 0xa8, 0xcc, 0xc3, 0x20, 0x68, 0x97, 0x38, 0x97, 0xd3, 0x4c, 0x36, 0x21,
-	0xf1, 0x1d, 0x89, 0xdb, 0x65, 0x21, 0x2b, 0x56, 0xfa, 0x3f, 0xca, 0xb0, 0xdb, 0xe7, 0x7e, 0x58,
-	0xb1, 0x13, 0x24, 0xce, 0x9c, 0xba, 0x61, 0x80, 0xce, 0x01, 0x12, 0xea, 0x50, 0xe2, 0x93, 0x80,
-	0x26, 0x6d, 0xe5, 0xa8, 0x7c, 0xac, 0x76, 0x9f, 0x76, 0x72, 0x11, 0xbc, 0xa7, 0xd2, 0x99, 0xa5,
-	0xf2, 0x38, 0xa7, 0x8a, 0xba, 0xa0, 0x92, 0x3b, 0x12, 0x50, 0x9b, 0x86, 0xb7, 0x24, 0x68, 0x57,
-	0x8e, 0x94, 0x63, 0xb5, 0xbb, 0xdb, 0x11, 0x01, 0x1a, 0xec, 0x8b, 0xc5, 0x3e, 0x60, 0x20, 0x19,
-	0xfd, 0xf8, 0xaf, 0x25, 0x68, 0x66, 0xd6, 0x90, 0x09, 0x8d, 0xb9, 0x43, 0xc9, 0x4d, 0x18, 0xaf,
-	0x78, 0x98, 0xad, 0xee, 0x4f, 0x1e, 0xe8, 0x48, 0x67, 0x20, 0xf5, 0x70, 0x66, 0x01, 0xfd, 0x18,
-	0xea, 0x73, 0x81, 0x1e, 0x47, 0x47, 0xed, 0xee, 0xe5, 0x8d, 0x49, 0x60, 0x71, 0x2a, 0x83, 0x34,
-	0x28, 0x27, 0xef, 0x3c, 0x0e, 0xd9, 0x16, 0x66, 0xa4, 0xfe, 0x47, 0x05, 0x1a, 0xa9, 0x5d, 0xb4,
-	0x07, 0x3b, 0x7d, 0xd3, 0x7e, 0x3d, 0xc1, 0xc6, 0x60, 0x7a, 0x3e, 0x19, 0x7d, 0x65, 0x0c, 0xb5,
-	0x47, 0x68, 0x0b, 0x1a, 0x7d, 0xd3, 0xee, 0x1b, 0xe7, 0xa3, 0x89, 0xa6, 0xa0, 0x6d, 0x68, 0xf6,
-	0x4d, 0x7b, 0x30, 0x1d, 0x8f, 0x47, 0x96, 0x56, 0x42, 0x3b, 0xa0, 0xf6, 0x4d, 0x1b, 0x4f, 0x4d,
-	0xb3, 0xdf, 0x1b, 0xbc, 0xd4, 0xca, 0xe8, 0x00, 0x76, 0xfb, 0xa6, 0x3d, 0x1c, 0x9b, 0xf6, 0xd0,
-	0xb8, 0xc0, 0xc6, 0xa0, 0x67, 0x19, 0x43, 0xad, 0x82, 0x00, 0x6a, 0x8c, 0x3d, 0x34, 0xb5, 0xaa,
-	0xa4, 0x67, 0x86, 0xa5, 0xd5, 0xa4, 0xb9, 0xd1, 0x64, 0x66, 0x60, 0x4b, 0xab, 0xcb, 0xe5, 0xeb,
-	0x8b, 0x61, 0xcf, 0x32, 0xb4, 0x86, 0x5c, 0x0e, 0x0d, 0xd3, 0xb0, 0x0c, 0xad, 0xf9, 0xa2, 0xd2,
-	0x28, 0x69, 0xe5, 0x17, 0x95, 0x46, 0x59, 0xab, 0xe8, 0x7f, 0x50, 0xe0, 0x60, 0x46, 0x63, 0xe2,
-	0xf8, 0x2f, 0xc9, 0x0a, 0x3b, 0xc1, 0x0d, 0xc1, 0xe4, 0xdd, 0x92, 0x24, 0x14, 0x3d, 0x86, 0x46,
-	0x14, 0x26, 0x2e, 0xc3, 0x8e, 0x03, 0xdc, 0xc4, 0xd9, 0x1a, 0x9d, 0x42, 0xf3, 0x96, 0xac, 0xec,
-	0x98, 0xc9, 0x4b, 0xc0, 0x50, 0x27, 0x2b, 0xc8, 0xcc, 0x52, 0xe3, 0x56, 0x52, 0x79, 0x7c, 0xcb,
-	0x1f, 0xc6, 0x57, 0xbf, 0x86, 0xc3, 0x4d, 0xa7, 0x92, 0x28, 0x0c, 0x12, 0x82, 0x4c, 0x40, 0x42,
-	0xd1, 0xa6, 0xeb, 0xdc, 0x72, 0xff, 0xd4, 0xee, 0x27, 0xdf, 0x5a, 0x00, 0x78, 0xf7, 0x6a, 0x93,
-	0xa5, 0x7f, 0x0d, 0x7b, 0x62, 0x1f, 0xcb, 0xb9, 0xf2, 0x48, 0xf2, 0x90, 0xd0, 0x0f, 0xa1, 0x46,
-	0xb9, 0x70, 0xbb, 0x74, 0x54, 0x3e, 0x6e, 0x62, 0xb9, 0xfa, 0xd8, 0x08, 0x17, 0xb0, 0x5f, 0xdc,
-	0xf9, 0xbf, 0x12, 0xdf, 0xcf, 0xa1, 0x82, 0x97, 0x1e, 0x41, 0xfb, 0x50, 0xf5, 0x1d, 0x3a, 0x7f,
-	0x2b, 0xa3, 0x11, 0x0b, 0x16, 0xca, 0xb5, 0xeb, 0x51, 0x12, 0xf3, 0x14, 0x36, 0xb1, 0x5c, 0xe9,
-	0x7f, 0x52, 0xa0, 0x76, 0xc6, 0x49, 0xf4, 0x19, 0x54, 0xe3, 0x25, 0x0b, 0x56, 0xf4, 0xba, 0x96,
-	0xf7, 0x80, 0x59, 0xc6, 0xe2, 0x33, 0x1a, 0x41, 0xeb, 0xda, 0x25, 0xde, 0x82, 0xb7, 0xee, 0x38,
-	0x5c, 0x88, 0xaa, 0x68, 0x75, 0x3f, 0xcd, 0x2b, 0x08, 0x9b, 0x9d, 0xb3, 0x82, 0x20, 0xde, 0x50,
-	0xd4, 0x9f, 0x41, 0xab, 0x28, 0xc1, 0xda, 0xc9, 0xc0, 0xd8, 0x9e, 0x4e, 0xec, 0xf1, 0x68, 0x36,
-	0xee, 0x59, 0x83, 0xe7, 0xda, 0x23, 0xde, 0x31, 0xc6, 0xcc, 0xb2, 0x8d, 0xb3, 0xb3, 0x29, 0xb6,
-	0x34, 0x45, 0xff, 0x67, 0x09, 0xb6, 0x04, 0x28, 0xb3, 0x70, 0x19, 0xcf, 0x09, 0xcb, 0xe2, 0x2d,
-	0x59, 0x25, 0x91, 0x33, 0x27, 0x69, 0x16, 0xd3, 0x35, 0x03, 0x24, 0x79, 0xeb, 0xc4, 0x0b, 0x19,
-	0xb9, 0x58, 0xa0, 0x5f, 0x80, 0xca, 0xb3, 0x49, 0x6d, 0xba, 0x8a, 0x08, 0xcf, 0x63, 0xab, 0xbb,
-	0xbf, 0x2e, 0x6c, 0x9e, 0x2b, 0x6a, 0xad, 0x22, 0x82, 0x81, 0x66, 0x74, 0xb1, 0x1b, 0x2a, 0x0f,
-	0xe8, 0x86, 0x75, 0x0d, 0x55, 0x0b, 0x35, 0x74, 0x92, 0x25, 0xa4, 0x26, 0xad, 0xbc, 0x87, 0x5e,
-	0x9a, 0x24, 0xd4, 0x81, 0x5a, 0x18, 0xd8, 0x8b, 0x85, 0xd7, 0xae, 0x73, 0x37, 0xbf, 0x97, 0x97,
-	0x9d, 0x06, 0xc3, 0xa1, 0xd9, 0x13, 0x65, 0x51, 0x0d, 0x83, 0xe1, 0xc2, 0x43, 0x4f, 0xa0, 0x45,
-	0xbe, 0xa6, 0x24, 0x0e, 0x1c, 0xcf, 0xf6, 0x57, 0x6c, 0x7a, 0x35, 0x78, 0xe8, 0xdb, 0x29, 0x77,
-	0xcc, 0x98, 0xe8, 0x33, 0xd8, 0x49, 0x68, 0x18, 0xd9, 0xce, 0x35, 0x25, 0xb1, 0x3d, 0x0f, 0xa3,
-	0x55, 0xbb, 0x79, 0xa4, 0x1c, 0x37, 0xf0, 0x36, 0x63, 0xf7, 0x18, 0x77, 0x10, 0x46, 0x2b, 0xfd,
-	0x15, 0x34, 0x71, 0x78, 0x3f, 0x78, 0xcb, 0xe3, 0xd1, 0xa1, 0x76, 0x45, 0xae, 0xc3, 0x98, 0xc8,
-	0x42, 0x05, 0x39, 0xc8, 0x71, 0x78, 0x8f, 0xe5, 0x17, 0x74, 0x04, 0x55, 0x6e, 0x53, 0x8e, 0x8b,
-	0xbc, 0x88, 0xf8, 0xa0, 0x3b, 0xd0, 0xc0, 0xe1, 0x3d, 0x4f, 0x3b, 0xfa, 0x04, 0x04, 0xc0, 0x76,
-	0xe0, 0xf8, 0x69, 0xf6, 0x9a, 0x9c, 0x33, 0x71, 0x7c, 0x82, 0x9e, 0x81, 0x1a, 0x87, 0xf7, 0xf6,
-	0x9c, 0x6f, 0x2f, 0x3a, 0x51, 0xed, 0x1e, 0x14, 0x8a, 0x33, 0x75, 0x0e, 0x43, 0x9c, 0x92, 0x89,
-	0xfe, 0x0a, 0x60, 0x5d, 0x5b, 0x1f, 0xda, 0xe4, 0x47, 0x2c, 0x1b, 0xc4, 0x5b, 0xa4, 0xf6, 0xb7,
-	0xa4, 0xcb, 0xdc, 0x02, 0x96, 0xdf, 0x18, 0x10, 0x33, 0x56, 0x3c, 0xe7, 0xd4, 0x5d, 0x7c, 0x87,
-	0x92, 0x43, 0x50, 0xb9, 0xa1, 0xee, 0x82, 0xd7, 0x5a, 0x13, 0x73, 0x5a, 0xff, 0x12, 0xaa, 0x97,
-	0xdc, 0xdc, 0x33, 0x50, 0xb9, 0x94, 0xcd, 0xd8, 0x69, 0x0f, 0x16, 0xc2, 0xcc, 0xb6, 0xc6, 0x90,
-	0xa4, 0x64, 0xa2, 0xf7, 0x60, 0xfb, 0xa5, 0xdc, 0x96, 0x0b, 0x7c, 0xbc, 0x5f, 0xfa, 0x9f, 0x4b,
-	0x50, 0x7f, 0x11, 0x2e, 0x59, 0x61, 0xa0, 0x16, 0x94, 0xdc, 0x05, 0xd7, 0x2b, 0xe3, 0x92, 0xbb,
-	0x40, 0xbf, 0x86, 0x96, 0xef, 0xde, 0xc4, 0x0e, 0x2b, 0x2f, 0xd1, 0x29, 0xa2, 0xd9, 0xbf, 0x9f,
-	0xf7, 0x6c, 0x9c, 0x4a, 0xf0, 0x76, 0xd9, 0xf6, 0xf3, 0xcb, 0x5c, 0x03, 0x94, 0x0b, 0x0d, 0xf0,
-	0x04, 0x5a, 0x5e, 0x38, 0x77, 0x3c, 0x3b, 0x1b, 0xbf, 0x15, 0x51, 0xa4, 0x9c, 0x7b, 0x91, 0xce,
-	0xe0, 0x0d, 0x5c, 0xaa, 0x0f, 0xc4, 0x05, 0x7d, 0x01, 0x5b, 0x91, 0x13, 0x53, 0x77, 0xee, 0x46,
-	0x0e, 0xbb, 0xc0, 0xd4, 0xb8, 0x62, 0xc1, 0xed, 0x02, 0x6e, 0xb8, 0x20, 0x8e, 0x3e, 0x07, 0x2d,
-	0xe1, 0xa3, 0xc5, 0xbe, 0x0f, 0xe3, 0xdb, 0x6b, 0x2f, 0xbc, 0x4f, 0xda, 0x75, 0xee, 0xff, 0x8e,
-	0xe0, 0xbf, 0x49, 0xd9, 0xfa, 0xbf, 0x4a, 0x50, 0xbb, 0x14, 0x55, 0x76, 0x02, 0x15, 0x8e, 0x91,
-	0xb8, 0xa4, 0x1c, 0xe6, 0x37, 0x13, 0x12, 0x1c, 0x20, 0x2e, 0x83, 0x7e, 0x00, 0x4d, 0xea, 0xfa,
-	0x24, 0xa1, 0x8e, 0x1f, 0x71, 0x50, 0xcb, 0x78, 0xcd, 0xf8, 0xa6, 0x5a, 0x61, 0x37, 0x11, 0x36,
-	0x03, 0x04, 0x4c, 0x8c, 0x44, 0x3f, 0x85, 0x26, 0xeb, 0x0d, 0x7e, 0x71, 0x6a, 0x57, 0x79, 0xb3,
-	0xed, 0x6f, 0x74, 0x06, 0xdf, 0x16, 0x37, 0xe2, 0xb4, 0xdb, 0x7e, 0x09, 0x2a, 0xaf, 0x66, 0xa9,
-	0x24, 0x86, 0xcf, 0x61, 0x71, 0xf8, 0xa4, 0x5d, 0x83, 0x61, 0x3d, 0xaf, 0xd1, 0x53, 0xa8, 0xde,
-	0x71, 0x97, 0xea, 0xf2, 0x02, 0x97, 0x0f, 0x8e, 0xc3, 0x2f, 0xbe, 0xb3, 0xd3, 0xf1, 0xb7, 0xa2,
-	0x9a, 0xf8, 0xd8, 0xd9, 0x38, 0x1d, 0x65, 0xa1, 0xe1, 0x54, 0x86, 0x47, 0xe5, 0x7b, 0x7c, 0xf2,
-	0xb0, 0xa8, 0x7c, 0x0f, 0x7d, 0x0a, 0x5b, 0xf3, 0x65, 0x1c, 0xf3, 0x2b, 0xa3, 0xeb, 0x93, 0xf6,
-	0x3e, 0x07, 0x47, 0x95, 0x3c, 0xcb, 0xf5, 0x89, 0xfe, 0xfb, 0x12, 0xb4, 0x2e, 0xc5, 0xa1, 0x9a,
-	0x1e, 0xe4, 0x5f, 0xc2, 0x1e, 0xb9, 0xbe, 0x26, 0x73, 0xea, 0xde, 0x11, 0x7b, 0xee, 0x78, 0x1e,
-	0x89, 0x6d, 0x59, 0xca, 0x6a, 0x77, 0xa7, 0x23, 0x2e, 0xd7, 0x03, 0xce, 0x1f, 0x0d, 0xf1, 0x6e,
-	0x26, 0x2b, 0x59, 0x0b, 0x64, 0xc0, 0x9e, 0xeb, 0xfb, 0x64, 0xe1, 0x3a, 0x34, 0x6f, 0x40, 0xcc,
-	0xb0, 0x03, 0x39, 0x10, 0x2e, 0xad, 0x73, 0x87, 0x92, 0xb5, 0x99, 0x4c, 0x23, 0x33, 0xf3, 0x84,
-	0xd5, 0x7b, 0x7c, 0x93, 0xdd, 0x0d, 0xb6, 0xa5, 0xa6, 0xc5, 0x99, 0x58, 0x7e, 0x2c, 0xdc, 0x3b,
-	0x2a, 0x1b, 0xf7, 0x8e, 0xf5, 0xd9, 0x50, 0xfd, 0xd0, 0xd9, 0xa0, 0x7f, 0x01, 0x3b, 0x19, 0x10,
-	0xf2, 0x5e, 0x71, 0x02, 0x35, 0x9e, 0xdc, 0x74, 0x8a, 0xa0, 0xf7, 0xeb, 0x10, 0x4b, 0x09, 0xfd,
-	0x77, 0x25, 0x40, 0xa9, 0x7e, 0x78, 0x9f, 0xfc, 0x8f, 0x82, 0xb9, 0x0f, 0x55, 0xce, 0x97, 0x48,
-	0x8a, 0x05, 0xc3, 0xc1, 0x73, 0x12, 0x1a, 0xdd, 0x66, 0x30, 0x0a, 0xe5, 0x57, 0xec, 0x17, 0x93,
-	0x64, 0xe9, 0x51, 0x2c, 0x25, 0xf4, 0xbf, 0x28, 0xb0, 0x57, 0xc0, 0x41, 0x62, 0xb9, 0x3e, 0x18,
-	0x94, 0xff, 0x7c, 0x30, 0xa0, 0x63, 0x68, 0x44, 0xb7, 0xdf, 0x72, 0x80, 0x64, 0x5f, 0xbf, 0xb1,
-	0xaf, 0x7f, 0x08, 0x95, 0x98, 0xcd, 0x97, 0x0a, 0xd7, 0xcc, 0x9f, 0x96, 0x9c, 0xcf, 0x8e, 0xdc,
-	0x42, 0x1c, 0x85, 0x23, 0x57, 0xfa, 0xff, 0x77, 0x05, 0x0e, 0xd6, 0x75, 0xb0, 0xf4, 0xe8, 0xff,
-	0x55, 0x2a, 0xf5, 0x18, 0x0e, 0x37, 0xa3, 0xfb, 0xa8, 0x04, 0x7d, 0x07, 0xd8, 0x4f, 0x7e, 0x05,
-	0x6a, 0xee, 0x6e, 0xc5, 0x9e, 0x60, 0xa3, 0xf3, 0xc9, 0x14, 0x1b, 0xda, 0x23, 0xd4, 0x80, 0xca,
-	0xcc, 0x9a, 0x5e, 0x68, 0x0a, 0xa3, 0x8c, 0xdf, 0x18, 0x03, 0xf1, 0xac, 0x63, 0x94, 0x2d, 0x85,
-	0xca, 0x27, 0x7f, 0x53, 0x00, 0xd6, 0x53, 0x1f, 0xa9, 0x50, 0x7f, 0x3d, 0x79, 0x39, 0x99, 0xbe,
-	0x99, 0x08, 0x03, 0xe7, 0xd6, 0x68, 0xa8, 0x29, 0xa8, 0x09, 0x55, 0xf1, 0x4e, 0x2c, 0xb1, 0x1d,
-	0xe4, 0x23, 0xb1, 0xcc, 0x5e, 0x90, 0xd9, 0x0b, 0xb1, 0x82, 0xea, 0x50, 0xce, 0xde, 0x81, 0xf2,
-	0xe1, 0x57, 0x63, 0x06, 0xb1, 0x71, 0x61, 0xf6, 0x06, 0x86, 0x56, 0x67, 0x1f, 0xb2, 0x27, 0x20,
-	0x40, 0x2d, 0x7d, 0xff, 0x31, 0x4d, 0xf6, 0x6a, 0x04, 0xb6, 0xcf, 0xd4, 0x7a, 0x6e, 0x60, 0x4d,
-	0x65, 0x3c, 0x3c, 0x7d, 0xa3, 0x6d, 0x31, 0xde, 0xd9, 0xc8, 0x30, 0x87, 0xda, 0x36, 0x7b, 0x36,
-	0x3e, 0x37, 0x7a, 0xd8, 0xea, 0x1b, 0x3d, 0x4b, 0x6b, 0xb1, 0x2f, 0x97, 0xdc, 0xc1, 0x1d, 0xb6,
-	0xcd, 0x8b, 0xe9, 0x6b, 0x3c, 0xe9, 0x99, 0x9a, 0x76, 0xf2, 0x14, 0xb6, 0x0b, 0x87, 0x3d, 0xdb,
-	0xcb, 0xea, 0xf5, 0x4d, 0x63, 0xa6, 0x3d, 0x62, 0xf4, 0xec, 0x79, 0x0f, 0x0f, 0x67, 0x9a, 0xd2,
-	0xff, 0xfc, 0xab, 0xa7, 0x77, 0x2e, 0x25, 0x49, 0xd2, 0x71, 0xc3, 0x53, 0x41, 0x9d, 0xde, 0x84,
-	0xa7, 0x77, 0xf4, 0x94, 0xff, 0x85, 0x71, 0xba, 0x9e, 0x48, 0x57, 0x35, 0xce, 0xf9, 0xd9, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x78, 0x33, 0x5b, 0xba, 0x1e, 0x11, 0x00, 0x00,
+	// 1938 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x72, 0xe3, 0xc6,
+	0xf1, 0x5f, 0x10, 0xfc, 0x6c, 0x48, 0x14, 0x34, 0xfa, 0xf8, 0xf3, 0xbf, 0x15, 0xbb, 0x64, 0x54,
+	0xd6, 0x2b, 0xab, 0x2a, 0x94, 0x43, 0x27, 0x9b, 0xaa, 0x54, 0x39, 0x0e, 0x3f, 0xb0, 0x5a, 0xae,
+	0xf8, 0xa1, 0x1d, 0x62, 0x77, 0x1d, 0x5f, 0x50, 0x10, 0x38, 0x92, 0x10, 0x82, 0x00, 0x16, 0x18,
+	0x4a, 0xe6, 0x03, 0x24, 0x79, 0x80, 0x54, 0xe5, 0x1d, 0x72, 0xc8, 0x29, 0xd7, 0xe4, 0x90, 0x4b,
+	0x9e, 0x22, 0xd7, 0x3c, 0x40, 0xde, 0x20, 0x35, 0x1f, 0x00, 0x41, 0xc9, 0xb1, 0xb4, 0xae, 0xca,
+	0xc1, 0xb9, 0xb0, 0x7a, 0x1a, 0x3d, 0x3d, 0xdd, 0xbf, 0xfe, 0x98, 0xe6, 0x80, 0x7e, 0xee, 0x05,
+	0x7e, 0x78, 0x39, 0x75, 0xa8, 0xd3, 0x8c, 0xe2, 0x90, 0x86, 0x08, 0x56, 0x9c, 0xc7, 0xda, 0x35,
+	0x8d, 0x23, 0x57, 0x7c, 0x78, 0xac, 0xbd, 0x5b, 0x90, 0x78, 0x29, 0x17, 0x75, 0x1a, 0x46, 0xe1,
+	0x6a, 0x97, 0x31, 0x84, 0x4a, 0xf7, 0xca, 0x89, 0x13, 0x42, 0xd1, 0x3e, 0x94, 0x5d, 0xdf, 0x23,
+	0x01, 0x6d, 0x28, 0x07, 0xca, 0x61, 0x09, 0xcb, 0x15, 0x42, 0x50, 0x74, 0xc3, 0x20, 0x68, 0x14,
+	0x38, 0x97, 0xd3, 0x4c, 0x36, 0x21, 0xf1, 0x35, 0x89, 0x1b, 0xaa, 0x90, 0x15, 0x2b, 0xe3, 0x9f,
+	0x2a, 0x6c, 0x77, 0xb8, 0x1d, 0x56, 0xec, 0x04, 0x89, 0xe3, 0x52, 0x2f, 0x0c, 0xd0, 0x09, 0x40,
+	0x42, 0x1d, 0x4a, 0xe6, 0x24, 0xa0, 0x49, 0x43, 0x39, 0x50, 0x0f, 0xb5, 0xd6, 0xd3, 0x66, 0xce,
+	0x83, 0x3b, 0x5b, 0x9a, 0x93, 0x54, 0x1e, 0xe7, 0xb6, 0xa2, 0x16, 0x68, 0xe4, 0x9a, 0x04, 0xd4,
+	0xa6, 0xe1, 0x8c, 0x04, 0x8d, 0xe2, 0x81, 0x72, 0xa8, 0xb5, 0xb6, 0x9b, 0xc2, 0x41, 0x93, 0x7d,
+	0xb1, 0xd8, 0x07, 0x0c, 0x24, 0xa3, 0x1f, 0xff, 0xbd, 0x00, 0xb5, 0x4c, 0x1b, 0x1a, 0x40, 0xd5,
+	0x75, 0x28, 0xb9, 0x0c, 0xe3, 0x25, 0x77, 0xb3, 0xde, 0xfa, 0xf4, 0x81, 0x86, 0x34, 0xbb, 0x72,
+	0x1f, 0xce, 0x34, 0xa0, 0x1f, 0x41, 0xc5, 0x15, 0xe8, 0x71, 0x74, 0xb4, 0xd6, 0x4e, 0x5e, 0x99,
+	0x04, 0x16, 0xa7, 0x32, 0x48, 0x07, 0x35, 0x79, 0xe7, 0x73, 0xc8, 0x36, 0x30, 0x23, 0x8d, 0x3f,
+	0x2a, 0x50, 0x4d, 0xf5, 0xa2, 0x1d, 0xd8, 0xea, 0x0c, 0xec, 0xd7, 0x23, 0x6c, 0x76, 0xc7, 0x27,
+	0xa3, 0xfe, 0x57, 0x66, 0x4f, 0x7f, 0x84, 0x36, 0xa0, 0xda, 0x19, 0xd8, 0x1d, 0xf3, 0xa4, 0x3f,
+	0xd2, 0x15, 0xb4, 0x09, 0xb5, 0xce, 0xc0, 0xee, 0x8e, 0x87, 0xc3, 0xbe, 0xa5, 0x17, 0xd0, 0x16,
+	0x68, 0x9d, 0x81, 0x8d, 0xc7, 0x83, 0x41, 0xa7, 0xdd, 0x3d, 0xd5, 0x55, 0xb4, 0x07, 0xdb, 0x9d,
+	0x81, 0xdd, 0x1b, 0x0e, 0xec, 0x9e, 0x79, 0x86, 0xcd, 0x6e, 0xdb, 0x32, 0x7b, 0x7a, 0x11, 0x01,
+	0x94, 0x19, 0xbb, 0x37, 0xd0, 0x4b, 0x92, 0x9e, 0x98, 0x96, 0x5e, 0x96, 0xea, 0xfa, 0xa3, 0x89,
+	0x89, 0x2d, 0xbd, 0x22, 0x97, 0xaf, 0xcf, 0x7a, 0x6d, 0xcb, 0xd4, 0xab, 0x72, 0xd9, 0x33, 0x07,
+	0xa6, 0x65, 0xea, 0xb5, 0x97, 0xc5, 0x6a, 0x41, 0x57, 0x5f, 0x16, 0xab, 0xaa, 0x5e, 0x34, 0x7e,
+	0xaf, 0xc0, 0xde, 0x84, 0xc6, 0xc4, 0x99, 0x9f, 0x92, 0x25, 0x76, 0x82, 0x4b, 0x82, 0xc9, 0xbb,
+	0x05, 0x49, 0x28, 0x7a, 0x0c, 0xd5, 0x28, 0x4c, 0x3c, 0x86, 0x1d, 0x07, 0xb8, 0x86, 0xb3, 0x35,
+	0x3a, 0x86, 0xda, 0x8c, 0x2c, 0xed, 0x98, 0xc9, 0x4b, 0xc0, 0x50, 0x33, 0x4b, 0xc8, 0x4c, 0x53,
+	0x75, 0x26, 0xa9, 0x3c, 0xbe, 0xea, 0xfd, 0xf8, 0x1a, 0x17, 0xb0, 0x7f, 0xdb, 0xa8, 0x24, 0x0a,
+	0x83, 0x84, 0xa0, 0x01, 0x20, 0xb1, 0xd1, 0xa6, 0xab, 0xd8, 0x72, 0xfb, 0xb4, 0xd6, 0x07, 0xdf,
+	0x9a, 0x00, 0x78, 0xfb, 0xfc, 0x36, 0xcb, 0xf8, 0x1a, 0x76, 0xc4, 0x39, 0x96, 0x73, 0xee, 0x93,
+	0xe4, 0x21, 0xae, 0xef, 0x43, 0x99, 0x72, 0xe1, 0x46, 0xe1, 0x40, 0x3d, 0xac, 0x61, 0xb9, 0x7a,
+	0x5f, 0x0f, 0xa7, 0xb0, 0xbb, 0x7e, 0xf2, 0x7f, 0xc5, 0xbf, 0x9f, 0x40, 0x11, 0x2f, 0x7c, 0x82,
+	0x76, 0xa1, 0x34, 0x77, 0xa8, 0x7b, 0x25, 0xbd, 0x11, 0x0b, 0xe6, 0xca, 0x85, 0xe7, 0x53, 0x12,
+	0xf3, 0x10, 0xd6, 0xb0, 0x5c, 0x19, 0x7f, 0x56, 0xa0, 0xfc, 0x9c, 0x93, 0xe8, 0x63, 0x28, 0xc5,
+	0x0b, 0x9f, 0xa4, 0xb5, 0xae, 0xe7, 0x2d, 0x60, 0x9a, 0xb1, 0xf8, 0x8c, 0xfa, 0x50, 0xbf, 0xf0,
+	0x88, 0x3f, 0xe5, 0xa5, 0x3b, 0x0c, 0xa7, 0x22, 0x2b, 0xea, 0xad, 0x8f, 0xf2, 0x1b, 0x84, 0xce,
+	0xe6, 0xf3, 0x35, 0x41, 0x7c, 0x6b, 0xa3, 0xf1, 0x0c, 0xea, 0xeb, 0x12, 0xac, 0x9c, 0x4c, 0x8c,
+	0xed, 0xf1, 0xc8, 0x1e, 0xf6, 0x27, 0xc3, 0xb6, 0xd5, 0x7d, 0xa1, 0x3f, 0xe2, 0x15, 0x63, 0x4e,
+	0x2c, 0xdb, 0x7c, 0xfe, 0x7c, 0x8c, 0x2d, 0x5d, 0x31, 0xfe, 0xa0, 0xc2, 0x86, 0x00, 0x65, 0x12,
+	0x2e, 0x62, 0x97, 0xb0, 0x28, 0xce, 0xc8, 0x32, 0x89, 0x1c, 0x97, 0xa4, 0x51, 0x4c, 0xd7, 0x0c,
+	0x90, 0xe4, 0xca, 0x89, 0xa7, 0xd2, 0x73, 0xb1, 0x40, 0x3f, 0x05, 0x8d, 0x47, 0x93, 0xda, 0x74,
+	0x19, 0x11, 0x1e, 0xc7, 0x7a, 0x6b, 0x77, 0x95, 0xd8, 0x3c, 0x56, 0xd4, 0x5a, 0x46, 0x04, 0x03,
+	0xcd, 0xe8, 0xf5, 0x6a, 0x28, 0x3e, 0xa0, 0x1a, 0x56, 0x39, 0x54, 0x5a, 0xcb, 0xa1, 0xa3, 0x2c,
+	0x20, 0x65, 0xa9, 0xe5, 0x0e, 0x7a, 0x69, 0x90, 0x50, 0x13, 0xca, 0x61, 0x60, 0x4f, 0xa7, 0x7e,
+	0xa3, 0xc2, 0xcd, 0xfc, 0xbf, 0xbc, 0xec, 0x38, 0xe8, 0xf5, 0x06, 0x6d, 0x91, 0x16, 0xa5, 0x30,
+	0xe8, 0x4d, 0x7d, 0xf4, 0x04, 0xea, 0xe4, 0x6b, 0x4a, 0xe2, 0xc0, 0xf1, 0xed, 0xf9, 0x92, 0x75,
+	0xaf, 0x2a, 0x77, 0x7d, 0x33, 0xe5, 0x0e, 0x19, 0x13, 0x7d, 0x0c, 0x5b, 0x09, 0x0d, 0x23, 0xdb,
+	0xb9, 0xa0, 0x24, 0xb6, 0xdd, 0x30, 0x5a, 0x36, 0x6a, 0x07, 0xca, 0x61, 0x15, 0x6f, 0x32, 0x76,
+	0x9b, 0x71, 0xbb, 0x61, 0xb4, 0x44, 0x9f, 0x80, 0xce, 0x3e, 0xda, 0x6e, 0x18, 0xb8, 0x8b, 0x38,
+	0x26, 0x81, 0xbb, 0x6c, 0xc0, 0x81, 0x72, 0xa8, 0xe2, 0x2d, 0xc6, 0xef, 0xae, 0xd8, 0xc6, 0x2b,
+	0xa8, 0xe1, 0xf0, 0xa6, 0x7b, 0xc5, 0x5d, 0x37, 0xa0, 0x7c, 0x4e, 0x2e, 0xc2, 0x98, 0xc8, 0x9c,
+	0x06, 0xd9, 0xf3, 0x71, 0x78, 0x83, 0xe5, 0x17, 0x74, 0x00, 0x25, 0x7e, 0x7c, 0xa3, 0x70, 0x47,
+	0x44, 0x7c, 0x30, 0x1c, 0xa8, 0xe2, 0xf0, 0x86, 0x67, 0x08, 0xfa, 0x00, 0x44, 0x2c, 0xec, 0xc0,
+	0x99, 0xa7, 0x81, 0xae, 0x71, 0xce, 0xc8, 0x99, 0x13, 0xf4, 0x0c, 0xb4, 0x38, 0xbc, 0xb1, 0x5d,
+	0x7e, 0xbc, 0x28, 0x5a, 0xad, 0xb5, 0xb7, 0x96, 0xc7, 0xa9, 0x71, 0x18, 0xe2, 0x94, 0x4c, 0x8c,
+	0x57, 0x00, 0xab, 0x34, 0xbc, 0xef, 0x90, 0x1f, 0xb2, 0xc0, 0x11, 0x7f, 0x9a, 0xea, 0xdf, 0x90,
+	0x26, 0x73, 0x0d, 0x58, 0x7e, 0x33, 0x7e, 0xab, 0x40, 0x6d, 0xc2, 0x12, 0xed, 0x84, 0x7a, 0xd3,
+	0xef, 0x90, 0x9e, 0x08, 0x8a, 0x97, 0xd4, 0x9b, 0xf2, 0xbc, 0xac, 0x61, 0x4e, 0xa3, 0xcf, 0xa0,
+	0xca, 0xcd, 0x88, 0x66, 0x49, 0xa3, 0xc8, 0xcf, 0x5e, 0x4b, 0x04, 0x9e, 0xb1, 0x03, 0x27, 0xa1,
+	0x67, 0xa7, 0x38, 0x13, 0x34, 0xbe, 0x80, 0xd2, 0x1b, 0x6e, 0xc3, 0x33, 0xd0, 0xb8, 0x6a, 0x9b,
+	0xe9, 0x4a, 0x8b, 0x7c, 0x0d, 0x9c, 0xcc, 0x5e, 0x0c, 0x49, 0x4a, 0x26, 0x46, 0x1b, 0x36, 0x4f,
+	0xa5, 0xad, 0x5c, 0xe0, 0xfd, 0x9d, 0x31, 0xfe, 0x52, 0x80, 0xca, 0xcb, 0x70, 0xc1, 0x32, 0x0f,
+	0xd5, 0xa1, 0xe0, 0x4d, 0xf9, 0x3e, 0x15, 0x17, 0xbc, 0x29, 0xfa, 0x25, 0xd4, 0xe7, 0xde, 0x65,
+	0xec, 0xb0, 0xfc, 0x15, 0xa5, 0x28, 0xba, 0xc9, 0xff, 0xe7, 0x2d, 0x1b, 0xa6, 0x12, 0xbc, 0x1e,
+	0x37, 0xe7, 0xf9, 0x65, 0xae, 0xc2, 0xd4, 0xb5, 0x0a, 0x7b, 0x02, 0x75, 0x3f, 0x74, 0x1d, 0xdf,
+	0xce, 0xfa, 0x7b, 0x51, 0x54, 0x01, 0xe7, 0x9e, 0x49, 0xe6, 0x6d, 0x5c, 0x4a, 0x0f, 0xc4, 0x05,
+	0x7d, 0x0e, 0x1b, 0x91, 0x13, 0x53, 0xcf, 0xf5, 0x22, 0x87, 0x4d, 0x48, 0x65, 0xbe, 0x71, 0xcd,
+	0xec, 0x35, 0xdc, 0xf0, 0x9a, 0x38, 0x2b, 0xaa, 0x84, 0xf7, 0x2e, 0xfb, 0x26, 0x8c, 0x67, 0x17,
+	0x7e, 0x78, 0x93, 0x34, 0x2a, 0xdc, 0xfe, 0x2d, 0xc1, 0x7f, 0x9b, 0xb2, 0x8d, 0x3f, 0xa9, 0x50,
+	0x7e, 0x23, 0x72, 0xf3, 0x08, 0x8a, 0x1c, 0x23, 0x31, 0x05, 0xed, 0xe7, 0x0f, 0x13, 0x12, 0x1c,
+	0x20, 0x2e, 0x83, 0x7e, 0x00, 0x35, 0xea, 0xcd, 0x49, 0x42, 0x9d, 0x79, 0xc4, 0x41, 0x55, 0xf1,
+	0x8a, 0xf1, 0x8d, 0x09, 0xa6, 0x83, 0xca, 0x9a, 0x8c, 0x80, 0x89, 0x91, 0xe8, 0xc7, 0x50, 0x63,
+	0x15, 0xc5, 0x27, 0xb3, 0x46, 0x89, 0x97, 0xe8, 0xee, 0xad, 0x7a, 0xe2, 0xc7, 0xe2, 0x6a, 0x2c,
+	0x29, 0xf4, 0x33, 0xd0, 0x78, 0x0d, 0xc8, 0x4d, 0xa2, 0xbb, 0xed, 0xaf, 0x77, 0xb7, 0xb4, 0xd6,
+	0x30, 0xac, 0x2e, 0x04, 0xf4, 0x14, 0x4a, 0xd7, 0xdc, 0xa4, 0x8a, 0x9c, 0x10, 0xf3, 0xce, 0x71,
+	0xf8, 0xc5, 0x77, 0x76, 0xfd, 0xfe, 0x5a, 0x64, 0x53, 0xa3, 0x7a, 0xf7, 0xfa, 0x95, 0x89, 0x86,
+	0x53, 0x19, 0xee, 0xd5, 0xdc, 0x6f, 0xd4, 0xa4, 0x57, 0x73, 0x1f, 0x7d, 0x04, 0x1b, 0xa2, 0x63,
+	0x51, 0x9b, 0x01, 0xd2, 0xd8, 0xe5, 0xe0, 0x68, 0x92, 0x67, 0x79, 0x73, 0x82, 0x7e, 0x0e, 0x1b,
+	0xbe, 0x93, 0xd0, 0x68, 0x26, 0xdd, 0xd8, 0x3b, 0x50, 0x6e, 0xd7, 0x9b, 0x28, 0x35, 0xe1, 0x87,
+	0x26, 0x84, 0xf9, 0xc2, 0xf8, 0x5d, 0x01, 0xea, 0x6f, 0xc4, 0x8d, 0x9f, 0x4e, 0x19, 0x5f, 0xc0,
+	0x0e, 0xb9, 0xb8, 0x20, 0x2e, 0xf5, 0xae, 0x89, 0xed, 0x3a, 0xbe, 0x4f, 0x62, 0x5b, 0x96, 0x81,
+	0xd6, 0xda, 0x6a, 0x8a, 0xc9, 0xbf, 0xcb, 0xf9, 0xfd, 0x1e, 0xde, 0xce, 0x64, 0x25, 0x6b, 0x8a,
+	0x4c, 0xd8, 0xf1, 0xe6, 0x73, 0x32, 0xf5, 0x1c, 0x9a, 0x57, 0x20, 0xba, 0xe6, 0x9e, 0x6c, 0x41,
+	0x6f, 0xac, 0x13, 0x87, 0x92, 0x95, 0x9a, 0x6c, 0x47, 0xa6, 0xe6, 0x09, 0xab, 0x95, 0xf8, 0x32,
+	0x1b, 0x5c, 0x36, 0xe5, 0x4e, 0x8b, 0x33, 0xb1, 0xfc, 0xb8, 0x36, 0x14, 0x15, 0x6f, 0x0d, 0x45,
+	0xab, 0x8b, 0xab, 0x74, 0xdf, 0xc5, 0x65, 0x7c, 0x0e, 0x5b, 0x19, 0x10, 0x72, 0xe8, 0x39, 0x82,
+	0x32, 0x47, 0x34, 0xed, 0x40, 0xe8, 0x6e, 0x0e, 0x63, 0x29, 0x61, 0xfc, 0xa6, 0x00, 0x28, 0xdd,
+	0x1f, 0xde, 0x24, 0xdf, 0x53, 0x30, 0x77, 0xa1, 0xc4, 0xf9, 0x12, 0x49, 0xb1, 0x60, 0x38, 0x88,
+	0x9c, 0xc9, 0x60, 0x14, 0x9b, 0x5f, 0xb1, 0x5f, 0x4c, 0x92, 0x85, 0x4f, 0xb1, 0x94, 0x30, 0xfe,
+	0xaa, 0xc0, 0xce, 0x1a, 0x0e, 0x12, 0xcb, 0xd5, 0x55, 0xa4, 0xfc, 0xe7, 0xab, 0x08, 0x1d, 0x42,
+	0x35, 0x9a, 0x7d, 0xcb, 0x95, 0x95, 0x7d, 0xfd, 0xc6, 0x9e, 0xf0, 0x21, 0x14, 0xe3, 0xf0, 0x26,
+	0xbd, 0x70, 0xf2, 0xf7, 0x33, 0xe7, 0xb3, 0x4b, 0x7e, 0xcd, 0x8f, 0xb5, 0x4b, 0x5e, 0xda, 0xff,
+	0x0f, 0x05, 0xf6, 0x56, 0x79, 0xb0, 0xf0, 0xe9, 0xff, 0x54, 0x28, 0x8d, 0x18, 0xf6, 0x6f, 0x7b,
+	0xf7, 0x5e, 0x01, 0xfa, 0x0e, 0xb0, 0x1b, 0x5f, 0x82, 0x96, 0xbb, 0xef, 0xef, 0x9b, 0x59, 0x56,
+	0xc9, 0x56, 0xb8, 0x37, 0xd9, 0x2e, 0x41, 0xcb, 0x75, 0x36, 0xd6, 0x08, 0x85, 0x66, 0xa9, 0x40,
+	0xb9, 0xdb, 0x08, 0xf3, 0x83, 0x87, 0x46, 0xd3, 0x45, 0x34, 0x63, 0x37, 0x90, 0x1b, 0xce, 0x23,
+	0x9f, 0x50, 0x22, 0x42, 0x52, 0xc5, 0x2b, 0x86, 0x71, 0x05, 0x1b, 0x43, 0x2f, 0xf0, 0xe6, 0x8e,
+	0xcf, 0x15, 0x30, 0x18, 0x72, 0xd6, 0x73, 0xfa, 0x61, 0xc3, 0x16, 0xfa, 0x10, 0xb4, 0xc8, 0x9e,
+	0xd9, 0x6e, 0xe8, 0x2f, 0xe6, 0x81, 0x18, 0x03, 0x54, 0x5c, 0x8b, 0x4e, 0xbb, 0x82, 0xc1, 0x46,
+	0x18, 0x79, 0xd2, 0xc4, 0xbd, 0x22, 0x73, 0x07, 0x7d, 0x9a, 0x8d, 0x0c, 0x22, 0x2e, 0x8d, 0xf5,
+	0x61, 0x63, 0x65, 0x54, 0x3a, 0x4c, 0x18, 0x7f, 0x53, 0x40, 0x3f, 0x21, 0x54, 0xec, 0xff, 0x7e,
+	0x66, 0xaf, 0x61, 0xc2, 0x76, 0xce, 0x05, 0x99, 0xa2, 0xef, 0x0d, 0xc5, 0xd1, 0x2f, 0x40, 0xcb,
+	0xfd, 0xe7, 0x60, 0x4f, 0x13, 0xfd, 0x93, 0xd1, 0x18, 0x9b, 0xfa, 0x23, 0x54, 0x85, 0xe2, 0xc4,
+	0x1a, 0x9f, 0xe9, 0x0a, 0xa3, 0xcc, 0x2f, 0xcd, 0xae, 0x78, 0xee, 0x60, 0x94, 0x2d, 0x85, 0xd4,
+	0xa3, 0x7f, 0x29, 0x00, 0xab, 0x61, 0x05, 0x69, 0x50, 0x79, 0x3d, 0x3a, 0x1d, 0x8d, 0xdf, 0x8e,
+	0x84, 0x82, 0x13, 0xab, 0xdf, 0xd3, 0x15, 0x54, 0x83, 0x92, 0x78, 0x3f, 0x29, 0xb0, 0x13, 0xe4,
+	0xe3, 0x89, 0xca, 0x5e, 0x56, 0xb2, 0x97, 0x93, 0x22, 0xaa, 0x80, 0x9a, 0xbd, 0x8f, 0xc8, 0x07,
+	0x91, 0x32, 0x53, 0x88, 0xcd, 0xb3, 0x41, 0xbb, 0x6b, 0xea, 0x15, 0xf6, 0x21, 0x7b, 0x1a, 0x01,
+	0x28, 0xa7, 0xef, 0x22, 0x6c, 0x27, 0x7b, 0x4d, 0x01, 0x76, 0xce, 0xd8, 0x7a, 0x61, 0x62, 0x5d,
+	0x63, 0x3c, 0x3c, 0x7e, 0xab, 0x6f, 0x30, 0xde, 0xf3, 0xbe, 0x39, 0xe8, 0xe9, 0x9b, 0xec, 0x39,
+	0xe5, 0x85, 0xd9, 0xc6, 0x56, 0xc7, 0x6c, 0x5b, 0x7a, 0x9d, 0x7d, 0x79, 0xc3, 0x0d, 0xdc, 0x62,
+	0xc7, 0xbc, 0x1c, 0xbf, 0xc6, 0xa3, 0xf6, 0x40, 0xd7, 0x99, 0xea, 0x41, 0x7b, 0x62, 0x9d, 0x9d,
+	0xea, 0xdb, 0x08, 0x41, 0xbd, 0x3b, 0x3e, 0xfb, 0x15, 0x7b, 0xf0, 0x39, 0x63, 0xa7, 0xf5, 0x74,
+	0x74, 0xf4, 0x94, 0x65, 0x60, 0x7e, 0x68, 0x05, 0x28, 0x5b, 0xed, 0xce, 0xc0, 0x9c, 0xe8, 0x8f,
+	0x18, 0x3d, 0x79, 0xd1, 0xc6, 0xbd, 0x89, 0xae, 0x74, 0x3e, 0xf9, 0xea, 0xe9, 0xb5, 0x47, 0x49,
+	0x92, 0x34, 0xbd, 0xf0, 0x58, 0x50, 0xc7, 0x97, 0xe1, 0xf1, 0x35, 0x3d, 0xe6, 0x4f, 0x7f, 0xc7,
+	0xab, 0xe0, 0x9c, 0x97, 0x39, 0xe7, 0xb3, 0x7f, 0x0f, 0x00, 0xae, 0xda, 0x71, 0x02, 0x56, 0x14,
+	0x00, 0x00,
 }
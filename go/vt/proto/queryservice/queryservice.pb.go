@@ -30,42 +30,43 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("queryservice.proto", fileDescriptor_4bd2dde8711f22e3) }
 
 var fileDescriptor_4bd2dde8711f22e3 = []byte{
-	// 554 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x86, 0xe1, 0xd0, 0x06, 0x4d, 0xd2, 0x52, 0xb6, 0x14, 0xa8, 0x53, 0xd2, 0x8f, 0x1b, 0x42,
-	0x4a, 0x10, 0x20, 0x21, 0x55, 0xe2, 0xd0, 0x44, 0x54, 0x20, 0xc4, 0x57, 0x0a, 0x15, 0x02, 0x09,
-	0x69, 0xe3, 0x8c, 0x82, 0x55, 0xc7, 0x9b, 0x7a, 0xd7, 0x29, 0xfc, 0x09, 0x7e, 0x33, 0xaa, 0xed,
-	0x19, 0xef, 0x6e, 0xec, 0xde, 0xb2, 0xef, 0x3b, 0xf3, 0x64, 0xbc, 0xe3, 0x19, 0x83, 0xb8, 0xcc,
-	0x30, 0xfd, 0xab, 0x31, 0x5d, 0x46, 0x21, 0xf6, 0x17, 0xa9, 0x32, 0x4a, 0x74, 0x6c, 0x2d, 0x68,
-	0xe7, 0xa7, 0xc2, 0x0a, 0xb6, 0x26, 0x51, 0x12, 0xab, 0xd9, 0x54, 0x1a, 0x59, 0x28, 0xcf, 0xff,
-	0x6d, 0xc0, 0xda, 0x97, 0xeb, 0x08, 0x71, 0x0c, 0xad, 0x37, 0x7f, 0x30, 0xcc, 0x0c, 0x8a, 0x9d,
-	0x7e, 0x91, 0x54, 0x9e, 0xc7, 0x78, 0x99, 0xa1, 0x36, 0xc1, 0x03, 0x5f, 0xd6, 0x0b, 0x95, 0x68,
-	0x3c, 0xba, 0x25, 0xde, 0x41, 0xa7, 0x14, 0x87, 0xd2, 0x84, 0xbf, 0x45, 0xe0, 0x46, 0xe6, 0x22,
-	0x51, 0xba, 0xb5, 0x1e, 0xa3, 0x3e, 0xc2, 0xc6, 0x99, 0x49, 0x51, 0xce, 0xa9, 0x18, 0x8a, 0x77,
-	0x54, 0x82, 0xed, 0xd5, 0x9b, 0x44, 0x7b, 0x76, 0x5b, 0xbc, 0x84, 0xb5, 0x21, 0xce, 0xa2, 0x44,
-	0x6c, 0x97, 0xa1, 0xf9, 0x89, 0xf2, 0xef, 0xbb, 0x22, 0x57, 0xf1, 0x0a, 0xd6, 0x47, 0x6a, 0x3e,
-	0x8f, 0x8c, 0xa0, 0x88, 0xe2, 0x48, 0x79, 0x3b, 0x9e, 0xca, 0x89, 0xaf, 0xe1, 0xce, 0x58, 0xc5,
-	0xf1, 0x44, 0x86, 0x17, 0x82, 0xee, 0x8b, 0x04, 0x4a, 0x7e, 0xb8, 0xa2, 0x73, 0xfa, 0x31, 0xb4,
-	0x3e, 0xa7, 0xb8, 0x90, 0x69, 0xd5, 0x84, 0xf2, 0xec, 0x37, 0x81, 0x65, 0xce, 0xfd, 0x04, 0x9b,
-	0x45, 0x39, 0xa5, 0x35, 0x15, 0x7b, 0x4e, 0x95, 0x24, 0x13, 0xe9, 0x71, 0x83, 0xcb, 0xc0, 0x6f,
-	0xb0, 0x45, 0x25, 0x32, 0xb2, 0xe7, 0xd5, 0xee, 0x43, 0xf7, 0x1b, 0x7d, 0xc6, 0x7e, 0x87, 0x7b,
-	0xa3, 0x14, 0xa5, 0xc1, 0xaf, 0xa9, 0x4c, 0xb4, 0x0c, 0x4d, 0xa4, 0x12, 0x41, 0x79, 0x2b, 0x0e,
-	0x81, 0x0f, 0x9a, 0x03, 0x98, 0x7c, 0x0a, 0xed, 0x33, 0x23, 0x53, 0x53, 0xb6, 0x6e, 0x97, 0x5f,
-	0x0e, 0xd6, 0x88, 0x16, 0xd4, 0x59, 0x0e, 0x07, 0x0d, 0xf7, 0x91, 0x39, 0x95, 0xb6, 0xc2, 0xb1,
-	0x2d, 0xe6, 0xfc, 0x82, 0xed, 0x91, 0x4a, 0xc2, 0x38, 0x9b, 0x3a, 0xcf, 0x7a, 0xc8, 0x17, 0xbf,
-	0xe2, 0x11, 0xf7, 0xe8, 0xa6, 0x10, 0xe6, 0x8f, 0xe1, 0xee, 0x18, 0xe5, 0xd4, 0x66, 0x53, 0x53,
-	0x3d, 0x9d, 0xb8, 0xbd, 0x26, 0xdb, 0x1e, 0xe5, 0x7c, 0x18, 0x68, 0xfc, 0x02, 0x7b, 0x42, 0xbc,
-	0xe9, 0xeb, 0xd6, 0x7a, 0x76, 0xa3, 0x6d, 0xa7, 0x58, 0x0d, 0xfb, 0x35, 0x39, 0xce, 0x7e, 0x38,
-	0x68, 0x0e, 0xb0, 0x97, 0xc4, 0x07, 0xd4, 0x5a, 0xce, 0xb0, 0x18, 0x7c, 0x5e, 0x12, 0x8e, 0xea,
-	0x2f, 0x09, 0xcf, 0xb4, 0x96, 0xc4, 0x08, 0xa0, 0x34, 0x4f, 0xc2, 0x0b, 0xf1, 0xc8, 0x8d, 0x3f,
-	0xa9, 0xda, 0xbd, 0x5b, 0xe3, 0x70, 0x51, 0xef, 0xa1, 0x53, 0xa0, 0xdf, 0xa2, 0x8c, 0x4d, 0xb5,
-	0x04, 0x6d, 0xd1, 0xbf, 0x39, 0xd7, 0xb3, 0x2a, 0x3a, 0x85, 0xd6, 0x79, 0xf9, 0x6c, 0x41, 0xdf,
-	0xda, 0xda, 0xe7, 0xee, 0xa3, 0x75, 0x6b, 0x3d, 0x8b, 0x33, 0x86, 0x36, 0xc9, 0xea, 0x4a, 0x8b,
-	0x5e, 0x5d, 0xbc, 0xba, 0xd2, 0xd5, 0xf8, 0x36, 0xf9, 0x16, 0xf3, 0x27, 0x6c, 0x56, 0x7f, 0x95,
-	0xc5, 0x46, 0x8b, 0xc3, 0xfa, 0x32, 0xae, 0xbd, 0xea, 0x8d, 0xbe, 0x21, 0xa4, 0x82, 0x0f, 0x9f,
-	0xfe, 0x78, 0xb2, 0x8c, 0x0c, 0x6a, 0xdd, 0x8f, 0xd4, 0xa0, 0xf8, 0x35, 0x98, 0xa9, 0xc1, 0xd2,
-	0x0c, 0xf2, 0x0f, 0xd6, 0xc0, 0xfe, 0xb8, 0x4d, 0xd6, 0x73, 0xed, 0xc5, 0xff, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x16, 0x79, 0x9a, 0xc3, 0x07, 0x07, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xdb, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe1, 0xa2, 0x0d, 0x4c, 0xd2, 0x52, 0xb6, 0x14, 0xa8, 0x13, 0xd2, 0xc3, 0x1d, 0x42,
+	0x4a, 0x10, 0x20, 0x21, 0x55, 0xe2, 0xa2, 0x89, 0x28, 0x27, 0x71, 0x4a, 0xa0, 0x42, 0x20, 0x21,
+	0x6d, 0x9c, 0x51, 0x6a, 0xd5, 0xf1, 0xa6, 0xde, 0x75, 0x0a, 0xaf, 0xca, 0xd3, 0xa0, 0xda, 0x9e,
+	0xf1, 0xae, 0x63, 0xf7, 0x2e, 0xfb, 0xff, 0x33, 0x5f, 0xc6, 0x3b, 0x9e, 0x31, 0x88, 0x8b, 0x04,
+	0xe3, 0xbf, 0x1a, 0xe3, 0x65, 0xe0, 0x63, 0x6f, 0x11, 0x2b, 0xa3, 0x44, 0xcb, 0xd6, 0xbc, 0x66,
+	0x7a, 0xca, 0x2c, 0x6f, 0x6b, 0x12, 0x44, 0xa1, 0x9a, 0x4d, 0xa5, 0x91, 0x99, 0xf2, 0xec, 0xdf,
+	0x06, 0xac, 0x7d, 0xbd, 0x8a, 0x10, 0x47, 0xd0, 0x78, 0xfd, 0x07, 0xfd, 0xc4, 0xa0, 0xd8, 0xe9,
+	0x65, 0x49, 0xf9, 0x79, 0x84, 0x17, 0x09, 0x6a, 0xe3, 0xdd, 0x2f, 0xcb, 0x7a, 0xa1, 0x22, 0x8d,
+	0x87, 0x37, 0xc4, 0x3b, 0x68, 0xe5, 0xe2, 0x40, 0x1a, 0xff, 0x4c, 0x78, 0x6e, 0x64, 0x2a, 0x12,
+	0xa5, 0x5d, 0xe9, 0x31, 0xea, 0x13, 0x6c, 0x8c, 0x4d, 0x8c, 0x72, 0x4e, 0xc5, 0x50, 0xbc, 0xa3,
+	0x12, 0xac, 0x53, 0x6d, 0x12, 0xed, 0xe9, 0x4d, 0xf1, 0x02, 0xd6, 0x06, 0x38, 0x0b, 0x22, 0xb1,
+	0x9d, 0x87, 0xa6, 0x27, 0xca, 0xbf, 0xe7, 0x8a, 0x5c, 0xc5, 0x4b, 0x58, 0x1f, 0xaa, 0xf9, 0x3c,
+	0x30, 0x82, 0x22, 0xb2, 0x23, 0xe5, 0xed, 0x94, 0x54, 0x4e, 0x7c, 0x05, 0xb7, 0x46, 0x2a, 0x0c,
+	0x27, 0xd2, 0x3f, 0x17, 0x74, 0x5f, 0x24, 0x50, 0xf2, 0x83, 0x15, 0x9d, 0xd3, 0x8f, 0xa0, 0xf1,
+	0x25, 0xc6, 0x85, 0x8c, 0x8b, 0x26, 0xe4, 0xe7, 0x72, 0x13, 0x58, 0xe6, 0xdc, 0xcf, 0xb0, 0x99,
+	0x95, 0x93, 0x5b, 0x53, 0xd1, 0x71, 0xaa, 0x24, 0x99, 0x48, 0x8f, 0x6a, 0x5c, 0x06, 0x7e, 0x87,
+	0x2d, 0x2a, 0x91, 0x91, 0xdd, 0x52, 0xed, 0x65, 0xe8, 0x5e, 0xad, 0xcf, 0xd8, 0x1f, 0x70, 0x77,
+	0x18, 0xa3, 0x34, 0xf8, 0x2d, 0x96, 0x91, 0x96, 0xbe, 0x09, 0x54, 0x24, 0x28, 0x6f, 0xc5, 0x21,
+	0xf0, 0x7e, 0x7d, 0x00, 0x93, 0x4f, 0xa0, 0x39, 0x36, 0x32, 0x36, 0x79, 0xeb, 0x76, 0xf9, 0xe5,
+	0x60, 0x8d, 0x68, 0x5e, 0x95, 0xe5, 0x70, 0xd0, 0x70, 0x1f, 0x99, 0x53, 0x68, 0x2b, 0x1c, 0xdb,
+	0x62, 0xce, 0x6f, 0xd8, 0x1e, 0xaa, 0xc8, 0x0f, 0x93, 0xa9, 0xf3, 0xac, 0x07, 0x7c, 0xf1, 0x2b,
+	0x1e, 0x71, 0x0f, 0xaf, 0x0b, 0x61, 0xfe, 0x08, 0xee, 0x8c, 0x50, 0x4e, 0x6d, 0x36, 0x35, 0xb5,
+	0xa4, 0x13, 0xb7, 0x5b, 0x67, 0xdb, 0xa3, 0x9c, 0x0e, 0x03, 0x8d, 0x9f, 0x67, 0x4f, 0x48, 0x69,
+	0xfa, 0xda, 0x95, 0x9e, 0xdd, 0x68, 0xdb, 0xc9, 0x56, 0xc3, 0x5e, 0x45, 0x8e, 0xb3, 0x1f, 0xf6,
+	0xeb, 0x03, 0xec, 0x25, 0xf1, 0x11, 0xb5, 0x96, 0x33, 0xcc, 0x06, 0x9f, 0x97, 0x84, 0xa3, 0x96,
+	0x97, 0x44, 0xc9, 0xb4, 0x96, 0xc4, 0x10, 0x20, 0x37, 0x8f, 0xfd, 0x73, 0xf1, 0xd0, 0x8d, 0x3f,
+	0x2e, 0xda, 0xbd, 0x5b, 0xe1, 0x70, 0x51, 0x1f, 0xa0, 0x95, 0xa1, 0xdf, 0xa2, 0x0c, 0x4d, 0xb1,
+	0x04, 0x6d, 0xb1, 0x7c, 0x73, 0xae, 0x67, 0x55, 0x74, 0x02, 0x8d, 0xd3, 0xfc, 0xd9, 0xbc, 0x9e,
+	0xb5, 0xb5, 0x4f, 0xdd, 0x47, 0x6b, 0x57, 0x7a, 0x16, 0x67, 0x04, 0x4d, 0x92, 0xd5, 0xa5, 0x16,
+	0xdd, 0xaa, 0x78, 0x75, 0xa9, 0x8b, 0xf1, 0xad, 0xf3, 0x2d, 0xe6, 0x2f, 0xd8, 0x2c, 0xfe, 0x2a,
+	0x09, 0x8d, 0x16, 0x07, 0xd5, 0x65, 0x5c, 0x79, 0xc5, 0x1b, 0x7d, 0x4d, 0x88, 0x05, 0x7f, 0x0f,
+	0xb7, 0xdf, 0xa0, 0x19, 0xfb, 0x67, 0x38, 0x97, 0xa2, 0x63, 0x27, 0xb1, 0x5c, 0x2c, 0xb0, 0x6a,
+	0x97, 0x68, 0x83, 0x27, 0x3f, 0x1f, 0x2f, 0x03, 0x83, 0x5a, 0xf7, 0x02, 0xd5, 0xcf, 0x7e, 0xf5,
+	0x67, 0xaa, 0xbf, 0x34, 0xfd, 0xf4, 0xe3, 0xd7, 0xb7, 0x3f, 0x94, 0x93, 0xf5, 0x54, 0x7b, 0xfe,
+	0x7f, 0x00, 0x2e, 0xef, 0x22, 0x13, 0x53, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -130,6 +131,8 @@ type QueryClient interface {
 	VStreamRows(ctx context.Context, in *binlogdata.VStreamRowsRequest, opts ...grpc.CallOption) (Query_VStreamRowsClient, error)
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(ctx context.Context, in *binlogdata.VStreamResultsRequest, opts ...grpc.CallOption) (Query_VStreamResultsClient, error)
+	// GetSchema returns the tables loaded by the schema engine of the tablet.
+	GetSchema(ctx context.Context, in *binlogdata.GetSchemaRequest, opts ...grpc.CallOption) (*binlogdata.GetSchemaResponse, error)
 }
 
 type queryClient struct {
@@ -476,6 +479,15 @@ func (x *queryVStreamResultsClient) Recv() (*binlogdata.VStreamResultsResponse,
 	return m, nil
 }
 
+func (c *queryClient) GetSchema(ctx context.Context, in *binlogdata.GetSchemaRequest, opts ...grpc.CallOption) (*binlogdata.GetSchemaResponse, error) {
+	out := new(binlogdata.GetSchemaResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/GetSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Execute executes the specified SQL query (might be in a
@@ -528,6 +540,8 @@ type QueryServer interface {
 	VStreamRows(*binlogdata.VStreamRowsRequest, Query_VStreamRowsServer) error
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(*binlogdata.VStreamResultsRequest, Query_VStreamResultsServer) error
+	// GetSchema returns the tables loaded by the schema engine of the tablet.
+	GetSchema(context.Context, *binlogdata.GetSchemaRequest) (*binlogdata.GetSchemaResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -600,6 +614,9 @@ func (*UnimplementedQueryServer) VStreamRows(req *binlogdata.VStreamRowsRequest,
 func (*UnimplementedQueryServer) VStreamResults(req *binlogdata.VStreamResultsRequest, srv Query_VStreamResultsServer) error {
 	return status.Errorf(codes.Unimplemented, "method VStreamResults not implemented")
 }
+func (*UnimplementedQueryServer) GetSchema(ctx context.Context, req *binlogdata.GetSchemaRequest) (*binlogdata.GetSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
 
 func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1019,6 +1036,24 @@ func (x *queryVStreamResultsServer) Send(m *binlogdata.VStreamResultsResponse) e
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(binlogdata.GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSchema(ctx, req.(*binlogdata.GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "queryservice.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1087,6 +1122,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MessageAck",
 			Handler:    _Query_MessageAck_Handler,
 		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _Query_GetSchema_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
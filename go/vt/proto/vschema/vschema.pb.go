@@ -265,10 +265,14 @@ type Table struct {
 	// column_list_authoritative is set to true if columns is
 	// an authoritative list for the table. This allows
 	// us to expand 'select *' expressions.
-	ColumnListAuthoritative bool     `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	ColumnListAuthoritative bool `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
+	// source is set for a reference table that is materialized
+	// from the table of the same name in the source keyspace.
+	// Writes to the table are routed to the source.
+	Source               string   `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Table) Reset()         { *m = Table{} }
@@ -338,6 +342,13 @@ func (m *Table) GetColumnListAuthoritative() bool {
 	return false
 }
 
+func (m *Table) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
 // ColumnVindex is used to associate a column to a vindex.
 type ColumnVindex struct {
 	// Legacy implementation, moving forward all vindexes should define a list of columns.
@@ -562,48 +573,48 @@ func init() {
 func init() { proto.RegisterFile("vschema.proto", fileDescriptor_3f6849254fea3e77) }
 
 var fileDescriptor_3f6849254fea3e77 = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0x96, 0x13, 0x62, 0x92, 0x31, 0x09, 0xbf, 0xdf, 0x0a, 0xa8, 0x1b, 0x84, 0x88, 0x2c, 0xda,
-	0xa6, 0x3d, 0x24, 0x52, 0x50, 0x25, 0x9a, 0x8a, 0xaa, 0x14, 0x71, 0x40, 0x45, 0x6a, 0x65, 0x10,
-	0x87, 0x5e, 0x2c, 0xe3, 0x6c, 0x61, 0x45, 0xe2, 0x35, 0xbb, 0x6b, 0x97, 0x3c, 0x4a, 0xaf, 0x7d,
-	0xad, 0x3e, 0x42, 0x5f, 0xa2, 0xf2, 0xfe, 0x31, 0x1b, 0x48, 0x6f, 0x3b, 0x3b, 0xf3, 0x7d, 0xf3,
-	0xed, 0xec, 0xcc, 0x40, 0xbb, 0xe0, 0xc9, 0x0d, 0x9e, 0xc5, 0x83, 0x8c, 0x51, 0x41, 0xd1, 0xaa,
-	0x36, 0xbb, 0xde, 0x5d, 0x8e, 0xd9, 0x5c, 0xdd, 0x06, 0x63, 0x58, 0x0b, 0x69, 0x2e, 0x48, 0x7a,
-	0x1d, 0xe6, 0x53, 0xcc, 0xd1, 0x1b, 0x68, 0xb0, 0xf2, 0xe0, 0x3b, 0xbd, 0x7a, 0xdf, 0x1b, 0x6d,
-	0x0c, 0x0c, 0x89, 0x15, 0x15, 0xaa, 0x90, 0xe0, 0x14, 0x3c, 0xeb, 0x16, 0xed, 0x00, 0x7c, 0x67,
-	0x74, 0x16, 0x89, 0xf8, 0x6a, 0x8a, 0x7d, 0xa7, 0xe7, 0xf4, 0x5b, 0x61, 0xab, 0xbc, 0xb9, 0x28,
-	0x2f, 0xd0, 0x36, 0xb4, 0x04, 0x55, 0x4e, 0xee, 0xd7, 0x7a, 0xf5, 0x7e, 0x2b, 0x6c, 0x0a, 0x2a,
-	0x7d, 0x3c, 0xf8, 0x53, 0x83, 0xe6, 0x67, 0x3c, 0xe7, 0x59, 0x9c, 0x60, 0xe4, 0xc3, 0x2a, 0xbf,
-	0x89, 0xd9, 0x04, 0x4f, 0x24, 0x4b, 0x33, 0x34, 0x26, 0x7a, 0x0f, 0xcd, 0x82, 0xa4, 0x13, 0x7c,
-	0xaf, 0x29, 0xbc, 0xd1, 0x6e, 0x25, 0xd0, 0xc0, 0x07, 0x97, 0x3a, 0xe2, 0x24, 0x15, 0x6c, 0x1e,
-	0x56, 0x00, 0xf4, 0x16, 0x5c, 0x9d, 0xbd, 0x2e, 0xa1, 0x3b, 0x4f, 0xa1, 0x4a, 0x8d, 0x02, 0xea,
-	0x60, 0x74, 0x00, 0x3e, 0xc3, 0x77, 0x39, 0x61, 0x38, 0xc2, 0xf7, 0xd9, 0x94, 0x24, 0x44, 0x44,
-	0x4c, 0x3d, 0xdb, 0x5f, 0x91, 0xf2, 0xb6, 0xb4, 0xff, 0x44, 0xbb, 0x75, 0x51, 0xba, 0x67, 0xd0,
-	0x5e, 0xd0, 0x82, 0xfe, 0x83, 0xfa, 0x2d, 0x9e, 0xeb, 0xd2, 0x94, 0x47, 0xf4, 0x02, 0x1a, 0x45,
-	0x3c, 0xcd, 0xb1, 0x5f, 0xeb, 0x39, 0x7d, 0x6f, 0xb4, 0x5e, 0x49, 0x52, 0xc0, 0x50, 0x79, 0xc7,
-	0xb5, 0x03, 0xa7, 0x7b, 0x0a, 0x9e, 0x25, 0x6f, 0x09, 0xd7, 0xde, 0x22, 0x57, 0xa7, 0xe2, 0x92,
-	0x30, 0x8b, 0x2a, 0xf8, 0xe5, 0x80, 0xab, 0x12, 0x20, 0x04, 0x2b, 0x62, 0x9e, 0x99, 0xef, 0x92,
-	0x67, 0xb4, 0x0f, 0x6e, 0x16, 0xb3, 0x78, 0x66, 0x6a, 0xbc, 0xfd, 0x48, 0xd5, 0xe0, 0xab, 0xf4,
-	0xea, 0x32, 0xa9, 0x50, 0xb4, 0x01, 0x0d, 0xfa, 0x23, 0xc5, 0xcc, 0xaf, 0x4b, 0x26, 0x65, 0x74,
-	0xdf, 0x81, 0x67, 0x05, 0x2f, 0x11, 0xbd, 0x61, 0x8b, 0x6e, 0xd9, 0x22, 0x7f, 0xd6, 0xa0, 0xa1,
-	0x3a, 0x67, 0x99, 0xc6, 0x0f, 0xb0, 0x9e, 0xd0, 0x69, 0x3e, 0x4b, 0xa3, 0x47, 0x0d, 0xb1, 0x59,
-	0x89, 0x3d, 0x96, 0x7e, 0x5d, 0xc8, 0x4e, 0x62, 0x59, 0x98, 0xa3, 0x43, 0xe8, 0xc4, 0xb9, 0xa0,
-	0x11, 0x49, 0x13, 0x86, 0x67, 0x38, 0x15, 0x52, 0xb7, 0x37, 0xda, 0xaa, 0xe0, 0x47, 0xb9, 0xa0,
-	0xa7, 0xc6, 0x1b, 0xb6, 0x63, 0xdb, 0x44, 0xaf, 0x61, 0x55, 0x11, 0x72, 0x7f, 0x45, 0xa6, 0x5d,
-	0x7f, 0x94, 0x36, 0x34, 0x7e, 0xb4, 0x05, 0x6e, 0x46, 0xd2, 0x14, 0x4f, 0xfc, 0x86, 0xd4, 0xaf,
-	0x2d, 0x34, 0x86, 0xe7, 0xfa, 0x05, 0x53, 0xc2, 0x45, 0x14, 0xe7, 0xe2, 0x86, 0x32, 0x22, 0x62,
-	0x41, 0x0a, 0xec, 0xbb, 0xb2, 0xb1, 0x9e, 0xa9, 0x80, 0x33, 0xc2, 0xc5, 0x91, 0xed, 0x0e, 0x2e,
-	0x60, 0xcd, 0x7e, 0x5d, 0x99, 0x43, 0x85, 0xea, 0x1a, 0x69, 0xab, 0xac, 0x5c, 0x1a, 0xcf, 0x4c,
-	0x71, 0xe5, 0xb9, 0x9c, 0x2e, 0x23, 0xbd, 0x2e, 0xa7, 0xd0, 0x98, 0xc1, 0x31, 0xb4, 0x17, 0x1e,
-	0xfd, 0x4f, 0xda, 0x2e, 0x34, 0x39, 0xbe, 0xcb, 0x71, 0x9a, 0x18, 0xea, 0xca, 0x0e, 0x0e, 0xc1,
-	0x3d, 0x5e, 0x4c, 0xee, 0x58, 0xc9, 0x77, 0xf5, 0x57, 0x96, 0xa8, 0xce, 0xc8, 0x1b, 0xa8, 0x55,
-	0x74, 0x31, 0xcf, 0xb0, 0xfa, 0xd7, 0xe0, 0xb7, 0x03, 0x70, 0xce, 0x8a, 0xcb, 0x73, 0x59, 0x4c,
-	0xf4, 0x11, 0x5a, 0xb7, 0x7a, 0x38, 0xcd, 0x4a, 0x0a, 0xaa, 0x4a, 0x3f, 0xc4, 0x55, 0x13, 0xac,
-	0x9b, 0xf2, 0x01, 0x84, 0xc6, 0xd0, 0xd6, 0xd3, 0x1a, 0xa9, 0xc5, 0xa6, 0xa6, 0x63, 0x73, 0xd9,
-	0x62, 0xe3, 0xe1, 0x1a, 0xb3, 0xac, 0xee, 0x17, 0xe8, 0x2c, 0x12, 0x2f, 0x69, 0xe0, 0x57, 0x8b,
-	0x53, 0xf7, 0xff, 0x93, 0xa5, 0x62, 0xf5, 0xf4, 0xa7, 0x97, 0xdf, 0xf6, 0x0a, 0x22, 0x30, 0xe7,
-	0x03, 0x42, 0x87, 0xea, 0x34, 0xbc, 0xa6, 0xc3, 0x42, 0x0c, 0xe5, 0x36, 0x1e, 0x6a, 0xec, 0x95,
-	0x2b, 0xcd, 0xfd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xa7, 0x99, 0x19, 0xc3, 0x05, 0x00,
-	0x00,
+	// 683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xe1, 0x4e, 0xdb, 0x3a,
+	0x14, 0x56, 0x1a, 0x9a, 0xb6, 0x27, 0xb4, 0xdc, 0x6b, 0x01, 0x37, 0xb7, 0x08, 0x51, 0x45, 0xdc,
+	0xbb, 0x6e, 0x3f, 0x5a, 0xa9, 0x68, 0x12, 0xeb, 0xc4, 0x34, 0x86, 0xf8, 0x81, 0x86, 0xb4, 0x29,
+	0x20, 0x7e, 0xec, 0x4f, 0x14, 0x52, 0x0f, 0x2c, 0xda, 0x38, 0xd8, 0x4e, 0x46, 0x5f, 0x67, 0x6f,
+	0xb0, 0xe7, 0xd9, 0x23, 0xec, 0x25, 0xa6, 0xd8, 0x4e, 0x70, 0xa0, 0xfb, 0xe7, 0xcf, 0xe7, 0x7c,
+	0x9f, 0x3f, 0x1f, 0xfb, 0x1c, 0xe8, 0xe6, 0x3c, 0xbe, 0xc5, 0x8b, 0x68, 0x94, 0x32, 0x2a, 0x28,
+	0x6a, 0x69, 0xd8, 0x77, 0xef, 0x33, 0xcc, 0x96, 0x6a, 0xd7, 0x9f, 0xc2, 0x7a, 0x40, 0x33, 0x41,
+	0x92, 0x9b, 0x20, 0x9b, 0x63, 0x8e, 0x5e, 0x41, 0x93, 0x15, 0x0b, 0xcf, 0x1a, 0xd8, 0x43, 0x77,
+	0xb2, 0x39, 0x2a, 0x45, 0x8c, 0xac, 0x40, 0xa5, 0xf8, 0x67, 0xe0, 0x1a, 0xbb, 0x68, 0x17, 0xe0,
+	0x2b, 0xa3, 0x8b, 0x50, 0x44, 0xd7, 0x73, 0xec, 0x59, 0x03, 0x6b, 0xd8, 0x09, 0x3a, 0xc5, 0xce,
+	0x65, 0xb1, 0x81, 0x76, 0xa0, 0x23, 0xa8, 0x0a, 0x72, 0xaf, 0x31, 0xb0, 0x87, 0x9d, 0xa0, 0x2d,
+	0xa8, 0x8c, 0x71, 0xff, 0x57, 0x03, 0xda, 0x1f, 0xf1, 0x92, 0xa7, 0x51, 0x8c, 0x91, 0x07, 0x2d,
+	0x7e, 0x1b, 0xb1, 0x19, 0x9e, 0x49, 0x95, 0x76, 0x50, 0x42, 0xf4, 0x16, 0xda, 0x39, 0x49, 0x66,
+	0xf8, 0x41, 0x4b, 0xb8, 0x93, 0xbd, 0xca, 0x60, 0x49, 0x1f, 0x5d, 0xe9, 0x8c, 0xd3, 0x44, 0xb0,
+	0x65, 0x50, 0x11, 0xd0, 0x6b, 0x70, 0xf4, 0xe9, 0xb6, 0xa4, 0xee, 0x3e, 0xa7, 0x2a, 0x37, 0x8a,
+	0xa8, 0x93, 0xd1, 0x21, 0x78, 0x0c, 0xdf, 0x67, 0x84, 0xe1, 0x10, 0x3f, 0xa4, 0x73, 0x12, 0x13,
+	0x11, 0x32, 0x75, 0x6d, 0x6f, 0x4d, 0xda, 0xdb, 0xd6, 0xf1, 0x53, 0x1d, 0xd6, 0x45, 0xe9, 0x9f,
+	0x43, 0xb7, 0xe6, 0x05, 0xfd, 0x05, 0xf6, 0x1d, 0x5e, 0xea, 0xd2, 0x14, 0x4b, 0xf4, 0x1f, 0x34,
+	0xf3, 0x68, 0x9e, 0x61, 0xaf, 0x31, 0xb0, 0x86, 0xee, 0x64, 0xa3, 0xb2, 0xa4, 0x88, 0x81, 0x8a,
+	0x4e, 0x1b, 0x87, 0x56, 0xff, 0x0c, 0x5c, 0xc3, 0xde, 0x0a, 0xad, 0xfd, 0xba, 0x56, 0xaf, 0xd2,
+	0x92, 0x34, 0x43, 0xca, 0xff, 0x6e, 0x81, 0xa3, 0x0e, 0x40, 0x08, 0xd6, 0xc4, 0x32, 0x2d, 0x9f,
+	0x4b, 0xae, 0xd1, 0x01, 0x38, 0x69, 0xc4, 0xa2, 0x45, 0x59, 0xe3, 0x9d, 0x27, 0xae, 0x46, 0x9f,
+	0x65, 0x54, 0x97, 0x49, 0xa5, 0xa2, 0x4d, 0x68, 0xd2, 0x6f, 0x09, 0x66, 0x9e, 0x2d, 0x95, 0x14,
+	0xe8, 0xbf, 0x01, 0xd7, 0x48, 0x5e, 0x61, 0x7a, 0xd3, 0x34, 0xdd, 0x31, 0x4d, 0xfe, 0x68, 0x40,
+	0x53, 0xfd, 0x9c, 0x55, 0x1e, 0xdf, 0xc1, 0x46, 0x4c, 0xe7, 0xd9, 0x22, 0x09, 0x9f, 0x7c, 0x88,
+	0xad, 0xca, 0xec, 0x89, 0x8c, 0xeb, 0x42, 0xf6, 0x62, 0x03, 0x61, 0x8e, 0x8e, 0xa0, 0x17, 0x65,
+	0x82, 0x86, 0x24, 0x89, 0x19, 0x5e, 0xe0, 0x44, 0x48, 0xdf, 0xee, 0x64, 0xbb, 0xa2, 0x1f, 0x67,
+	0x82, 0x9e, 0x95, 0xd1, 0xa0, 0x1b, 0x99, 0x10, 0xbd, 0x84, 0x96, 0x12, 0xe4, 0xde, 0xda, 0xc0,
+	0xae, 0xbd, 0x9c, 0x3a, 0x36, 0x28, 0xe3, 0x68, 0x1b, 0x9c, 0x94, 0x24, 0x09, 0x9e, 0x79, 0x4d,
+	0xe9, 0x5f, 0x23, 0x34, 0x85, 0x7f, 0xf5, 0x0d, 0xe6, 0x84, 0x8b, 0x30, 0xca, 0xc4, 0x2d, 0x65,
+	0x44, 0x44, 0x82, 0xe4, 0xd8, 0x73, 0xe4, 0xc7, 0xfa, 0x47, 0x25, 0x9c, 0x13, 0x2e, 0x8e, 0xcd,
+	0x70, 0xa1, 0xc9, 0x69, 0xc6, 0x62, 0xec, 0xb5, 0x94, 0xa6, 0x42, 0xfe, 0x25, 0xac, 0x9b, 0xb7,
+	0x2e, 0xf2, 0x94, 0x84, 0xae, 0x9d, 0x46, 0x45, 0x45, 0x93, 0x68, 0x51, 0x16, 0x5d, 0xae, 0x8b,
+	0xae, 0x2b, 0xaf, 0x64, 0xcb, 0xee, 0x2c, 0xa1, 0x7f, 0x02, 0xdd, 0x5a, 0x31, 0xfe, 0x28, 0xdb,
+	0x87, 0x36, 0xc7, 0xf7, 0x19, 0x4e, 0xe2, 0x52, 0xba, 0xc2, 0xfe, 0x11, 0x38, 0x27, 0xf5, 0xc3,
+	0x2d, 0xe3, 0xf0, 0x3d, 0xfd, 0xc4, 0x05, 0xab, 0x37, 0x71, 0x47, 0x6a, 0x44, 0x5d, 0x2e, 0x53,
+	0xac, 0xde, 0xdb, 0xff, 0x69, 0x01, 0x5c, 0xb0, 0xfc, 0xea, 0x42, 0x16, 0x19, 0xbd, 0x87, 0xce,
+	0x9d, 0x6e, 0xda, 0x72, 0x54, 0xf9, 0xd5, 0x0b, 0x3c, 0xe6, 0x55, 0x9d, 0xad, 0x3f, 0xeb, 0x23,
+	0x09, 0x4d, 0xa1, 0xab, 0xbb, 0x38, 0x54, 0x03, 0x4f, 0x75, 0xcd, 0xd6, 0xaa, 0x81, 0xc7, 0x83,
+	0x75, 0x66, 0xa0, 0xfe, 0x27, 0xe8, 0xd5, 0x85, 0x57, 0x7c, 0xec, 0x17, 0xf5, 0x6e, 0xfc, 0xfb,
+	0xd9, 0xb0, 0x31, 0xfe, 0xfa, 0x87, 0xff, 0xbf, 0xec, 0xe7, 0x44, 0x60, 0xce, 0x47, 0x84, 0x8e,
+	0xd5, 0x6a, 0x7c, 0x43, 0xc7, 0xb9, 0x18, 0xcb, 0x29, 0x3d, 0xd6, 0xdc, 0x6b, 0x47, 0xc2, 0x83,
+	0xdf, 0x03, 0x00, 0xc0, 0x86, 0x78, 0x4e, 0xdb, 0x05, 0x00, 0x00,
 }
@@ -181,8 +181,11 @@ type MaterializeSettings struct {
 	StopAfterCopy bool                        `protobuf:"varint,4,opt,name=stop_after_copy,json=stopAfterCopy,proto3" json:"stop_after_copy,omitempty"`
 	TableSettings []*TableMaterializeSettings `protobuf:"bytes,5,rep,name=table_settings,json=tableSettings,proto3" json:"table_settings,omitempty"`
 	// optional parameters.
-	Cell                 string   `protobuf:"bytes,6,opt,name=cell,proto3" json:"cell,omitempty"`
-	TabletTypes          string   `protobuf:"bytes,7,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	Cell        string `protobuf:"bytes,6,opt,name=cell,proto3" json:"cell,omitempty"`
+	TabletTypes string `protobuf:"bytes,7,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	// copy_concurrency is the number of tables, or chunks of tables,
+	// that are copied in parallel by each stream.
+	CopyConcurrency      int64    `protobuf:"varint,8,opt,name=copy_concurrency,json=copyConcurrency,proto3" json:"copy_concurrency,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -262,6 +265,13 @@ func (m *MaterializeSettings) GetTabletTypes() string {
 	return ""
 }
 
+func (m *MaterializeSettings) GetCopyConcurrency() int64 {
+	if m != nil {
+		return m.CopyConcurrency
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ExecuteVtctlCommandRequest)(nil), "vtctldata.ExecuteVtctlCommandRequest")
 	proto.RegisterType((*ExecuteVtctlCommandResponse)(nil), "vtctldata.ExecuteVtctlCommandResponse")
@@ -272,32 +282,33 @@ func init() {
 func init() { proto.RegisterFile("vtctldata.proto", fileDescriptor_f41247b323a1ab2e) }
 
 var fileDescriptor_f41247b323a1ab2e = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
+	// 440 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xd1, 0x6e, 0xd3, 0x30,
-	0x14, 0x86, 0x95, 0xb5, 0x1b, 0xeb, 0x29, 0x4d, 0xc1, 0xdc, 0x58, 0x45, 0x48, 0xa1, 0xc0, 0x88,
-	0x84, 0xd4, 0x48, 0xe3, 0x09, 0xa0, 0xf4, 0x06, 0xc4, 0x4d, 0xa8, 0x40, 0xe2, 0x26, 0x72, 0x93,
-	0xb3, 0xc8, 0x9a, 0x1b, 0x07, 0xfb, 0xa4, 0x5b, 0x79, 0x03, 0x5e, 0x86, 0x67, 0x44, 0xb6, 0xb3,
-	0x70, 0xb3, 0xdd, 0x1d, 0x7f, 0xe7, 0xb7, 0xfd, 0x9f, 0x5f, 0x07, 0xe6, 0x07, 0x2a, 0x49, 0x55,
-	0x82, 0xc4, 0xaa, 0x35, 0x9a, 0x34, 0x9b, 0x0c, 0x60, 0x31, 0x53, 0xba, 0xee, 0x48, 0xaa, 0xd0,
-	0x59, 0xfe, 0x80, 0xc5, 0xe6, 0x16, 0xcb, 0x8e, 0xf0, 0xbb, 0x93, 0xac, 0xf5, 0x7e, 0x2f, 0x9a,
-	0x2a, 0xc7, 0x5f, 0x1d, 0x5a, 0x62, 0x0c, 0xc6, 0xc2, 0xd4, 0x96, 0x47, 0xc9, 0x28, 0x9d, 0xe4,
-	0xbe, 0x66, 0x6f, 0x20, 0x16, 0x25, 0x49, 0xdd, 0x14, 0x24, 0xf7, 0xa8, 0x3b, 0xe2, 0x27, 0x49,
-	0x94, 0x8e, 0xf2, 0x59, 0xa0, 0xdb, 0x00, 0x97, 0x6b, 0x78, 0x7e, 0xef, 0xc3, 0xb6, 0xd5, 0x8d,
-	0x45, 0xf6, 0x1a, 0x4e, 0xf1, 0x80, 0x0d, 0xf1, 0x28, 0x89, 0xd2, 0xe9, 0x65, 0xbc, 0xba, 0xb3,
-	0xb5, 0x71, 0x34, 0x0f, 0xcd, 0xe5, 0x9f, 0x08, 0xf8, 0x56, 0xec, 0x14, 0x7e, 0x15, 0x84, 0x46,
-	0x0a, 0x25, 0x7f, 0xe3, 0x37, 0x24, 0x92, 0x4d, 0x6d, 0xd9, 0x4b, 0x78, 0x4c, 0xc2, 0xd4, 0x48,
-	0x05, 0x39, 0x89, 0x7f, 0x69, 0x92, 0x4f, 0x03, 0xf3, 0xb7, 0xd8, 0x3b, 0x78, 0x6a, 0x75, 0x67,
-	0x4a, 0x2c, 0xf0, 0xb6, 0x35, 0x68, 0xad, 0xd4, 0x8d, 0xb7, 0x3b, 0xc9, 0x9f, 0x84, 0xc6, 0x66,
-	0xe0, 0xec, 0x05, 0x40, 0x69, 0x50, 0x10, 0x16, 0x55, 0xa5, 0xf8, 0xc8, 0xab, 0x26, 0x81, 0x7c,
-	0xaa, 0xd4, 0xf2, 0xef, 0x09, 0x3c, 0xbb, 0xcf, 0xc6, 0x02, 0xce, 0x6f, 0xb4, 0xb9, 0xbe, 0x52,
-	0xfa, 0xa6, 0xb7, 0x30, 0x9c, 0xd9, 0x5b, 0x98, 0xf7, 0xff, 0x5f, 0xe3, 0xd1, 0xb6, 0xa2, 0xc4,
-	0xfe, 0xf7, 0x38, 0xe0, 0x2f, 0x3d, 0x75, 0xc2, 0x7e, 0x96, 0x41, 0x18, 0x0c, 0xc4, 0x01, 0x0f,
-	0xc2, 0x0b, 0x98, 0x5b, 0xd2, 0x6d, 0x21, 0xae, 0x08, 0x4d, 0x51, 0xea, 0xf6, 0xc8, 0xc7, 0x49,
-	0x94, 0x9e, 0xe7, 0x33, 0x87, 0x3f, 0x38, 0xba, 0xd6, 0xed, 0x91, 0x7d, 0x86, 0xd8, 0xa7, 0x52,
-	0xd8, 0xde, 0x27, 0x3f, 0x4d, 0x46, 0xe9, 0xf4, 0xf2, 0xd5, 0xea, 0xff, 0x6e, 0x3c, 0x94, 0x6c,
-	0x3e, 0xf3, 0x57, 0x87, 0x09, 0x19, 0x8c, 0x4b, 0x54, 0x8a, 0x9f, 0x79, 0x47, 0xbe, 0x0e, 0xe1,
-	0xef, 0x94, 0x0b, 0xff, 0xd8, 0xa2, 0xe5, 0x8f, 0xee, 0xc2, 0x77, 0x6c, 0xeb, 0xd0, 0xc7, 0xf4,
-	0xe7, 0xc5, 0x41, 0x12, 0x5a, 0xbb, 0x92, 0x3a, 0x0b, 0x55, 0x56, 0xeb, 0xec, 0x40, 0x99, 0x5f,
-	0xbd, 0x6c, 0x30, 0xb2, 0x3b, 0xf3, 0xe0, 0xfd, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0x37,
-	0xd0, 0x53, 0xb8, 0x02, 0x00, 0x00,
+	0x14, 0x86, 0x95, 0xb5, 0x1b, 0xad, 0x4b, 0xd3, 0x61, 0x6e, 0xac, 0x22, 0xa4, 0x50, 0x60, 0x04,
+	0x21, 0x35, 0xd2, 0x78, 0x02, 0x28, 0xbd, 0x01, 0x71, 0x13, 0x2a, 0x90, 0xb8, 0x89, 0x5c, 0xe7,
+	0x2c, 0xb2, 0xe6, 0xc6, 0xc1, 0x3e, 0xe9, 0x16, 0xde, 0x80, 0x47, 0xe4, 0x6d, 0x90, 0xed, 0x2c,
+	0xbb, 0x19, 0x77, 0xc7, 0xdf, 0xf9, 0x6d, 0xff, 0xe7, 0xd7, 0x21, 0x8b, 0x23, 0x0a, 0x54, 0x25,
+	0x47, 0xbe, 0x6e, 0x8c, 0x46, 0x4d, 0xa7, 0x03, 0x58, 0xce, 0x95, 0xae, 0x5a, 0x94, 0x2a, 0x74,
+	0x56, 0x3f, 0xc8, 0x72, 0x7b, 0x0b, 0xa2, 0x45, 0xf8, 0xee, 0x24, 0x1b, 0x7d, 0x38, 0xf0, 0xba,
+	0xcc, 0xe1, 0x57, 0x0b, 0x16, 0x29, 0x25, 0x63, 0x6e, 0x2a, 0xcb, 0xa2, 0x64, 0x94, 0x4e, 0x73,
+	0x5f, 0xd3, 0xd7, 0x24, 0xe6, 0x02, 0xa5, 0xae, 0x0b, 0x94, 0x07, 0xd0, 0x2d, 0xb2, 0x93, 0x24,
+	0x4a, 0x47, 0xf9, 0x3c, 0xd0, 0x5d, 0x80, 0xab, 0x0d, 0x79, 0xf6, 0xe0, 0xc3, 0xb6, 0xd1, 0xb5,
+	0x05, 0xfa, 0x8a, 0x9c, 0xc2, 0x11, 0x6a, 0x64, 0x51, 0x12, 0xa5, 0xb3, 0xcb, 0x78, 0x7d, 0x67,
+	0x6b, 0xeb, 0x68, 0x1e, 0x9a, 0xab, 0x3f, 0x11, 0x61, 0x3b, 0xbe, 0x57, 0xf0, 0x95, 0x23, 0x18,
+	0xc9, 0x95, 0xfc, 0x0d, 0xdf, 0x00, 0x51, 0xd6, 0x95, 0xa5, 0x2f, 0xc8, 0x63, 0xe4, 0xa6, 0x02,
+	0x2c, 0xd0, 0x49, 0xfc, 0x4b, 0xd3, 0x7c, 0x16, 0x98, 0xbf, 0x45, 0xdf, 0x91, 0x27, 0x56, 0xb7,
+	0x46, 0x40, 0x01, 0xb7, 0x8d, 0x01, 0x6b, 0xa5, 0xae, 0xbd, 0xdd, 0x69, 0x7e, 0x1e, 0x1a, 0xdb,
+	0x81, 0xd3, 0xe7, 0x84, 0x08, 0x03, 0x1c, 0xa1, 0x28, 0x4b, 0xc5, 0x46, 0x5e, 0x35, 0x0d, 0xe4,
+	0x53, 0xa9, 0x56, 0x7f, 0x4f, 0xc8, 0xd3, 0x87, 0x6c, 0x2c, 0xc9, 0xe4, 0x46, 0x9b, 0xeb, 0x2b,
+	0xa5, 0x6f, 0x7a, 0x0b, 0xc3, 0x99, 0xbe, 0x21, 0x8b, 0xfe, 0xff, 0x6b, 0xe8, 0x6c, 0xc3, 0x05,
+	0xf4, 0xbf, 0xc7, 0x01, 0x7f, 0xe9, 0xa9, 0x13, 0xf6, 0xb3, 0x0c, 0xc2, 0x60, 0x20, 0x0e, 0x78,
+	0x10, 0x5e, 0x90, 0x85, 0x45, 0xdd, 0x14, 0xfc, 0x0a, 0xc1, 0x14, 0x42, 0x37, 0x1d, 0x1b, 0x27,
+	0x51, 0x3a, 0xc9, 0xe7, 0x0e, 0x7f, 0x70, 0x74, 0xa3, 0x9b, 0x8e, 0x7e, 0x26, 0xb1, 0x4f, 0xa5,
+	0xb0, 0xbd, 0x4f, 0x76, 0x9a, 0x8c, 0xd2, 0xd9, 0xe5, 0xcb, 0xf5, 0xfd, 0x6e, 0xfc, 0x2f, 0xd9,
+	0x7c, 0xee, 0xaf, 0x0e, 0x13, 0x52, 0x32, 0x16, 0xa0, 0x14, 0x3b, 0xf3, 0x8e, 0x7c, 0x1d, 0xc2,
+	0xdf, 0x2b, 0x17, 0x7e, 0xd7, 0x80, 0x65, 0x8f, 0xee, 0xc2, 0x77, 0x6c, 0xe7, 0x10, 0x7d, 0x4b,
+	0xce, 0x9d, 0xbf, 0x42, 0xe8, 0x5a, 0xb4, 0xc6, 0x40, 0x2d, 0x3a, 0x36, 0xf1, 0xab, 0xb2, 0x70,
+	0x7c, 0x73, 0x8f, 0x3f, 0xa6, 0x3f, 0x2f, 0x8e, 0x12, 0xc1, 0xda, 0xb5, 0xd4, 0x59, 0xa8, 0xb2,
+	0x4a, 0x67, 0x47, 0xcc, 0xfc, 0x96, 0x66, 0x83, 0xe7, 0xfd, 0x99, 0x07, 0xef, 0xff, 0x0d, 0x00,
+	0x41, 0x02, 0xb0, 0xe5, 0xe3, 0x02, 0x00, 0x00,
 }
@@ -142,9 +142,13 @@ type Session struct {
 	FoundRows uint64 `protobuf:"varint,12,opt,name=found_rows,json=foundRows,proto3" json:"found_rows,omitempty"`
 	// user_defined_variables contains all the @variables defined for this session
 	UserDefinedVariables map[string]*query.BindVariable `protobuf:"bytes,13,rep,name=user_defined_variables,json=userDefinedVariables,proto3" json:"user_defined_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+	// savepoints stores all the savepoints set in the current transaction,
+	// in the order they were set. They are replayed on shards that join
+	// the transaction later.
+	Savepoints           []string `protobuf:"bytes,14,rep,name=savepoints,proto3" json:"savepoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Session) Reset()         { *m = Session{} }
@@ -263,6 +267,13 @@ func (m *Session) GetUserDefinedVariables() map[string]*query.BindVariable {
 	return nil
 }
 
+func (m *Session) GetSavepoints() []string {
+	if m != nil {
+		return m.Savepoints
+	}
+	return nil
+}
+
 type Session_ShardSession struct {
 	Target               *query.Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	TransactionId        int64         `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -3170,120 +3181,121 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 1835 bytes of a gzipped FileDescriptorProto
+	// 1846 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdd, 0x6e, 0x1b, 0xc7,
-	0x15, 0xce, 0xee, 0xf2, 0xf7, 0x2c, 0xff, 0x3c, 0xa6, 0x15, 0x9a, 0x51, 0x6c, 0x76, 0x53, 0x21,
-	0xb4, 0x6b, 0x48, 0x8d, 0x82, 0x06, 0x41, 0x90, 0xa2, 0xb0, 0x28, 0xc5, 0x20, 0x62, 0xfd, 0x74,
-	0x44, 0xdb, 0x6d, 0x91, 0x62, 0xb1, 0xe2, 0x8e, 0xe9, 0xad, 0xa8, 0x5d, 0x66, 0x67, 0x48, 0x57,
-	0x37, 0x45, 0xde, 0x20, 0xe8, 0x45, 0x81, 0xc2, 0x28, 0x50, 0xf4, 0xaa, 0x57, 0xbd, 0x2d, 0xd0,
-	0xf6, 0xa6, 0xd7, 0xbd, 0x29, 0xfa, 0x0a, 0xed, 0x1b, 0xf4, 0x09, 0x82, 0x9d, 0x99, 0xfd, 0xe1,
-	0x5a, 0xb4, 0x28, 0xca, 0x32, 0xe8, 0x1b, 0x61, 0x67, 0xce, 0xec, 0xcc, 0x37, 0xdf, 0xf9, 0xce,
-	0x99, 0xc3, 0x59, 0x41, 0x69, 0xc2, 0x06, 0x16, 0x23, 0xeb, 0x23, 0xdf, 0x63, 0x1e, 0xca, 0x89,
-	0x56, 0xb3, 0x76, 0xe4, 0xb8, 0x43, 0x6f, 0x60, 0x5b, 0xcc, 0x12, 0x96, 0xa6, 0xfe, 0xf5, 0x98,
-	0xf8, 0xa7, 0xb2, 0x51, 0x61, 0xde, 0xc8, 0x4b, 0x1a, 0x27, 0xcc, 0x1f, 0xf5, 0x45, 0xc3, 0xf8,
-	0x5f, 0x0e, 0xf2, 0x87, 0x84, 0x52, 0xc7, 0x73, 0xd1, 0x1a, 0x54, 0x1c, 0xd7, 0x64, 0xbe, 0xe5,
-	0x52, 0xab, 0xcf, 0x1c, 0xcf, 0x6d, 0x28, 0x2d, 0xa5, 0x5d, 0xc0, 0x65, 0xc7, 0xed, 0xc5, 0x9d,
-	0xa8, 0x03, 0x15, 0xfa, 0xcc, 0xf2, 0x6d, 0x93, 0x8a, 0xf7, 0x68, 0x43, 0x6d, 0x69, 0x6d, 0x7d,
-	0x73, 0x75, 0x5d, 0xa2, 0x93, 0xf3, 0xad, 0x1f, 0x06, 0xa3, 0x64, 0x03, 0x97, 0x69, 0xa2, 0x45,
-	0xd1, 0x7b, 0x50, 0xa4, 0x8e, 0x3b, 0x18, 0x12, 0xd3, 0x3e, 0x6a, 0x68, 0x7c, 0x99, 0x82, 0xe8,
-	0xd8, 0x3e, 0x42, 0xb7, 0x00, 0xac, 0x31, 0xf3, 0xfa, 0xde, 0xc9, 0x89, 0xc3, 0x1a, 0x19, 0x6e,
-	0x4d, 0xf4, 0xa0, 0x0f, 0xa0, 0xcc, 0x2c, 0x7f, 0x40, 0x98, 0x49, 0x99, 0xef, 0xb8, 0x83, 0x46,
-	0xb6, 0xa5, 0xb4, 0x8b, 0xb8, 0x24, 0x3a, 0x0f, 0x79, 0x1f, 0xda, 0x80, 0xbc, 0x37, 0x62, 0x1c,
-	0x5f, 0xae, 0xa5, 0xb4, 0xf5, 0xcd, 0x1b, 0xeb, 0x82, 0x95, 0x9d, 0x5f, 0x93, 0xfe, 0x98, 0x91,
-	0x7d, 0x61, 0xc4, 0xe1, 0x28, 0xb4, 0x05, 0xb5, 0xc4, 0xde, 0xcd, 0x13, 0xcf, 0x26, 0x8d, 0x7c,
-	0x4b, 0x69, 0x57, 0x36, 0xdf, 0x0d, 0x77, 0x96, 0xa0, 0x61, 0xd7, 0xb3, 0x09, 0xae, 0xb2, 0xe9,
-	0x0e, 0xb4, 0x01, 0x85, 0xe7, 0x96, 0xef, 0x3a, 0xee, 0x80, 0x36, 0x0a, 0x9c, 0x95, 0xeb, 0x72,
-	0xd5, 0x9f, 0x06, 0x7f, 0x9f, 0x08, 0x1b, 0x8e, 0x06, 0xa1, 0x9f, 0x40, 0x69, 0xe4, 0x93, 0x98,
-	0xca, 0xe2, 0x1c, 0x54, 0xea, 0x23, 0x9f, 0x44, 0x44, 0xde, 0x87, 0xf2, 0xc8, 0xa3, 0x2c, 0x9e,
-	0x01, 0xe6, 0x98, 0xa1, 0x14, 0xbc, 0x12, 0x4d, 0xf1, 0x7d, 0xa8, 0x0c, 0x2d, 0xca, 0x4c, 0xc7,
-	0xa5, 0xc4, 0x67, 0xa6, 0x63, 0x37, 0xf4, 0x96, 0xd2, 0xce, 0xe0, 0x52, 0xd0, 0xdb, 0xe5, 0x9d,
-	0x5d, 0x1b, 0xbd, 0x0f, 0xf0, 0xd4, 0x1b, 0xbb, 0xb6, 0xe9, 0x7b, 0xcf, 0x69, 0xa3, 0xc4, 0x47,
-	0x14, 0x79, 0x0f, 0xf6, 0x9e, 0x53, 0x64, 0xc2, 0xca, 0x98, 0x12, 0xdf, 0xb4, 0xc9, 0x53, 0xc7,
-	0x25, 0xb6, 0x39, 0xb1, 0x7c, 0xc7, 0x3a, 0x1a, 0x12, 0xda, 0x28, 0x73, 0x40, 0x77, 0xd2, 0x80,
-	0x1e, 0x51, 0xe2, 0x6f, 0x8b, 0xc1, 0x8f, 0xc3, 0xb1, 0x3b, 0x2e, 0xf3, 0x4f, 0x71, 0x7d, 0x7c,
-	0x86, 0xa9, 0xf9, 0x15, 0x94, 0x92, 0x7b, 0x40, 0x6b, 0x90, 0x13, 0xfe, 0xe6, 0x2a, 0xd5, 0x37,
-	0xcb, 0x92, 0xe8, 0x1e, 0xef, 0xc4, 0xd2, 0x18, 0x88, 0x3a, 0xe9, 0x55, 0xc7, 0x6e, 0xa8, 0x2d,
-	0xa5, 0xad, 0xe1, 0x72, 0xa2, 0xb7, 0x6b, 0x37, 0xbf, 0x82, 0x9b, 0x33, 0x01, 0xa1, 0x1a, 0x68,
-	0xc7, 0xe4, 0x94, 0xaf, 0x53, 0xc4, 0xc1, 0x23, 0xba, 0x03, 0xd9, 0x89, 0x35, 0x1c, 0x13, 0x3e,
-	0x59, 0xec, 0xe4, 0x2d, 0xc7, 0x8d, 0xde, 0xc5, 0x62, 0xc4, 0x67, 0xea, 0xa7, 0x8a, 0xf1, 0x6f,
-	0x15, 0x2a, 0x52, 0x76, 0x98, 0x7c, 0x3d, 0x26, 0x94, 0xa1, 0x7b, 0x50, 0xec, 0x5b, 0xc3, 0x21,
-	0xf1, 0x03, 0x48, 0x62, 0x07, 0xd5, 0x75, 0x11, 0x99, 0x1d, 0xde, 0xdf, 0xdd, 0xc6, 0x05, 0x31,
-	0xa2, 0x6b, 0xa3, 0x3b, 0x90, 0x97, 0x0e, 0x96, 0x2b, 0x56, 0x53, 0x74, 0xe2, 0xd0, 0x8e, 0x3e,
-	0x84, 0x2c, 0x07, 0xc3, 0xa3, 0x4a, 0xdf, 0xbc, 0x16, 0x42, 0x0b, 0x3c, 0xc5, 0x45, 0x88, 0x85,
-	0x1d, 0xfd, 0x08, 0x74, 0x16, 0x00, 0x65, 0x26, 0x3b, 0x1d, 0x11, 0x1e, 0x66, 0x95, 0xcd, 0xfa,
-	0x7a, 0x94, 0x2d, 0x7a, 0xdc, 0xd8, 0x3b, 0x1d, 0x11, 0x0c, 0x2c, 0x7a, 0x46, 0xf7, 0x00, 0xb9,
-	0x5e, 0x20, 0x96, 0xa9, 0x4c, 0x91, 0xe5, 0x41, 0x5a, 0x73, 0x3d, 0xd6, 0x9d, 0x4a, 0x16, 0x6b,
-	0x50, 0x39, 0x26, 0xa7, 0x74, 0x64, 0xf5, 0x89, 0xc9, 0x33, 0x00, 0x0f, 0xc6, 0x22, 0x2e, 0x87,
-	0xbd, 0xdc, 0xa7, 0xc9, 0x60, 0xcd, 0xcf, 0x13, 0xac, 0xc6, 0xb7, 0x0a, 0x54, 0x23, 0x46, 0xe9,
-	0xc8, 0x73, 0x29, 0x41, 0x6b, 0x90, 0x25, 0xbe, 0xef, 0xf9, 0x29, 0x3a, 0xf1, 0x41, 0x67, 0x27,
-	0xe8, 0xc6, 0xc2, 0x7a, 0x11, 0x2e, 0xef, 0x42, 0xce, 0x27, 0x74, 0x3c, 0x64, 0x92, 0x4c, 0x94,
-	0x0c, 0x66, 0xcc, 0x2d, 0x58, 0x8e, 0x30, 0xfe, 0xab, 0x42, 0x5d, 0x22, 0xe2, 0x7b, 0xa2, 0xcb,
-	0xe3, 0xe9, 0x26, 0x14, 0x42, 0xba, 0xb9, 0x9b, 0x8b, 0x38, 0x6a, 0xa3, 0x15, 0xc8, 0x71, 0xbf,
-	0xd0, 0x46, 0xb6, 0xa5, 0xb5, 0x8b, 0x58, 0xb6, 0xd2, 0xea, 0xc8, 0x5d, 0x4a, 0x1d, 0xf9, 0x19,
-	0xea, 0x48, 0xb8, 0xbd, 0x30, 0x97, 0xdb, 0x7f, 0xa7, 0xc0, 0x8d, 0x14, 0xc9, 0x4b, 0xe1, 0xfc,
-	0xff, 0xab, 0x70, 0x53, 0xe2, 0xfa, 0x52, 0x32, 0xdb, 0x7d, 0x5b, 0x14, 0xf0, 0x3d, 0x28, 0x45,
-	0x21, 0xea, 0x48, 0x1d, 0x94, 0xb0, 0x7e, 0x1c, 0xef, 0x63, 0x49, 0xc5, 0xf0, 0x42, 0x81, 0xe6,
-	0x59, 0xa4, 0x2f, 0x85, 0x22, 0xbe, 0xd1, 0xe0, 0xdd, 0x18, 0x1c, 0xb6, 0xdc, 0x01, 0x79, 0x4b,
-	0xf4, 0xf0, 0x11, 0xc0, 0x31, 0x39, 0x35, 0x7d, 0x0e, 0x99, 0xab, 0x21, 0xd8, 0x69, 0xe4, 0xeb,
-	0x70, 0x37, 0xb8, 0x78, 0x1c, 0xee, 0x6b, 0x49, 0xf5, 0xf1, 0x7b, 0x05, 0x1a, 0x2f, 0xbb, 0x60,
-	0x29, 0xd4, 0xf1, 0xb7, 0x4c, 0xa4, 0x8e, 0x1d, 0x97, 0x39, 0xec, 0xf4, 0xad, 0xc9, 0x16, 0xf7,
-	0x00, 0x11, 0x8e, 0xd8, 0xec, 0x7b, 0xc3, 0xf1, 0x89, 0x6b, 0xba, 0xd6, 0x09, 0x91, 0x05, 0x78,
-	0x4d, 0x58, 0x3a, 0xdc, 0xb0, 0x67, 0x9d, 0x10, 0xf4, 0x33, 0xb8, 0x2e, 0x47, 0x4f, 0xa5, 0x98,
-	0x1c, 0x17, 0x55, 0x3b, 0x44, 0x3a, 0x83, 0x89, 0xf5, 0xb0, 0x03, 0x5f, 0x13, 0x93, 0x7c, 0x39,
-	0x3b, 0x25, 0xe5, 0x2f, 0x25, 0xb9, 0xc2, 0xf9, 0x92, 0x2b, 0xce, 0x23, 0xb9, 0xe6, 0x11, 0x14,
-	0x42, 0xd0, 0xe8, 0x36, 0x64, 0x38, 0x34, 0x85, 0x43, 0xd3, 0xc3, 0xf2, 0x34, 0x40, 0xc4, 0x0d,
-	0xa8, 0x9e, 0x2c, 0x22, 0x4b, 0xb2, 0x5e, 0x44, 0xb7, 0x41, 0x4f, 0x70, 0xc5, 0x7d, 0x55, 0xc2,
-	0x10, 0x67, 0xe3, 0xa4, 0xac, 0x13, 0x8c, 0x2d, 0x85, 0xac, 0xff, 0xa3, 0xc2, 0x75, 0x09, 0x6d,
-	0xcb, 0x62, 0xfd, 0x67, 0x57, 0x2e, 0xe9, 0x1f, 0x40, 0x3e, 0x40, 0xe3, 0x10, 0xda, 0xd0, 0xb8,
-	0xa6, 0xce, 0x10, 0x75, 0x38, 0x62, 0xd1, 0x82, 0x77, 0x0d, 0x2a, 0x16, 0x3d, 0xa3, 0xd8, 0x2d,
-	0x5b, 0xf4, 0x4d, 0x54, 0xba, 0x2f, 0x94, 0xa8, 0xae, 0x94, 0x9c, 0x5e, 0x99, 0xab, 0x7f, 0x08,
-	0x79, 0xe1, 0xc8, 0x90, 0xcd, 0x15, 0x89, 0x4d, 0xb8, 0xf9, 0x89, 0xc3, 0x9e, 0x89, 0xa9, 0xc3,
-	0x61, 0x86, 0x0b, 0x55, 0xce, 0x34, 0xdf, 0x1b, 0xa7, 0x3b, 0xce, 0x32, 0xca, 0x05, 0xb2, 0x8c,
-	0x3a, 0xb3, 0x2a, 0xd5, 0x92, 0x55, 0xa9, 0xf1, 0xd7, 0xb8, 0xce, 0xe2, 0x64, 0xbc, 0xa1, 0x4a,
-	0xfb, 0xa3, 0xb4, 0xcc, 0xa2, 0x1b, 0x81, 0xd4, 0xee, 0xdf, 0x94, 0xd8, 0x2e, 0x7a, 0xb9, 0x61,
-	0xfc, 0x21, 0xae, 0x95, 0xa6, 0x88, 0xbb, 0x32, 0x2d, 0xdd, 0x4b, 0x6b, 0xe9, 0xac, 0xbc, 0x11,
-	0xe9, 0xe8, 0x37, 0x50, 0xe7, 0x4c, 0xc6, 0x19, 0xfe, 0x35, 0x8a, 0x29, 0x5d, 0xe0, 0x6a, 0x2f,
-	0x15, 0xb8, 0xc6, 0x3f, 0x55, 0xb8, 0x95, 0xa4, 0xe7, 0x4d, 0x16, 0xf1, 0x9f, 0xa4, 0xc5, 0xb5,
-	0x3a, 0x25, 0xae, 0x14, 0x25, 0x4b, 0xab, 0xb0, 0x3f, 0x29, 0x70, 0x7b, 0x26, 0x85, 0x4b, 0x22,
-	0xb3, 0x3f, 0xab, 0x50, 0x3f, 0x64, 0x3e, 0xb1, 0x4e, 0x2e, 0x75, 0x1b, 0x13, 0xa9, 0x52, 0xbd,
-	0xd8, 0x15, 0x8b, 0x36, 0xbf, 0x8b, 0x52, 0x47, 0x49, 0xe6, 0x9c, 0xa3, 0x24, 0x3b, 0xd7, 0x0d,
-	0x67, 0x82, 0xd7, 0xdc, 0xab, 0x79, 0x35, 0x3a, 0x70, 0x23, 0x45, 0x94, 0x74, 0x61, 0x5c, 0x0e,
-	0x28, 0xe7, 0x96, 0x03, 0xdf, 0xaa, 0xd0, 0x9c, 0x9a, 0xe5, 0x32, 0xe9, 0x7a, 0x6e, 0xd2, 0x93,
-	0xa9, 0x40, 0x9b, 0x79, 0xae, 0x64, 0x5e, 0x75, 0xdb, 0x91, 0x9d, 0xd3, 0x51, 0x17, 0x0e, 0x92,
-	0x2e, 0xbc, 0x77, 0x26, 0x21, 0x0b, 0x90, 0xfb, 0x47, 0x15, 0x6e, 0x4f, 0xcd, 0x75, 0xe9, 0x9c,
-	0xf5, 0x5a, 0x18, 0x4e, 0x27, 0xdb, 0xcc, 0xb9, 0xb7, 0x09, 0x57, 0x46, 0xf6, 0x1e, 0xb4, 0x66,
-	0x13, 0xb4, 0x00, 0xe3, 0x7f, 0x51, 0xe1, 0xfd, 0xf4, 0x84, 0x97, 0xf9, 0x61, 0xff, 0x5a, 0xf8,
-	0x9e, 0xfe, 0xb5, 0x9e, 0x59, 0xe0, 0xd7, 0xfa, 0x95, 0xf1, 0xff, 0x10, 0x6e, 0xcd, 0xa2, 0x6b,
-	0x01, 0xf6, 0x7f, 0x0e, 0xa5, 0x2d, 0x32, 0x70, 0xdc, 0xc5, 0xb8, 0x9e, 0xfa, 0xde, 0xa4, 0x4e,
-	0x7f, 0x6f, 0x32, 0x3e, 0x83, 0xb2, 0x9c, 0x5a, 0xe2, 0x4a, 0x24, 0x4a, 0xe5, 0x9c, 0x44, 0xf9,
-	0x8d, 0x02, 0xe5, 0x0e, 0xff, 0x2c, 0x75, 0xe5, 0x85, 0xc2, 0x0a, 0xe4, 0x2c, 0xe6, 0x9d, 0x38,
-	0x7d, 0xf9, 0xc1, 0x4c, 0xb6, 0x8c, 0x1a, 0x54, 0x42, 0x04, 0x02, 0xbf, 0xf1, 0x2b, 0xa8, 0x62,
-	0x6f, 0x38, 0x3c, 0xb2, 0xfa, 0xc7, 0x57, 0x8d, 0xca, 0x40, 0x50, 0x8b, 0xd7, 0x92, 0xeb, 0xff,
-	0x12, 0x6e, 0x62, 0x42, 0xbd, 0xe1, 0x84, 0x24, 0x4a, 0x8a, 0xc5, 0x90, 0x20, 0xc8, 0xd8, 0x4c,
-	0x7e, 0xb5, 0x29, 0x62, 0xfe, 0x6c, 0xfc, 0x43, 0x81, 0xfa, 0x2e, 0xa1, 0xd4, 0x1a, 0x10, 0x21,
-	0xb0, 0xc5, 0xa6, 0x7e, 0x55, 0xcd, 0x58, 0x87, 0xac, 0x38, 0x79, 0x45, 0xbc, 0x89, 0x06, 0xda,
-	0x80, 0x62, 0x14, 0x6c, 0xfc, 0x4c, 0x3e, 0x3b, 0xd6, 0x0a, 0x61, 0xac, 0x05, 0xe8, 0x13, 0xf7,
-	0x23, 0xfc, 0xd9, 0xf8, 0xad, 0x02, 0xd7, 0x24, 0xfa, 0xfb, 0x8b, 0xfa, 0xe7, 0x55, 0xd0, 0xc3,
-	0x35, 0xb5, 0x78, 0x4d, 0x74, 0x0b, 0xb4, 0x30, 0x19, 0xeb, 0x9b, 0x25, 0x19, 0x65, 0x8f, 0xad,
-	0xe1, 0x98, 0xe0, 0xc0, 0x60, 0xec, 0x42, 0xa9, 0x9b, 0xa8, 0x34, 0xd1, 0x2a, 0xa8, 0x11, 0x8c,
-	0xe9, 0xe1, 0xaa, 0x63, 0xa7, 0xaf, 0x28, 0xd4, 0x97, 0xae, 0x28, 0xfe, 0xae, 0xc0, 0x6a, 0xbc,
-	0xc5, 0x4b, 0x1f, 0x4c, 0x17, 0xdd, 0xed, 0xe7, 0x50, 0x75, 0x6c, 0xf3, 0xa5, 0x63, 0x48, 0xdf,
-	0xac, 0x87, 0x2a, 0x4e, 0x6e, 0x16, 0x97, 0x9d, 0x44, 0x8b, 0x1a, 0xab, 0xd0, 0x3c, 0x4b, 0xbc,
-	0x52, 0xda, 0x1f, 0xc3, 0x8d, 0x07, 0x84, 0x1d, 0xfa, 0x93, 0xf0, 0x95, 0x70, 0x4b, 0x49, 0x90,
-	0xca, 0x34, 0x48, 0x03, 0xc3, 0x4a, 0xfa, 0x25, 0x99, 0x69, 0x3e, 0x85, 0x12, 0xf5, 0x27, 0xe6,
-	0xd4, 0x9b, 0x41, 0x66, 0x8d, 0x44, 0x95, 0x7c, 0x49, 0xa7, 0x71, 0xc3, 0xf8, 0x97, 0x02, 0x95,
-	0xc7, 0x97, 0x91, 0x7f, 0xea, 0x18, 0x50, 0xe7, 0x3c, 0x06, 0x3e, 0x84, 0xec, 0x64, 0xc0, 0xe4,
-	0xcd, 0x54, 0x70, 0x6a, 0x25, 0xfe, 0xfb, 0xe0, 0xf1, 0x03, 0xe6, 0xd8, 0x58, 0xd8, 0x83, 0xe4,
-	0xfe, 0xd4, 0x19, 0x32, 0xe2, 0x47, 0x91, 0x92, 0x18, 0xf9, 0x05, 0xb7, 0x60, 0x39, 0xc2, 0xf8,
-	0x31, 0x54, 0xa3, 0xbd, 0xc4, 0x67, 0x03, 0x99, 0x10, 0x97, 0xd1, 0x86, 0x22, 0x0f, 0xb5, 0xe4,
-	0x42, 0x3b, 0x81, 0x09, 0xcb, 0x11, 0x77, 0xb7, 0xa1, 0x9a, 0xfa, 0x34, 0x8f, 0xaa, 0xa0, 0x3f,
-	0xda, 0x3b, 0x3c, 0xd8, 0xe9, 0x74, 0xbf, 0xe8, 0xee, 0x6c, 0xd7, 0xde, 0x41, 0x00, 0xb9, 0xc3,
-	0xee, 0xde, 0x83, 0x87, 0x3b, 0x35, 0x05, 0x15, 0x21, 0xbb, 0xfb, 0xe8, 0x61, 0xaf, 0x5b, 0x53,
-	0x83, 0xc7, 0xde, 0x93, 0xfd, 0x83, 0x4e, 0x4d, 0xbb, 0xfb, 0x39, 0xe8, 0x22, 0x8f, 0xee, 0xfb,
-	0x36, 0xf1, 0x83, 0x17, 0xf6, 0xf6, 0xf1, 0xee, 0xfd, 0x87, 0xb5, 0x77, 0x50, 0x1e, 0xb4, 0x03,
-	0x1c, 0xbc, 0x59, 0x80, 0xcc, 0xc1, 0xfe, 0x61, 0xaf, 0xa6, 0xa2, 0x0a, 0xc0, 0xfd, 0x47, 0xbd,
-	0xfd, 0xce, 0xfe, 0xee, 0x6e, 0xb7, 0x57, 0xd3, 0xb6, 0x3e, 0x81, 0xaa, 0xe3, 0xad, 0x4f, 0x1c,
-	0x46, 0x28, 0x15, 0xff, 0x5c, 0xf1, 0x8b, 0x0f, 0x64, 0xcb, 0xf1, 0x36, 0xc4, 0xd3, 0xc6, 0xc0,
-	0xdb, 0x98, 0xb0, 0x0d, 0x6e, 0xdd, 0x10, 0x52, 0x3c, 0xca, 0xf1, 0xd6, 0xc7, 0xdf, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0xb3, 0x65, 0x97, 0x24, 0xdc, 0x21, 0x00, 0x00,
+	0x15, 0xce, 0xee, 0xf2, 0xf7, 0xf0, 0xd7, 0x63, 0x5a, 0x59, 0x33, 0x8a, 0xcd, 0x6e, 0x2a, 0x84,
+	0x76, 0x0d, 0xa9, 0x51, 0xd0, 0x20, 0x08, 0x52, 0x14, 0x16, 0xa5, 0x18, 0x44, 0xac, 0x9f, 0x8e,
+	0x68, 0xb9, 0x2d, 0x52, 0x2c, 0x56, 0xdc, 0x31, 0xbd, 0x15, 0xb5, 0xcb, 0xec, 0x0c, 0xe9, 0xea,
+	0xa6, 0x08, 0xd0, 0x07, 0x08, 0x7a, 0x51, 0xa0, 0x30, 0x0a, 0x14, 0xbd, 0xea, 0x55, 0x6f, 0x0b,
+	0xb4, 0xbd, 0xe9, 0x75, 0x6f, 0x8a, 0xbe, 0x42, 0x1f, 0xa1, 0x4f, 0x10, 0xec, 0xcc, 0xec, 0x0f,
+	0x57, 0xa2, 0x45, 0x49, 0x96, 0x41, 0xdf, 0x08, 0x3b, 0x73, 0x66, 0x66, 0xbf, 0xf9, 0xce, 0x77,
+	0xce, 0x1c, 0xce, 0x0a, 0xca, 0x13, 0x36, 0xb0, 0x18, 0x59, 0x1d, 0xf9, 0x1e, 0xf3, 0x50, 0x4e,
+	0xb4, 0x9a, 0xf5, 0x43, 0xc7, 0x1d, 0x7a, 0x03, 0xdb, 0x62, 0x96, 0xb0, 0x34, 0x4b, 0x5f, 0x8f,
+	0x89, 0x7f, 0x22, 0x1b, 0x55, 0xe6, 0x8d, 0xbc, 0xa4, 0x71, 0xc2, 0xfc, 0x51, 0x5f, 0x34, 0x8c,
+	0xdf, 0xe6, 0x21, 0xbf, 0x4f, 0x28, 0x75, 0x3c, 0x17, 0xad, 0x40, 0xd5, 0x71, 0x4d, 0xe6, 0x5b,
+	0x2e, 0xb5, 0xfa, 0xcc, 0xf1, 0x5c, 0x5d, 0x69, 0x29, 0xed, 0x02, 0xae, 0x38, 0x6e, 0x2f, 0xee,
+	0x44, 0x1d, 0xa8, 0xd2, 0xe7, 0x96, 0x6f, 0x9b, 0x54, 0xcc, 0xa3, 0xba, 0xda, 0xd2, 0xda, 0xa5,
+	0xf5, 0xe5, 0x55, 0x89, 0x4e, 0xae, 0xb7, 0xba, 0x1f, 0x8c, 0x92, 0x0d, 0x5c, 0xa1, 0x89, 0x16,
+	0x45, 0xef, 0x41, 0x91, 0x3a, 0xee, 0x60, 0x48, 0x4c, 0xfb, 0x50, 0xd7, 0xf8, 0x6b, 0x0a, 0xa2,
+	0x63, 0xf3, 0x10, 0xdd, 0x01, 0xb0, 0xc6, 0xcc, 0xeb, 0x7b, 0xc7, 0xc7, 0x0e, 0xd3, 0x33, 0xdc,
+	0x9a, 0xe8, 0x41, 0x1f, 0x40, 0x85, 0x59, 0xfe, 0x80, 0x30, 0x93, 0x32, 0xdf, 0x71, 0x07, 0x7a,
+	0xb6, 0xa5, 0xb4, 0x8b, 0xb8, 0x2c, 0x3a, 0xf7, 0x79, 0x1f, 0x5a, 0x83, 0xbc, 0x37, 0x62, 0x1c,
+	0x5f, 0xae, 0xa5, 0xb4, 0x4b, 0xeb, 0xb7, 0x56, 0x05, 0x2b, 0x5b, 0xbf, 0x26, 0xfd, 0x31, 0x23,
+	0xbb, 0xc2, 0x88, 0xc3, 0x51, 0x68, 0x03, 0xea, 0x89, 0xbd, 0x9b, 0xc7, 0x9e, 0x4d, 0xf4, 0x7c,
+	0x4b, 0x69, 0x57, 0xd7, 0xdf, 0x0d, 0x77, 0x96, 0xa0, 0x61, 0xdb, 0xb3, 0x09, 0xae, 0xb1, 0xe9,
+	0x0e, 0xb4, 0x06, 0x85, 0x17, 0x96, 0xef, 0x3a, 0xee, 0x80, 0xea, 0x05, 0xce, 0xca, 0x4d, 0xf9,
+	0xd6, 0x9f, 0x06, 0x7f, 0x9f, 0x0a, 0x1b, 0x8e, 0x06, 0xa1, 0x9f, 0x40, 0x79, 0xe4, 0x93, 0x98,
+	0xca, 0xe2, 0x1c, 0x54, 0x96, 0x46, 0x3e, 0x89, 0x88, 0x7c, 0x08, 0x95, 0x91, 0x47, 0x59, 0xbc,
+	0x02, 0xcc, 0xb1, 0x42, 0x39, 0x98, 0x12, 0x2d, 0xf1, 0x7d, 0xa8, 0x0e, 0x2d, 0xca, 0x4c, 0xc7,
+	0xa5, 0xc4, 0x67, 0xa6, 0x63, 0xeb, 0xa5, 0x96, 0xd2, 0xce, 0xe0, 0x72, 0xd0, 0xdb, 0xe5, 0x9d,
+	0x5d, 0x1b, 0xbd, 0x0f, 0xf0, 0xcc, 0x1b, 0xbb, 0xb6, 0xe9, 0x7b, 0x2f, 0xa8, 0x5e, 0xe6, 0x23,
+	0x8a, 0xbc, 0x07, 0x7b, 0x2f, 0x28, 0x32, 0x61, 0x69, 0x4c, 0x89, 0x6f, 0xda, 0xe4, 0x99, 0xe3,
+	0x12, 0xdb, 0x9c, 0x58, 0xbe, 0x63, 0x1d, 0x0e, 0x09, 0xd5, 0x2b, 0x1c, 0xd0, 0xbd, 0x34, 0xa0,
+	0x27, 0x94, 0xf8, 0x9b, 0x62, 0xf0, 0x41, 0x38, 0x76, 0xcb, 0x65, 0xfe, 0x09, 0x6e, 0x8c, 0xcf,
+	0x30, 0x05, 0xa2, 0xa0, 0xd6, 0x84, 0x8c, 0x3c, 0xc7, 0x65, 0x54, 0xaf, 0xb6, 0xb4, 0x76, 0x11,
+	0x27, 0x7a, 0x9a, 0x5f, 0x41, 0x39, 0xb9, 0x47, 0xb4, 0x02, 0x39, 0xa1, 0x07, 0xae, 0xe2, 0xd2,
+	0x7a, 0x45, 0x3a, 0xa2, 0xc7, 0x3b, 0xb1, 0x34, 0x06, 0xa2, 0x4f, 0x7a, 0xdd, 0xb1, 0x75, 0xb5,
+	0xa5, 0xb4, 0x35, 0x5c, 0x49, 0xf4, 0x76, 0xed, 0xe6, 0x57, 0x70, 0x7b, 0x26, 0x60, 0x54, 0x07,
+	0xed, 0x88, 0x9c, 0xf0, 0xf7, 0x14, 0x71, 0xf0, 0x88, 0xee, 0x41, 0x76, 0x62, 0x0d, 0xc7, 0x84,
+	0x2f, 0x16, 0x8b, 0x60, 0xc3, 0x71, 0xa3, 0xb9, 0x58, 0x8c, 0xf8, 0x4c, 0xfd, 0x54, 0x31, 0xfe,
+	0xa3, 0x42, 0x55, 0xca, 0x12, 0x93, 0xaf, 0xc7, 0x84, 0x32, 0xf4, 0x00, 0x8a, 0x7d, 0x6b, 0x38,
+	0x24, 0x7e, 0x00, 0x49, 0xec, 0xa0, 0xb6, 0x2a, 0x22, 0xb7, 0xc3, 0xfb, 0xbb, 0x9b, 0xb8, 0x20,
+	0x46, 0x74, 0x6d, 0x74, 0x0f, 0xf2, 0x52, 0x00, 0xba, 0x1a, 0x8d, 0x4d, 0xd2, 0x8d, 0x43, 0x3b,
+	0xfa, 0x10, 0xb2, 0x1c, 0x0c, 0x8f, 0xba, 0xd2, 0xfa, 0x8d, 0x10, 0x5a, 0xe0, 0x49, 0x2e, 0x52,
+	0x2c, 0xec, 0xe8, 0x47, 0x50, 0x62, 0x01, 0x50, 0x66, 0xb2, 0x93, 0x11, 0xe1, 0x61, 0x58, 0x5d,
+	0x6f, 0xac, 0x46, 0xd9, 0xa4, 0xc7, 0x8d, 0xbd, 0x93, 0x11, 0xc1, 0xc0, 0xa2, 0x67, 0xf4, 0x00,
+	0x90, 0xeb, 0x31, 0x33, 0x95, 0x49, 0xb2, 0x3c, 0x88, 0xeb, 0xae, 0xc7, 0xba, 0x53, 0xc9, 0x64,
+	0x05, 0xaa, 0x47, 0xe4, 0x84, 0x8e, 0xac, 0x3e, 0x31, 0x79, 0x86, 0xe0, 0xc1, 0x5a, 0xc4, 0x95,
+	0xb0, 0x97, 0xfb, 0x34, 0x19, 0xcc, 0xf9, 0x79, 0x82, 0xd9, 0xf8, 0x56, 0x81, 0x5a, 0xc4, 0x28,
+	0x1d, 0x79, 0x2e, 0x25, 0x68, 0x05, 0xb2, 0xc4, 0xf7, 0x3d, 0x3f, 0x45, 0x27, 0xde, 0xeb, 0x6c,
+	0x05, 0xdd, 0x58, 0x58, 0x2f, 0xc2, 0xe5, 0x7d, 0xc8, 0xf9, 0x84, 0x8e, 0x87, 0x4c, 0x92, 0x89,
+	0x92, 0xc1, 0x8e, 0xb9, 0x05, 0xcb, 0x11, 0xc6, 0xff, 0x54, 0x68, 0x48, 0x44, 0x7c, 0x4f, 0x74,
+	0x71, 0x3c, 0xdd, 0x84, 0x42, 0x48, 0x37, 0x77, 0x73, 0x11, 0x47, 0x6d, 0xb4, 0x04, 0x39, 0xee,
+	0x17, 0xaa, 0x67, 0x79, 0xc8, 0xc9, 0x56, 0x5a, 0x1d, 0xb9, 0x2b, 0xa9, 0x23, 0x3f, 0x43, 0x1d,
+	0x09, 0xb7, 0x17, 0xe6, 0x72, 0xfb, 0xef, 0x15, 0xb8, 0x95, 0x22, 0x79, 0x21, 0x9c, 0xff, 0x7f,
+	0x15, 0x6e, 0x4b, 0x5c, 0x5f, 0x4a, 0x66, 0xbb, 0x6f, 0x8b, 0x02, 0xbe, 0x07, 0xe5, 0x28, 0x44,
+	0x1d, 0xa9, 0x83, 0x32, 0x2e, 0x1d, 0xc5, 0xfb, 0x58, 0x50, 0x31, 0xbc, 0x54, 0xa0, 0x79, 0x16,
+	0xe9, 0x0b, 0xa1, 0x88, 0x6f, 0x34, 0x78, 0x37, 0x06, 0x87, 0x2d, 0x77, 0x40, 0xde, 0x12, 0x3d,
+	0x7c, 0x04, 0x70, 0x44, 0x4e, 0x4c, 0x9f, 0x43, 0xe6, 0x6a, 0x08, 0x76, 0x1a, 0xf9, 0x3a, 0xdc,
+	0x0d, 0x2e, 0x1e, 0xc9, 0xa7, 0x45, 0xd5, 0xc7, 0x1f, 0x14, 0xd0, 0x4f, 0xbb, 0x60, 0x21, 0xd4,
+	0xf1, 0xf7, 0x4c, 0xa4, 0x8e, 0x2d, 0x97, 0x39, 0xec, 0xe4, 0xad, 0xc9, 0x16, 0x0f, 0x00, 0x11,
+	0x8e, 0xd8, 0xec, 0x7b, 0xc3, 0xf1, 0xb1, 0x6b, 0xba, 0xd6, 0x31, 0x91, 0x05, 0x7a, 0x5d, 0x58,
+	0x3a, 0xdc, 0xb0, 0x63, 0x1d, 0x13, 0xf4, 0x33, 0xb8, 0x29, 0x47, 0x4f, 0xa5, 0x98, 0x1c, 0x17,
+	0x55, 0x3b, 0x44, 0x3a, 0x83, 0x89, 0xd5, 0xb0, 0x03, 0xdf, 0x10, 0x8b, 0x7c, 0x39, 0x3b, 0x25,
+	0xe5, 0xaf, 0x24, 0xb9, 0xc2, 0xf9, 0x92, 0x2b, 0xce, 0x23, 0xb9, 0xe6, 0x21, 0x14, 0x42, 0xd0,
+	0xe8, 0x2e, 0x64, 0x38, 0x34, 0x85, 0x43, 0x2b, 0x85, 0xe5, 0x69, 0x80, 0x88, 0x1b, 0x50, 0x23,
+	0x59, 0x44, 0x96, 0x65, 0xbd, 0x88, 0xee, 0x42, 0x29, 0xc1, 0x15, 0xf7, 0x55, 0x19, 0x43, 0x9c,
+	0x8d, 0x93, 0xb2, 0x4e, 0x30, 0xb6, 0x10, 0xb2, 0xfe, 0xaf, 0x0a, 0x37, 0x25, 0xb4, 0x0d, 0x8b,
+	0xf5, 0x9f, 0x5f, 0xbb, 0xa4, 0x7f, 0x00, 0xf9, 0x00, 0x8d, 0x43, 0xa8, 0xae, 0xb5, 0xb4, 0xb3,
+	0x45, 0x1d, 0x8e, 0xb8, 0x6c, 0xc1, 0xbb, 0x02, 0x55, 0x8b, 0x9e, 0x51, 0xec, 0x56, 0x2c, 0xfa,
+	0x26, 0x2a, 0xdd, 0x97, 0x0a, 0x34, 0xa6, 0x39, 0xbd, 0x36, 0x57, 0xff, 0x10, 0xf2, 0xc2, 0x91,
+	0x21, 0x9b, 0x4b, 0x12, 0x9b, 0x70, 0xf3, 0x53, 0x87, 0x3d, 0x17, 0x4b, 0x87, 0xc3, 0x0c, 0x17,
+	0x6a, 0x9c, 0x69, 0xbe, 0x37, 0x4e, 0x77, 0x9c, 0x65, 0x94, 0x0b, 0x64, 0x19, 0x75, 0x66, 0x55,
+	0xaa, 0x25, 0xab, 0x52, 0xe3, 0x6f, 0x71, 0x9d, 0xc5, 0xc9, 0x78, 0x43, 0x95, 0xf6, 0x47, 0x69,
+	0x99, 0x45, 0x37, 0x06, 0xa9, 0xdd, 0xbf, 0x29, 0xb1, 0x5d, 0xf4, 0xf2, 0xc3, 0xf8, 0x63, 0x5c,
+	0x2b, 0x4d, 0x11, 0x77, 0x6d, 0x5a, 0x7a, 0x90, 0xd6, 0xd2, 0x59, 0x79, 0x23, 0xd2, 0xd1, 0x6f,
+	0xa0, 0xc1, 0x99, 0x8c, 0x33, 0xfc, 0x6b, 0x14, 0x53, 0xba, 0xc0, 0xd5, 0x4e, 0x15, 0xb8, 0xc6,
+	0xbf, 0x54, 0xb8, 0x93, 0xa4, 0xe7, 0x4d, 0x16, 0xf1, 0x9f, 0xa4, 0xc5, 0xb5, 0x3c, 0x25, 0xae,
+	0x14, 0x25, 0x0b, 0xab, 0xb0, 0x3f, 0x2b, 0x70, 0x77, 0x26, 0x85, 0x0b, 0x22, 0xb3, 0xbf, 0xa8,
+	0xd0, 0xd8, 0x67, 0x3e, 0xb1, 0x8e, 0xaf, 0x74, 0x1b, 0x13, 0xa9, 0x52, 0xbd, 0xd8, 0x15, 0x8b,
+	0x36, 0xbf, 0x8b, 0x52, 0x47, 0x49, 0xe6, 0x9c, 0xa3, 0x24, 0x3b, 0xd7, 0x0d, 0x68, 0x82, 0xd7,
+	0xdc, 0xab, 0x79, 0x35, 0x3a, 0x70, 0x2b, 0x45, 0x94, 0x74, 0x61, 0x5c, 0x0e, 0x28, 0xe7, 0x96,
+	0x03, 0xdf, 0xaa, 0xd0, 0x9c, 0x5a, 0xe5, 0x2a, 0xe9, 0x7a, 0x6e, 0xd2, 0x93, 0xa9, 0x40, 0x9b,
+	0x79, 0xae, 0x64, 0x5e, 0x75, 0xdb, 0x91, 0x9d, 0xd3, 0x51, 0x17, 0x0e, 0x92, 0x2e, 0xbc, 0x77,
+	0x26, 0x21, 0x97, 0x20, 0xf7, 0x4f, 0x2a, 0xdc, 0x9d, 0x5a, 0xeb, 0xca, 0x39, 0xeb, 0xb5, 0x30,
+	0x9c, 0x4e, 0xb6, 0x99, 0x73, 0x6f, 0x13, 0xae, 0x8d, 0xec, 0x1d, 0x68, 0xcd, 0x26, 0xe8, 0x12,
+	0x8c, 0xff, 0x55, 0x85, 0xf7, 0xd3, 0x0b, 0x5e, 0xe5, 0x87, 0xfd, 0x6b, 0xe1, 0x7b, 0xfa, 0xd7,
+	0x7a, 0xe6, 0x12, 0xbf, 0xd6, 0xaf, 0x8d, 0xff, 0xc7, 0x70, 0x67, 0x16, 0x5d, 0x97, 0x60, 0xff,
+	0xe7, 0x50, 0xde, 0x20, 0x03, 0xc7, 0xbd, 0x1c, 0xd7, 0x53, 0xdf, 0xa3, 0xd4, 0xe9, 0xef, 0x51,
+	0xc6, 0x67, 0x50, 0x91, 0x4b, 0x4b, 0x5c, 0x89, 0x44, 0xa9, 0x9c, 0x93, 0x28, 0xbf, 0x51, 0xa0,
+	0xd2, 0xe1, 0x9f, 0xad, 0xae, 0xbd, 0x50, 0x58, 0x82, 0x9c, 0xc5, 0xbc, 0x63, 0xa7, 0x2f, 0x3f,
+	0xa8, 0xc9, 0x96, 0x51, 0x87, 0x6a, 0x88, 0x40, 0xe0, 0x37, 0x7e, 0x05, 0x35, 0xec, 0x0d, 0x87,
+	0x87, 0x56, 0xff, 0xe8, 0xba, 0x51, 0x19, 0x08, 0xea, 0xf1, 0xbb, 0xe4, 0xfb, 0x7f, 0x09, 0xb7,
+	0x31, 0xa1, 0xde, 0x70, 0x42, 0x12, 0x25, 0xc5, 0xe5, 0x90, 0x20, 0xc8, 0xd8, 0x4c, 0x7e, 0xb5,
+	0x29, 0x62, 0xfe, 0x6c, 0xfc, 0x53, 0x81, 0xc6, 0x36, 0xa1, 0xd4, 0x1a, 0x10, 0x21, 0xb0, 0xcb,
+	0x2d, 0xfd, 0xaa, 0x9a, 0xb1, 0x01, 0x59, 0x71, 0xf2, 0x8a, 0x78, 0x13, 0x0d, 0xb4, 0x06, 0xc5,
+	0x28, 0xd8, 0xf4, 0x8c, 0x94, 0xec, 0xe9, 0x58, 0x2b, 0x84, 0xb1, 0x16, 0xa0, 0x4f, 0xdc, 0x8f,
+	0xf0, 0x67, 0xe3, 0x77, 0x0a, 0xdc, 0x90, 0xe8, 0x1f, 0xf6, 0x8f, 0x5e, 0x3f, 0xf4, 0xf0, 0x9d,
+	0x5a, 0xfc, 0x4e, 0x74, 0x07, 0xb4, 0x30, 0x19, 0x97, 0xd6, 0xcb, 0x32, 0xca, 0x0e, 0x82, 0xfb,
+	0x06, 0x1c, 0x18, 0x8c, 0x6d, 0x28, 0x77, 0x13, 0x95, 0x26, 0x5a, 0x06, 0x35, 0x82, 0x31, 0x3d,
+	0x5c, 0x75, 0xec, 0xf4, 0x15, 0x85, 0x7a, 0xea, 0x8a, 0xe2, 0x1f, 0x0a, 0x2c, 0xc7, 0x5b, 0xbc,
+	0xf2, 0xc1, 0x74, 0xd1, 0xdd, 0x7e, 0x0e, 0x35, 0xc7, 0x36, 0x4f, 0x1d, 0x43, 0xa5, 0xf5, 0x46,
+	0xa8, 0xe2, 0xe4, 0x66, 0x71, 0xc5, 0x49, 0xb4, 0xa8, 0xb1, 0x0c, 0xcd, 0xb3, 0xc4, 0x2b, 0xa5,
+	0xfd, 0x31, 0xdc, 0x7a, 0x44, 0xd8, 0xbe, 0x3f, 0x09, 0xa7, 0x84, 0x5b, 0x4a, 0x82, 0x54, 0xa6,
+	0x41, 0x1a, 0x18, 0x96, 0xd2, 0x93, 0x64, 0xa6, 0xf9, 0x14, 0xca, 0xd4, 0x9f, 0x98, 0x53, 0x33,
+	0x83, 0xcc, 0x1a, 0x89, 0x2a, 0x39, 0xa9, 0x44, 0xe3, 0x86, 0xf1, 0x6f, 0x05, 0xaa, 0x07, 0x57,
+	0x91, 0x7f, 0xea, 0x18, 0x50, 0xe7, 0x3c, 0x06, 0x3e, 0x84, 0xec, 0x64, 0xc0, 0xe4, 0xcd, 0x54,
+	0x70, 0x6a, 0x25, 0xfe, 0x3b, 0xe1, 0xe0, 0x11, 0x73, 0x6c, 0x2c, 0xec, 0x41, 0x72, 0x7f, 0xe6,
+	0x0c, 0x19, 0xf1, 0xa3, 0x48, 0x49, 0x8c, 0xfc, 0x82, 0x5b, 0xb0, 0x1c, 0x61, 0xfc, 0x18, 0x6a,
+	0xd1, 0x5e, 0xe2, 0xb3, 0x81, 0x4c, 0x48, 0xf0, 0x2d, 0x58, 0x69, 0x69, 0xe9, 0xe9, 0x07, 0x5b,
+	0x81, 0x09, 0xcb, 0x11, 0xf7, 0x37, 0xa1, 0x96, 0xfa, 0x74, 0x8f, 0x6a, 0x50, 0x7a, 0xb2, 0xb3,
+	0xbf, 0xb7, 0xd5, 0xe9, 0x7e, 0xd1, 0xdd, 0xda, 0xac, 0xbf, 0x83, 0x00, 0x72, 0xfb, 0xdd, 0x9d,
+	0x47, 0x8f, 0xb7, 0xea, 0x0a, 0x2a, 0x42, 0x76, 0xfb, 0xc9, 0xe3, 0x5e, 0xb7, 0xae, 0x06, 0x8f,
+	0xbd, 0xa7, 0xbb, 0x7b, 0x9d, 0xba, 0x76, 0xff, 0x73, 0x28, 0x89, 0x3c, 0xba, 0xeb, 0xdb, 0xc4,
+	0x0f, 0x26, 0xec, 0xec, 0xe2, 0xed, 0x87, 0x8f, 0xeb, 0xef, 0xa0, 0x3c, 0x68, 0x7b, 0x38, 0x98,
+	0x59, 0x80, 0xcc, 0xde, 0xee, 0x7e, 0xaf, 0xae, 0xa2, 0x2a, 0xc0, 0xc3, 0x27, 0xbd, 0xdd, 0xce,
+	0xee, 0xf6, 0x76, 0xb7, 0x57, 0xd7, 0x36, 0x3e, 0x81, 0x9a, 0xe3, 0xad, 0x4e, 0x1c, 0x46, 0x28,
+	0x15, 0xff, 0x7c, 0xf1, 0x8b, 0x0f, 0x64, 0xcb, 0xf1, 0xd6, 0xc4, 0xd3, 0xda, 0xc0, 0x5b, 0x9b,
+	0xb0, 0x35, 0x6e, 0x5d, 0x13, 0x52, 0x3c, 0xcc, 0xf1, 0xd6, 0xc7, 0xdf, 0x0d, 0x00, 0xbf, 0x35,
+	0x9d, 0x5f, 0xfc, 0x21, 0x00, 0x00,
 }
@@ -53,6 +53,9 @@ const (
 	StmtUnknown
 	StmtComment
 	StmtPriv
+	StmtSavepoint
+	StmtSRollback
+	StmtRelease
 )
 
 // Preview analyzes the beginning of the query using a simpler and faster
@@ -73,7 +76,7 @@ func Preview(sql string) StatementType {
 	// Comparison is done in order of priority.
 	loweredFirstWord := strings.ToLower(firstWord)
 	switch loweredFirstWord {
-	case "select":
+	case "select", "with":
 		return StmtSelect
 	case "stream":
 		return StmtStream
@@ -101,6 +104,12 @@ func Preview(sql string) StatementType {
 		return StmtRollback
 	}
 	switch loweredFirstWord {
+	case "savepoint":
+		return StmtSavepoint
+	case "rollback":
+		return StmtSRollback
+	case "release":
+		return StmtRelease
 	case "create", "alter", "rename", "drop", "truncate", "flush":
 		return StmtDDL
 	case "set":
@@ -149,6 +158,12 @@ func (s StatementType) String() string {
 		return "OTHER"
 	case StmtPriv:
 		return "PRIV"
+	case StmtSavepoint:
+		return "SAVEPOINT"
+	case StmtSRollback:
+		return "SAVEPOINT_ROLLBACK"
+	case StmtRelease:
+		return "RELEASE"
 	default:
 		return "UNKNOWN"
 	}
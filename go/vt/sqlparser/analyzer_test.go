@@ -61,6 +61,9 @@ func TestPreview(t *testing.T) {
 		{"commit /*...*/", StmtCommit},
 		{"rollback", StmtRollback},
 		{"rollback /*...*/", StmtRollback},
+		{"rollback to a", StmtSRollback},
+		{"savepoint a", StmtSavepoint},
+		{"release savepoint a", StmtRelease},
 		{"create", StmtDDL},
 		{"alter", StmtDDL},
 		{"rename", StmtDDL},
@@ -80,6 +83,7 @@ func TestPreview(t *testing.T) {
 		{"unknown", StmtUnknown},
 
 		{"/* leading comment */ select ...", StmtSelect},
+		{"with t as (select ...) select ...", StmtSelect},
 		{"/* leading comment */ (select ...", StmtSelect},
 		{"/* leading comment */ /* leading comment 2 */ select ...", StmtSelect},
 		{"/*! MySQL-specific comment */", StmtComment},
@@ -50,11 +50,13 @@ type (
 		iInsertRows()
 		AddOrder(*Order)
 		SetLimit(*Limit)
+		SetWith(With)
 		SQLNode
 	}
 
 	// Select represents a SELECT statement.
 	Select struct {
+		With        With
 		Cache       string
 		Comments    Comments
 		Distinct    string
@@ -64,6 +66,7 @@ type (
 		Where       *Where
 		GroupBy     GroupBy
 		Having      *Where
+		Windows     NamedWindows
 		OrderBy     OrderBy
 		Limit       *Limit
 		Lock        string
@@ -71,6 +74,7 @@ type (
 
 	// Union represents a UNION statement.
 	Union struct {
+		With        With
 		Type        string
 		Left, Right SelectStatement
 		OrderBy     OrderBy
@@ -151,7 +155,7 @@ type (
 		// FromTables is set if Action is RenameStr or DropStr.
 		FromTables TableNames
 
-		// ToTables is set if Action is RenameStr.
+		// ToTables is set if Action is RenameStr or AddRoutingRuleStr.
 		ToTables TableNames
 
 		// Table is set if Action is other than RenameStr or DropStr.
@@ -202,6 +206,21 @@ type (
 	// Rollback represents a Rollback statement.
 	Rollback struct{}
 
+	// Savepoint represents a SAVEPOINT statement.
+	Savepoint struct {
+		Name ColIdent
+	}
+
+	// SRollback represents a ROLLBACK TO SAVEPOINT statement.
+	SRollback struct {
+		Name ColIdent
+	}
+
+	// Release represents a RELEASE SAVEPOINT statement.
+	Release struct {
+		Name ColIdent
+	}
+
 	// OtherRead represents a DESCRIBE, or EXPLAIN statement.
 	// It should be used only as an indicator. It does not contain
 	// the full AST for the statement.
@@ -228,6 +247,9 @@ func (*Use) iStatement()               {}
 func (*Begin) iStatement()             {}
 func (*Commit) iStatement()            {}
 func (*Rollback) iStatement()          {}
+func (*Savepoint) iStatement()         {}
+func (*SRollback) iStatement()         {}
+func (*Release) iStatement()           {}
 func (*OtherRead) iStatement()         {}
 func (*OtherAdmin) iStatement()        {}
 func (*Select) iSelectStatement()      {}
@@ -378,6 +400,18 @@ type ShowFilter struct {
 	Filter Expr
 }
 
+// With represents the WITH clause of a SELECT or UNION.
+// Only non-recursive common table expressions are supported.
+type With []*CommonTableExpr
+
+// CommonTableExpr represents a single named subquery
+// of a WITH clause.
+type CommonTableExpr struct {
+	Name     TableIdent
+	Columns  Columns
+	Subquery *Subquery
+}
+
 // Comments represents a list of comments.
 type Comments [][]byte
 
@@ -630,6 +664,7 @@ type (
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
@@ -765,6 +800,46 @@ type Order struct {
 	Direction string
 }
 
+// OverClause represents the OVER clause of a window function call.
+// Either WindowName or WindowSpec is set.
+type OverClause struct {
+	WindowName ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// WindowSpecification represents the definition of a window:
+// (name PARTITION BY ... ORDER BY ... frame). All the parts are optional.
+type WindowSpecification struct {
+	Name            ColIdent
+	PartitionClause Exprs
+	OrderClause     OrderBy
+	FrameClause     *FrameClause
+}
+
+// FrameClause represents the frame of a window specification.
+// End is nil if only the start of the frame is specified.
+type FrameClause struct {
+	Unit  string
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FramePoint represents a frame boundary. Expr is only
+// set for the ExprPrecedingStr and ExprFollowingStr types.
+type FramePoint struct {
+	Type string
+	Expr Expr
+}
+
+// NamedWindows represents the WINDOW clause of a SELECT.
+type NamedWindows []*NamedWindow
+
+// NamedWindow represents a single window definition of a WINDOW clause.
+type NamedWindow struct {
+	Name       ColIdent
+	WindowSpec *WindowSpecification
+}
+
 // Limit represents a LIMIT clause.
 type Limit struct {
 	Offset, Rowcount Expr
@@ -815,10 +890,10 @@ type TableIdent struct {
 
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%s",
-		node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
+	buf.Myprintf("%vselect %v%s%s%s%v from %v%v%v%v%v%v%v%s",
+		node.With, node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
 		node.From, node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock)
 }
 
@@ -829,7 +904,7 @@ func (node *ParenSelect) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v %s %v%v%v%s", node.Left, node.Type, node.Right,
+	buf.Myprintf("%v%v %s %v%v%v%s", node.With, node.Left, node.Type, node.Right,
 		node.OrderBy, node.Limit, node.Lock)
 }
 
@@ -943,6 +1018,10 @@ func (node *DDL) Format(buf *TrackedBuffer) {
 		buf.Myprintf("alter vschema add sequence %v", node.Table)
 	case AddAutoIncStr:
 		buf.Myprintf("alter vschema on %v add auto_increment %v", node.Table, node.AutoIncSpec)
+	case AddRoutingRuleStr:
+		buf.Myprintf("alter vschema add routing rule %v to %v", node.Table, node.ToTables)
+	case DropRoutingRuleStr:
+		buf.Myprintf("alter vschema drop routing rule %v", node.Table)
 	default:
 		buf.Myprintf("%s table %v", node.Action, node.Table)
 	}
@@ -1238,6 +1317,21 @@ func (node *Rollback) Format(buf *TrackedBuffer) {
 	buf.WriteString("rollback")
 }
 
+// Format formats the node.
+func (node *Savepoint) Format(buf *TrackedBuffer) {
+	buf.Myprintf("savepoint %v", node.Name)
+}
+
+// Format formats the node.
+func (node *SRollback) Format(buf *TrackedBuffer) {
+	buf.Myprintf("rollback to %v", node.Name)
+}
+
+// Format formats the node.
+func (node *Release) Format(buf *TrackedBuffer) {
+	buf.Myprintf("release savepoint %v", node.Name)
+}
+
 // Format formats the node.
 func (node *OtherRead) Format(buf *TrackedBuffer) {
 	buf.WriteString("otherread")
@@ -1248,6 +1342,24 @@ func (node *OtherAdmin) Format(buf *TrackedBuffer) {
 	buf.WriteString("otheradmin")
 }
 
+// Format formats the node.
+func (node With) Format(buf *TrackedBuffer) {
+	if len(node) == 0 {
+		return
+	}
+	prefix := "with "
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+	buf.WriteString(" ")
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v%v as %v", node.Name, node.Columns, node.Subquery)
+}
+
 // Format formats the node.
 func (node Comments) Format(buf *TrackedBuffer) {
 	for _, c := range node {
@@ -1554,7 +1666,7 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	} else {
 		buf.WriteString(funcName)
 	}
-	buf.Myprintf("(%s%v)", distinct, node.Exprs)
+	buf.Myprintf("(%s%v)%v", distinct, node.Exprs, node.Over)
 }
 
 // Format formats the node
@@ -1675,6 +1787,76 @@ func (node *Order) Format(buf *TrackedBuffer) {
 	buf.Myprintf("%v %s", node.Expr, node.Direction)
 }
 
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.WindowSpec != nil {
+		buf.Myprintf(" over %v", node.WindowSpec)
+		return
+	}
+	buf.Myprintf(" over %v", node.WindowName)
+}
+
+// Format formats the node.
+func (node *WindowSpecification) Format(buf *TrackedBuffer) {
+	buf.WriteByte('(')
+	sep := ""
+	if !node.Name.IsEmpty() {
+		buf.Myprintf("%v", node.Name)
+		sep = " "
+	}
+	if len(node.PartitionClause) != 0 {
+		buf.Myprintf("%spartition by %v", sep, node.PartitionClause)
+		sep = " "
+	}
+	if len(node.OrderClause) != 0 {
+		prefix := sep + "order by "
+		for _, n := range node.OrderClause {
+			buf.Myprintf("%s%v", prefix, n)
+			prefix = ", "
+		}
+		sep = " "
+	}
+	if node.FrameClause != nil {
+		buf.Myprintf("%s%v", sep, node.FrameClause)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.Myprintf("%s %v", node.Unit, node.Start)
+		return
+	}
+	buf.Myprintf("%s between %v and %v", node.Unit, node.Start, node.End)
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		buf.Myprintf("%v %s", node.Expr, node.Type)
+		return
+	}
+	buf.Myprintf("%s", node.Type)
+}
+
+// Format formats the node.
+func (node NamedWindows) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *NamedWindow) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v as %v", node.Name, node.WindowSpec)
+}
+
 // Format formats the node.
 func (node *Limit) Format(buf *TrackedBuffer) {
 	if node == nil {
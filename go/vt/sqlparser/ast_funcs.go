@@ -518,8 +518,14 @@ var Aggregates = map[string]bool{
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// An aggregate function with an OVER clause is a window function.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
+}
+
+// IsWindowFunction returns true if the function has an OVER clause.
+func (node *FuncExpr) IsWindowFunction() bool {
+	return node.Over != nil
 }
 
 // NewColIdent makes a new ColIdent.
@@ -703,6 +709,11 @@ func (node *Select) SetLimit(limit *Limit) {
 	node.Limit = limit
 }
 
+// SetWith sets the WITH clause
+func (node *Select) SetWith(with With) {
+	node.With = with
+}
+
 // AddWhere adds the boolean expression to the
 // WHERE clause as an AND condition. If the expression
 // is an OR clause, it parenthesizes it. Currently,
@@ -757,6 +768,11 @@ func (node *ParenSelect) SetLimit(limit *Limit) {
 	panic("unreachable")
 }
 
+// SetWith sets the WITH clause
+func (node *ParenSelect) SetWith(with With) {
+	panic("unreachable")
+}
+
 // AddOrder adds an order by element
 func (node *Union) AddOrder(order *Order) {
 	node.OrderBy = append(node.OrderBy, order)
@@ -767,6 +783,11 @@ func (node *Union) SetLimit(limit *Limit) {
 	node.Limit = limit
 }
 
+// SetWith sets the WITH clause
+func (node *Union) SetWith(with With) {
+	node.With = with
+}
+
 type atCount int
 
 const (
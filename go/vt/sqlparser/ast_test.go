@@ -385,6 +385,14 @@ func TestIsAggregate(t *testing.T) {
 	if f.IsAggregate() {
 		t.Error("IsAggregate: true, want false")
 	}
+
+	f = FuncExpr{Name: NewColIdent("sum"), Over: &OverClause{WindowName: NewColIdent("w")}}
+	if f.IsAggregate() {
+		t.Error("IsAggregate: true, want false")
+	}
+	if !f.IsWindowFunction() {
+		t.Error("IsWindowFunction: false, want true")
+	}
 }
 
 func TestIsImpossible(t *testing.T) {
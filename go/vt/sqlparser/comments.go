@@ -32,6 +32,9 @@ const (
 	DirectiveQueryTimeout = "QUERY_TIMEOUT_MS"
 	// DirectiveScatterErrorsAsWarnings enables partial success scatter select queries
 	DirectiveScatterErrorsAsWarnings = "SCATTER_ERRORS_AS_WARNINGS"
+	// DirectiveHashAggregate aggregates scatter results in memory instead of
+	// requiring the shards to return rows sorted by the grouping keys.
+	DirectiveHashAggregate = "HASH_AGGREGATE"
 )
 
 func isNonSpace(r rune) bool {
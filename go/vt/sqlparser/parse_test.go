@@ -131,6 +131,17 @@ var (
 		input: "select * from t1 join t2 on a = b join t3",
 	}, {
 		input: "select * from t1 where col in (select 1 from dual union select 2 from dual)",
+	}, {
+		input: "with t as (select a from t1) select a from t",
+	}, {
+		input:  "WITH t (x, y) AS (SELECT a, b FROM t1), s AS (select 1 from dual) select x from t join s",
+		output: "with t(x, y) as (select a, b from t1), s as (select 1 from dual) select x from t join s",
+	}, {
+		input: "with t as (select a from t1) select a from t union select b from t2 order by a asc",
+	}, {
+		input: "select * from t1 where col in (with t as (select a from t2) select a from t)",
+	}, {
+		input: "insert into a(b, c) with t as (select d, e from f) select d, e from t",
 	}, {
 		input: "select * from t1 where exists (select a from t2 union select b from t3)",
 	}, {
@@ -1082,6 +1093,17 @@ var (
 	}, {
 		input:  "alter vschema on a drop vindex `add`",
 		output: "alter vschema on a drop vindex `add`",
+	}, {
+		input: "alter vschema add routing rule a to ks.a",
+	}, {
+		input: "alter vschema add routing rule ks.a to ks2.a, ks3.a",
+	}, {
+		input:  "alter vschema add routing rule `a@replica` to `ks`.`a`",
+		output: "alter vschema add routing rule `a@replica` to ks.a",
+	}, {
+		input: "alter vschema drop routing rule a",
+	}, {
+		input: "alter vschema drop routing rule ks.a",
 	}, {
 		input:  "create index a on b",
 		output: "alter table b",
@@ -1340,6 +1362,8 @@ var (
 		input: "show vschema vindexes",
 	}, {
 		input: "show vschema vindexes on t",
+	}, {
+		input: "show vschema routing rules",
 	}, {
 		input:  "show warnings",
 		output: "show warnings",
@@ -1477,6 +1501,34 @@ var (
 		input: "select name, group_concat(distinct id, score order by id desc separator ':' limit 1) from t group by name",
 	}, {
 		input: "select name, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by name",
+	}, {
+		input: "select id, row_number() over () from t",
+	}, {
+		input: "select id, row_number() over (partition by a, b order by c desc) from t",
+	}, {
+		input: "select id, rank() over (order by score asc) from t",
+	}, {
+		input:  "select id, sum(a) OVER (PARTITION BY b ORDER BY c ROWS UNBOUNDED PRECEDING) from t",
+		output: "select id, sum(a) over (partition by b order by c asc rows unbounded preceding) from t",
+	}, {
+		input: "select id, sum(a) over (order by c asc rows between 1 preceding and 1 following) from t",
+	}, {
+		input: "select id, sum(a) over (order by c asc rows between current row and unbounded following) from t",
+	}, {
+		input: "select id, sum(a) over (order by c asc range between interval 1 day preceding and current row) from t",
+	}, {
+		input: "select id, avg(a) over (order by c asc rows between :a preceding and current row) from t",
+	}, {
+		input: "select id, count(distinct a) over (partition by b) from t",
+	}, {
+		input: "select id, lag(a, 1) over w, lead(a, 1) over w from t window w as (partition by b order by c desc)",
+	}, {
+		input: "select id, first_value(a) over (w rows unbounded preceding) from t window w as (order by c asc), v as (w partition by b)",
+	}, {
+		input: "select id, sum(a) over w from t where b = 1 group by id having count(*) > 1 window w as (order by id asc) order by id asc limit 1",
+	}, {
+		input:  "select `over`, `window`, `rows`, `range`, current, row, unbounded, preceding, following from t",
+		output: "select `over`, `window`, `rows`, `range`, `current`, `row`, `unbounded`, `preceding`, `following` from t",
 	}, {
 		input: "select * from t partition (p0)",
 	}, {
@@ -1511,6 +1563,21 @@ var (
 		input: "commit",
 	}, {
 		input: "rollback",
+	}, {
+		input: "savepoint a",
+	}, {
+		input:  "savepoint `@@@;a`",
+		output: "savepoint `@@@;a`",
+	}, {
+		input: "rollback to a",
+	}, {
+		input:  "rollback to savepoint a",
+		output: "rollback to a",
+	}, {
+		input:  "rollback to savepoint savepoint",
+		output: "rollback to `savepoint`",
+	}, {
+		input: "release savepoint a",
 	}, {
 		input: "create database test_db",
 	}, {
@@ -2523,6 +2590,18 @@ var (
 	}{{
 		input:  "select $ from t",
 		output: "syntax error at position 9 near '$'",
+	}, {
+		input:  "with recursive t as (select 1 from dual) select * from t",
+		output: "syntax error at position 17 near 't'",
+	}, {
+		input:  "select sum(a) over (rows between a preceding and current row) from t",
+		output: "syntax error at position 35 near 'a'",
+	}, {
+		input:  "select a from t window w",
+		output: "syntax error at position 25",
+	}, {
+		input:  "select rows from t",
+		output: "syntax error at position 12 near 'rows'",
 	}, {
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
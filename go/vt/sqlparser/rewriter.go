@@ -144,6 +144,18 @@ func (r *replaceColumnsItems) inc() {
 	*r++
 }
 
+func replaceCommonTableExprColumns(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Columns = newNode.(Columns)
+}
+
+func replaceCommonTableExprName(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Name = newNode.(TableIdent)
+}
+
+func replaceCommonTableExprSubquery(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Subquery = newNode.(*Subquery)
+}
+
 func replaceComparisonExprEscape(newNode, parent SQLNode) {
 	parent.(*ComparisonExpr).Escape = newNode.(Expr)
 }
@@ -292,6 +304,18 @@ func replaceForeignKeyDefinitionSource(newNode, parent SQLNode) {
 	parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
 }
 
+func replaceFrameClauseEnd(newNode, parent SQLNode) {
+	parent.(*FrameClause).End = newNode.(*FramePoint)
+}
+
+func replaceFrameClauseStart(newNode, parent SQLNode) {
+	parent.(*FrameClause).Start = newNode.(*FramePoint)
+}
+
+func replaceFramePointExpr(newNode, parent SQLNode) {
+	parent.(*FramePoint).Expr = newNode.(Expr)
+}
+
 func replaceFuncExprExprs(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
 }
@@ -300,6 +324,10 @@ func replaceFuncExprName(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Name = newNode.(ColIdent)
 }
 
+func replaceFuncExprOver(newNode, parent SQLNode) {
+	parent.(*FuncExpr).Over = newNode.(*OverClause)
+}
+
 func replaceFuncExprQualifier(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
 }
@@ -414,6 +442,24 @@ func replaceMatchExprExpr(newNode, parent SQLNode) {
 	parent.(*MatchExpr).Expr = newNode.(Expr)
 }
 
+func replaceNamedWindowName(newNode, parent SQLNode) {
+	parent.(*NamedWindow).Name = newNode.(ColIdent)
+}
+
+func replaceNamedWindowWindowSpec(newNode, parent SQLNode) {
+	parent.(*NamedWindow).WindowSpec = newNode.(*WindowSpecification)
+}
+
+type replaceNamedWindowsItems int
+
+func (r *replaceNamedWindowsItems) replace(newNode, container SQLNode) {
+	container.(NamedWindows)[int(*r)] = newNode.(*NamedWindow)
+}
+
+func (r *replaceNamedWindowsItems) inc() {
+	*r++
+}
+
 func replaceNextvalExpr(newNode, parent SQLNode) {
 	tmp := parent.(Nextval)
 	tmp.Expr = newNode.(Expr)
@@ -459,6 +505,14 @@ func (r *replaceOrderByItems) inc() {
 	*r++
 }
 
+func replaceOverClauseWindowName(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowName = newNode.(ColIdent)
+}
+
+func replaceOverClauseWindowSpec(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowSpec = newNode.(*WindowSpecification)
+}
+
 func replaceParenExprExpr(newNode, parent SQLNode) {
 	parent.(*ParenExpr).Expr = newNode.(Expr)
 }
@@ -515,6 +569,18 @@ func replaceRangeCondTo(newNode, parent SQLNode) {
 	parent.(*RangeCond).To = newNode.(Expr)
 }
 
+func replaceReleaseName(newNode, parent SQLNode) {
+	parent.(*Release).Name = newNode.(ColIdent)
+}
+
+func replaceSRollbackName(newNode, parent SQLNode) {
+	parent.(*SRollback).Name = newNode.(ColIdent)
+}
+
+func replaceSavepointName(newNode, parent SQLNode) {
+	parent.(*Savepoint).Name = newNode.(ColIdent)
+}
+
 func replaceSelectComments(newNode, parent SQLNode) {
 	parent.(*Select).Comments = newNode.(Comments)
 }
@@ -547,6 +613,14 @@ func replaceSelectWhere(newNode, parent SQLNode) {
 	parent.(*Select).Where = newNode.(*Where)
 }
 
+func replaceSelectWindows(newNode, parent SQLNode) {
+	parent.(*Select).Windows = newNode.(NamedWindows)
+}
+
+func replaceSelectWith(newNode, parent SQLNode) {
+	parent.(*Select).With = newNode.(With)
+}
+
 type replaceSelectExprsItems int
 
 func (r *replaceSelectExprsItems) replace(newNode, container SQLNode) {
@@ -719,6 +793,10 @@ func replaceUnionRight(newNode, parent SQLNode) {
 	parent.(*Union).Right = newNode.(SelectStatement)
 }
 
+func replaceUnionWith(newNode, parent SQLNode) {
+	parent.(*Union).With = newNode.(With)
+}
+
 func replaceUpdateComments(newNode, parent SQLNode) {
 	parent.(*Update).Comments = newNode.(Comments)
 }
@@ -824,6 +902,32 @@ func replaceWhereExpr(newNode, parent SQLNode) {
 	parent.(*Where).Expr = newNode.(Expr)
 }
 
+func replaceWindowSpecificationFrameClause(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).FrameClause = newNode.(*FrameClause)
+}
+
+func replaceWindowSpecificationName(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).Name = newNode.(ColIdent)
+}
+
+func replaceWindowSpecificationOrderClause(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).OrderClause = newNode.(OrderBy)
+}
+
+func replaceWindowSpecificationPartitionClause(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).PartitionClause = newNode.(Exprs)
+}
+
+type replaceWithItems int
+
+func (r *replaceWithItems) replace(newNode, container SQLNode) {
+	container.(With)[int(*r)] = newNode.(*CommonTableExpr)
+}
+
+func (r *replaceWithItems) inc() {
+	*r++
+}
+
 // apply is where the visiting happens. Here is where we keep the big switch-case that will be used
 // to do the actual visiting of SQLNodes
 func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
@@ -917,6 +1021,11 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *Commit:
 
+	case *CommonTableExpr:
+		a.apply(node, n.Columns, replaceCommonTableExprColumns)
+		a.apply(node, n.Name, replaceCommonTableExprName)
+		a.apply(node, n.Subquery, replaceCommonTableExprSubquery)
+
 	case *ComparisonExpr:
 		a.apply(node, n.Escape, replaceComparisonExprEscape)
 		a.apply(node, n.Left, replaceComparisonExprLeft)
@@ -987,9 +1096,17 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.ReferencedTable, replaceForeignKeyDefinitionReferencedTable)
 		a.apply(node, n.Source, replaceForeignKeyDefinitionSource)
 
+	case *FrameClause:
+		a.apply(node, n.End, replaceFrameClauseEnd)
+		a.apply(node, n.Start, replaceFrameClauseStart)
+
+	case *FramePoint:
+		a.apply(node, n.Expr, replaceFramePointExpr)
+
 	case *FuncExpr:
 		a.apply(node, n.Exprs, replaceFuncExprExprs)
 		a.apply(node, n.Name, replaceFuncExprName)
+		a.apply(node, n.Over, replaceFuncExprOver)
 		a.apply(node, n.Qualifier, replaceFuncExprQualifier)
 
 	case GroupBy:
@@ -1052,6 +1169,18 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.Columns, replaceMatchExprColumns)
 		a.apply(node, n.Expr, replaceMatchExprExpr)
 
+	case *NamedWindow:
+		a.apply(node, n.Name, replaceNamedWindowName)
+		a.apply(node, n.WindowSpec, replaceNamedWindowWindowSpec)
+
+	case NamedWindows:
+		replacer := replaceNamedWindowsItems(0)
+		replacerRef := &replacer
+		for _, item := range n {
+			a.apply(node, item, replacerRef.replace)
+			replacerRef.inc()
+		}
+
 	case Nextval:
 		a.apply(node, n.Expr, replaceNextvalExpr)
 
@@ -1090,6 +1219,10 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *OtherRead:
 
+	case *OverClause:
+		a.apply(node, n.WindowName, replaceOverClauseWindowName)
+		a.apply(node, n.WindowSpec, replaceOverClauseWindowSpec)
+
 	case *ParenExpr:
 		a.apply(node, n.Expr, replaceParenExprExpr)
 
@@ -1127,10 +1260,19 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case ReferenceAction:
 
+	case *Release:
+		a.apply(node, n.Name, replaceReleaseName)
+
 	case *Rollback:
 
 	case *SQLVal:
 
+	case *SRollback:
+		a.apply(node, n.Name, replaceSRollbackName)
+
+	case *Savepoint:
+		a.apply(node, n.Name, replaceSavepointName)
+
 	case *Select:
 		a.apply(node, n.Comments, replaceSelectComments)
 		a.apply(node, n.From, replaceSelectFrom)
@@ -1140,6 +1282,8 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.OrderBy, replaceSelectOrderBy)
 		a.apply(node, n.SelectExprs, replaceSelectSelectExprs)
 		a.apply(node, n.Where, replaceSelectWhere)
+		a.apply(node, n.Windows, replaceSelectWindows)
+		a.apply(node, n.With, replaceSelectWith)
 
 	case SelectExprs:
 		replacer := replaceSelectExprsItems(0)
@@ -1243,6 +1387,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.Limit, replaceUnionLimit)
 		a.apply(node, n.OrderBy, replaceUnionOrderBy)
 		a.apply(node, n.Right, replaceUnionRight)
+		a.apply(node, n.With, replaceUnionWith)
 
 	case *Update:
 		a.apply(node, n.Comments, replaceUpdateComments)
@@ -1306,6 +1451,20 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *Where:
 		a.apply(node, n.Expr, replaceWhereExpr)
 
+	case *WindowSpecification:
+		a.apply(node, n.FrameClause, replaceWindowSpecificationFrameClause)
+		a.apply(node, n.Name, replaceWindowSpecificationName)
+		a.apply(node, n.OrderClause, replaceWindowSpecificationOrderClause)
+		a.apply(node, n.PartitionClause, replaceWindowSpecificationPartitionClause)
+
+	case With:
+		replacer := replaceWithItems(0)
+		replacerRef := &replacer
+		for _, item := range n {
+			a.apply(node, item, replacerRef.replace)
+			replacerRef.inc()
+		}
+
 	default:
 		panic("unknown ast type " + reflect.TypeOf(node).String())
 	}
@@ -44,6 +44,8 @@ type yySymType struct {
 	empty                struct{}
 	statement            Statement
 	selStmt              SelectStatement
+	with                 With
+	cte                  *CommonTableExpr
 	ddl                  *DDL
 	ins                  *Insert
 	byt                  byte
@@ -73,6 +75,12 @@ type yySymType struct {
 	whens                []*When
 	when                 *When
 	orderBy              OrderBy
+	overClause           *OverClause
+	windowSpec           *WindowSpecification
+	frameClause          *FrameClause
+	framePoint           *FramePoint
+	namedWindows         NamedWindows
+	namedWindow          *NamedWindow
 	order                *Order
 	limit                *Limit
 	updateExprs          UpdateExprs
@@ -249,193 +257,202 @@ const PROCEDURE = 57484
 const TRIGGER = 57485
 const VINDEX = 57486
 const VINDEXES = 57487
-const STATUS = 57488
-const VARIABLES = 57489
-const WARNINGS = 57490
-const SEQUENCE = 57491
-const BEGIN = 57492
-const START = 57493
-const TRANSACTION = 57494
-const COMMIT = 57495
-const ROLLBACK = 57496
-const BIT = 57497
-const TINYINT = 57498
-const SMALLINT = 57499
-const MEDIUMINT = 57500
-const INT = 57501
-const INTEGER = 57502
-const BIGINT = 57503
-const INTNUM = 57504
-const REAL = 57505
-const DOUBLE = 57506
-const FLOAT_TYPE = 57507
-const DECIMAL = 57508
-const NUMERIC = 57509
-const TIME = 57510
-const TIMESTAMP = 57511
-const DATETIME = 57512
-const YEAR = 57513
-const CHAR = 57514
-const VARCHAR = 57515
-const BOOL = 57516
-const CHARACTER = 57517
-const VARBINARY = 57518
-const NCHAR = 57519
-const TEXT = 57520
-const TINYTEXT = 57521
-const MEDIUMTEXT = 57522
-const LONGTEXT = 57523
-const BLOB = 57524
-const TINYBLOB = 57525
-const MEDIUMBLOB = 57526
-const LONGBLOB = 57527
-const JSON = 57528
-const ENUM = 57529
-const GEOMETRY = 57530
-const POINT = 57531
-const LINESTRING = 57532
-const POLYGON = 57533
-const GEOMETRYCOLLECTION = 57534
-const MULTIPOINT = 57535
-const MULTILINESTRING = 57536
-const MULTIPOLYGON = 57537
-const NULLX = 57538
-const AUTO_INCREMENT = 57539
-const APPROXNUM = 57540
-const SIGNED = 57541
-const UNSIGNED = 57542
-const ZEROFILL = 57543
-const COLLATION = 57544
-const DATABASES = 57545
-const TABLES = 57546
-const VITESS_METADATA = 57547
-const VSCHEMA = 57548
-const FULL = 57549
-const PROCESSLIST = 57550
-const COLUMNS = 57551
-const FIELDS = 57552
-const ENGINES = 57553
-const PLUGINS = 57554
-const NAMES = 57555
-const CHARSET = 57556
-const GLOBAL = 57557
-const SESSION = 57558
-const ISOLATION = 57559
-const LEVEL = 57560
-const READ = 57561
-const WRITE = 57562
-const ONLY = 57563
-const REPEATABLE = 57564
-const COMMITTED = 57565
-const UNCOMMITTED = 57566
-const SERIALIZABLE = 57567
-const CURRENT_TIMESTAMP = 57568
-const DATABASE = 57569
-const CURRENT_DATE = 57570
-const CURRENT_TIME = 57571
-const LOCALTIME = 57572
-const LOCALTIMESTAMP = 57573
-const UTC_DATE = 57574
-const UTC_TIME = 57575
-const UTC_TIMESTAMP = 57576
-const REPLACE = 57577
-const CONVERT = 57578
-const CAST = 57579
-const SUBSTR = 57580
-const SUBSTRING = 57581
-const GROUP_CONCAT = 57582
-const SEPARATOR = 57583
-const TIMESTAMPADD = 57584
-const TIMESTAMPDIFF = 57585
-const MATCH = 57586
-const AGAINST = 57587
-const BOOLEAN = 57588
-const LANGUAGE = 57589
-const WITH = 57590
-const QUERY = 57591
-const EXPANSION = 57592
-const UNUSED = 57593
-const ARRAY = 57594
-const CUME_DIST = 57595
-const DESCRIPTION = 57596
-const DENSE_RANK = 57597
-const EMPTY = 57598
-const EXCEPT = 57599
-const FIRST_VALUE = 57600
-const GROUPING = 57601
-const GROUPS = 57602
-const JSON_TABLE = 57603
-const LAG = 57604
-const LAST_VALUE = 57605
-const LATERAL = 57606
-const LEAD = 57607
-const MEMBER = 57608
-const NTH_VALUE = 57609
-const NTILE = 57610
-const OF = 57611
-const OVER = 57612
-const PERCENT_RANK = 57613
-const RANK = 57614
-const RECURSIVE = 57615
-const ROW_NUMBER = 57616
-const SYSTEM = 57617
-const WINDOW = 57618
-const ACTIVE = 57619
-const ADMIN = 57620
-const BUCKETS = 57621
-const CLONE = 57622
-const COMPONENT = 57623
-const DEFINITION = 57624
-const ENFORCED = 57625
-const EXCLUDE = 57626
-const FOLLOWING = 57627
-const GEOMCOLLECTION = 57628
-const GET_MASTER_PUBLIC_KEY = 57629
-const HISTOGRAM = 57630
-const HISTORY = 57631
-const INACTIVE = 57632
-const INVISIBLE = 57633
-const LOCKED = 57634
-const MASTER_COMPRESSION_ALGORITHMS = 57635
-const MASTER_PUBLIC_KEY_PATH = 57636
-const MASTER_TLS_CIPHERSUITES = 57637
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57638
-const NESTED = 57639
-const NETWORK_NAMESPACE = 57640
-const NOWAIT = 57641
-const NULLS = 57642
-const OJ = 57643
-const OLD = 57644
-const OPTIONAL = 57645
-const ORDINALITY = 57646
-const ORGANIZATION = 57647
-const OTHERS = 57648
-const PATH = 57649
-const PERSIST = 57650
-const PERSIST_ONLY = 57651
-const PRECEDING = 57652
-const PRIVILEGE_CHECKS_USER = 57653
-const PROCESS = 57654
-const RANDOM = 57655
-const REFERENCE = 57656
-const REQUIRE_ROW_FORMAT = 57657
-const RESOURCE = 57658
-const RESPECT = 57659
-const RESTART = 57660
-const RETAIN = 57661
-const REUSE = 57662
-const ROLE = 57663
-const SECONDARY = 57664
-const SECONDARY_ENGINE = 57665
-const SECONDARY_LOAD = 57666
-const SECONDARY_UNLOAD = 57667
-const SKIP = 57668
-const SRID = 57669
-const THREAD_PRIORITY = 57670
-const TIES = 57671
-const UNBOUNDED = 57672
-const VCPU = 57673
-const VISIBLE = 57674
+const ROUTING = 57488
+const RULE = 57489
+const RULES = 57490
+const STATUS = 57491
+const VARIABLES = 57492
+const WARNINGS = 57493
+const SEQUENCE = 57494
+const BEGIN = 57495
+const START = 57496
+const TRANSACTION = 57497
+const COMMIT = 57498
+const ROLLBACK = 57499
+const SAVEPOINT = 57500
+const RELEASE = 57501
+const BIT = 57502
+const TINYINT = 57503
+const SMALLINT = 57504
+const MEDIUMINT = 57505
+const INT = 57506
+const INTEGER = 57507
+const BIGINT = 57508
+const INTNUM = 57509
+const REAL = 57510
+const DOUBLE = 57511
+const FLOAT_TYPE = 57512
+const DECIMAL = 57513
+const NUMERIC = 57514
+const TIME = 57515
+const TIMESTAMP = 57516
+const DATETIME = 57517
+const YEAR = 57518
+const CHAR = 57519
+const VARCHAR = 57520
+const BOOL = 57521
+const CHARACTER = 57522
+const VARBINARY = 57523
+const NCHAR = 57524
+const TEXT = 57525
+const TINYTEXT = 57526
+const MEDIUMTEXT = 57527
+const LONGTEXT = 57528
+const BLOB = 57529
+const TINYBLOB = 57530
+const MEDIUMBLOB = 57531
+const LONGBLOB = 57532
+const JSON = 57533
+const ENUM = 57534
+const GEOMETRY = 57535
+const POINT = 57536
+const LINESTRING = 57537
+const POLYGON = 57538
+const GEOMETRYCOLLECTION = 57539
+const MULTIPOINT = 57540
+const MULTILINESTRING = 57541
+const MULTIPOLYGON = 57542
+const NULLX = 57543
+const AUTO_INCREMENT = 57544
+const APPROXNUM = 57545
+const SIGNED = 57546
+const UNSIGNED = 57547
+const ZEROFILL = 57548
+const COLLATION = 57549
+const DATABASES = 57550
+const TABLES = 57551
+const VITESS_METADATA = 57552
+const VSCHEMA = 57553
+const FULL = 57554
+const PROCESSLIST = 57555
+const COLUMNS = 57556
+const FIELDS = 57557
+const ENGINES = 57558
+const PLUGINS = 57559
+const NAMES = 57560
+const CHARSET = 57561
+const GLOBAL = 57562
+const SESSION = 57563
+const ISOLATION = 57564
+const LEVEL = 57565
+const READ = 57566
+const WRITE = 57567
+const ONLY = 57568
+const REPEATABLE = 57569
+const COMMITTED = 57570
+const UNCOMMITTED = 57571
+const SERIALIZABLE = 57572
+const CURRENT_TIMESTAMP = 57573
+const DATABASE = 57574
+const CURRENT_DATE = 57575
+const CURRENT_TIME = 57576
+const LOCALTIME = 57577
+const LOCALTIMESTAMP = 57578
+const UTC_DATE = 57579
+const UTC_TIME = 57580
+const UTC_TIMESTAMP = 57581
+const REPLACE = 57582
+const CONVERT = 57583
+const CAST = 57584
+const SUBSTR = 57585
+const SUBSTRING = 57586
+const GROUP_CONCAT = 57587
+const SEPARATOR = 57588
+const TIMESTAMPADD = 57589
+const TIMESTAMPDIFF = 57590
+const MATCH = 57591
+const AGAINST = 57592
+const BOOLEAN = 57593
+const LANGUAGE = 57594
+const WITH = 57595
+const QUERY = 57596
+const EXPANSION = 57597
+const OVER = 57598
+const WINDOW = 57599
+const ROWS = 57600
+const RANGE = 57601
+const CURRENT = 57602
+const ROW = 57603
+const UNBOUNDED = 57604
+const PRECEDING = 57605
+const FOLLOWING = 57606
+const UNUSED = 57607
+const ARRAY = 57608
+const CUME_DIST = 57609
+const DESCRIPTION = 57610
+const DENSE_RANK = 57611
+const EMPTY = 57612
+const EXCEPT = 57613
+const FIRST_VALUE = 57614
+const GROUPING = 57615
+const GROUPS = 57616
+const JSON_TABLE = 57617
+const LAG = 57618
+const LAST_VALUE = 57619
+const LATERAL = 57620
+const LEAD = 57621
+const MEMBER = 57622
+const NTH_VALUE = 57623
+const NTILE = 57624
+const OF = 57625
+const PERCENT_RANK = 57626
+const RANK = 57627
+const RECURSIVE = 57628
+const ROW_NUMBER = 57629
+const SYSTEM = 57630
+const ACTIVE = 57631
+const ADMIN = 57632
+const BUCKETS = 57633
+const CLONE = 57634
+const COMPONENT = 57635
+const DEFINITION = 57636
+const ENFORCED = 57637
+const EXCLUDE = 57638
+const GEOMCOLLECTION = 57639
+const GET_MASTER_PUBLIC_KEY = 57640
+const HISTOGRAM = 57641
+const HISTORY = 57642
+const INACTIVE = 57643
+const INVISIBLE = 57644
+const LOCKED = 57645
+const MASTER_COMPRESSION_ALGORITHMS = 57646
+const MASTER_PUBLIC_KEY_PATH = 57647
+const MASTER_TLS_CIPHERSUITES = 57648
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57649
+const NESTED = 57650
+const NETWORK_NAMESPACE = 57651
+const NOWAIT = 57652
+const NULLS = 57653
+const OJ = 57654
+const OLD = 57655
+const OPTIONAL = 57656
+const ORDINALITY = 57657
+const ORGANIZATION = 57658
+const OTHERS = 57659
+const PATH = 57660
+const PERSIST = 57661
+const PERSIST_ONLY = 57662
+const PRIVILEGE_CHECKS_USER = 57663
+const PROCESS = 57664
+const RANDOM = 57665
+const REFERENCE = 57666
+const REQUIRE_ROW_FORMAT = 57667
+const RESOURCE = 57668
+const RESPECT = 57669
+const RESTART = 57670
+const RETAIN = 57671
+const REUSE = 57672
+const ROLE = 57673
+const SECONDARY = 57674
+const SECONDARY_ENGINE = 57675
+const SECONDARY_LOAD = 57676
+const SECONDARY_UNLOAD = 57677
+const SKIP = 57678
+const SRID = 57679
+const THREAD_PRIORITY = 57680
+const TIES = 57681
+const VCPU = 57682
+const VISIBLE = 57683
 
 var yyToknames = [...]string{
 	"$end",
@@ -600,6 +617,9 @@ var yyToknames = [...]string{
 	"TRIGGER",
 	"VINDEX",
 	"VINDEXES",
+	"ROUTING",
+	"RULE",
+	"RULES",
 	"STATUS",
 	"VARIABLES",
 	"WARNINGS",
@@ -609,6 +629,8 @@ var yyToknames = [...]string{
 	"TRANSACTION",
 	"COMMIT",
 	"ROLLBACK",
+	"SAVEPOINT",
+	"RELEASE",
 	"BIT",
 	"TINYINT",
 	"SMALLINT",
@@ -705,6 +727,15 @@ var yyToknames = [...]string{
 	"WITH",
 	"QUERY",
 	"EXPANSION",
+	"OVER",
+	"WINDOW",
+	"ROWS",
+	"RANGE",
+	"CURRENT",
+	"ROW",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
 	"UNUSED",
 	"ARRAY",
 	"CUME_DIST",
@@ -724,13 +755,11 @@ var yyToknames = [...]string{
 	"NTH_VALUE",
 	"NTILE",
 	"OF",
-	"OVER",
 	"PERCENT_RANK",
 	"RANK",
 	"RECURSIVE",
 	"ROW_NUMBER",
 	"SYSTEM",
-	"WINDOW",
 	"ACTIVE",
 	"ADMIN",
 	"BUCKETS",
@@ -739,7 +768,6 @@ var yyToknames = [...]string{
 	"DEFINITION",
 	"ENFORCED",
 	"EXCLUDE",
-	"FOLLOWING",
 	"GEOMCOLLECTION",
 	"GET_MASTER_PUBLIC_KEY",
 	"HISTOGRAM",
@@ -764,7 +792,6 @@ var yyToknames = [...]string{
 	"PATH",
 	"PERSIST",
 	"PERSIST_ONLY",
-	"PRECEDING",
 	"PRIVILEGE_CHECKS_USER",
 	"PROCESS",
 	"RANDOM",
@@ -784,7 +811,6 @@ var yyToknames = [...]string{
 	"SRID",
 	"THREAD_PRIORITY",
 	"TIES",
-	"UNBOUNDED",
 	"VCPU",
 	"VISIBLE",
 	"';'",
@@ -800,426 +826,471 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 3,
-	5, 32,
-	-2, 4,
-	-1, 37,
-	163, 307,
-	164, 307,
-	-2, 295,
-	-1, 325,
-	115, 651,
-	-2, 647,
-	-1, 326,
-	115, 652,
-	-2, 648,
-	-1, 395,
-	85, 900,
-	-2, 66,
-	-1, 396,
-	85, 818,
-	-2, 67,
-	-1, 401,
-	85, 787,
-	-2, 613,
+	-1, 24,
+	5, 41,
+	-2, 29,
+	-1, 38,
+	166, 319,
+	167, 319,
+	-2, 307,
+	-1, 64,
+	5, 41,
+	-2, 30,
+	-1, 331,
+	115, 693,
+	-2, 689,
+	-1, 332,
+	115, 694,
+	-2, 690,
+	-1, 402,
+	85, 951,
+	-2, 75,
 	-1, 403,
-	85, 848,
-	-2, 615,
-	-1, 702,
-	1, 360,
-	5, 360,
-	12, 360,
-	13, 360,
-	14, 360,
-	15, 360,
-	17, 360,
-	19, 360,
-	30, 360,
-	31, 360,
-	43, 360,
-	44, 360,
-	45, 360,
-	46, 360,
-	47, 360,
-	49, 360,
-	50, 360,
-	53, 360,
-	54, 360,
-	56, 360,
-	57, 360,
-	350, 360,
-	-2, 378,
-	-1, 705,
-	54, 47,
-	56, 47,
-	-2, 51,
-	-1, 859,
-	115, 654,
-	-2, 650,
-	-1, 1091,
-	5, 33,
-	-2, 446,
-	-1, 1122,
-	5, 32,
-	-2, 587,
-	-1, 1370,
-	5, 33,
-	-2, 588,
-	-1, 1423,
-	5, 32,
-	-2, 590,
-	-1, 1503,
-	5, 33,
-	-2, 591,
+	85, 864,
+	-2, 76,
+	-1, 408,
+	85, 832,
+	-2, 655,
+	-1, 410,
+	85, 894,
+	-2, 657,
+	-1, 717,
+	1, 376,
+	5, 376,
+	12, 376,
+	13, 376,
+	14, 376,
+	15, 376,
+	17, 376,
+	19, 376,
+	30, 376,
+	31, 376,
+	43, 376,
+	44, 376,
+	45, 376,
+	46, 376,
+	47, 376,
+	49, 376,
+	50, 376,
+	53, 376,
+	54, 376,
+	56, 376,
+	57, 376,
+	274, 376,
+	359, 376,
+	-2, 394,
+	-1, 720,
+	54, 56,
+	56, 56,
+	-2, 60,
+	-1, 878,
+	115, 696,
+	-2, 692,
+	-1, 1121,
+	5, 42,
+	-2, 462,
+	-1, 1422,
+	5, 42,
+	-2, 630,
+	-1, 1568,
+	5, 42,
+	-2, 633,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 16267
+const yyLast = 17798
 
 var yyAct = [...]int{
 
-	325, 1537, 1125, 1527, 1219, 1332, 330, 974, 1491, 1390,
-	1403, 1272, 1436, 356, 1143, 657, 343, 566, 947, 1306,
-	1003, 997, 1273, 1269, 57, 304, 656, 3, 1170, 945,
-	1126, 1017, 80, 555, 983, 970, 268, 973, 289, 268,
-	1279, 1285, 1244, 332, 295, 884, 1082, 804, 895, 1187,
-	1196, 822, 891, 564, 718, 1149, 400, 934, 987, 949,
-	861, 699, 588, 594, 1013, 394, 389, 268, 80, 698,
-	524, 525, 268, 913, 268, 927, 328, 313, 303, 717,
-	600, 609, 386, 391, 707, 397, 671, 56, 1036, 296,
-	297, 298, 299, 672, 704, 302, 61, 1530, 1514, 544,
-	1525, 368, 1035, 374, 375, 372, 373, 371, 370, 369,
-	1501, 1522, 317, 1333, 1513, 1500, 1261, 376, 377, 1362,
-	529, 1300, 63, 64, 65, 66, 67, 1301, 1302, 964,
-	266, 82, 83, 84, 301, 82, 83, 84, 965, 966,
-	1245, 582, 1034, 1465, 622, 621, 631, 632, 624, 625,
-	626, 627, 628, 629, 630, 623, 300, 719, 633, 720,
-	1178, 388, 264, 260, 261, 262, 526, 1158, 528, 256,
-	1157, 577, 254, 1159, 258, 578, 575, 576, 1247, 996,
-	1221, 1393, 82, 83, 84, 1004, 1353, 1351, 580, 294,
-	918, 1223, 1031, 1028, 1029, 1206, 1027, 790, 793, 1524,
-	581, 570, 571, 561, 792, 563, 1521, 1492, 1218, 928,
-	1484, 988, 1249, 1545, 1253, 1437, 1248, 545, 1246, 1445,
-	531, 258, 1224, 1251, 797, 1202, 1203, 1204, 1038, 1041,
-	1439, 990, 1250, 1222, 781, 1215, 560, 562, 794, 791,
-	1541, 1217, 1295, 1294, 1293, 1252, 1254, 527, 1144, 1146,
-	1473, 534, 894, 271, 259, 268, 536, 537, 1048, 1373,
-	268, 1047, 546, 1230, 541, 1033, 268, 257, 1100, 1154,
-	1097, 1110, 268, 553, 645, 646, 559, 80, 1076, 833,
-	990, 263, 80, 713, 80, 613, 551, 1032, 255, 971,
-	80, 82, 83, 84, 1205, 633, 960, 830, 1438, 1210,
-	1207, 1198, 1208, 1201, 608, 1197, 1482, 827, 1199, 1200,
-	82, 83, 84, 1466, 623, 557, 1004, 633, 823, 70,
-	80, 558, 1209, 1446, 1444, 1454, 1037, 1145, 1499, 538,
-	989, 539, 596, 1283, 540, 569, 597, 572, 990, 721,
-	1216, 1039, 1214, 583, 1319, 584, 585, 1263, 914, 535,
-	1539, 783, 1176, 1540, 543, 1538, 1095, 71, 1094, 1096,
-	550, 1487, 547, 548, 549, 603, 552, 868, 82, 83,
-	84, 643, 1505, 645, 646, 645, 646, 607, 606, 989,
-	1399, 866, 867, 865, 268, 268, 268, 626, 627, 628,
-	629, 630, 623, 80, 608, 633, 556, 836, 837, 80,
-	587, 1398, 824, 82, 83, 84, 914, 598, 1107, 253,
-	397, 1546, 607, 606, 697, 606, 622, 621, 631, 632,
-	624, 625, 626, 627, 628, 629, 630, 623, 702, 608,
-	633, 608, 54, 607, 606, 993, 1507, 989, 523, 1191,
-	1265, 994, 986, 984, 864, 985, 1190, 1179, 607, 606,
-	608, 982, 988, 1547, 607, 606, 674, 676, 678, 680,
-	682, 684, 685, 675, 677, 608, 681, 683, 706, 686,
-	1083, 608, 711, 1282, 383, 384, 715, 1483, 696, 617,
-	705, 620, 1073, 1074, 1075, 1417, 1396, 634, 635, 636,
-	637, 638, 639, 640, 586, 618, 619, 616, 622, 621,
-	631, 632, 624, 625, 626, 627, 628, 629, 630, 623,
-	1188, 1059, 633, 621, 631, 632, 624, 625, 626, 627,
-	628, 629, 630, 623, 268, 530, 633, 832, 779, 80,
-	809, 782, 1451, 784, 268, 268, 80, 80, 80, 82,
-	83, 84, 268, 886, 22, 268, 1442, 1523, 268, 802,
-	803, 1450, 268, 1315, 80, 82, 83, 84, 991, 80,
-	80, 80, 268, 80, 80, 831, 1509, 587, 268, 268,
-	1233, 80, 80, 624, 625, 626, 627, 628, 629, 630,
-	623, 897, 780, 633, 607, 606, 1442, 1495, 808, 787,
-	788, 789, 851, 853, 854, 82, 83, 84, 852, 1161,
-	80, 608, 532, 533, 308, 268, 1368, 807, 806, 1442,
-	587, 80, 811, 812, 813, 1089, 815, 816, 729, 1442,
-	1474, 1453, 838, 1270, 819, 820, 1282, 798, 785, 786,
-	862, 858, 1442, 1441, 885, 931, 795, 1388, 1387, 388,
-	1375, 587, 801, 887, 1323, 631, 632, 624, 625, 626,
-	627, 628, 629, 630, 623, 80, 814, 633, 1372, 587,
-	863, 857, 817, 818, 346, 345, 348, 349, 350, 351,
-	859, 904, 907, 347, 352, 709, 840, 915, 1325, 1324,
-	1321, 1322, 1321, 1320, 1089, 587, 899, 24, 80, 80,
-	931, 587, 855, 897, 587, 709, 268, 728, 727, 847,
-	930, 24, 319, 24, 268, 268, 1162, 963, 268, 268,
-	1150, 1120, 268, 268, 268, 80, 1121, 58, 710, 954,
-	712, 708, 888, 889, 1150, 1113, 931, 1112, 80, 525,
-	1422, 1089, 397, 708, 714, 834, 54, 955, 710, 796,
-	708, 957, 54, 923, 924, 975, 1515, 702, 911, 1405,
-	54, 702, 54, 1220, 931, 702, 998, 1406, 1089, 999,
-	1000, 1001, 1002, 1005, 1006, 1007, 1380, 1021, 1282, 1018,
-	806, 310, 1311, 1286, 1287, 1010, 1011, 1012, 1165, 958,
-	961, 953, 268, 80, 1014, 80, 1009, 1040, 839, 1008,
-	929, 268, 268, 268, 268, 268, 962, 268, 268, 978,
-	1532, 268, 80, 956, 1528, 936, 939, 940, 941, 937,
-	1019, 938, 942, 1313, 1292, 1286, 1287, 1289, 587, 1270,
-	54, 1192, 268, 828, 268, 268, 800, 1137, 1135, 268,
-	846, 1291, 1138, 1136, 1134, 1133, 1023, 1519, 1025, 1061,
-	1015, 1016, 1139, 1512, 940, 941, 1229, 896, 898, 314,
-	315, 858, 1517, 1071, 1070, 1052, 1056, 622, 621, 631,
-	632, 624, 625, 626, 627, 628, 629, 630, 623, 1053,
-	1054, 633, 1183, 726, 554, 1175, 1022, 1489, 1488, 862,
-	601, 1064, 1420, 1173, 601, 1042, 1043, 1044, 1045, 1046,
-	859, 1049, 1050, 602, 1167, 1051, 599, 602, 1065, 1366,
-	589, 1066, 1401, 1024, 936, 939, 940, 941, 937, 863,
-	938, 942, 590, 900, 901, 799, 1055, 906, 909, 910,
-	944, 311, 312, 1060, 305, 1459, 1078, 306, 58, 1458,
-	1069, 1408, 268, 268, 268, 268, 268, 1127, 1068, 1150,
-	579, 1101, 922, 1098, 268, 925, 926, 268, 1534, 1533,
-	62, 268, 821, 604, 1122, 268, 568, 567, 1534, 1470,
-	326, 1394, 829, 60, 55, 1, 1526, 1334, 1402, 1030,
-	1490, 1435, 1160, 899, 80, 702, 702, 702, 702, 702,
-	1305, 1163, 981, 1166, 1151, 972, 1106, 1171, 1171, 69,
-	702, 975, 81, 522, 1129, 1130, 269, 1132, 702, 269,
-	1140, 1128, 68, 1481, 1131, 980, 979, 1443, 591, 595,
-	1148, 1152, 1392, 1153, 992, 1177, 1172, 995, 1155, 1312,
-	1180, 1181, 80, 80, 1174, 614, 1486, 269, 81, 734,
-	732, 733, 269, 731, 269, 736, 735, 730, 282, 1182,
-	392, 1184, 1185, 1186, 1168, 1169, 943, 722, 1020, 605,
-	72, 1213, 80, 1212, 1026, 826, 573, 574, 284, 1189,
-	658, 641, 1067, 1156, 398, 1277, 835, 593, 268, 669,
-	1457, 1195, 1407, 1105, 668, 912, 1194, 80, 1085, 1211,
-	331, 850, 1086, 344, 341, 342, 841, 1226, 1227, 1119,
-	1091, 1092, 1093, 615, 1235, 329, 885, 1099, 1072, 321,
-	1102, 1103, 701, 694, 935, 1225, 1109, 1228, 933, 932,
-	1111, 387, 1288, 1114, 1115, 1116, 1117, 1118, 1284, 700,
-	1232, 1361, 1464, 1271, 80, 80, 1237, 1127, 1266, 1262,
-	1236, 845, 26, 59, 316, 1274, 1142, 19, 1243, 1256,
-	18, 1255, 17, 20, 16, 1087, 1088, 15, 80, 14,
-	1276, 542, 30, 21, 13, 12, 11, 10, 1064, 9,
-	8, 7, 1231, 80, 1104, 80, 80, 859, 6, 1171,
-	1171, 5, 1304, 1290, 1297, 4, 307, 23, 2, 0,
-	975, 1281, 975, 0, 1318, 0, 0, 0, 1296, 0,
-	0, 0, 0, 268, 0, 0, 0, 1309, 1310, 0,
-	1303, 1308, 0, 0, 0, 0, 0, 1299, 323, 0,
-	0, 0, 0, 268, 0, 269, 1316, 1317, 0, 80,
-	269, 1335, 80, 80, 80, 268, 269, 0, 0, 0,
-	0, 80, 269, 0, 268, 0, 0, 81, 0, 0,
-	0, 1327, 81, 0, 81, 0, 0, 0, 1235, 0,
-	81, 0, 0, 0, 0, 0, 1328, 0, 1330, 0,
-	810, 0, 1340, 1342, 0, 0, 0, 1346, 1347, 0,
-	1348, 0, 0, 1350, 0, 1352, 0, 702, 1241, 1242,
-	81, 1349, 825, 0, 0, 0, 0, 1326, 1341, 1127,
-	0, 0, 0, 0, 0, 1367, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 0, 1376, 1329, 0, 848,
-	849, 1163, 80, 1377, 0, 0, 0, 0, 0, 1339,
-	0, 975, 0, 1386, 0, 0, 0, 80, 0, 1389,
-	0, 0, 0, 0, 80, 0, 0, 0, 0, 0,
-	0, 1395, 0, 1397, 269, 269, 269, 0, 1410, 0,
-	0, 1404, 0, 81, 0, 0, 0, 0, 0, 81,
-	0, 0, 658, 0, 0, 902, 903, 0, 1409, 0,
-	0, 0, 0, 80, 80, 0, 80, 0, 0, 0,
-	1400, 80, 1274, 80, 80, 80, 268, 1416, 0, 80,
-	1429, 1421, 1430, 1432, 1433, 0, 0, 0, 1423, 0,
-	0, 0, 1428, 0, 1434, 0, 80, 268, 1440, 0,
-	0, 1447, 0, 0, 0, 1455, 0, 0, 0, 0,
-	0, 0, 0, 0, 969, 0, 0, 1343, 0, 0,
-	0, 1448, 0, 1449, 1471, 1345, 1274, 0, 1480, 0,
-	0, 0, 0, 80, 0, 0, 1354, 1355, 1479, 1478,
-	0, 1472, 0, 0, 80, 80, 0, 0, 0, 0,
-	0, 0, 1494, 0, 1493, 0, 1369, 1370, 1371, 0,
-	1374, 1404, 975, 1502, 80, 1497, 0, 1127, 0, 0,
-	0, 0, 0, 0, 269, 268, 0, 1385, 0, 81,
-	0, 0, 0, 80, 269, 269, 81, 81, 81, 0,
-	1511, 1456, 269, 0, 0, 269, 0, 0, 269, 0,
-	0, 0, 269, 0, 81, 0, 80, 1518, 1516, 81,
-	81, 81, 269, 81, 81, 1520, 0, 0, 269, 269,
-	1531, 81, 81, 0, 1062, 1063, 1542, 595, 0, 0,
-	1365, 0, 647, 648, 649, 650, 651, 652, 653, 654,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	81, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 81, 0, 1431, 0, 0, 0, 357, 51, 1506,
-	622, 621, 631, 632, 624, 625, 626, 627, 628, 629,
-	630, 623, 0, 0, 633, 0, 0, 0, 0, 0,
-	1090, 0, 1460, 1461, 1462, 1463, 0, 1467, 279, 1468,
-	1469, 0, 0, 0, 0, 81, 0, 1108, 0, 0,
-	0, 1475, 0, 1476, 1477, 0, 0, 0, 0, 51,
-	0, 0, 0, 82, 83, 84, 0, 309, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 81, 81,
-	0, 0, 0, 0, 0, 1498, 269, 0, 0, 0,
-	0, 0, 0, 1503, 269, 269, 1364, 0, 269, 269,
-	0, 0, 269, 269, 269, 81, 0, 0, 0, 0,
-	0, 1508, 0, 0, 0, 0, 272, 0, 81, 0,
-	0, 0, 1359, 275, 0, 0, 0, 355, 0, 0,
-	0, 283, 278, 0, 0, 0, 622, 621, 631, 632,
-	624, 625, 626, 627, 628, 629, 630, 623, 0, 0,
-	633, 0, 0, 0, 0, 0, 1543, 1544, 0, 79,
-	0, 0, 0, 0, 281, 1358, 0, 0, 0, 0,
-	288, 0, 269, 81, 0, 81, 0, 0, 0, 0,
-	0, 269, 269, 269, 269, 269, 0, 269, 269, 0,
-	0, 269, 81, 0, 0, 399, 0, 273, 0, 622,
-	621, 631, 632, 624, 625, 626, 627, 628, 629, 630,
-	623, 0, 269, 633, 269, 269, 0, 0, 0, 269,
-	0, 0, 1357, 0, 285, 276, 0, 286, 287, 292,
-	0, 0, 0, 277, 280, 0, 274, 291, 290, 0,
-	1264, 0, 622, 621, 631, 632, 624, 625, 626, 627,
-	628, 629, 630, 623, 860, 0, 633, 869, 870, 871,
-	872, 873, 874, 875, 876, 877, 878, 879, 880, 881,
-	882, 883, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1298, 565, 0, 0, 0, 0, 565,
-	0, 565, 0, 0, 0, 0, 0, 565, 0, 622,
-	621, 631, 632, 624, 625, 626, 627, 628, 629, 630,
-	623, 0, 919, 633, 0, 0, 0, 51, 751, 0,
-	0, 0, 269, 269, 269, 269, 269, 0, 1238, 0,
-	0, 0, 642, 0, 269, 644, 0, 269, 0, 0,
-	0, 269, 0, 0, 0, 269, 0, 1356, 622, 621,
-	631, 632, 624, 625, 626, 627, 628, 629, 630, 623,
-	0, 0, 633, 655, 81, 659, 660, 661, 662, 663,
-	664, 665, 666, 667, 0, 670, 673, 673, 673, 679,
-	673, 673, 679, 673, 687, 688, 689, 690, 691, 692,
-	693, 0, 703, 0, 0, 0, 0, 0, 1363, 739,
-	0, 0, 0, 0, 399, 0, 0, 0, 658, 399,
-	0, 399, 81, 81, 0, 0, 1378, 399, 0, 1379,
-	0, 0, 1381, 0, 622, 621, 631, 632, 624, 625,
-	626, 627, 628, 629, 630, 623, 0, 752, 633, 0,
-	0, 0, 81, 0, 0, 0, 0, 611, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	765, 768, 769, 770, 771, 772, 773, 81, 774, 775,
-	776, 777, 778, 753, 754, 755, 756, 737, 738, 766,
-	0, 740, 0, 741, 742, 743, 744, 745, 746, 747,
-	748, 749, 750, 757, 758, 759, 760, 761, 762, 763,
-	764, 0, 0, 0, 0, 1079, 1080, 1081, 0, 0,
-	0, 0, 0, 0, 81, 81, 0, 0, 0, 0,
-	399, 0, 0, 0, 0, 0, 723, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 565, 0, 81, 0,
-	0, 0, 0, 565, 565, 565, 0, 0, 0, 0,
-	0, 767, 0, 81, 0, 81, 81, 0, 0, 0,
-	0, 565, 0, 0, 0, 0, 565, 565, 565, 0,
-	565, 565, 0, 0, 0, 0, 0, 0, 565, 565,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	0, 1496, 658, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 1084, 0, 0, 0, 81,
-	0, 0, 81, 81, 81, 269, 0, 0, 0, 0,
-	0, 81, 0, 0, 269, 622, 621, 631, 632, 624,
-	625, 626, 627, 628, 629, 630, 623, 0, 0, 633,
-	622, 621, 631, 632, 624, 625, 626, 627, 628, 629,
-	630, 623, 51, 0, 633, 0, 399, 0, 0, 0,
-	0, 0, 0, 399, 399, 399, 0, 659, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 399, 0, 0, 0, 0, 399, 399, 399, 0,
-	399, 399, 0, 0, 81, 0, 0, 0, 399, 399,
-	0, 0, 81, 0, 0, 0, 0, 0, 0, 0,
-	0, 946, 0, 0, 0, 703, 0, 81, 0, 703,
-	0, 0, 1239, 1240, 81, 0, 0, 842, 0, 0,
-	0, 0, 0, 0, 0, 0, 1257, 1258, 611, 1259,
-	1260, 399, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1267, 1268, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 81, 81, 0, 81, 0, 0, 0,
-	0, 81, 0, 81, 81, 81, 269, 0, 0, 81,
-	0, 0, 890, 0, 0, 0, 0, 0, 0, 0,
-	565, 0, 565, 0, 0, 0, 81, 269, 916, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 565,
-	0, 0, 0, 0, 1314, 920, 921, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 81, 0, 0, 0, 0, 0, 0,
-	0, 0, 399, 0, 81, 81, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 399, 0, 0, 0, 0,
-	0, 0, 0, 0, 81, 0, 1077, 0, 0, 0,
-	24, 25, 52, 27, 28, 269, 0, 1344, 0, 0,
-	0, 592, 0, 81, 0, 0, 0, 0, 0, 43,
-	0, 0, 0, 0, 29, 48, 49, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 81, 0, 0, 0,
-	399, 0, 399, 0, 0, 38, 0, 267, 0, 54,
-	293, 0, 0, 0, 0, 0, 0, 0, 0, 399,
-	0, 0, 0, 0, 0, 1123, 1124, 0, 0, 703,
-	703, 703, 703, 703, 0, 320, 0, 0, 390, 0,
-	0, 0, 0, 267, 946, 267, 1147, 0, 0, 0,
-	0, 399, 703, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 31, 32, 34, 33, 36, 0, 50, 0,
-	0, 0, 0, 0, 0, 1411, 1412, 1413, 1414, 1415,
-	0, 0, 0, 1418, 1419, 0, 0, 0, 0, 0,
-	37, 44, 45, 0, 0, 46, 47, 35, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	565, 39, 40, 0, 41, 42, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 916, 565,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	331, 1631, 1621, 1581, 1597, 1393, 1375, 864, 671, 1549,
+	1249, 994, 336, 1155, 1173, 1454, 1315, 1461, 349, 1492,
+	990, 314, 1349, 967, 362, 600, 965, 1156, 393, 574,
+	1023, 1316, 1037, 83, 1179, 1282, 1312, 276, 329, 297,
+	276, 1200, 1003, 565, 306, 83, 1328, 1322, 1287, 1112,
+	848, 904, 993, 915, 911, 853, 1226, 1217, 276, 914,
+	839, 821, 969, 954, 714, 933, 609, 732, 534, 1007,
+	276, 83, 733, 713, 535, 276, 881, 276, 1033, 859,
+	407, 334, 947, 401, 622, 320, 65, 398, 396, 722,
+	685, 63, 307, 308, 309, 310, 54, 686, 313, 1603,
+	1604, 1601, 1602, 1600, 670, 3, 1056, 1578, 1586, 1560,
+	1561, 1587, 1283, 56, 67, 68, 69, 70, 1624, 323,
+	1055, 1017, 1586, 56, 576, 1587, 554, 1591, 374, 1619,
+	380, 381, 378, 379, 377, 376, 375, 1150, 1582, 56,
+	1566, 338, 1151, 318, 382, 383, 1613, 1376, 1590, 85,
+	86, 87, 1478, 1565, 1304, 1414, 539, 1343, 264, 1588,
+	1054, 262, 61, 266, 734, 322, 735, 272, 268, 269,
+	270, 984, 61, 1588, 312, 1524, 636, 635, 645, 646,
+	638, 639, 640, 641, 642, 643, 644, 637, 61, 311,
+	647, 1188, 1344, 1345, 1187, 404, 1208, 1189, 1016, 85,
+	86, 87, 985, 986, 1253, 1444, 1024, 1405, 592, 593,
+	1051, 1048, 1049, 587, 1047, 305, 1403, 588, 585, 586,
+	85, 86, 87, 302, 580, 581, 846, 590, 1068, 1065,
+	1255, 805, 1615, 1608, 1550, 1288, 1516, 1248, 948, 1543,
+	1008, 807, 1493, 1639, 555, 541, 1058, 1061, 266, 1500,
+	1635, 1379, 809, 1010, 1256, 1338, 814, 1495, 1174, 1176,
+	303, 265, 1254, 276, 546, 547, 796, 571, 276, 573,
+	556, 1337, 591, 1290, 276, 1336, 806, 766, 808, 537,
+	276, 563, 263, 544, 569, 83, 279, 267, 1053, 811,
+	83, 271, 83, 1070, 1532, 810, 1069, 551, 83, 1130,
+	570, 572, 1425, 1010, 83, 1277, 83, 1245, 659, 660,
+	1052, 1184, 1292, 1247, 1296, 1140, 1291, 1127, 1289, 579,
+	1105, 582, 1583, 1294, 1584, 1494, 83, 594, 879, 728,
+	626, 561, 1293, 85, 86, 87, 1583, 1175, 1584, 640,
+	641, 642, 643, 644, 637, 1295, 1297, 647, 637, 1057,
+	1525, 647, 1009, 1501, 1499, 991, 598, 599, 754, 1024,
+	1633, 647, 548, 1634, 549, 1632, 980, 550, 1084, 620,
+	619, 1564, 619, 1059, 85, 86, 87, 57, 661, 662,
+	663, 664, 665, 666, 667, 668, 621, 57, 621, 621,
+	568, 276, 276, 276, 840, 844, 767, 557, 558, 559,
+	83, 567, 1009, 57, 659, 660, 83, 1006, 1004, 533,
+	1005, 1541, 1246, 604, 1244, 1509, 1002, 1008, 712, 1326,
+	736, 721, 659, 660, 780, 783, 784, 785, 786, 787,
+	788, 1306, 789, 790, 791, 792, 793, 768, 769, 770,
+	771, 752, 753, 781, 934, 755, 1137, 756, 757, 758,
+	759, 760, 761, 762, 763, 764, 765, 772, 773, 774,
+	775, 776, 777, 778, 779, 934, 688, 690, 692, 694,
+	696, 698, 699, 689, 691, 657, 695, 697, 841, 700,
+	798, 1010, 566, 1611, 726, 1089, 1090, 730, 636, 635,
+	645, 646, 638, 639, 640, 641, 642, 643, 644, 637,
+	1013, 1206, 647, 85, 86, 87, 1014, 1102, 1103, 1104,
+	540, 85, 86, 87, 888, 782, 638, 639, 640, 641,
+	642, 643, 644, 637, 404, 1545, 647, 708, 886, 887,
+	885, 1570, 1450, 717, 276, 1126, 620, 619, 794, 83,
+	1449, 797, 1113, 799, 276, 276, 83, 83, 83, 61,
+	620, 619, 276, 621, 73, 276, 1362, 1308, 276, 819,
+	820, 884, 276, 1125, 83, 1124, 1221, 621, 795, 83,
+	83, 83, 276, 83, 83, 802, 803, 804, 276, 276,
+	1009, 83, 83, 815, 620, 619, 1220, 616, 620, 619,
+	542, 543, 74, 824, 1209, 1572, 1640, 83, 828, 829,
+	830, 621, 832, 833, 1542, 621, 1473, 1447, 825, 1218,
+	836, 837, 1081, 276, 826, 83, 616, 1609, 276, 1101,
+	1614, 261, 1574, 616, 83, 855, 636, 635, 645, 646,
+	638, 639, 640, 641, 642, 643, 644, 637, 1641, 1506,
+	647, 1505, 823, 870, 872, 873, 1101, 1553, 905, 871,
+	85, 86, 87, 1313, 906, 882, 1325, 907, 645, 646,
+	638, 639, 640, 641, 642, 643, 644, 637, 880, 83,
+	647, 889, 890, 891, 892, 893, 894, 895, 896, 897,
+	898, 899, 900, 901, 902, 903, 1101, 616, 876, 390,
+	391, 924, 927, 857, 85, 86, 87, 935, 862, 1086,
+	1101, 1533, 83, 83, 1101, 1497, 1358, 878, 874, 724,
+	856, 276, 352, 351, 354, 355, 356, 357, 1180, 276,
+	276, 353, 358, 276, 276, 1011, 939, 276, 276, 276,
+	83, 1440, 1439, 917, 920, 921, 1325, 1085, 926, 929,
+	930, 908, 909, 83, 535, 1420, 85, 86, 87, 975,
+	1191, 877, 725, 977, 727, 863, 620, 619, 951, 943,
+	944, 931, 951, 942, 1427, 616, 945, 946, 1424, 616,
+	1368, 1367, 883, 621, 1364, 1365, 1364, 1363, 919, 1119,
+	616, 951, 616, 917, 616, 743, 742, 315, 1025, 1026,
+	1027, 724, 1388, 974, 1180, 723, 950, 276, 83, 982,
+	83, 1236, 1060, 973, 723, 1508, 276, 276, 276, 823,
+	276, 276, 998, 981, 276, 276, 978, 1366, 276, 83,
+	1192, 983, 951, 1143, 1142, 1119, 1039, 1043, 1119, 1045,
+	1087, 1232, 1233, 1234, 725, 729, 723, 1119, 1325, 276,
+	813, 276, 276, 612, 606, 613, 276, 61, 1074, 956,
+	959, 960, 961, 957, 404, 958, 962, 1592, 1457, 83,
+	717, 1626, 1018, 56, 717, 1432, 1038, 995, 717, 1035,
+	1036, 1354, 1329, 1330, 1019, 1020, 1021, 1022, 61, 1195,
+	1034, 1091, 1029, 1078, 1028, 1250, 1458, 1099, 1041, 1417,
+	1030, 1031, 1032, 61, 1622, 1075, 1076, 1356, 1332, 1313,
+	1235, 1222, 845, 817, 1335, 1240, 1237, 1228, 1238, 1231,
+	882, 1227, 61, 1167, 1229, 1230, 1165, 1169, 1168, 960,
+	961, 1166, 1334, 1164, 1093, 1109, 1110, 1111, 1239, 636,
+	635, 645, 646, 638, 639, 640, 641, 642, 643, 644,
+	637, 1107, 1163, 647, 332, 610, 611, 1606, 1589, 1385,
+	1261, 1594, 276, 276, 276, 276, 276, 1270, 1269, 1213,
+	878, 860, 1108, 1157, 276, 741, 860, 276, 849, 564,
+	1205, 276, 1117, 1118, 861, 276, 1547, 84, 1546, 861,
+	850, 277, 858, 1476, 277, 1203, 1197, 1418, 1452, 84,
+	24, 1134, 1190, 1044, 83, 816, 1610, 1272, 1136, 964,
+	601, 1181, 277, 1196, 877, 1193, 1518, 1201, 1201, 1556,
+	1182, 602, 1183, 315, 277, 84, 64, 1159, 1160, 277,
+	1162, 277, 607, 608, 1268, 1158, 1170, 883, 1161, 1555,
+	1513, 1180, 1267, 1178, 589, 1628, 1627, 66, 1131, 1128,
+	838, 1628, 83, 83, 1212, 617, 1214, 1215, 1216, 1202,
+	1210, 1211, 1152, 578, 577, 1529, 1185, 956, 959, 960,
+	961, 957, 1445, 958, 962, 1083, 276, 1329, 1330, 276,
+	317, 919, 1224, 62, 83, 1198, 1199, 1, 1620, 1377,
+	1453, 1050, 1548, 1491, 1348, 1001, 992, 1219, 72, 532,
+	71, 1225, 1540, 717, 717, 717, 717, 717, 1000, 999,
+	1498, 1443, 83, 1257, 1012, 1241, 83, 1207, 717, 1015,
+	1355, 1204, 1544, 749, 747, 748, 717, 746, 995, 1258,
+	1259, 751, 750, 745, 290, 399, 905, 635, 645, 646,
+	638, 639, 640, 641, 642, 643, 644, 637, 1260, 963,
+	647, 737, 1265, 1264, 1040, 618, 75, 1243, 1242, 1046,
+	843, 583, 1280, 1281, 83, 83, 1305, 584, 1271, 1278,
+	292, 1276, 655, 1157, 1314, 1266, 1300, 1301, 1186, 1302,
+	1303, 405, 1320, 1286, 1088, 1299, 1317, 1298, 83, 852,
+	1585, 1310, 1311, 1559, 1558, 1463, 1596, 1577, 1554, 1512,
+	1324, 1135, 682, 83, 932, 83, 83, 337, 869, 1201,
+	1201, 1340, 1107, 350, 347, 348, 1347, 277, 1333, 1094,
+	1149, 629, 277, 335, 1361, 327, 1342, 716, 277, 709,
+	955, 878, 953, 276, 277, 1339, 952, 394, 1331, 84,
+	1275, 1359, 1360, 1327, 84, 715, 84, 1387, 1413, 1351,
+	1352, 1353, 84, 276, 1357, 1346, 1523, 1098, 84, 83,
+	84, 1378, 1416, 27, 83, 83, 83, 276, 1319, 316,
+	389, 21, 20, 19, 18, 1309, 17, 276, 325, 22,
+	84, 1370, 16, 15, 14, 552, 31, 23, 83, 13,
+	12, 11, 10, 9, 83, 8, 1371, 7, 1373, 6,
+	5, 4, 636, 635, 645, 646, 638, 639, 640, 641,
+	642, 643, 644, 637, 1384, 319, 647, 25, 603, 55,
+	2, 0, 0, 0, 0, 0, 0, 995, 1391, 995,
+	1395, 1396, 0, 0, 0, 1401, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 277, 277, 277, 1157, 0,
+	0, 0, 0, 0, 84, 0, 1428, 83, 0, 0,
+	84, 1419, 0, 1429, 0, 83, 0, 0, 1193, 0,
+	0, 0, 1442, 0, 0, 0, 1438, 0, 0, 0,
+	83, 0, 0, 0, 0, 0, 0, 83, 0, 0,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 0, 0, 0, 1446, 83, 1448, 0, 0, 1451,
+	1466, 0, 1275, 0, 0, 0, 0, 0, 1456, 0,
+	1398, 1399, 0, 1400, 1389, 1460, 1402, 0, 1404, 0,
+	0, 0, 1459, 0, 0, 83, 83, 0, 83, 0,
+	1465, 0, 0, 83, 0, 83, 83, 83, 276, 1472,
+	1485, 83, 1486, 1488, 1489, 1317, 0, 1467, 1468, 1469,
+	1470, 1471, 1477, 0, 1484, 1474, 1475, 0, 83, 276,
+	0, 1490, 0, 1496, 1502, 1510, 0, 1503, 0, 1504,
+	0, 995, 1441, 0, 0, 0, 0, 0, 277, 0,
+	1515, 0, 0, 84, 0, 1517, 0, 0, 277, 277,
+	84, 84, 84, 0, 1539, 1530, 277, 0, 0, 277,
+	0, 1455, 277, 1317, 0, 1537, 277, 0, 84, 83,
+	83, 1538, 0, 84, 84, 84, 277, 84, 84, 0,
+	0, 1552, 277, 277, 1551, 84, 84, 1562, 1479, 0,
+	717, 0, 0, 83, 0, 0, 0, 0, 0, 0,
+	0, 84, 1157, 1567, 276, 0, 0, 0, 0, 0,
+	0, 83, 0, 0, 0, 0, 0, 277, 0, 84,
+	0, 0, 277, 0, 1576, 1580, 0, 0, 84, 0,
+	0, 0, 0, 0, 0, 0, 287, 0, 0, 83,
+	0, 0, 0, 1595, 1593, 1531, 1599, 0, 0, 0,
+	0, 0, 0, 83, 0, 0, 0, 627, 938, 0,
+	1607, 85, 86, 87, 0, 0, 83, 0, 0, 0,
+	83, 0, 0, 84, 1616, 1618, 1617, 0, 0, 0,
+	0, 0, 0, 1625, 0, 0, 0, 1605, 0, 0,
+	1636, 0, 672, 1455, 995, 0, 0, 0, 0, 0,
+	0, 683, 0, 0, 0, 0, 84, 84, 0, 0,
+	0, 0, 0, 0, 280, 277, 0, 0, 0, 0,
+	0, 283, 1629, 277, 277, 0, 0, 277, 277, 291,
+	286, 277, 277, 277, 84, 0, 0, 0, 0, 56,
+	26, 58, 28, 29, 0, 0, 0, 84, 0, 0,
+	0, 0, 363, 60, 0, 0, 0, 0, 46, 0,
+	0, 0, 289, 30, 51, 52, 0, 0, 0, 0,
+	0, 296, 0, 0, 0, 0, 0, 0, 60, 0,
+	0, 0, 0, 0, 39, 0, 0, 1411, 61, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	281, 277, 84, 0, 84, 0, 0, 0, 0, 0,
+	277, 277, 277, 60, 277, 277, 0, 0, 277, 277,
+	0, 0, 277, 84, 0, 0, 0, 293, 284, 0,
+	294, 295, 300, 0, 0, 0, 285, 288, 0, 282,
+	299, 298, 0, 277, 0, 277, 277, 0, 0, 0,
+	277, 32, 33, 35, 34, 37, 1410, 53, 0, 0,
+	0, 0, 0, 84, 636, 635, 645, 646, 638, 639,
+	640, 641, 642, 643, 644, 637, 0, 0, 647, 38,
+	47, 48, 0, 0, 49, 50, 36, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 827, 0, 0, 0,
+	0, 0, 0, 40, 41, 0, 42, 43, 44, 45,
+	0, 0, 0, 0, 0, 0, 0, 0, 842, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	851, 854, 0, 636, 635, 645, 646, 638, 639, 640,
+	641, 642, 643, 644, 637, 0, 0, 647, 867, 868,
+	0, 0, 0, 0, 0, 0, 277, 277, 277, 277,
+	277, 0, 0, 0, 0, 0, 0, 0, 277, 0,
+	0, 277, 0, 0, 0, 277, 1409, 0, 0, 277,
+	0, 615, 0, 0, 0, 0, 0, 0, 0, 0,
+	59, 0, 0, 0, 0, 0, 0, 0, 84, 631,
+	0, 634, 672, 57, 0, 922, 923, 648, 649, 650,
+	651, 652, 653, 654, 1408, 632, 633, 630, 636, 635,
+	645, 646, 638, 639, 640, 641, 642, 643, 644, 637,
+	0, 0, 647, 0, 0, 0, 0, 575, 0, 0,
+	0, 0, 575, 0, 575, 0, 84, 84, 0, 0,
+	575, 0, 0, 636, 635, 645, 646, 638, 639, 640,
+	641, 642, 643, 644, 637, 989, 0, 647, 0, 605,
+	277, 0, 0, 277, 614, 0, 0, 0, 84, 0,
+	0, 0, 0, 656, 0, 0, 658, 0, 0, 0,
+	0, 636, 635, 645, 646, 638, 639, 640, 641, 642,
+	643, 644, 637, 0, 0, 647, 84, 0, 0, 0,
+	84, 0, 0, 0, 669, 0, 673, 674, 675, 676,
+	677, 678, 679, 680, 681, 0, 684, 687, 687, 687,
+	693, 687, 687, 693, 687, 701, 702, 703, 704, 705,
+	706, 707, 1279, 0, 718, 0, 636, 635, 645, 646,
+	638, 639, 640, 641, 642, 643, 644, 637, 84, 84,
+	647, 0, 636, 635, 645, 646, 638, 639, 640, 641,
+	642, 643, 644, 637, 0, 0, 647, 0, 0, 0,
+	0, 1114, 84, 0, 361, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 84, 0, 84,
+	84, 636, 635, 645, 646, 638, 639, 640, 641, 642,
+	643, 644, 637, 0, 0, 647, 0, 82, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 277, 0, 304,
+	0, 0, 0, 0, 0, 0, 0, 0, 719, 0,
+	0, 0, 0, 0, 0, 0, 1120, 277, 0, 0,
+	0, 0, 0, 84, 0, 406, 0, 0, 84, 84,
+	84, 277, 0, 1138, 0, 0, 0, 0, 0, 0,
+	0, 277, 0, 0, 0, 274, 0, 0, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 0, 84, 0,
+	0, 575, 0, 0, 0, 0, 0, 0, 575, 575,
+	575, 0, 0, 0, 0, 0, 0, 0, 395, 0,
+	0, 0, 0, 536, 0, 538, 575, 0, 0, 0,
+	0, 575, 575, 575, 0, 575, 575, 0, 0, 916,
+	918, 0, 0, 575, 575, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 84, 0, 0, 0, 0, 0, 0, 60, 84,
+	0, 0, 0, 0, 0, 0, 658, 0, 0, 0,
+	0, 0, 0, 0, 84, 0, 0, 0, 0, 0,
+	0, 84, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 0, 0, 277, 0, 0, 0, 0, 0, 84,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 60, 0, 0, 1262, 1263, 854, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 673, 0, 0, 84,
+	84, 0, 84, 0, 0, 0, 0, 84, 0, 84,
+	84, 84, 277, 0, 0, 84, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 84, 277, 0, 0, 1307, 0, 0, 406,
+	0, 966, 0, 0, 406, 718, 406, 0, 0, 718,
+	0, 0, 406, 0, 0, 0, 0, 0, 595, 0,
+	597, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 545, 0, 0, 0, 0, 553, 0, 0, 1341,
+	624, 0, 560, 84, 84, 1092, 0, 0, 562, 0,
+	0, 0, 0, 1100, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 277, 0,
+	575, 0, 575, 0, 0, 84, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1115, 0, 0, 0,
+	1116, 575, 0, 0, 0, 0, 0, 0, 1121, 1122,
+	1123, 0, 0, 84, 406, 1129, 0, 0, 1132, 1133,
+	738, 0, 0, 0, 1139, 0, 0, 84, 1141, 0,
+	0, 1144, 1145, 1146, 1147, 1148, 0, 0, 0, 0,
+	84, 0, 0, 0, 84, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1172, 0, 0, 0, 0, 711,
+	1106, 720, 0, 0, 0, 0, 0, 1415, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 672, 0, 0,
+	0, 0, 0, 0, 0, 1430, 0, 0, 1431, 0,
+	0, 1433, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1153, 1154, 0, 0, 718, 718, 718, 718, 718, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 966,
+	0, 1177, 0, 406, 0, 0, 0, 718, 0, 0,
+	406, 406, 406, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 406, 0,
+	0, 0, 0, 406, 406, 406, 0, 406, 406, 0,
+	0, 0, 0, 0, 0, 406, 406, 0, 0, 0,
+	0, 0, 744, 0, 0, 0, 1284, 1285, 0, 0,
+	0, 847, 800, 801, 0, 0, 0, 0, 0, 0,
+	812, 0, 0, 395, 0, 575, 818, 0, 0, 865,
+	0, 0, 0, 0, 0, 0, 0, 0, 624, 0,
+	831, 406, 0, 0, 0, 0, 834, 835, 0, 0,
+	0, 0, 0, 0, 0, 0, 575, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 672, 0, 0,
+	0, 0, 0, 910, 0, 0, 866, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 936,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1579, 672, 940, 941, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1318, 0, 60, 0, 0, 0,
+	0, 0, 0, 0, 406, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 406, 0, 0,
+	0, 0, 0, 0, 0, 1390, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1397, 0, 0, 949,
+	0, 0, 0, 0, 628, 0, 0, 1406, 1407, 0,
+	0, 0, 976, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1421, 1422, 1423,
+	0, 1426, 406, 0, 406, 0, 0, 0, 0, 0,
+	0, 275, 0, 0, 301, 0, 0, 0, 1437, 0,
+	0, 0, 0, 406, 0, 0, 0, 0, 0, 0,
+	0, 0, 321, 0, 0, 0, 0, 0, 0, 0,
+	326, 0, 0, 0, 397, 658, 0, 0, 0, 275,
+	0, 275, 0, 0, 0, 1042, 1394, 0, 0, 0,
+	0, 0, 0, 1095, 1062, 1063, 1064, 0, 1066, 1067,
+	0, 0, 1071, 1072, 0, 0, 1073, 1412, 0, 0,
+	0, 0, 0, 0, 406, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1077, 0, 0,
+	0, 0, 0, 0, 1082, 0, 0, 0, 0, 1434,
+	1435, 1436, 0, 1487, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1514, 0, 575, 0, 0, 0, 1519, 1520, 1521, 1522,
+	0, 1526, 0, 1527, 1528, 936, 0, 0, 0, 0,
+	0, 718, 0, 0, 0, 1534, 0, 1535, 1536, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 399, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 53, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1275,
-	0, 51, 0, 0, 0, 0, 267, 0, 0, 0,
-	0, 267, 0, 0, 0, 0, 0, 267, 0, 1193,
-	399, 0, 0, 267, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1535, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 399,
+	0, 0, 0, 1318, 1557, 0, 1480, 0, 406, 0,
+	0, 0, 1563, 0, 0, 0, 0, 0, 0, 0,
+	1568, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1507, 0, 1573, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 275, 0, 0,
+	0, 0, 275, 0, 0, 0, 1223, 406, 275, 0,
+	0, 1318, 0, 60, 275, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 406, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1273, 0, 1637, 1638,
+	406, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1251, 0, 0, 1252, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 399, 0,
-	0, 703, 0, 0, 0, 0, 0, 0, 916, 0,
-	0, 1278, 1280, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 267, 267, 267, 0, 1360,
-	0, 0, 0, 0, 0, 1280, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 406, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 936, 0, 0, 1321, 1323,
+	0, 0, 0, 0, 0, 275, 275, 275, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	399, 0, 399, 1307, 0, 0, 0, 0, 0, 0,
-	0, 1382, 1383, 1384, 0, 0, 0, 0, 0, 0,
+	0, 0, 1323, 1394, 0, 0, 0, 0, 1623, 0,
+	0, 0, 0, 0, 0, 0, 0, 406, 0, 406,
+	1350, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 565, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1331, 0, 0, 1336,
-	1337, 1338, 0, 0, 0, 0, 0, 0, 399, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1275, 0, 0, 1424,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1374, 0, 0, 0, 0, 1380, 1381,
+	1382, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 267, 0, 0, 0, 1452,
-	916, 0, 0, 0, 0, 267, 267, 0, 0, 0,
-	0, 0, 0, 267, 0, 0, 267, 0, 0, 267,
-	1275, 399, 51, 805, 0, 0, 0, 0, 0, 1391,
-	0, 0, 0, 267, 0, 0, 0, 0, 0, 267,
-	267, 0, 0, 0, 399, 0, 0, 0, 0, 0,
-	0, 399, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1369, 406, 0, 0, 0, 0, 0, 1392, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 267, 0, 0, 0,
-	0, 0, 0, 0, 0, 805, 0, 0, 0, 0,
-	1425, 1426, 0, 1427, 0, 0, 0, 0, 1391, 0,
-	1391, 1391, 1391, 0, 0, 0, 1307, 0, 0, 0,
+	0, 1372, 0, 0, 0, 0, 0, 0, 275, 0,
+	0, 0, 0, 0, 0, 1383, 0, 0, 275, 275,
+	0, 0, 0, 0, 0, 1386, 275, 0, 0, 275,
+	936, 0, 275, 0, 0, 0, 822, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 275, 0, 0, 0,
+	0, 406, 275, 275, 0, 0, 0, 0, 0, 865,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1529, 1391, 0, 0, 0, 320, 0, 0,
-	0, 0, 320, 320, 0, 0, 320, 320, 320, 0,
-	0, 0, 917, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 406, 0, 0, 0, 0, 0,
+	0, 406, 0, 0, 0, 0, 0, 321, 0, 0,
+	0, 0, 275, 0, 0, 0, 0, 0, 0, 1462,
+	0, 822, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1485, 320, 320, 320, 320, 320, 0, 267, 0, 0,
-	0, 399, 399, 0, 0, 267, 951, 0, 0, 267,
-	267, 0, 0, 267, 959, 805, 0, 0, 916, 0,
-	0, 1504, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1481,
+	1482, 0, 1483, 0, 0, 0, 0, 865, 395, 865,
+	865, 865, 0, 0, 326, 1350, 0, 0, 0, 326,
+	326, 0, 0, 326, 326, 326, 0, 0, 0, 937,
+	0, 0, 865, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 326, 326,
+	326, 326, 326, 0, 0, 275, 0, 0, 0, 0,
+	0, 0, 0, 275, 971, 0, 0, 275, 275, 0,
+	0, 275, 979, 822, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 406, 406, 0, 0, 1511, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1510, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 936, 0, 0, 1569, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1391, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1575, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 267, 0, 0, 0, 0, 0, 0,
-	0, 0, 267, 267, 267, 267, 267, 0, 267, 267,
-	0, 0, 267, 0, 0, 0, 0, 0, 0, 0,
+	0, 275, 0, 0, 0, 0, 0, 0, 0, 0,
+	275, 275, 275, 1598, 275, 275, 0, 0, 275, 275,
+	0, 0, 275, 0, 0, 0, 0, 865, 0, 0,
+	0, 0, 1571, 0, 0, 0, 0, 0, 0, 0,
+	1612, 0, 0, 275, 1598, 1079, 1080, 0, 0, 0,
+	275, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 267, 0, 1057, 1058, 0, 0, 0,
-	267, 0, 0, 0, 0, 805, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 320, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 822, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 326, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 320, 320, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 326, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 320, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 937, 275, 275, 275, 275,
+	275, 0, 0, 0, 0, 0, 0, 0, 1171, 0,
+	0, 275, 0, 0, 0, 971, 0, 0, 0, 275,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 917, 267, 267, 267, 267, 267, 0, 0,
-	0, 0, 0, 0, 0, 1141, 0, 0, 267, 0,
-	0, 0, 951, 0, 0, 0, 267, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1228,1295 +1299,1402 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	275, 0, 0, 275, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 320,
+	0, 0, 0, 326, 0, 0, 0, 0, 0, 0,
+	0, 326, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 320, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 822, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 937, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 805, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 917, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 275, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 267, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 275, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 267, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 267, 0, 0, 0,
-	0, 0, 0, 0, 0, 267, 0, 0, 0, 0,
+	0, 275, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 275, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 917, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	937, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 275, 0, 0, 0, 0, 0,
+	0, 0, 0, 275, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 509, 497, 0, 454, 512, 427, 444, 520,
-	445, 448, 485, 412, 467, 168, 442, 951, 431, 407,
-	438, 408, 429, 456, 114, 460, 426, 499, 470, 511,
-	140, 432, 518, 142, 476, 0, 214, 156, 267, 0,
-	458, 501, 465, 494, 453, 486, 417, 475, 513, 443,
-	483, 514, 0, 0, 0, 82, 83, 84, 0, 976,
-	977, 0, 0, 0, 0, 0, 104, 0, 480, 508,
-	440, 482, 484, 406, 477, 0, 410, 413, 519, 504,
-	435, 436, 1164, 0, 0, 0, 0, 0, 0, 457,
-	466, 491, 451, 0, 0, 0, 0, 0, 0, 0,
-	0, 433, 917, 474, 0, 0, 0, 414, 411, 0,
-	0, 455, 0, 0, 0, 416, 267, 434, 492, 0,
-	404, 122, 496, 503, 452, 270, 507, 450, 449, 510,
-	187, 0, 218, 125, 139, 100, 86, 96, 0, 124,
-	165, 194, 198, 500, 430, 439, 108, 437, 196, 175,
-	234, 473, 177, 195, 143, 224, 188, 233, 243, 244,
-	221, 241, 248, 211, 89, 220, 232, 105, 206, 91,
-	230, 217, 154, 134, 135, 90, 0, 192, 113, 120,
-	110, 167, 227, 228, 109, 251, 97, 240, 93, 98,
-	239, 161, 223, 231, 155, 148, 92, 229, 153, 147,
-	138, 117, 127, 185, 145, 186, 128, 158, 157, 159,
-	0, 409, 0, 215, 237, 252, 102, 425, 222, 246,
-	247, 0, 0, 103, 121, 116, 184, 160, 99, 130,
-	212, 137, 144, 191, 250, 174, 197, 106, 236, 213,
-	421, 424, 419, 420, 468, 469, 515, 516, 517, 493,
-	415, 0, 422, 423, 0, 498, 505, 506, 472, 85,
-	94, 141, 249, 189, 119, 238, 405, 418, 112, 428,
-	0, 0, 441, 446, 447, 459, 461, 462, 463, 464,
-	471, 478, 479, 481, 487, 488, 489, 490, 495, 502,
-	521, 87, 88, 95, 101, 107, 111, 115, 118, 123,
-	126, 129, 131, 132, 133, 136, 146, 149, 150, 151,
-	152, 162, 163, 164, 166, 169, 170, 171, 172, 173,
-	176, 178, 179, 180, 181, 182, 183, 190, 193, 199,
-	200, 201, 202, 203, 204, 205, 207, 208, 209, 210,
-	216, 219, 225, 226, 235, 242, 245, 509, 497, 0,
-	454, 512, 427, 444, 520, 445, 448, 485, 412, 467,
-	168, 442, 0, 431, 407, 438, 408, 429, 456, 114,
-	460, 426, 499, 470, 511, 140, 432, 518, 142, 476,
-	0, 214, 156, 0, 0, 458, 501, 465, 494, 453,
-	486, 417, 475, 513, 443, 483, 514, 0, 0, 0,
-	82, 83, 84, 0, 976, 977, 0, 0, 0, 0,
-	0, 104, 0, 480, 508, 440, 482, 484, 406, 477,
-	0, 410, 413, 519, 504, 435, 436, 0, 0, 0,
-	0, 0, 0, 0, 457, 466, 491, 451, 0, 0,
-	0, 0, 0, 0, 0, 0, 433, 0, 474, 0,
-	0, 0, 414, 411, 0, 0, 455, 0, 0, 0,
-	416, 0, 434, 492, 0, 404, 122, 496, 503, 452,
-	270, 507, 450, 449, 510, 187, 0, 218, 125, 139,
-	100, 86, 96, 0, 124, 165, 194, 198, 500, 430,
-	439, 108, 437, 196, 175, 234, 473, 177, 195, 143,
-	224, 188, 233, 243, 244, 221, 241, 248, 211, 89,
-	220, 232, 105, 206, 91, 230, 217, 154, 134, 135,
-	90, 0, 192, 113, 120, 110, 167, 227, 228, 109,
-	251, 97, 240, 93, 98, 239, 161, 223, 231, 155,
-	148, 92, 229, 153, 147, 138, 117, 127, 185, 145,
-	186, 128, 158, 157, 159, 0, 409, 0, 215, 237,
-	252, 102, 425, 222, 246, 247, 0, 0, 103, 121,
-	116, 184, 160, 99, 130, 212, 137, 144, 191, 250,
-	174, 197, 106, 236, 213, 421, 424, 419, 420, 468,
-	469, 515, 516, 517, 493, 415, 0, 422, 423, 0,
-	498, 505, 506, 472, 85, 94, 141, 249, 189, 119,
-	238, 405, 418, 112, 428, 0, 0, 441, 446, 447,
-	459, 461, 462, 463, 464, 471, 478, 479, 481, 487,
-	488, 489, 490, 495, 502, 521, 87, 88, 95, 101,
-	107, 111, 115, 118, 123, 126, 129, 131, 132, 133,
-	136, 146, 149, 150, 151, 152, 162, 163, 164, 166,
-	169, 170, 171, 172, 173, 176, 178, 179, 180, 181,
-	182, 183, 190, 193, 199, 200, 201, 202, 203, 204,
-	205, 207, 208, 209, 210, 216, 219, 225, 226, 235,
-	242, 245, 509, 497, 0, 454, 512, 427, 444, 520,
-	445, 448, 485, 412, 467, 168, 442, 0, 431, 407,
-	438, 408, 429, 456, 114, 460, 426, 499, 470, 511,
-	140, 432, 518, 142, 476, 0, 214, 156, 0, 0,
-	458, 501, 465, 494, 453, 486, 417, 475, 513, 443,
-	483, 514, 54, 0, 0, 82, 83, 84, 0, 0,
-	0, 0, 0, 0, 0, 0, 104, 0, 480, 508,
-	440, 482, 484, 406, 477, 0, 410, 413, 519, 504,
-	435, 436, 0, 0, 0, 0, 0, 0, 0, 457,
-	466, 491, 451, 0, 0, 0, 0, 0, 0, 0,
-	0, 433, 0, 474, 0, 0, 0, 414, 411, 0,
-	0, 455, 0, 0, 0, 416, 0, 434, 492, 0,
-	404, 122, 496, 503, 452, 270, 507, 450, 449, 510,
-	187, 0, 218, 125, 139, 100, 86, 96, 0, 124,
-	165, 194, 198, 500, 430, 439, 108, 437, 196, 175,
-	234, 473, 177, 195, 143, 224, 188, 233, 243, 244,
-	221, 241, 248, 211, 89, 220, 232, 105, 206, 91,
-	230, 217, 154, 134, 135, 90, 0, 192, 113, 120,
-	110, 167, 227, 228, 109, 251, 97, 240, 93, 98,
-	239, 161, 223, 231, 155, 148, 92, 229, 153, 147,
-	138, 117, 127, 185, 145, 186, 128, 158, 157, 159,
-	0, 409, 0, 215, 237, 252, 102, 425, 222, 246,
-	247, 0, 0, 103, 121, 116, 184, 160, 99, 130,
-	212, 137, 144, 191, 250, 174, 197, 106, 236, 213,
-	421, 424, 419, 420, 468, 469, 515, 516, 517, 493,
-	415, 0, 422, 423, 0, 498, 505, 506, 472, 85,
-	94, 141, 249, 189, 119, 238, 405, 418, 112, 428,
-	0, 0, 441, 446, 447, 459, 461, 462, 463, 464,
-	471, 478, 479, 481, 487, 488, 489, 490, 495, 502,
-	521, 87, 88, 95, 101, 107, 111, 115, 118, 123,
-	126, 129, 131, 132, 133, 136, 146, 149, 150, 151,
-	152, 162, 163, 164, 166, 169, 170, 171, 172, 173,
-	176, 178, 179, 180, 181, 182, 183, 190, 193, 199,
-	200, 201, 202, 203, 204, 205, 207, 208, 209, 210,
-	216, 219, 225, 226, 235, 242, 245, 509, 497, 0,
-	454, 512, 427, 444, 520, 445, 448, 485, 412, 467,
-	168, 442, 0, 431, 407, 438, 408, 429, 456, 114,
-	460, 426, 499, 470, 511, 140, 432, 518, 142, 476,
-	0, 214, 156, 0, 0, 458, 501, 465, 494, 453,
-	486, 417, 475, 513, 443, 483, 514, 0, 0, 0,
-	82, 83, 84, 0, 0, 0, 0, 0, 0, 0,
-	0, 104, 0, 480, 508, 440, 482, 484, 406, 477,
-	0, 410, 413, 519, 504, 435, 436, 0, 0, 0,
-	0, 0, 0, 0, 457, 466, 491, 451, 0, 0,
-	0, 0, 0, 0, 1234, 0, 433, 0, 474, 0,
-	0, 0, 414, 411, 0, 0, 455, 0, 0, 0,
-	416, 0, 434, 492, 0, 404, 122, 496, 503, 452,
-	270, 507, 450, 449, 510, 187, 0, 218, 125, 139,
-	100, 86, 96, 0, 124, 165, 194, 198, 500, 430,
-	439, 108, 437, 196, 175, 234, 473, 177, 195, 143,
-	224, 188, 233, 243, 244, 221, 241, 248, 211, 89,
-	220, 232, 105, 206, 91, 230, 217, 154, 134, 135,
-	90, 0, 192, 113, 120, 110, 167, 227, 228, 109,
-	251, 97, 240, 93, 98, 239, 161, 223, 231, 155,
-	148, 92, 229, 153, 147, 138, 117, 127, 185, 145,
-	186, 128, 158, 157, 159, 0, 409, 0, 215, 237,
-	252, 102, 425, 222, 246, 247, 0, 0, 103, 121,
-	116, 184, 160, 99, 130, 212, 137, 144, 191, 250,
-	174, 197, 106, 236, 213, 421, 424, 419, 420, 468,
-	469, 515, 516, 517, 493, 415, 0, 422, 423, 0,
-	498, 505, 506, 472, 85, 94, 141, 249, 189, 119,
-	238, 405, 418, 112, 428, 0, 0, 441, 446, 447,
-	459, 461, 462, 463, 464, 471, 478, 479, 481, 487,
-	488, 489, 490, 495, 502, 521, 87, 88, 95, 101,
-	107, 111, 115, 118, 123, 126, 129, 131, 132, 133,
-	136, 146, 149, 150, 151, 152, 162, 163, 164, 166,
-	169, 170, 171, 172, 173, 176, 178, 179, 180, 181,
-	182, 183, 190, 193, 199, 200, 201, 202, 203, 204,
-	205, 207, 208, 209, 210, 216, 219, 225, 226, 235,
-	242, 245, 509, 497, 0, 454, 512, 427, 444, 520,
-	445, 448, 485, 412, 467, 168, 442, 0, 431, 407,
-	438, 408, 429, 456, 114, 460, 426, 499, 470, 511,
-	140, 432, 518, 142, 476, 0, 214, 156, 0, 0,
-	458, 501, 465, 494, 453, 486, 417, 475, 513, 443,
-	483, 514, 0, 0, 0, 82, 83, 84, 0, 0,
-	0, 0, 0, 0, 0, 0, 104, 0, 480, 508,
-	440, 482, 484, 406, 477, 0, 410, 413, 519, 504,
-	435, 436, 0, 0, 0, 0, 0, 0, 0, 457,
-	466, 491, 451, 0, 0, 0, 0, 0, 0, 960,
-	0, 433, 0, 474, 0, 0, 0, 414, 411, 0,
-	0, 455, 0, 0, 0, 416, 0, 434, 492, 0,
-	404, 122, 496, 503, 452, 270, 507, 450, 449, 510,
-	187, 0, 218, 125, 139, 100, 86, 96, 0, 124,
-	165, 194, 198, 500, 430, 439, 108, 437, 196, 175,
-	234, 473, 177, 195, 143, 224, 188, 233, 243, 244,
-	221, 241, 248, 211, 89, 220, 232, 105, 206, 91,
-	230, 217, 154, 134, 135, 90, 0, 192, 113, 120,
-	110, 167, 227, 228, 109, 251, 97, 240, 93, 98,
-	239, 161, 223, 231, 155, 148, 92, 229, 153, 147,
-	138, 117, 127, 185, 145, 186, 128, 158, 157, 159,
-	0, 409, 0, 215, 237, 252, 102, 425, 222, 246,
-	247, 0, 0, 103, 121, 116, 184, 160, 99, 130,
-	212, 137, 144, 191, 250, 174, 197, 106, 236, 213,
-	421, 424, 419, 420, 468, 469, 515, 516, 517, 493,
-	415, 0, 422, 423, 0, 498, 505, 506, 472, 85,
-	94, 141, 249, 189, 119, 238, 405, 418, 112, 428,
-	0, 0, 441, 446, 447, 459, 461, 462, 463, 464,
-	471, 478, 479, 481, 487, 488, 489, 490, 495, 502,
-	521, 87, 88, 95, 101, 107, 111, 115, 118, 123,
-	126, 129, 131, 132, 133, 136, 146, 149, 150, 151,
-	152, 162, 163, 164, 166, 169, 170, 171, 172, 173,
-	176, 178, 179, 180, 181, 182, 183, 190, 193, 199,
-	200, 201, 202, 203, 204, 205, 207, 208, 209, 210,
-	216, 219, 225, 226, 235, 242, 245, 509, 497, 0,
-	454, 512, 427, 444, 520, 445, 448, 485, 412, 467,
-	168, 442, 0, 431, 407, 438, 408, 429, 456, 114,
-	460, 426, 499, 470, 511, 140, 432, 518, 142, 476,
-	0, 214, 156, 0, 0, 458, 501, 465, 494, 453,
-	486, 417, 475, 513, 443, 483, 514, 0, 0, 0,
-	82, 83, 84, 0, 0, 0, 0, 0, 0, 0,
-	0, 104, 0, 480, 508, 440, 482, 484, 406, 477,
-	0, 410, 413, 519, 504, 435, 436, 0, 0, 0,
-	0, 0, 0, 0, 457, 466, 491, 451, 0, 0,
-	0, 0, 0, 0, 856, 0, 433, 0, 474, 0,
-	0, 0, 414, 411, 0, 0, 455, 0, 0, 0,
-	416, 0, 434, 492, 0, 404, 122, 496, 503, 452,
-	270, 507, 450, 449, 510, 187, 0, 218, 125, 139,
-	100, 86, 96, 0, 124, 165, 194, 198, 500, 430,
-	439, 108, 437, 196, 175, 234, 473, 177, 195, 143,
-	224, 188, 233, 243, 244, 221, 241, 248, 211, 89,
-	220, 232, 105, 206, 91, 230, 217, 154, 134, 135,
-	90, 0, 192, 113, 120, 110, 167, 227, 228, 109,
-	251, 97, 240, 93, 98, 239, 161, 223, 231, 155,
-	148, 92, 229, 153, 147, 138, 117, 127, 185, 145,
-	186, 128, 158, 157, 159, 0, 409, 0, 215, 237,
-	252, 102, 425, 222, 246, 247, 0, 0, 103, 121,
-	116, 184, 160, 99, 130, 212, 137, 144, 191, 250,
-	174, 197, 106, 236, 213, 421, 424, 419, 420, 468,
-	469, 515, 516, 517, 493, 415, 0, 422, 423, 0,
-	498, 505, 506, 472, 85, 94, 141, 249, 189, 119,
-	238, 405, 418, 112, 428, 0, 0, 441, 446, 447,
-	459, 461, 462, 463, 464, 471, 478, 479, 481, 487,
-	488, 489, 490, 495, 502, 521, 87, 88, 95, 101,
-	107, 111, 115, 118, 123, 126, 129, 131, 132, 133,
-	136, 146, 149, 150, 151, 152, 162, 163, 164, 166,
-	169, 170, 171, 172, 173, 176, 178, 179, 180, 181,
-	182, 183, 190, 193, 199, 200, 201, 202, 203, 204,
-	205, 207, 208, 209, 210, 216, 219, 225, 226, 235,
-	242, 245, 509, 497, 0, 454, 512, 427, 444, 520,
-	445, 448, 485, 412, 467, 168, 442, 0, 431, 407,
-	438, 408, 429, 456, 114, 460, 426, 499, 470, 511,
-	140, 432, 518, 142, 476, 0, 214, 156, 0, 0,
-	458, 501, 465, 494, 453, 486, 417, 475, 513, 443,
-	483, 514, 0, 0, 0, 82, 83, 84, 0, 0,
-	0, 0, 0, 0, 0, 0, 104, 0, 480, 508,
-	440, 482, 484, 406, 477, 0, 410, 413, 519, 504,
-	435, 436, 0, 0, 0, 0, 0, 0, 0, 457,
-	466, 491, 451, 0, 0, 0, 0, 0, 0, 0,
-	0, 433, 0, 474, 0, 0, 0, 414, 411, 0,
-	0, 455, 0, 0, 0, 416, 0, 434, 492, 0,
-	404, 122, 496, 503, 452, 270, 507, 450, 449, 510,
-	187, 0, 218, 125, 139, 100, 86, 96, 0, 124,
-	165, 194, 198, 500, 430, 439, 108, 437, 196, 175,
-	234, 473, 177, 195, 143, 224, 188, 233, 243, 244,
-	221, 241, 248, 211, 89, 220, 232, 105, 206, 91,
-	230, 217, 154, 134, 135, 90, 0, 192, 113, 120,
-	110, 167, 227, 228, 109, 251, 97, 240, 93, 98,
-	239, 161, 223, 231, 155, 148, 92, 229, 153, 147,
-	138, 117, 127, 185, 145, 186, 128, 158, 157, 159,
-	0, 409, 0, 215, 237, 252, 102, 425, 222, 246,
-	247, 0, 0, 103, 121, 116, 184, 160, 99, 130,
-	212, 137, 144, 191, 250, 174, 197, 106, 236, 213,
-	421, 424, 419, 420, 468, 469, 515, 516, 517, 493,
-	415, 0, 422, 423, 0, 498, 505, 506, 472, 85,
-	94, 141, 249, 189, 119, 238, 405, 418, 112, 428,
-	0, 0, 441, 446, 447, 459, 461, 462, 463, 464,
-	471, 478, 479, 481, 487, 488, 489, 490, 495, 502,
-	521, 87, 88, 95, 101, 107, 111, 115, 118, 123,
-	126, 129, 131, 132, 133, 136, 146, 149, 150, 151,
-	152, 162, 163, 164, 166, 169, 170, 171, 172, 173,
-	176, 178, 179, 180, 181, 182, 183, 190, 193, 199,
-	200, 201, 202, 203, 204, 205, 207, 208, 209, 210,
-	216, 219, 225, 226, 235, 242, 245, 509, 497, 0,
-	454, 512, 427, 444, 520, 445, 448, 485, 412, 467,
-	168, 442, 0, 431, 407, 438, 408, 429, 456, 114,
-	460, 426, 499, 470, 511, 140, 432, 518, 142, 476,
-	0, 214, 156, 0, 0, 458, 501, 465, 494, 453,
-	486, 417, 475, 513, 443, 483, 514, 0, 0, 0,
-	82, 83, 84, 0, 0, 0, 0, 0, 0, 0,
-	0, 104, 0, 480, 508, 440, 482, 484, 406, 477,
-	0, 410, 413, 519, 504, 435, 436, 0, 0, 0,
-	0, 0, 0, 0, 457, 466, 491, 451, 0, 0,
-	0, 0, 0, 0, 0, 0, 433, 0, 474, 0,
-	0, 0, 414, 411, 0, 0, 455, 0, 0, 0,
-	416, 0, 434, 492, 0, 404, 122, 496, 503, 452,
-	270, 507, 450, 449, 510, 187, 0, 218, 125, 139,
-	100, 86, 96, 0, 124, 165, 194, 198, 500, 430,
-	439, 108, 437, 196, 175, 234, 473, 177, 195, 143,
-	224, 188, 233, 243, 244, 221, 241, 248, 211, 89,
-	220, 232, 105, 206, 91, 230, 217, 154, 134, 135,
-	90, 0, 192, 113, 120, 110, 167, 227, 228, 109,
-	251, 97, 240, 93, 402, 239, 161, 223, 231, 155,
-	148, 92, 229, 153, 147, 138, 117, 127, 185, 145,
-	186, 128, 158, 157, 159, 0, 409, 0, 215, 237,
-	252, 102, 425, 222, 246, 247, 0, 0, 103, 121,
-	116, 184, 403, 401, 130, 212, 137, 144, 191, 250,
-	174, 197, 106, 236, 213, 421, 424, 419, 420, 468,
-	469, 515, 516, 517, 493, 415, 0, 422, 423, 0,
-	498, 505, 506, 472, 85, 94, 141, 249, 189, 119,
-	238, 405, 418, 112, 428, 0, 0, 441, 446, 447,
-	459, 461, 462, 463, 464, 471, 478, 479, 481, 487,
-	488, 489, 490, 495, 502, 521, 87, 88, 95, 101,
-	107, 111, 115, 118, 123, 126, 129, 131, 132, 133,
-	136, 146, 149, 150, 151, 152, 162, 163, 164, 166,
-	169, 170, 171, 172, 173, 176, 178, 179, 180, 181,
-	182, 183, 190, 193, 199, 200, 201, 202, 203, 204,
-	205, 207, 208, 209, 210, 216, 219, 225, 226, 235,
-	242, 245, 509, 497, 0, 454, 512, 427, 444, 520,
-	445, 448, 485, 412, 467, 168, 442, 0, 431, 407,
-	438, 408, 429, 456, 114, 460, 426, 499, 470, 511,
-	140, 432, 518, 142, 476, 0, 214, 156, 0, 0,
-	458, 501, 465, 494, 453, 486, 417, 475, 513, 443,
-	483, 514, 0, 0, 0, 82, 83, 84, 0, 0,
-	0, 0, 0, 0, 0, 0, 104, 0, 480, 508,
-	440, 482, 484, 406, 477, 0, 410, 413, 519, 504,
-	435, 436, 0, 0, 0, 0, 0, 0, 0, 457,
-	466, 491, 451, 0, 0, 0, 0, 0, 0, 0,
-	0, 433, 0, 474, 0, 0, 0, 414, 411, 0,
-	0, 455, 0, 0, 0, 416, 0, 434, 492, 0,
-	404, 122, 496, 503, 452, 270, 507, 450, 449, 510,
-	187, 0, 218, 125, 139, 100, 86, 96, 0, 124,
-	165, 194, 198, 500, 430, 439, 108, 437, 196, 175,
-	234, 473, 177, 195, 143, 224, 188, 233, 243, 244,
-	221, 241, 248, 211, 89, 220, 716, 105, 206, 91,
-	230, 217, 154, 134, 135, 90, 0, 192, 113, 120,
-	110, 167, 227, 228, 109, 251, 97, 240, 93, 402,
-	239, 161, 223, 231, 155, 148, 92, 229, 153, 147,
-	138, 117, 127, 185, 145, 186, 128, 158, 157, 159,
-	0, 409, 0, 215, 237, 252, 102, 425, 222, 246,
-	247, 0, 0, 103, 121, 116, 184, 403, 401, 130,
-	212, 137, 144, 191, 250, 174, 197, 106, 236, 213,
-	421, 424, 419, 420, 468, 469, 515, 516, 517, 493,
-	415, 0, 422, 423, 0, 498, 505, 506, 472, 85,
-	94, 141, 249, 189, 119, 238, 405, 418, 112, 428,
-	0, 0, 441, 446, 447, 459, 461, 462, 463, 464,
-	471, 478, 479, 481, 487, 488, 489, 490, 495, 502,
-	521, 87, 88, 95, 101, 107, 111, 115, 118, 123,
-	126, 129, 131, 132, 133, 136, 146, 149, 150, 151,
-	152, 162, 163, 164, 166, 169, 170, 171, 172, 173,
-	176, 178, 179, 180, 181, 182, 183, 190, 193, 199,
-	200, 201, 202, 203, 204, 205, 207, 208, 209, 210,
-	216, 219, 225, 226, 235, 242, 245, 509, 497, 0,
-	454, 512, 427, 444, 520, 445, 448, 485, 412, 467,
-	168, 442, 0, 431, 407, 438, 408, 429, 456, 114,
-	460, 426, 499, 470, 511, 140, 432, 518, 142, 476,
-	0, 214, 156, 0, 0, 458, 501, 465, 494, 453,
-	486, 417, 475, 513, 443, 483, 514, 0, 0, 0,
-	82, 83, 84, 0, 0, 0, 0, 0, 0, 0,
-	0, 104, 0, 480, 508, 440, 482, 484, 406, 477,
-	0, 410, 413, 519, 504, 435, 436, 0, 0, 0,
-	0, 0, 0, 0, 457, 466, 491, 451, 0, 0,
-	0, 0, 0, 0, 0, 0, 433, 0, 474, 0,
-	0, 0, 414, 411, 0, 0, 455, 0, 0, 0,
-	416, 0, 434, 492, 0, 404, 122, 496, 503, 452,
-	270, 507, 450, 449, 510, 187, 0, 218, 125, 139,
-	100, 86, 96, 0, 124, 165, 194, 198, 500, 430,
-	439, 108, 437, 196, 175, 234, 473, 177, 195, 143,
-	224, 188, 233, 243, 244, 221, 241, 248, 211, 89,
-	220, 393, 105, 206, 91, 230, 217, 154, 134, 135,
-	90, 0, 192, 113, 120, 110, 167, 227, 228, 109,
-	251, 97, 240, 93, 402, 239, 161, 223, 231, 155,
-	148, 92, 229, 153, 147, 138, 117, 127, 185, 145,
-	186, 128, 158, 157, 159, 0, 409, 0, 215, 237,
-	252, 102, 425, 222, 246, 247, 0, 0, 103, 121,
-	116, 184, 403, 401, 396, 395, 137, 144, 191, 250,
-	174, 197, 106, 236, 213, 421, 424, 419, 420, 468,
-	469, 515, 516, 517, 493, 415, 0, 422, 423, 0,
-	498, 505, 506, 472, 85, 94, 141, 249, 189, 119,
-	238, 405, 418, 112, 428, 0, 0, 441, 446, 447,
-	459, 461, 462, 463, 464, 471, 478, 479, 481, 487,
-	488, 489, 490, 495, 502, 521, 87, 88, 95, 101,
-	107, 111, 115, 118, 123, 126, 129, 131, 132, 133,
-	136, 146, 149, 150, 151, 152, 162, 163, 164, 166,
-	169, 170, 171, 172, 173, 176, 178, 179, 180, 181,
-	182, 183, 190, 193, 199, 200, 201, 202, 203, 204,
-	205, 207, 208, 209, 210, 216, 219, 225, 226, 235,
-	242, 245, 168, 0, 0, 892, 0, 327, 0, 0,
-	0, 114, 0, 324, 0, 0, 0, 140, 893, 367,
-	142, 0, 0, 214, 156, 0, 0, 0, 0, 358,
-	359, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-	0, 0, 82, 83, 84, 346, 345, 348, 349, 350,
-	351, 0, 0, 104, 347, 352, 353, 354, 0, 0,
-	0, 322, 339, 0, 366, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 336, 337, 318, 0, 0, 0,
-	381, 0, 338, 0, 0, 333, 334, 335, 340, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 122, 380,
-	0, 0, 270, 0, 0, 378, 0, 187, 0, 218,
-	125, 139, 100, 86, 96, 0, 124, 165, 194, 198,
-	0, 0, 0, 108, 0, 196, 175, 234, 0, 177,
-	195, 143, 224, 188, 233, 243, 244, 221, 241, 248,
-	211, 89, 220, 232, 105, 206, 91, 230, 217, 154,
-	134, 135, 90, 0, 192, 113, 120, 110, 167, 227,
-	228, 109, 251, 97, 240, 93, 98, 239, 161, 223,
-	231, 155, 148, 92, 229, 153, 147, 138, 117, 127,
-	185, 145, 186, 128, 158, 157, 159, 0, 0, 0,
-	215, 237, 252, 102, 0, 222, 246, 247, 0, 0,
-	103, 121, 116, 184, 160, 99, 130, 212, 137, 144,
-	191, 250, 174, 197, 106, 236, 213, 368, 379, 374,
-	375, 372, 373, 371, 370, 369, 382, 360, 361, 362,
-	363, 365, 0, 376, 377, 364, 85, 94, 141, 249,
-	189, 119, 238, 0, 0, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 88,
-	95, 101, 107, 111, 115, 118, 123, 126, 129, 131,
-	132, 133, 136, 146, 149, 150, 151, 152, 162, 163,
-	164, 166, 169, 170, 171, 172, 173, 176, 178, 179,
-	180, 181, 182, 183, 190, 193, 199, 200, 201, 202,
-	203, 204, 205, 207, 208, 209, 210, 216, 219, 225,
-	226, 235, 242, 245, 168, 0, 0, 0, 0, 327,
-	0, 0, 0, 114, 0, 324, 0, 0, 0, 140,
-	0, 367, 142, 0, 0, 214, 156, 0, 0, 0,
-	0, 358, 359, 0, 0, 0, 0, 0, 0, 967,
-	0, 54, 0, 0, 82, 83, 84, 346, 345, 348,
-	349, 350, 351, 0, 0, 104, 347, 352, 353, 354,
-	968, 0, 0, 322, 339, 0, 366, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 336, 337, 0, 0,
-	0, 0, 381, 0, 338, 0, 0, 333, 334, 335,
-	340, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	122, 380, 0, 0, 270, 0, 0, 378, 0, 187,
-	0, 218, 125, 139, 100, 86, 96, 0, 124, 165,
-	194, 198, 0, 0, 0, 108, 0, 196, 175, 234,
-	0, 177, 195, 143, 224, 188, 233, 243, 244, 221,
-	241, 248, 211, 89, 220, 232, 105, 206, 91, 230,
-	217, 154, 134, 135, 90, 0, 192, 113, 120, 110,
-	167, 227, 228, 109, 251, 97, 240, 93, 98, 239,
-	161, 223, 231, 155, 148, 92, 229, 153, 147, 138,
-	117, 127, 185, 145, 186, 128, 158, 157, 159, 0,
-	0, 0, 215, 237, 252, 102, 0, 222, 246, 247,
-	0, 0, 103, 121, 116, 184, 160, 99, 130, 212,
-	137, 144, 191, 250, 174, 197, 106, 236, 213, 368,
-	379, 374, 375, 372, 373, 371, 370, 369, 382, 360,
-	361, 362, 363, 365, 0, 376, 377, 364, 85, 94,
-	141, 249, 189, 119, 238, 0, 0, 112, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	87, 88, 95, 101, 107, 111, 115, 118, 123, 126,
-	129, 131, 132, 133, 136, 146, 149, 150, 151, 152,
-	162, 163, 164, 166, 169, 170, 171, 172, 173, 176,
-	178, 179, 180, 181, 182, 183, 190, 193, 199, 200,
-	201, 202, 203, 204, 205, 207, 208, 209, 210, 216,
-	219, 225, 226, 235, 242, 245, 168, 0, 0, 0,
-	0, 327, 0, 0, 0, 114, 0, 324, 0, 0,
-	0, 140, 0, 367, 142, 0, 0, 214, 156, 0,
-	0, 0, 0, 358, 359, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 587, 82, 83, 84, 346,
-	345, 348, 349, 350, 351, 0, 0, 104, 347, 352,
-	353, 354, 0, 0, 0, 322, 339, 0, 366, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 336, 337,
-	0, 0, 0, 0, 381, 0, 338, 0, 0, 333,
-	334, 335, 340, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 122, 380, 0, 0, 270, 0, 0, 378,
-	0, 187, 0, 218, 125, 139, 100, 86, 96, 0,
-	124, 165, 194, 198, 0, 0, 0, 108, 0, 196,
-	175, 234, 0, 177, 195, 143, 224, 188, 233, 243,
-	244, 221, 241, 248, 211, 89, 220, 232, 105, 206,
-	91, 230, 217, 154, 134, 135, 90, 0, 192, 113,
-	120, 110, 167, 227, 228, 109, 251, 97, 240, 93,
-	98, 239, 161, 223, 231, 155, 148, 92, 229, 153,
-	147, 138, 117, 127, 185, 145, 186, 128, 158, 157,
-	159, 0, 0, 0, 215, 237, 252, 102, 0, 222,
-	246, 247, 0, 0, 103, 121, 116, 184, 160, 99,
-	130, 212, 137, 144, 191, 250, 174, 197, 106, 236,
-	213, 368, 379, 374, 375, 372, 373, 371, 370, 369,
-	382, 360, 361, 362, 363, 365, 0, 376, 377, 364,
-	85, 94, 141, 249, 189, 119, 238, 0, 0, 112,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 87, 88, 95, 101, 107, 111, 115, 118,
-	123, 126, 129, 131, 132, 133, 136, 146, 149, 150,
-	151, 152, 162, 163, 164, 166, 169, 170, 171, 172,
-	173, 176, 178, 179, 180, 181, 182, 183, 190, 193,
-	199, 200, 201, 202, 203, 204, 205, 207, 208, 209,
-	210, 216, 219, 225, 226, 235, 242, 245, 168, 0,
-	0, 0, 0, 327, 0, 0, 0, 114, 0, 324,
-	0, 0, 0, 140, 0, 367, 142, 0, 0, 214,
-	156, 0, 0, 0, 0, 358, 359, 0, 0, 0,
-	0, 0, 0, 0, 0, 54, 0, 0, 82, 83,
-	84, 346, 345, 348, 349, 350, 351, 0, 0, 104,
-	347, 352, 353, 354, 0, 0, 0, 322, 339, 0,
-	366, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	336, 337, 318, 0, 0, 0, 381, 0, 338, 0,
-	0, 333, 334, 335, 340, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 122, 380, 0, 0, 270, 0,
-	0, 378, 0, 187, 0, 218, 125, 139, 100, 86,
-	96, 0, 124, 165, 194, 198, 0, 0, 0, 108,
-	0, 196, 175, 234, 0, 177, 195, 143, 224, 188,
-	233, 243, 244, 221, 241, 248, 211, 89, 220, 232,
-	105, 206, 91, 230, 217, 154, 134, 135, 90, 0,
-	192, 113, 120, 110, 167, 227, 228, 109, 251, 97,
-	240, 93, 98, 239, 161, 223, 231, 155, 148, 92,
-	229, 153, 147, 138, 117, 127, 185, 145, 186, 128,
-	158, 157, 159, 0, 0, 0, 215, 237, 252, 102,
-	0, 222, 246, 247, 0, 0, 103, 121, 116, 184,
-	160, 99, 130, 212, 137, 144, 191, 250, 174, 197,
-	106, 236, 213, 368, 379, 374, 375, 372, 373, 371,
-	370, 369, 382, 360, 361, 362, 363, 365, 0, 376,
-	377, 364, 85, 94, 141, 249, 189, 119, 238, 0,
-	0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 87, 88, 95, 101, 107, 111,
-	115, 118, 123, 126, 129, 131, 132, 133, 136, 146,
-	149, 150, 151, 152, 162, 163, 164, 166, 169, 170,
-	171, 172, 173, 176, 178, 179, 180, 181, 182, 183,
-	190, 193, 199, 200, 201, 202, 203, 204, 205, 207,
-	208, 209, 210, 216, 219, 225, 226, 235, 242, 245,
-	168, 0, 0, 0, 0, 327, 0, 0, 0, 114,
-	0, 324, 0, 0, 0, 140, 0, 367, 142, 0,
-	0, 214, 156, 0, 0, 0, 0, 358, 359, 0,
-	0, 0, 0, 0, 0, 0, 0, 54, 0, 0,
-	82, 83, 84, 346, 908, 348, 349, 350, 351, 0,
-	0, 104, 347, 352, 353, 354, 0, 0, 0, 322,
-	339, 0, 366, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 336, 337, 318, 0, 0, 0, 381, 0,
-	338, 0, 0, 333, 334, 335, 340, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 122, 380, 0, 0,
-	270, 0, 0, 378, 0, 187, 0, 218, 125, 139,
-	100, 86, 96, 0, 124, 165, 194, 198, 0, 0,
-	0, 108, 0, 196, 175, 234, 0, 177, 195, 143,
-	224, 188, 233, 243, 244, 221, 241, 248, 211, 89,
-	220, 232, 105, 206, 91, 230, 217, 154, 134, 135,
-	90, 0, 192, 113, 120, 110, 167, 227, 228, 109,
-	251, 97, 240, 93, 98, 239, 161, 223, 231, 155,
-	148, 92, 229, 153, 147, 138, 117, 127, 185, 145,
-	186, 128, 158, 157, 159, 0, 0, 0, 215, 237,
-	252, 102, 0, 222, 246, 247, 0, 0, 103, 121,
-	116, 184, 160, 99, 130, 212, 137, 144, 191, 250,
-	174, 197, 106, 236, 213, 368, 379, 374, 375, 372,
-	373, 371, 370, 369, 382, 360, 361, 362, 363, 365,
-	0, 376, 377, 364, 85, 94, 141, 249, 189, 119,
-	238, 0, 0, 112, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 87, 88, 95, 101,
-	107, 111, 115, 118, 123, 126, 129, 131, 132, 133,
-	136, 146, 149, 150, 151, 152, 162, 163, 164, 166,
-	169, 170, 171, 172, 173, 176, 178, 179, 180, 181,
-	182, 183, 190, 193, 199, 200, 201, 202, 203, 204,
-	205, 207, 208, 209, 210, 216, 219, 225, 226, 235,
-	242, 245, 168, 0, 0, 0, 0, 327, 0, 0,
-	0, 114, 0, 324, 0, 0, 0, 140, 0, 367,
-	142, 0, 0, 214, 156, 0, 0, 0, 0, 358,
-	359, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-	0, 0, 82, 83, 84, 346, 905, 348, 349, 350,
-	351, 0, 0, 104, 347, 352, 353, 354, 0, 0,
-	0, 322, 339, 0, 366, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 336, 337, 318, 0, 0, 0,
-	381, 0, 338, 0, 0, 333, 334, 335, 340, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 122, 380,
-	0, 0, 270, 0, 0, 378, 0, 187, 0, 218,
-	125, 139, 100, 86, 96, 0, 124, 165, 194, 198,
-	0, 0, 0, 108, 0, 196, 175, 234, 0, 177,
-	195, 143, 224, 188, 233, 243, 244, 221, 241, 248,
-	211, 89, 220, 232, 105, 206, 91, 230, 217, 154,
-	134, 135, 90, 0, 192, 113, 120, 110, 167, 227,
-	228, 109, 251, 97, 240, 93, 98, 239, 161, 223,
-	231, 155, 148, 92, 229, 153, 147, 138, 117, 127,
-	185, 145, 186, 128, 158, 157, 159, 0, 0, 0,
-	215, 237, 252, 102, 0, 222, 246, 247, 0, 0,
-	103, 121, 116, 184, 160, 99, 130, 212, 137, 144,
-	191, 250, 174, 197, 106, 236, 213, 368, 379, 374,
-	375, 372, 373, 371, 370, 369, 382, 360, 361, 362,
-	363, 365, 0, 376, 377, 364, 85, 94, 141, 249,
-	189, 119, 238, 0, 0, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 88,
-	95, 101, 107, 111, 115, 118, 123, 126, 129, 131,
-	132, 133, 136, 146, 149, 150, 151, 152, 162, 163,
-	164, 166, 169, 170, 171, 172, 173, 176, 178, 179,
-	180, 181, 182, 183, 190, 193, 199, 200, 201, 202,
-	203, 204, 205, 207, 208, 209, 210, 216, 219, 225,
-	226, 235, 242, 245, 24, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 168, 0, 0, 0,
-	0, 327, 0, 0, 0, 114, 0, 324, 0, 0,
-	0, 140, 0, 367, 142, 0, 0, 214, 156, 0,
-	0, 0, 0, 358, 359, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 0, 82, 83, 84, 346,
-	345, 348, 349, 350, 351, 0, 0, 104, 347, 352,
-	353, 354, 0, 0, 0, 322, 339, 0, 366, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 336, 337,
-	0, 0, 0, 0, 381, 0, 338, 0, 0, 333,
-	334, 335, 340, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 122, 380, 0, 0, 270, 0, 0, 378,
-	0, 187, 0, 218, 125, 139, 100, 86, 96, 0,
-	124, 165, 194, 198, 0, 0, 0, 108, 0, 196,
-	175, 234, 0, 177, 195, 143, 224, 188, 233, 243,
-	244, 221, 241, 248, 211, 89, 220, 232, 105, 206,
-	91, 230, 217, 154, 134, 135, 90, 0, 192, 113,
-	120, 110, 167, 227, 228, 109, 251, 97, 240, 93,
-	98, 239, 161, 223, 231, 155, 148, 92, 229, 153,
-	147, 138, 117, 127, 185, 145, 186, 128, 158, 157,
-	159, 0, 0, 0, 215, 237, 252, 102, 0, 222,
-	246, 247, 0, 0, 103, 121, 116, 184, 160, 99,
-	130, 212, 137, 144, 191, 250, 174, 197, 106, 236,
-	213, 368, 379, 374, 375, 372, 373, 371, 370, 369,
-	382, 360, 361, 362, 363, 365, 0, 376, 377, 364,
-	85, 94, 141, 249, 189, 119, 238, 0, 0, 112,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 87, 88, 95, 101, 107, 111, 115, 118,
-	123, 126, 129, 131, 132, 133, 136, 146, 149, 150,
-	151, 152, 162, 163, 164, 166, 169, 170, 171, 172,
-	173, 176, 178, 179, 180, 181, 182, 183, 190, 193,
-	199, 200, 201, 202, 203, 204, 205, 207, 208, 209,
-	210, 216, 219, 225, 226, 235, 242, 245, 168, 0,
-	0, 0, 0, 327, 0, 0, 0, 114, 0, 324,
-	0, 0, 0, 140, 0, 367, 142, 0, 0, 214,
-	156, 0, 0, 0, 0, 358, 359, 0, 0, 0,
-	0, 0, 0, 0, 0, 54, 0, 0, 82, 83,
-	84, 346, 345, 348, 349, 350, 351, 0, 0, 104,
-	347, 352, 353, 354, 0, 0, 0, 322, 339, 0,
-	366, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	336, 337, 0, 0, 0, 0, 381, 0, 338, 0,
-	0, 333, 334, 335, 340, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 122, 380, 0, 0, 270, 0,
-	0, 378, 0, 187, 0, 218, 125, 139, 100, 86,
-	96, 0, 124, 165, 194, 198, 0, 0, 0, 108,
-	0, 196, 175, 234, 0, 177, 195, 143, 224, 188,
-	233, 243, 244, 221, 241, 248, 211, 89, 220, 232,
-	105, 206, 91, 230, 217, 154, 134, 135, 90, 0,
-	192, 113, 120, 110, 167, 227, 228, 109, 251, 97,
-	240, 93, 98, 239, 161, 223, 231, 155, 148, 92,
-	229, 153, 147, 138, 117, 127, 185, 145, 186, 128,
-	158, 157, 159, 0, 0, 0, 215, 237, 252, 102,
-	0, 222, 246, 247, 0, 0, 103, 121, 116, 184,
-	160, 99, 130, 212, 137, 144, 191, 250, 174, 197,
-	106, 236, 213, 368, 379, 374, 375, 372, 373, 371,
-	370, 369, 382, 360, 361, 362, 363, 365, 0, 376,
-	377, 364, 85, 94, 141, 249, 189, 119, 238, 0,
-	0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 87, 88, 95, 101, 107, 111,
-	115, 118, 123, 126, 129, 131, 132, 133, 136, 146,
-	149, 150, 151, 152, 162, 163, 164, 166, 169, 170,
-	171, 172, 173, 176, 178, 179, 180, 181, 182, 183,
-	190, 193, 199, 200, 201, 202, 203, 204, 205, 207,
-	208, 209, 210, 216, 219, 225, 226, 235, 242, 245,
-	168, 0, 0, 0, 0, 0, 0, 0, 0, 114,
-	0, 0, 0, 0, 0, 140, 0, 367, 142, 0,
-	0, 214, 156, 0, 0, 0, 0, 358, 359, 0,
-	0, 0, 0, 0, 0, 0, 0, 54, 0, 0,
-	82, 83, 84, 346, 345, 348, 349, 350, 351, 0,
-	0, 104, 347, 352, 353, 354, 0, 0, 0, 0,
-	339, 0, 366, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 336, 337, 0, 0, 0, 0, 381, 0,
-	338, 0, 0, 333, 334, 335, 340, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 122, 380, 0, 0,
-	270, 0, 0, 378, 0, 187, 0, 218, 125, 139,
-	100, 86, 96, 0, 124, 165, 194, 198, 0, 0,
-	0, 108, 0, 196, 175, 234, 1536, 177, 195, 143,
-	224, 188, 233, 243, 244, 221, 241, 248, 211, 89,
-	220, 232, 105, 206, 91, 230, 217, 154, 134, 135,
-	90, 0, 192, 113, 120, 110, 167, 227, 228, 109,
-	251, 97, 240, 93, 98, 239, 161, 223, 231, 155,
-	148, 92, 229, 153, 147, 138, 117, 127, 185, 145,
-	186, 128, 158, 157, 159, 0, 0, 0, 215, 237,
-	252, 102, 0, 222, 246, 247, 0, 0, 103, 121,
-	116, 184, 160, 99, 130, 212, 137, 144, 191, 250,
-	174, 197, 106, 236, 213, 368, 379, 374, 375, 372,
-	373, 371, 370, 369, 382, 360, 361, 362, 363, 365,
-	0, 376, 377, 364, 85, 94, 141, 249, 189, 119,
-	238, 0, 0, 112, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 87, 88, 95, 101,
-	107, 111, 115, 118, 123, 126, 129, 131, 132, 133,
-	136, 146, 149, 150, 151, 152, 162, 163, 164, 166,
-	169, 170, 171, 172, 173, 176, 178, 179, 180, 181,
-	182, 183, 190, 193, 199, 200, 201, 202, 203, 204,
-	205, 207, 208, 209, 210, 216, 219, 225, 226, 235,
-	242, 245, 168, 0, 0, 0, 0, 0, 0, 0,
-	0, 114, 0, 0, 0, 0, 0, 140, 0, 367,
-	142, 0, 0, 214, 156, 0, 0, 0, 0, 358,
-	359, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-	0, 587, 82, 83, 84, 346, 345, 348, 349, 350,
-	351, 0, 0, 104, 347, 352, 353, 354, 0, 0,
-	0, 0, 339, 0, 366, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 336, 337, 0, 0, 0, 0,
-	381, 0, 338, 0, 0, 333, 334, 335, 340, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 122, 380,
-	0, 0, 270, 0, 0, 378, 0, 187, 0, 218,
-	125, 139, 100, 86, 96, 0, 124, 165, 194, 198,
-	0, 0, 0, 108, 0, 196, 175, 234, 0, 177,
-	195, 143, 224, 188, 233, 243, 244, 221, 241, 248,
-	211, 89, 220, 232, 105, 206, 91, 230, 217, 154,
-	134, 135, 90, 0, 192, 113, 120, 110, 167, 227,
-	228, 109, 251, 97, 240, 93, 98, 239, 161, 223,
-	231, 155, 148, 92, 229, 153, 147, 138, 117, 127,
-	185, 145, 186, 128, 158, 157, 159, 0, 0, 0,
-	215, 237, 252, 102, 0, 222, 246, 247, 0, 0,
-	103, 121, 116, 184, 160, 99, 130, 212, 137, 144,
-	191, 250, 174, 197, 106, 236, 213, 368, 379, 374,
-	375, 372, 373, 371, 370, 369, 382, 360, 361, 362,
-	363, 365, 0, 376, 377, 364, 85, 94, 141, 249,
-	189, 119, 238, 0, 0, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 88,
-	95, 101, 107, 111, 115, 118, 123, 126, 129, 131,
-	132, 133, 136, 146, 149, 150, 151, 152, 162, 163,
-	164, 166, 169, 170, 171, 172, 173, 176, 178, 179,
-	180, 181, 182, 183, 190, 193, 199, 200, 201, 202,
-	203, 204, 205, 207, 208, 209, 210, 216, 219, 225,
-	226, 235, 242, 245, 168, 0, 0, 0, 0, 0,
-	0, 0, 0, 114, 0, 0, 0, 0, 0, 140,
-	0, 367, 142, 0, 0, 214, 156, 0, 0, 0,
-	0, 358, 359, 0, 0, 0, 0, 0, 0, 0,
-	0, 54, 0, 0, 82, 83, 84, 346, 345, 348,
-	349, 350, 351, 0, 0, 104, 347, 352, 353, 354,
-	0, 0, 0, 0, 339, 0, 366, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 336, 337, 0, 0,
-	0, 0, 381, 0, 338, 0, 0, 333, 334, 335,
-	340, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	122, 380, 0, 0, 270, 0, 0, 378, 0, 187,
-	0, 218, 125, 139, 100, 86, 96, 0, 124, 165,
-	194, 198, 0, 0, 0, 108, 0, 196, 175, 234,
-	0, 177, 195, 143, 224, 188, 233, 243, 244, 221,
-	241, 248, 211, 89, 220, 232, 105, 206, 91, 230,
-	217, 154, 134, 135, 90, 0, 192, 113, 120, 110,
-	167, 227, 228, 109, 251, 97, 240, 93, 98, 239,
-	161, 223, 231, 155, 148, 92, 229, 153, 147, 138,
-	117, 127, 185, 145, 186, 128, 158, 157, 159, 0,
-	0, 0, 215, 237, 252, 102, 0, 222, 246, 247,
-	0, 0, 103, 121, 116, 184, 160, 99, 130, 212,
-	137, 144, 191, 250, 174, 197, 106, 236, 213, 368,
-	379, 374, 375, 372, 373, 371, 370, 369, 382, 360,
-	361, 362, 363, 365, 0, 376, 377, 364, 85, 94,
-	141, 249, 189, 119, 238, 0, 0, 112, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	87, 88, 95, 101, 107, 111, 115, 118, 123, 126,
-	129, 131, 132, 133, 136, 146, 149, 150, 151, 152,
-	162, 163, 164, 166, 169, 170, 171, 172, 173, 176,
-	178, 179, 180, 181, 182, 183, 190, 193, 199, 200,
-	201, 202, 203, 204, 205, 207, 208, 209, 210, 216,
-	219, 225, 226, 235, 242, 245, 168, 0, 0, 0,
-	0, 0, 0, 0, 0, 114, 0, 0, 0, 0,
-	0, 140, 0, 0, 142, 0, 0, 214, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 83, 84, 0,
-	0, 0, 0, 0, 0, 0, 0, 104, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 622, 621, 631, 632, 624, 625,
-	626, 627, 628, 629, 630, 623, 0, 0, 633, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 122, 0, 0, 0, 270, 0, 0, 0,
-	0, 187, 0, 218, 125, 139, 100, 86, 96, 0,
-	124, 165, 194, 198, 0, 0, 0, 108, 0, 196,
-	175, 234, 0, 177, 195, 143, 224, 188, 233, 243,
-	244, 221, 241, 248, 211, 89, 220, 232, 105, 206,
-	91, 230, 217, 154, 134, 135, 90, 0, 192, 113,
-	120, 110, 167, 227, 228, 109, 251, 97, 240, 93,
-	98, 239, 161, 223, 231, 155, 148, 92, 229, 153,
-	147, 138, 117, 127, 185, 145, 186, 128, 158, 157,
-	159, 0, 0, 0, 215, 237, 252, 102, 0, 222,
-	246, 247, 0, 0, 103, 121, 116, 184, 160, 99,
-	130, 212, 137, 144, 191, 250, 174, 197, 106, 236,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 94, 141, 249, 189, 119, 238, 0, 0, 112,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 87, 88, 95, 101, 107, 111, 115, 118,
-	123, 126, 129, 131, 132, 133, 136, 146, 149, 150,
-	151, 152, 162, 163, 164, 166, 169, 170, 171, 172,
-	173, 176, 178, 179, 180, 181, 182, 183, 190, 193,
-	199, 200, 201, 202, 203, 204, 205, 207, 208, 209,
-	210, 216, 219, 225, 226, 235, 242, 245, 168, 0,
-	0, 0, 610, 0, 0, 0, 0, 114, 0, 0,
-	0, 0, 0, 140, 0, 0, 142, 0, 0, 214,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	84, 0, 612, 0, 0, 0, 0, 0, 0, 104,
-	0, 0, 0, 0, 0, 607, 606, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 608, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 122, 0, 0, 0, 270, 0,
-	0, 0, 0, 187, 0, 218, 125, 139, 100, 86,
-	96, 0, 124, 165, 194, 198, 0, 0, 0, 108,
-	0, 196, 175, 234, 0, 177, 195, 143, 224, 188,
-	233, 243, 244, 221, 241, 248, 211, 89, 220, 232,
-	105, 206, 91, 230, 217, 154, 134, 135, 90, 0,
-	192, 113, 120, 110, 167, 227, 228, 109, 251, 97,
-	240, 93, 98, 239, 161, 223, 231, 155, 148, 92,
-	229, 153, 147, 138, 117, 127, 185, 145, 186, 128,
-	158, 157, 159, 0, 0, 0, 215, 237, 252, 102,
-	0, 222, 246, 247, 0, 0, 103, 121, 116, 184,
-	160, 99, 130, 212, 137, 144, 191, 250, 174, 197,
-	106, 236, 213, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 94, 141, 249, 189, 119, 238, 0,
-	0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 87, 88, 95, 101, 107, 111,
-	115, 118, 123, 126, 129, 131, 132, 133, 136, 146,
-	149, 150, 151, 152, 162, 163, 164, 166, 169, 170,
-	171, 172, 173, 176, 178, 179, 180, 181, 182, 183,
-	190, 193, 199, 200, 201, 202, 203, 204, 205, 207,
-	208, 209, 210, 216, 219, 225, 226, 235, 242, 245,
-	168, 0, 0, 0, 0, 0, 0, 0, 0, 114,
-	0, 0, 0, 0, 0, 140, 0, 0, 142, 0,
-	0, 214, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 84, 0, 0, 0, 0, 0, 0, 0,
-	0, 104, 0, 0, 0, 0, 0, 74, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 122, 76, 77, 0,
-	73, 0, 0, 0, 78, 187, 0, 218, 125, 139,
-	100, 86, 96, 0, 124, 165, 194, 198, 0, 0,
-	0, 108, 0, 196, 175, 234, 0, 177, 195, 143,
-	224, 188, 233, 243, 244, 221, 241, 248, 211, 89,
-	220, 232, 105, 206, 91, 230, 217, 154, 134, 135,
-	90, 0, 192, 113, 120, 110, 167, 227, 228, 109,
-	251, 97, 240, 93, 98, 239, 161, 223, 231, 155,
-	148, 92, 229, 153, 147, 138, 117, 127, 185, 145,
-	186, 128, 158, 157, 159, 0, 0, 0, 215, 237,
-	252, 102, 0, 222, 246, 247, 0, 0, 103, 121,
-	116, 184, 160, 99, 130, 212, 137, 144, 191, 250,
-	174, 197, 106, 236, 213, 0, 75, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 94, 141, 249, 189, 119,
-	238, 0, 0, 112, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 87, 88, 95, 101,
-	107, 111, 115, 118, 123, 126, 129, 131, 132, 133,
-	136, 146, 149, 150, 151, 152, 162, 163, 164, 166,
-	169, 170, 171, 172, 173, 176, 178, 179, 180, 181,
-	182, 183, 190, 193, 199, 200, 201, 202, 203, 204,
-	205, 207, 208, 209, 210, 216, 219, 225, 226, 235,
-	242, 245, 168, 0, 0, 0, 950, 0, 0, 0,
-	0, 114, 0, 0, 0, 0, 0, 140, 0, 0,
-	142, 0, 0, 214, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 83, 84, 0, 952, 0, 0, 0,
-	0, 0, 0, 104, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 122, 0,
-	0, 0, 270, 0, 0, 0, 0, 187, 0, 218,
-	125, 139, 100, 86, 96, 0, 124, 165, 194, 198,
-	0, 0, 0, 108, 0, 196, 175, 234, 0, 177,
-	195, 143, 224, 188, 233, 243, 244, 221, 241, 248,
-	211, 89, 220, 232, 105, 206, 91, 230, 217, 154,
-	134, 135, 90, 0, 192, 113, 120, 110, 167, 227,
-	228, 109, 251, 97, 240, 93, 98, 239, 161, 223,
-	231, 155, 148, 92, 229, 153, 147, 138, 117, 127,
-	185, 145, 186, 128, 158, 157, 159, 0, 0, 0,
-	215, 237, 252, 102, 0, 222, 246, 247, 0, 0,
-	103, 121, 116, 184, 160, 99, 130, 212, 137, 144,
-	191, 250, 174, 197, 106, 236, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 94, 141, 249,
-	189, 119, 238, 0, 0, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 88,
-	95, 101, 107, 111, 115, 118, 123, 126, 129, 131,
-	132, 133, 136, 146, 149, 150, 151, 152, 162, 163,
-	164, 166, 169, 170, 171, 172, 173, 176, 178, 179,
-	180, 181, 182, 183, 190, 193, 199, 200, 201, 202,
-	203, 204, 205, 207, 208, 209, 210, 216, 219, 225,
-	226, 235, 242, 245, 24, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 168, 0, 0, 0,
-	0, 0, 0, 0, 0, 114, 0, 0, 0, 0,
-	0, 140, 0, 0, 142, 0, 0, 214, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 0, 82, 83, 84, 0,
-	0, 0, 0, 0, 0, 0, 0, 104, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 122, 0, 0, 0, 270, 0, 0, 0,
-	0, 187, 0, 218, 125, 139, 100, 86, 96, 0,
-	124, 165, 194, 198, 0, 0, 0, 108, 0, 196,
-	175, 234, 0, 177, 195, 143, 224, 188, 233, 243,
-	244, 221, 241, 248, 211, 89, 220, 232, 105, 206,
-	91, 230, 217, 154, 134, 135, 90, 0, 192, 113,
-	120, 110, 167, 227, 228, 109, 251, 97, 240, 93,
-	98, 239, 161, 223, 231, 155, 148, 92, 229, 153,
-	147, 138, 117, 127, 185, 145, 186, 128, 158, 157,
-	159, 0, 0, 0, 215, 237, 252, 102, 0, 222,
-	246, 247, 0, 0, 103, 121, 116, 184, 160, 99,
-	130, 212, 137, 144, 191, 250, 174, 197, 106, 236,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 94, 141, 249, 189, 119, 238, 0, 0, 112,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 87, 88, 95, 101, 107, 111, 115, 118,
-	123, 126, 129, 131, 132, 133, 136, 146, 149, 150,
-	151, 152, 162, 163, 164, 166, 169, 170, 171, 172,
-	173, 176, 178, 179, 180, 181, 182, 183, 190, 193,
-	199, 200, 201, 202, 203, 204, 205, 207, 208, 209,
-	210, 216, 219, 225, 226, 235, 242, 245, 168, 0,
-	0, 0, 950, 0, 0, 0, 0, 114, 0, 0,
-	0, 0, 0, 140, 0, 0, 142, 0, 0, 214,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	84, 0, 952, 0, 0, 0, 0, 0, 0, 104,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 122, 0, 0, 0, 270, 0,
-	0, 0, 0, 187, 0, 218, 125, 139, 100, 86,
-	96, 0, 124, 165, 194, 198, 0, 0, 0, 108,
-	0, 196, 175, 234, 0, 948, 195, 143, 224, 188,
-	233, 243, 244, 221, 241, 248, 211, 89, 220, 232,
-	105, 206, 91, 230, 217, 154, 134, 135, 90, 0,
-	192, 113, 120, 110, 167, 227, 228, 109, 251, 97,
-	240, 93, 98, 239, 161, 223, 231, 155, 148, 92,
-	229, 153, 147, 138, 117, 127, 185, 145, 186, 128,
-	158, 157, 159, 0, 0, 0, 215, 237, 252, 102,
-	0, 222, 246, 247, 0, 0, 103, 121, 116, 184,
-	160, 99, 130, 212, 137, 144, 191, 250, 174, 197,
-	106, 236, 213, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 94, 141, 249, 189, 119, 238, 0,
-	0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 87, 88, 95, 101, 107, 111,
-	115, 118, 123, 126, 129, 131, 132, 133, 136, 146,
-	149, 150, 151, 152, 162, 163, 164, 166, 169, 170,
-	171, 172, 173, 176, 178, 179, 180, 181, 182, 183,
-	190, 193, 199, 200, 201, 202, 203, 204, 205, 207,
-	208, 209, 210, 216, 219, 225, 226, 235, 242, 245,
-	168, 0, 0, 0, 0, 0, 0, 0, 0, 114,
-	0, 0, 0, 0, 0, 140, 0, 0, 142, 0,
-	0, 214, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 84, 0, 0, 843, 0, 0, 844, 0,
-	0, 104, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 122, 0, 0, 0,
-	270, 0, 0, 0, 0, 187, 0, 218, 125, 139,
-	100, 86, 96, 0, 124, 165, 194, 198, 0, 0,
-	0, 108, 0, 196, 175, 234, 0, 177, 195, 143,
-	224, 188, 233, 243, 244, 221, 241, 248, 211, 89,
-	220, 232, 105, 206, 91, 230, 217, 154, 134, 135,
-	90, 0, 192, 113, 120, 110, 167, 227, 228, 109,
-	251, 97, 240, 93, 98, 239, 161, 223, 231, 155,
-	148, 92, 229, 153, 147, 138, 117, 127, 185, 145,
-	186, 128, 158, 157, 159, 0, 0, 0, 215, 237,
-	252, 102, 0, 222, 246, 247, 0, 0, 103, 121,
-	116, 184, 160, 99, 130, 212, 137, 144, 191, 250,
-	174, 197, 106, 236, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 94, 141, 249, 189, 119,
-	238, 0, 0, 112, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 87, 88, 95, 101,
-	107, 111, 115, 118, 123, 126, 129, 131, 132, 133,
-	136, 146, 149, 150, 151, 152, 162, 163, 164, 166,
-	169, 170, 171, 172, 173, 176, 178, 179, 180, 181,
-	182, 183, 190, 193, 199, 200, 201, 202, 203, 204,
-	205, 207, 208, 209, 210, 216, 219, 225, 226, 235,
-	242, 245, 168, 0, 0, 0, 0, 0, 0, 0,
-	0, 114, 0, 725, 0, 0, 0, 140, 0, 0,
-	142, 0, 0, 214, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 83, 84, 0, 724, 0, 0, 0,
-	0, 0, 0, 104, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 122, 0,
-	0, 0, 270, 0, 0, 0, 0, 187, 0, 218,
-	125, 139, 100, 86, 96, 0, 124, 165, 194, 198,
-	0, 0, 0, 108, 0, 196, 175, 234, 0, 177,
-	195, 143, 224, 188, 233, 243, 244, 221, 241, 248,
-	211, 89, 220, 232, 105, 206, 91, 230, 217, 154,
-	134, 135, 90, 0, 192, 113, 120, 110, 167, 227,
-	228, 109, 251, 97, 240, 93, 98, 239, 161, 223,
-	231, 155, 148, 92, 229, 153, 147, 138, 117, 127,
-	185, 145, 186, 128, 158, 157, 159, 0, 0, 0,
-	215, 237, 252, 102, 0, 222, 246, 247, 0, 0,
-	103, 121, 116, 184, 160, 99, 130, 212, 137, 144,
-	191, 250, 174, 197, 106, 236, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 94, 141, 249,
-	189, 119, 238, 0, 0, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 88,
-	95, 101, 107, 111, 115, 118, 123, 126, 129, 131,
-	132, 133, 136, 146, 149, 150, 151, 152, 162, 163,
-	164, 166, 169, 170, 171, 172, 173, 176, 178, 179,
-	180, 181, 182, 183, 190, 193, 199, 200, 201, 202,
-	203, 204, 205, 207, 208, 209, 210, 216, 219, 225,
-	226, 235, 242, 245, 168, 0, 0, 0, 0, 0,
-	0, 0, 0, 114, 0, 0, 0, 0, 0, 140,
-	0, 0, 142, 0, 0, 214, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 587, 82, 83, 84, 0, 0, 0,
-	0, 0, 0, 0, 0, 104, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	122, 0, 0, 0, 270, 0, 0, 0, 0, 187,
-	0, 218, 125, 139, 100, 86, 96, 0, 124, 165,
-	194, 198, 0, 0, 0, 108, 0, 196, 175, 234,
-	0, 177, 195, 143, 224, 188, 233, 243, 244, 221,
-	241, 248, 211, 89, 220, 232, 105, 206, 91, 230,
-	217, 154, 134, 135, 90, 0, 192, 113, 120, 110,
-	167, 227, 228, 109, 251, 97, 240, 93, 98, 239,
-	161, 223, 231, 155, 148, 92, 229, 153, 147, 138,
-	117, 127, 185, 145, 186, 128, 158, 157, 159, 0,
-	0, 0, 215, 237, 252, 102, 0, 222, 246, 247,
-	0, 0, 103, 121, 116, 184, 160, 99, 130, 212,
-	137, 144, 191, 250, 174, 197, 106, 236, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 94,
-	141, 249, 189, 119, 238, 0, 0, 112, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	87, 88, 95, 101, 107, 111, 115, 118, 123, 126,
-	129, 131, 132, 133, 136, 146, 149, 150, 151, 152,
-	162, 163, 164, 166, 169, 170, 171, 172, 173, 176,
-	178, 179, 180, 181, 182, 183, 190, 193, 199, 200,
-	201, 202, 203, 204, 205, 207, 208, 209, 210, 216,
-	219, 225, 226, 235, 242, 245, 168, 0, 0, 0,
-	0, 0, 0, 0, 0, 114, 0, 0, 0, 0,
-	0, 140, 0, 0, 142, 0, 0, 214, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 0, 82, 83, 84, 0,
-	0, 0, 0, 0, 0, 0, 0, 104, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 122, 0, 0, 0, 270, 0, 0, 0,
-	0, 187, 0, 218, 125, 139, 100, 86, 96, 0,
-	124, 165, 194, 198, 0, 0, 0, 108, 0, 196,
-	175, 234, 0, 177, 195, 143, 224, 188, 233, 243,
-	244, 221, 241, 248, 211, 89, 220, 232, 105, 206,
-	91, 230, 217, 154, 134, 135, 90, 0, 192, 113,
-	120, 110, 167, 227, 228, 109, 251, 97, 240, 93,
-	98, 239, 161, 223, 231, 155, 148, 92, 229, 153,
-	147, 138, 117, 127, 185, 145, 186, 128, 158, 157,
-	159, 0, 0, 0, 215, 237, 252, 102, 0, 222,
-	246, 247, 0, 0, 103, 121, 116, 184, 160, 99,
-	130, 212, 137, 144, 191, 250, 174, 197, 106, 236,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 94, 141, 249, 189, 119, 238, 0, 0, 112,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 87, 88, 95, 101, 107, 111, 115, 118,
-	123, 126, 129, 131, 132, 133, 136, 146, 149, 150,
-	151, 152, 162, 163, 164, 166, 169, 170, 171, 172,
-	173, 176, 178, 179, 180, 181, 182, 183, 190, 193,
-	199, 200, 201, 202, 203, 204, 205, 207, 208, 209,
-	210, 216, 219, 225, 226, 235, 242, 245, 168, 0,
-	0, 0, 0, 0, 0, 0, 0, 114, 0, 0,
-	0, 0, 0, 140, 0, 0, 142, 0, 0, 214,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	84, 0, 952, 0, 0, 0, 0, 0, 0, 104,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 122, 0, 0, 0, 270, 0,
-	0, 0, 0, 187, 0, 218, 125, 139, 100, 86,
-	96, 0, 124, 165, 194, 198, 0, 0, 0, 108,
-	0, 196, 175, 234, 0, 177, 195, 143, 224, 188,
-	233, 243, 244, 221, 241, 248, 211, 89, 220, 232,
-	105, 206, 91, 230, 217, 154, 134, 135, 90, 0,
-	192, 113, 120, 110, 167, 227, 228, 109, 251, 97,
-	240, 93, 98, 239, 161, 223, 231, 155, 148, 92,
-	229, 153, 147, 138, 117, 127, 185, 145, 186, 128,
-	158, 157, 159, 0, 0, 0, 215, 237, 252, 102,
-	0, 222, 246, 247, 0, 0, 103, 121, 116, 184,
-	160, 99, 130, 212, 137, 144, 191, 250, 174, 197,
-	106, 236, 213, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 94, 141, 249, 189, 119, 238, 0,
-	0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 87, 88, 95, 101, 107, 111,
-	115, 118, 123, 126, 129, 131, 132, 133, 136, 146,
-	149, 150, 151, 152, 162, 163, 164, 166, 169, 170,
-	171, 172, 173, 176, 178, 179, 180, 181, 182, 183,
-	190, 193, 199, 200, 201, 202, 203, 204, 205, 207,
-	208, 209, 210, 216, 219, 225, 226, 235, 242, 245,
-	168, 0, 0, 0, 0, 0, 0, 0, 0, 114,
-	0, 0, 0, 0, 0, 140, 0, 0, 142, 0,
-	0, 214, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 84, 0, 612, 0, 0, 0, 0, 0,
-	0, 104, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 122, 0, 0, 0,
-	270, 0, 0, 0, 0, 187, 0, 218, 125, 139,
-	100, 86, 96, 0, 124, 165, 194, 198, 0, 0,
-	0, 108, 0, 196, 175, 234, 0, 177, 195, 143,
-	224, 188, 233, 243, 244, 221, 241, 248, 211, 89,
-	220, 232, 105, 206, 91, 230, 217, 154, 134, 135,
-	90, 0, 192, 113, 120, 110, 167, 227, 228, 109,
-	251, 97, 240, 93, 98, 239, 161, 223, 231, 155,
-	148, 92, 229, 153, 147, 138, 117, 127, 185, 145,
-	186, 128, 158, 157, 159, 0, 0, 0, 215, 237,
-	252, 102, 0, 222, 246, 247, 0, 0, 103, 121,
-	116, 184, 160, 99, 130, 212, 137, 144, 191, 250,
-	174, 197, 106, 236, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 94, 141, 249, 189, 119,
-	238, 0, 0, 112, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 87, 88, 95, 101,
-	107, 111, 115, 118, 123, 126, 129, 131, 132, 133,
-	136, 146, 149, 150, 151, 152, 162, 163, 164, 166,
-	169, 170, 171, 172, 173, 176, 178, 179, 180, 181,
-	182, 183, 190, 193, 199, 200, 201, 202, 203, 204,
-	205, 207, 208, 209, 210, 216, 219, 225, 226, 235,
-	242, 245, 168, 0, 0, 0, 0, 0, 0, 0,
-	695, 114, 0, 0, 0, 0, 0, 140, 0, 0,
-	142, 0, 0, 214, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 83, 84, 0, 0, 0, 0, 0,
-	0, 0, 0, 104, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 122, 0,
-	0, 0, 270, 0, 0, 0, 0, 187, 0, 218,
-	125, 139, 100, 86, 96, 0, 124, 165, 194, 198,
-	0, 0, 0, 108, 0, 196, 175, 234, 0, 177,
-	195, 143, 224, 188, 233, 243, 244, 221, 241, 248,
-	211, 89, 220, 232, 105, 206, 91, 230, 217, 154,
-	134, 135, 90, 0, 192, 113, 120, 110, 167, 227,
-	228, 109, 251, 97, 240, 93, 98, 239, 161, 223,
-	231, 155, 148, 92, 229, 153, 147, 138, 117, 127,
-	185, 145, 186, 128, 158, 157, 159, 0, 0, 0,
-	215, 237, 252, 102, 0, 222, 246, 247, 0, 0,
-	103, 121, 116, 184, 160, 99, 130, 212, 137, 144,
-	191, 250, 174, 197, 106, 236, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 94, 141, 249,
-	189, 119, 238, 0, 0, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 88,
-	95, 101, 107, 111, 115, 118, 123, 126, 129, 131,
-	132, 133, 136, 146, 149, 150, 151, 152, 162, 163,
-	164, 166, 169, 170, 171, 172, 173, 176, 178, 179,
-	180, 181, 182, 183, 190, 193, 199, 200, 201, 202,
-	203, 204, 205, 207, 208, 209, 210, 216, 219, 225,
-	226, 235, 242, 245, 385, 0, 0, 0, 0, 0,
-	0, 168, 0, 0, 0, 0, 0, 0, 0, 0,
-	114, 0, 0, 0, 0, 0, 140, 0, 0, 142,
-	0, 0, 214, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 83, 84, 0, 0, 0, 0, 0, 0,
-	0, 0, 104, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 122, 0, 0,
-	0, 270, 0, 0, 0, 0, 187, 0, 218, 125,
-	139, 100, 86, 96, 0, 124, 165, 194, 198, 0,
-	0, 0, 108, 0, 196, 175, 234, 0, 177, 195,
-	143, 224, 188, 233, 243, 244, 221, 241, 248, 211,
-	89, 220, 232, 105, 206, 91, 230, 217, 154, 134,
-	135, 90, 0, 192, 113, 120, 110, 167, 227, 228,
-	109, 251, 97, 240, 93, 98, 239, 161, 223, 231,
-	155, 148, 92, 229, 153, 147, 138, 117, 127, 185,
-	145, 186, 128, 158, 157, 159, 0, 0, 0, 215,
-	237, 252, 102, 0, 222, 246, 247, 0, 0, 103,
-	121, 116, 184, 160, 99, 130, 212, 137, 144, 191,
-	250, 174, 197, 106, 236, 213, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 85, 94, 141, 249, 189,
-	119, 238, 0, 0, 112, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 87, 88, 95,
-	101, 107, 111, 115, 118, 123, 126, 129, 131, 132,
-	133, 136, 146, 149, 150, 151, 152, 162, 163, 164,
-	166, 169, 170, 171, 172, 173, 176, 178, 179, 180,
-	181, 182, 183, 190, 193, 199, 200, 201, 202, 203,
-	204, 205, 207, 208, 209, 210, 216, 219, 225, 226,
-	235, 242, 245, 168, 0, 0, 0, 0, 0, 0,
-	0, 0, 114, 0, 0, 0, 0, 0, 140, 0,
-	0, 142, 0, 0, 214, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 83, 84, 0, 0, 0, 0,
-	0, 0, 0, 0, 104, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 122,
-	0, 265, 0, 270, 0, 0, 0, 0, 187, 0,
-	218, 125, 139, 100, 86, 96, 0, 124, 165, 194,
-	198, 0, 0, 0, 108, 0, 196, 175, 234, 0,
-	177, 195, 143, 224, 188, 233, 243, 244, 221, 241,
-	248, 211, 89, 220, 232, 105, 206, 91, 230, 217,
-	154, 134, 135, 90, 0, 192, 113, 120, 110, 167,
-	227, 228, 109, 251, 97, 240, 93, 98, 239, 161,
-	223, 231, 155, 148, 92, 229, 153, 147, 138, 117,
-	127, 185, 145, 186, 128, 158, 157, 159, 0, 0,
-	0, 215, 237, 252, 102, 0, 222, 246, 247, 0,
-	0, 103, 121, 116, 184, 160, 99, 130, 212, 137,
-	144, 191, 250, 174, 197, 106, 236, 213, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 85, 94, 141,
-	249, 189, 119, 238, 0, 0, 112, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 87,
-	88, 95, 101, 107, 111, 115, 118, 123, 126, 129,
-	131, 132, 133, 136, 146, 149, 150, 151, 152, 162,
-	163, 164, 166, 169, 170, 171, 172, 173, 176, 178,
-	179, 180, 181, 182, 183, 190, 193, 199, 200, 201,
-	202, 203, 204, 205, 207, 208, 209, 210, 216, 219,
-	225, 226, 235, 242, 245, 168, 0, 0, 0, 0,
-	0, 0, 0, 0, 114, 0, 0, 0, 0, 0,
-	140, 0, 0, 142, 0, 0, 214, 156, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 83, 84, 0, 0,
-	0, 0, 0, 0, 0, 0, 104, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 122, 0, 0, 0, 270, 0, 0, 0, 0,
-	187, 0, 218, 125, 139, 100, 86, 96, 0, 124,
-	165, 194, 198, 0, 0, 0, 108, 0, 196, 175,
-	234, 0, 177, 195, 143, 224, 188, 233, 243, 244,
-	221, 241, 248, 211, 89, 220, 232, 105, 206, 91,
-	230, 217, 154, 134, 135, 90, 0, 192, 113, 120,
-	110, 167, 227, 228, 109, 251, 97, 240, 93, 98,
-	239, 161, 223, 231, 155, 148, 92, 229, 153, 147,
-	138, 117, 127, 185, 145, 186, 128, 158, 157, 159,
-	0, 0, 0, 215, 237, 252, 102, 0, 222, 246,
-	247, 0, 0, 103, 121, 116, 184, 160, 99, 130,
-	212, 137, 144, 191, 250, 174, 197, 106, 236, 213,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 971, 0, 0, 0, 0, 0, 518, 506,
+	0, 461, 521, 434, 451, 529, 452, 455, 492, 419,
+	474, 172, 449, 275, 438, 414, 445, 415, 436, 463,
+	118, 467, 433, 508, 477, 520, 144, 439, 527, 146,
+	483, 0, 223, 160, 0, 0, 465, 510, 472, 502,
+	460, 493, 424, 482, 522, 450, 490, 523, 0, 0,
+	0, 85, 86, 87, 0, 996, 997, 0, 0, 0,
+	0, 0, 107, 0, 487, 517, 447, 489, 491, 413,
+	484, 0, 417, 420, 528, 513, 442, 443, 1194, 0,
+	0, 0, 0, 0, 937, 464, 473, 499, 458, 0,
+	0, 0, 0, 0, 0, 0, 0, 440, 275, 481,
+	0, 0, 0, 421, 418, 0, 0, 462, 0, 0,
+	0, 423, 0, 441, 500, 0, 411, 126, 505, 512,
+	459, 278, 516, 457, 456, 519, 191, 0, 227, 129,
+	143, 103, 89, 99, 0, 128, 169, 198, 202, 509,
+	437, 446, 112, 444, 200, 179, 243, 480, 181, 199,
+	147, 233, 192, 242, 252, 253, 211, 213, 214, 230,
+	250, 257, 220, 92, 229, 241, 108, 210, 215, 0,
+	94, 239, 226, 158, 138, 139, 93, 0, 196, 117,
+	124, 114, 171, 236, 237, 113, 259, 100, 249, 96,
+	101, 248, 165, 232, 240, 159, 152, 95, 238, 157,
+	151, 142, 121, 131, 189, 149, 190, 132, 162, 161,
+	163, 0, 416, 0, 224, 246, 260, 105, 432, 231,
+	255, 256, 0, 0, 106, 125, 120, 188, 164, 102,
+	134, 221, 141, 148, 195, 258, 178, 201, 109, 245,
+	222, 428, 431, 426, 427, 475, 476, 524, 525, 526,
+	501, 422, 0, 429, 430, 0, 507, 514, 515, 479,
+	88, 97, 145, 530, 193, 123, 494, 531, 504, 496,
+	111, 212, 244, 185, 127, 247, 412, 425, 116, 435,
+	0, 0, 448, 453, 454, 466, 468, 469, 470, 471,
+	478, 485, 486, 488, 495, 497, 498, 503, 511, 90,
+	91, 98, 104, 110, 115, 119, 122, 130, 133, 135,
+	136, 137, 140, 150, 153, 154, 155, 156, 166, 167,
+	168, 170, 173, 174, 175, 176, 177, 180, 182, 183,
+	184, 186, 187, 194, 197, 203, 204, 205, 206, 207,
+	208, 209, 216, 217, 218, 219, 225, 228, 234, 235,
+	251, 254, 518, 506, 0, 461, 521, 434, 451, 529,
+	452, 455, 492, 419, 474, 172, 449, 0, 438, 414,
+	445, 415, 436, 463, 118, 467, 433, 508, 477, 520,
+	144, 439, 527, 146, 483, 0, 223, 160, 0, 0,
+	465, 510, 472, 502, 460, 493, 424, 482, 522, 450,
+	490, 523, 0, 0, 0, 85, 86, 87, 0, 996,
+	997, 0, 0, 0, 0, 0, 107, 0, 487, 517,
+	447, 489, 491, 413, 484, 0, 417, 420, 528, 513,
+	442, 443, 0, 0, 0, 0, 0, 0, 0, 464,
+	473, 499, 458, 0, 0, 0, 0, 0, 0, 0,
+	0, 440, 0, 481, 0, 0, 0, 421, 418, 0,
+	0, 462, 0, 0, 0, 423, 0, 441, 500, 0,
+	411, 126, 505, 512, 459, 278, 516, 457, 456, 519,
+	191, 0, 227, 129, 143, 103, 89, 99, 0, 128,
+	169, 198, 202, 509, 437, 446, 112, 444, 200, 179,
+	243, 480, 181, 199, 147, 233, 192, 242, 252, 253,
+	211, 213, 214, 230, 250, 257, 220, 92, 229, 241,
+	108, 210, 215, 0, 94, 239, 226, 158, 138, 139,
+	93, 0, 196, 117, 124, 114, 171, 236, 237, 113,
+	259, 100, 249, 96, 101, 248, 165, 232, 240, 159,
+	152, 95, 238, 157, 151, 142, 121, 131, 189, 149,
+	190, 132, 162, 161, 163, 0, 416, 0, 224, 246,
+	260, 105, 432, 231, 255, 256, 0, 0, 106, 125,
+	120, 188, 164, 102, 134, 221, 141, 148, 195, 258,
+	178, 201, 109, 245, 222, 428, 431, 426, 427, 475,
+	476, 524, 525, 526, 501, 422, 0, 429, 430, 0,
+	507, 514, 515, 479, 88, 97, 145, 530, 193, 123,
+	494, 531, 504, 496, 111, 212, 244, 185, 127, 247,
+	412, 425, 116, 435, 0, 0, 448, 453, 454, 466,
+	468, 469, 470, 471, 478, 485, 486, 488, 495, 497,
+	498, 503, 511, 90, 91, 98, 104, 110, 115, 119,
+	122, 130, 133, 135, 136, 137, 140, 150, 153, 154,
+	155, 156, 166, 167, 168, 170, 173, 174, 175, 176,
+	177, 180, 182, 183, 184, 186, 187, 194, 197, 203,
+	204, 205, 206, 207, 208, 209, 216, 217, 218, 219,
+	225, 228, 234, 235, 251, 254, 518, 506, 0, 461,
+	521, 434, 451, 529, 452, 455, 492, 419, 474, 172,
+	449, 0, 438, 414, 445, 415, 436, 463, 118, 467,
+	433, 508, 477, 520, 144, 439, 527, 146, 483, 0,
+	223, 160, 0, 0, 465, 510, 472, 502, 460, 493,
+	424, 482, 522, 450, 490, 523, 61, 0, 0, 85,
+	86, 87, 0, 0, 0, 0, 0, 0, 0, 0,
+	107, 0, 487, 517, 447, 489, 491, 413, 484, 0,
+	417, 420, 528, 513, 442, 443, 0, 0, 0, 0,
+	0, 0, 0, 464, 473, 499, 458, 0, 0, 0,
+	0, 0, 0, 0, 0, 440, 0, 481, 0, 0,
+	0, 421, 418, 0, 0, 462, 0, 0, 0, 423,
+	0, 441, 500, 0, 411, 126, 505, 512, 459, 278,
+	516, 457, 456, 519, 191, 0, 227, 129, 143, 103,
+	89, 99, 0, 128, 169, 198, 202, 509, 437, 446,
+	112, 444, 200, 179, 243, 480, 181, 199, 147, 233,
+	192, 242, 252, 253, 211, 213, 214, 230, 250, 257,
+	220, 92, 229, 241, 108, 210, 215, 0, 94, 239,
+	226, 158, 138, 139, 93, 0, 196, 117, 124, 114,
+	171, 236, 237, 113, 259, 100, 249, 96, 101, 248,
+	165, 232, 240, 159, 152, 95, 238, 157, 151, 142,
+	121, 131, 189, 149, 190, 132, 162, 161, 163, 0,
+	416, 0, 224, 246, 260, 105, 432, 231, 255, 256,
+	0, 0, 106, 125, 120, 188, 164, 102, 134, 221,
+	141, 148, 195, 258, 178, 201, 109, 245, 222, 428,
+	431, 426, 427, 475, 476, 524, 525, 526, 501, 422,
+	0, 429, 430, 0, 507, 514, 515, 479, 88, 97,
+	145, 530, 193, 123, 494, 531, 504, 496, 111, 212,
+	244, 185, 127, 247, 412, 425, 116, 435, 0, 0,
+	448, 453, 454, 466, 468, 469, 470, 471, 478, 485,
+	486, 488, 495, 497, 498, 503, 511, 90, 91, 98,
+	104, 110, 115, 119, 122, 130, 133, 135, 136, 137,
+	140, 150, 153, 154, 155, 156, 166, 167, 168, 170,
+	173, 174, 175, 176, 177, 180, 182, 183, 184, 186,
+	187, 194, 197, 203, 204, 205, 206, 207, 208, 209,
+	216, 217, 218, 219, 225, 228, 234, 235, 251, 254,
+	518, 506, 0, 461, 521, 434, 451, 529, 452, 455,
+	492, 419, 474, 172, 449, 0, 438, 414, 445, 415,
+	436, 463, 118, 467, 433, 508, 477, 520, 144, 439,
+	527, 146, 483, 0, 223, 160, 0, 0, 465, 510,
+	472, 502, 460, 493, 424, 482, 522, 450, 490, 523,
+	0, 0, 0, 85, 86, 87, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 487, 517, 447, 489,
+	491, 413, 484, 0, 417, 420, 528, 513, 442, 443,
+	0, 0, 0, 0, 0, 0, 0, 464, 473, 499,
+	458, 0, 0, 0, 0, 0, 0, 1274, 0, 440,
+	0, 481, 0, 0, 0, 421, 418, 0, 0, 462,
+	0, 0, 0, 423, 0, 441, 500, 0, 411, 126,
+	505, 512, 459, 278, 516, 457, 456, 519, 191, 0,
+	227, 129, 143, 103, 89, 99, 0, 128, 169, 198,
+	202, 509, 437, 446, 112, 444, 200, 179, 243, 480,
+	181, 199, 147, 233, 192, 242, 252, 253, 211, 213,
+	214, 230, 250, 257, 220, 92, 229, 241, 108, 210,
+	215, 0, 94, 239, 226, 158, 138, 139, 93, 0,
+	196, 117, 124, 114, 171, 236, 237, 113, 259, 100,
+	249, 96, 101, 248, 165, 232, 240, 159, 152, 95,
+	238, 157, 151, 142, 121, 131, 189, 149, 190, 132,
+	162, 161, 163, 0, 416, 0, 224, 246, 260, 105,
+	432, 231, 255, 256, 0, 0, 106, 125, 120, 188,
+	164, 102, 134, 221, 141, 148, 195, 258, 178, 201,
+	109, 245, 222, 428, 431, 426, 427, 475, 476, 524,
+	525, 526, 501, 422, 0, 429, 430, 0, 507, 514,
+	515, 479, 88, 97, 145, 530, 193, 123, 494, 531,
+	504, 496, 111, 212, 244, 185, 127, 247, 412, 425,
+	116, 435, 0, 0, 448, 453, 454, 466, 468, 469,
+	470, 471, 478, 485, 486, 488, 495, 497, 498, 503,
+	511, 90, 91, 98, 104, 110, 115, 119, 122, 130,
+	133, 135, 136, 137, 140, 150, 153, 154, 155, 156,
+	166, 167, 168, 170, 173, 174, 175, 176, 177, 180,
+	182, 183, 184, 186, 187, 194, 197, 203, 204, 205,
+	206, 207, 208, 209, 216, 217, 218, 219, 225, 228,
+	234, 235, 251, 254, 518, 506, 0, 461, 521, 434,
+	451, 529, 452, 455, 492, 419, 474, 172, 449, 0,
+	438, 414, 445, 415, 436, 463, 118, 467, 433, 508,
+	477, 520, 144, 439, 527, 146, 483, 0, 223, 160,
+	0, 0, 465, 510, 472, 502, 460, 493, 424, 482,
+	522, 450, 490, 523, 0, 0, 0, 85, 86, 87,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	487, 517, 447, 489, 491, 413, 484, 0, 417, 420,
+	528, 513, 442, 443, 0, 0, 0, 0, 0, 0,
+	0, 464, 473, 499, 458, 0, 0, 0, 0, 0,
+	0, 980, 0, 440, 0, 481, 0, 0, 0, 421,
+	418, 0, 0, 462, 0, 0, 0, 423, 0, 441,
+	500, 0, 411, 126, 505, 512, 459, 278, 516, 457,
+	456, 519, 191, 0, 227, 129, 143, 103, 89, 99,
+	0, 128, 169, 198, 202, 509, 437, 446, 112, 444,
+	200, 179, 243, 480, 181, 199, 147, 233, 192, 242,
+	252, 253, 211, 213, 214, 230, 250, 257, 220, 92,
+	229, 241, 108, 210, 215, 0, 94, 239, 226, 158,
+	138, 139, 93, 0, 196, 117, 124, 114, 171, 236,
+	237, 113, 259, 100, 249, 96, 101, 248, 165, 232,
+	240, 159, 152, 95, 238, 157, 151, 142, 121, 131,
+	189, 149, 190, 132, 162, 161, 163, 0, 416, 0,
+	224, 246, 260, 105, 432, 231, 255, 256, 0, 0,
+	106, 125, 120, 188, 164, 102, 134, 221, 141, 148,
+	195, 258, 178, 201, 109, 245, 222, 428, 431, 426,
+	427, 475, 476, 524, 525, 526, 501, 422, 0, 429,
+	430, 0, 507, 514, 515, 479, 88, 97, 145, 530,
+	193, 123, 494, 531, 504, 496, 111, 212, 244, 185,
+	127, 247, 412, 425, 116, 435, 0, 0, 448, 453,
+	454, 466, 468, 469, 470, 471, 478, 485, 486, 488,
+	495, 497, 498, 503, 511, 90, 91, 98, 104, 110,
+	115, 119, 122, 130, 133, 135, 136, 137, 140, 150,
+	153, 154, 155, 156, 166, 167, 168, 170, 173, 174,
+	175, 176, 177, 180, 182, 183, 184, 186, 187, 194,
+	197, 203, 204, 205, 206, 207, 208, 209, 216, 217,
+	218, 219, 225, 228, 234, 235, 251, 254, 518, 506,
+	0, 461, 521, 434, 451, 529, 452, 455, 492, 419,
+	474, 172, 449, 0, 438, 414, 445, 415, 436, 463,
+	118, 467, 433, 508, 477, 520, 144, 439, 527, 146,
+	483, 0, 223, 160, 0, 0, 465, 510, 472, 502,
+	460, 493, 424, 482, 522, 450, 490, 523, 0, 0,
+	0, 85, 86, 87, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 487, 517, 447, 489, 491, 413,
+	484, 0, 417, 420, 528, 513, 442, 443, 0, 0,
+	0, 0, 0, 0, 0, 464, 473, 499, 458, 0,
+	0, 0, 0, 0, 0, 875, 0, 440, 0, 481,
+	0, 0, 0, 421, 418, 0, 0, 462, 0, 0,
+	0, 423, 0, 441, 500, 0, 411, 126, 505, 512,
+	459, 278, 516, 457, 456, 519, 191, 0, 227, 129,
+	143, 103, 89, 99, 0, 128, 169, 198, 202, 509,
+	437, 446, 112, 444, 200, 179, 243, 480, 181, 199,
+	147, 233, 192, 242, 252, 253, 211, 213, 214, 230,
+	250, 257, 220, 92, 229, 241, 108, 210, 215, 0,
+	94, 239, 226, 158, 138, 139, 93, 0, 196, 117,
+	124, 114, 171, 236, 237, 113, 259, 100, 249, 96,
+	101, 248, 165, 232, 240, 159, 152, 95, 238, 157,
+	151, 142, 121, 131, 189, 149, 190, 132, 162, 161,
+	163, 0, 416, 0, 224, 246, 260, 105, 432, 231,
+	255, 256, 0, 0, 106, 125, 120, 188, 164, 102,
+	134, 221, 141, 148, 195, 258, 178, 201, 109, 245,
+	222, 428, 431, 426, 427, 475, 476, 524, 525, 526,
+	501, 422, 0, 429, 430, 0, 507, 514, 515, 479,
+	88, 97, 145, 530, 193, 123, 494, 531, 504, 496,
+	111, 212, 244, 185, 127, 247, 412, 425, 116, 435,
+	0, 0, 448, 453, 454, 466, 468, 469, 470, 471,
+	478, 485, 486, 488, 495, 497, 498, 503, 511, 90,
+	91, 98, 104, 110, 115, 119, 122, 130, 133, 135,
+	136, 137, 140, 150, 153, 154, 155, 156, 166, 167,
+	168, 170, 173, 174, 175, 176, 177, 180, 182, 183,
+	184, 186, 187, 194, 197, 203, 204, 205, 206, 207,
+	208, 209, 216, 217, 218, 219, 225, 228, 234, 235,
+	251, 254, 518, 506, 0, 461, 521, 434, 451, 529,
+	452, 455, 492, 419, 474, 172, 449, 0, 438, 414,
+	445, 415, 436, 463, 118, 467, 433, 508, 477, 520,
+	144, 439, 527, 146, 483, 0, 223, 160, 0, 0,
+	465, 510, 472, 502, 460, 493, 424, 482, 522, 450,
+	490, 523, 0, 0, 0, 85, 86, 87, 0, 0,
+	0, 0, 0, 0, 0, 0, 107, 0, 487, 517,
+	447, 489, 491, 413, 484, 0, 417, 420, 528, 513,
+	442, 443, 0, 0, 0, 0, 0, 0, 0, 464,
+	473, 499, 458, 0, 0, 0, 0, 0, 0, 0,
+	0, 440, 0, 481, 0, 0, 0, 421, 418, 0,
+	0, 462, 0, 0, 0, 423, 0, 441, 500, 0,
+	411, 126, 505, 512, 459, 278, 516, 457, 456, 519,
+	191, 0, 227, 129, 143, 103, 89, 99, 0, 128,
+	169, 198, 202, 509, 437, 446, 112, 444, 200, 179,
+	243, 480, 181, 199, 147, 233, 192, 242, 252, 253,
+	211, 213, 214, 230, 250, 257, 220, 92, 229, 241,
+	108, 210, 215, 0, 94, 239, 226, 158, 138, 139,
+	93, 0, 196, 117, 124, 114, 171, 236, 237, 113,
+	259, 100, 249, 96, 101, 248, 165, 232, 240, 159,
+	152, 95, 238, 157, 151, 142, 121, 131, 189, 149,
+	190, 132, 162, 161, 163, 0, 416, 0, 224, 246,
+	260, 105, 432, 231, 255, 256, 0, 0, 106, 125,
+	120, 188, 164, 102, 134, 221, 141, 148, 195, 258,
+	178, 201, 109, 245, 222, 428, 431, 426, 427, 475,
+	476, 524, 525, 526, 501, 422, 0, 429, 430, 0,
+	507, 514, 515, 479, 88, 97, 145, 530, 193, 123,
+	494, 531, 504, 496, 111, 212, 244, 185, 127, 247,
+	412, 425, 116, 435, 0, 0, 448, 453, 454, 466,
+	468, 469, 470, 471, 478, 485, 486, 488, 495, 497,
+	498, 503, 511, 90, 91, 98, 104, 110, 115, 119,
+	122, 130, 133, 135, 136, 137, 140, 150, 153, 154,
+	155, 156, 166, 167, 168, 170, 173, 174, 175, 176,
+	177, 180, 182, 183, 184, 186, 187, 194, 197, 203,
+	204, 205, 206, 207, 208, 209, 216, 217, 218, 219,
+	225, 228, 234, 235, 251, 254, 518, 506, 0, 461,
+	521, 434, 451, 529, 452, 455, 492, 419, 474, 172,
+	449, 0, 438, 414, 445, 415, 436, 463, 118, 467,
+	433, 508, 477, 520, 144, 439, 527, 146, 483, 0,
+	223, 160, 0, 0, 465, 510, 472, 502, 460, 493,
+	424, 482, 522, 450, 490, 523, 0, 0, 0, 85,
+	86, 87, 0, 0, 0, 0, 0, 0, 0, 0,
+	107, 0, 487, 517, 447, 489, 491, 413, 484, 0,
+	417, 420, 528, 513, 442, 443, 0, 0, 0, 0,
+	0, 0, 0, 464, 473, 499, 458, 0, 0, 0,
+	0, 0, 0, 0, 0, 440, 0, 481, 0, 0,
+	0, 421, 418, 0, 0, 462, 0, 0, 0, 423,
+	0, 441, 500, 0, 411, 126, 505, 512, 459, 278,
+	516, 457, 456, 519, 191, 0, 227, 129, 143, 103,
+	89, 99, 0, 128, 169, 198, 202, 509, 437, 446,
+	112, 444, 200, 179, 243, 480, 181, 199, 147, 233,
+	192, 242, 252, 253, 211, 213, 214, 230, 250, 257,
+	220, 92, 229, 241, 108, 210, 215, 0, 94, 239,
+	226, 158, 138, 139, 93, 0, 196, 117, 124, 114,
+	171, 236, 237, 113, 259, 100, 249, 96, 409, 248,
+	165, 232, 240, 159, 152, 95, 238, 157, 151, 142,
+	121, 131, 189, 149, 190, 132, 162, 161, 163, 0,
+	416, 0, 224, 246, 260, 105, 432, 231, 255, 256,
+	0, 0, 106, 125, 120, 188, 410, 408, 134, 221,
+	141, 148, 195, 258, 178, 201, 109, 245, 222, 428,
+	431, 426, 427, 475, 476, 524, 525, 526, 501, 422,
+	0, 429, 430, 0, 507, 514, 515, 479, 88, 97,
+	145, 530, 193, 123, 494, 531, 504, 496, 111, 212,
+	244, 185, 127, 247, 412, 425, 116, 435, 0, 0,
+	448, 453, 454, 466, 468, 469, 470, 471, 478, 485,
+	486, 488, 495, 497, 498, 503, 511, 90, 91, 98,
+	104, 110, 115, 119, 122, 130, 133, 135, 136, 137,
+	140, 150, 153, 154, 155, 156, 166, 167, 168, 170,
+	173, 174, 175, 176, 177, 180, 182, 183, 184, 186,
+	187, 194, 197, 203, 204, 205, 206, 207, 208, 209,
+	216, 217, 218, 219, 225, 228, 234, 235, 251, 254,
+	518, 506, 0, 461, 521, 434, 451, 529, 452, 455,
+	492, 419, 474, 172, 449, 0, 438, 414, 445, 415,
+	436, 463, 118, 467, 433, 508, 477, 520, 144, 439,
+	527, 146, 483, 0, 223, 160, 0, 0, 465, 510,
+	472, 502, 460, 493, 424, 482, 522, 450, 490, 523,
+	0, 0, 0, 85, 86, 87, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 487, 517, 447, 489,
+	491, 413, 484, 0, 417, 420, 528, 513, 442, 443,
+	0, 0, 0, 0, 0, 0, 0, 464, 473, 499,
+	458, 0, 0, 0, 0, 0, 0, 0, 0, 440,
+	0, 481, 0, 0, 0, 421, 418, 0, 0, 462,
+	0, 0, 0, 423, 0, 441, 500, 0, 411, 126,
+	505, 512, 459, 278, 516, 457, 456, 519, 191, 0,
+	227, 129, 143, 103, 89, 99, 0, 128, 169, 198,
+	202, 509, 437, 446, 112, 444, 200, 179, 243, 480,
+	181, 199, 147, 233, 192, 242, 252, 253, 211, 213,
+	214, 230, 250, 257, 220, 92, 229, 731, 108, 210,
+	215, 0, 94, 239, 226, 158, 138, 139, 93, 0,
+	196, 117, 124, 114, 171, 236, 237, 113, 259, 100,
+	249, 96, 409, 248, 165, 232, 240, 159, 152, 95,
+	238, 157, 151, 142, 121, 131, 189, 149, 190, 132,
+	162, 161, 163, 0, 416, 0, 224, 246, 260, 105,
+	432, 231, 255, 256, 0, 0, 106, 125, 120, 188,
+	410, 408, 134, 221, 141, 148, 195, 258, 178, 201,
+	109, 245, 222, 428, 431, 426, 427, 475, 476, 524,
+	525, 526, 501, 422, 0, 429, 430, 0, 507, 514,
+	515, 479, 88, 97, 145, 530, 193, 123, 494, 531,
+	504, 496, 111, 212, 244, 185, 127, 247, 412, 425,
+	116, 435, 0, 0, 448, 453, 454, 466, 468, 469,
+	470, 471, 478, 485, 486, 488, 495, 497, 498, 503,
+	511, 90, 91, 98, 104, 110, 115, 119, 122, 130,
+	133, 135, 136, 137, 140, 150, 153, 154, 155, 156,
+	166, 167, 168, 170, 173, 174, 175, 176, 177, 180,
+	182, 183, 184, 186, 187, 194, 197, 203, 204, 205,
+	206, 207, 208, 209, 216, 217, 218, 219, 225, 228,
+	234, 235, 251, 254, 518, 506, 0, 461, 521, 434,
+	451, 529, 452, 455, 492, 419, 474, 172, 449, 0,
+	438, 414, 445, 415, 436, 463, 118, 467, 433, 508,
+	477, 520, 144, 439, 527, 146, 483, 0, 223, 160,
+	0, 0, 465, 510, 472, 502, 460, 493, 424, 482,
+	522, 450, 490, 523, 0, 0, 0, 85, 86, 87,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	487, 517, 447, 489, 491, 413, 484, 0, 417, 420,
+	528, 513, 442, 443, 0, 0, 0, 0, 0, 0,
+	0, 464, 473, 499, 458, 0, 0, 0, 0, 0,
+	0, 0, 0, 440, 0, 481, 0, 0, 0, 421,
+	418, 0, 0, 462, 0, 0, 0, 423, 0, 441,
+	500, 0, 411, 126, 505, 512, 459, 278, 516, 457,
+	456, 519, 191, 0, 227, 129, 143, 103, 89, 99,
+	0, 128, 169, 198, 202, 509, 437, 446, 112, 444,
+	200, 179, 243, 480, 181, 199, 147, 233, 192, 242,
+	252, 253, 211, 213, 214, 230, 250, 257, 220, 92,
+	229, 400, 108, 210, 215, 0, 94, 239, 226, 158,
+	138, 139, 93, 0, 196, 117, 124, 114, 171, 236,
+	237, 113, 259, 100, 249, 96, 409, 248, 165, 232,
+	240, 159, 152, 95, 238, 157, 151, 142, 121, 131,
+	189, 149, 190, 132, 162, 161, 163, 0, 416, 0,
+	224, 246, 260, 105, 432, 231, 255, 256, 0, 0,
+	106, 125, 120, 188, 410, 408, 403, 402, 141, 148,
+	195, 258, 178, 201, 109, 245, 222, 428, 431, 426,
+	427, 475, 476, 524, 525, 526, 501, 422, 0, 429,
+	430, 0, 507, 514, 515, 479, 88, 97, 145, 530,
+	193, 123, 494, 531, 504, 496, 111, 212, 244, 185,
+	127, 247, 412, 425, 116, 435, 0, 0, 448, 453,
+	454, 466, 468, 469, 470, 471, 478, 485, 486, 488,
+	495, 497, 498, 503, 511, 90, 91, 98, 104, 110,
+	115, 119, 122, 130, 133, 135, 136, 137, 140, 150,
+	153, 154, 155, 156, 166, 167, 168, 170, 173, 174,
+	175, 176, 177, 180, 182, 183, 184, 186, 187, 194,
+	197, 203, 204, 205, 206, 207, 208, 209, 216, 217,
+	218, 219, 225, 228, 234, 235, 251, 254, 172, 0,
+	0, 912, 0, 333, 0, 0, 0, 118, 0, 330,
+	0, 0, 0, 144, 913, 373, 146, 0, 0, 223,
+	160, 0, 0, 0, 0, 364, 365, 0, 0, 0,
+	0, 0, 0, 0, 0, 61, 0, 0, 85, 86,
+	87, 352, 351, 354, 355, 356, 357, 0, 0, 107,
+	353, 358, 359, 360, 0, 0, 0, 328, 345, 0,
+	372, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	342, 343, 324, 0, 0, 0, 387, 0, 344, 0,
+	0, 339, 340, 341, 346, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 126, 386, 0, 0, 278, 0,
+	0, 384, 0, 191, 0, 227, 129, 143, 103, 89,
+	99, 0, 128, 169, 198, 202, 0, 0, 0, 112,
+	0, 200, 179, 243, 0, 181, 199, 147, 233, 192,
+	242, 252, 253, 211, 213, 214, 230, 250, 257, 220,
+	92, 229, 241, 108, 210, 215, 0, 94, 239, 226,
+	158, 138, 139, 93, 0, 196, 117, 124, 114, 171,
+	236, 237, 113, 259, 100, 249, 96, 101, 248, 165,
+	232, 240, 159, 152, 95, 238, 157, 151, 142, 121,
+	131, 189, 149, 190, 132, 162, 161, 163, 0, 0,
+	0, 224, 246, 260, 105, 0, 231, 255, 256, 0,
+	0, 106, 125, 120, 188, 164, 102, 134, 221, 141,
+	148, 195, 258, 178, 201, 109, 245, 222, 374, 385,
+	380, 381, 378, 379, 377, 376, 375, 388, 366, 367,
+	368, 369, 371, 0, 382, 383, 370, 88, 97, 145,
+	0, 193, 123, 0, 0, 0, 0, 111, 212, 244,
+	185, 127, 247, 0, 0, 116, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 90, 91, 98, 104,
+	110, 115, 119, 122, 130, 133, 135, 136, 137, 140,
+	150, 153, 154, 155, 156, 166, 167, 168, 170, 173,
+	174, 175, 176, 177, 180, 182, 183, 184, 186, 187,
+	194, 197, 203, 204, 205, 206, 207, 208, 209, 216,
+	217, 218, 219, 225, 228, 234, 235, 251, 254, 172,
+	0, 0, 0, 0, 333, 0, 0, 0, 118, 0,
+	330, 0, 0, 0, 144, 0, 373, 146, 0, 0,
+	223, 160, 0, 0, 0, 0, 364, 365, 0, 0,
+	0, 0, 0, 0, 987, 0, 61, 0, 0, 85,
+	86, 87, 352, 351, 354, 355, 356, 357, 0, 0,
+	107, 353, 358, 359, 360, 988, 0, 0, 328, 345,
+	0, 372, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 343, 0, 0, 0, 0, 387, 0, 344,
+	0, 0, 339, 340, 341, 346, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 386, 0, 0, 278,
+	0, 0, 384, 0, 191, 0, 227, 129, 143, 103,
+	89, 99, 0, 128, 169, 198, 202, 0, 0, 0,
+	112, 0, 200, 179, 243, 0, 181, 199, 147, 233,
+	192, 242, 252, 253, 211, 213, 214, 230, 250, 257,
+	220, 92, 229, 241, 108, 210, 215, 0, 94, 239,
+	226, 158, 138, 139, 93, 0, 196, 117, 124, 114,
+	171, 236, 237, 113, 259, 100, 249, 96, 101, 248,
+	165, 232, 240, 159, 152, 95, 238, 157, 151, 142,
+	121, 131, 189, 149, 190, 132, 162, 161, 163, 0,
+	0, 0, 224, 246, 260, 105, 0, 231, 255, 256,
+	0, 0, 106, 125, 120, 188, 164, 102, 134, 221,
+	141, 148, 195, 258, 178, 201, 109, 245, 222, 374,
+	385, 380, 381, 378, 379, 377, 376, 375, 388, 366,
+	367, 368, 369, 371, 0, 382, 383, 370, 88, 97,
+	145, 0, 193, 123, 0, 0, 0, 0, 111, 212,
+	244, 185, 127, 247, 0, 0, 116, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 91, 98,
+	104, 110, 115, 119, 122, 130, 133, 135, 136, 137,
+	140, 150, 153, 154, 155, 156, 166, 167, 168, 170,
+	173, 174, 175, 176, 177, 180, 182, 183, 184, 186,
+	187, 194, 197, 203, 204, 205, 206, 207, 208, 209,
+	216, 217, 218, 219, 225, 228, 234, 235, 251, 254,
+	56, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 172, 0, 0, 0, 0, 333, 0, 0,
+	0, 118, 0, 330, 0, 0, 0, 144, 0, 373,
+	146, 0, 0, 223, 160, 0, 0, 0, 0, 364,
+	365, 0, 0, 0, 0, 0, 0, 0, 0, 61,
+	0, 0, 85, 86, 87, 352, 351, 354, 355, 356,
+	357, 0, 0, 107, 353, 358, 359, 360, 0, 0,
+	0, 328, 345, 0, 372, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 342, 343, 0, 0, 0, 0,
+	387, 0, 344, 0, 0, 339, 340, 341, 346, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 126, 386,
+	0, 0, 278, 0, 0, 384, 0, 191, 0, 227,
+	129, 143, 103, 89, 99, 0, 128, 169, 198, 202,
+	0, 0, 0, 112, 0, 200, 179, 243, 0, 181,
+	199, 147, 233, 192, 242, 252, 253, 211, 213, 214,
+	230, 250, 257, 220, 92, 229, 241, 108, 210, 215,
+	0, 94, 239, 226, 158, 138, 139, 93, 0, 196,
+	117, 124, 114, 171, 236, 237, 113, 259, 100, 249,
+	96, 101, 248, 165, 232, 240, 159, 152, 95, 238,
+	157, 151, 142, 121, 131, 189, 149, 190, 132, 162,
+	161, 163, 0, 0, 0, 224, 246, 260, 105, 0,
+	231, 255, 256, 0, 0, 106, 125, 120, 188, 164,
+	102, 134, 221, 141, 148, 195, 258, 178, 201, 109,
+	245, 222, 374, 385, 380, 381, 378, 379, 377, 376,
+	375, 388, 366, 367, 368, 369, 371, 0, 382, 383,
+	370, 88, 97, 145, 57, 193, 123, 0, 0, 0,
+	0, 111, 212, 244, 185, 127, 247, 0, 0, 116,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	90, 91, 98, 104, 110, 115, 119, 122, 130, 133,
+	135, 136, 137, 140, 150, 153, 154, 155, 156, 166,
+	167, 168, 170, 173, 174, 175, 176, 177, 180, 182,
+	183, 184, 186, 187, 194, 197, 203, 204, 205, 206,
+	207, 208, 209, 216, 217, 218, 219, 225, 228, 234,
+	235, 251, 254, 172, 0, 0, 0, 0, 333, 0,
+	0, 0, 118, 0, 330, 0, 0, 0, 144, 0,
+	373, 146, 0, 0, 223, 160, 0, 0, 0, 0,
+	364, 365, 0, 0, 0, 0, 0, 0, 0, 0,
+	61, 0, 616, 85, 86, 87, 352, 351, 354, 355,
+	356, 357, 0, 0, 107, 353, 358, 359, 360, 0,
+	0, 0, 328, 345, 0, 372, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 343, 0, 0, 0,
+	0, 387, 0, 344, 0, 0, 339, 340, 341, 346,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	386, 0, 0, 278, 0, 0, 384, 0, 191, 0,
+	227, 129, 143, 103, 89, 99, 0, 128, 169, 198,
+	202, 0, 0, 0, 112, 0, 200, 179, 243, 0,
+	181, 199, 147, 233, 192, 242, 252, 253, 211, 213,
+	214, 230, 250, 257, 220, 92, 229, 241, 108, 210,
+	215, 0, 94, 239, 226, 158, 138, 139, 93, 0,
+	196, 117, 124, 114, 171, 236, 237, 113, 259, 100,
+	249, 96, 101, 248, 165, 232, 240, 159, 152, 95,
+	238, 157, 151, 142, 121, 131, 189, 149, 190, 132,
+	162, 161, 163, 0, 0, 0, 224, 246, 260, 105,
+	0, 231, 255, 256, 0, 0, 106, 125, 120, 188,
+	164, 102, 134, 221, 141, 148, 195, 258, 178, 201,
+	109, 245, 222, 374, 385, 380, 381, 378, 379, 377,
+	376, 375, 388, 366, 367, 368, 369, 371, 0, 382,
+	383, 370, 88, 97, 145, 0, 193, 123, 0, 0,
+	0, 0, 111, 212, 244, 185, 127, 247, 0, 0,
+	116, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 90, 91, 98, 104, 110, 115, 119, 122, 130,
+	133, 135, 136, 137, 140, 150, 153, 154, 155, 156,
+	166, 167, 168, 170, 173, 174, 175, 176, 177, 180,
+	182, 183, 184, 186, 187, 194, 197, 203, 204, 205,
+	206, 207, 208, 209, 216, 217, 218, 219, 225, 228,
+	234, 235, 251, 254, 172, 0, 0, 0, 0, 333,
+	0, 0, 0, 118, 0, 330, 0, 0, 0, 144,
+	0, 373, 146, 0, 0, 223, 160, 0, 0, 0,
+	0, 364, 365, 0, 0, 0, 0, 0, 0, 0,
+	0, 61, 0, 0, 85, 86, 87, 352, 351, 354,
+	355, 356, 357, 0, 0, 107, 353, 358, 359, 360,
+	0, 0, 0, 328, 345, 0, 372, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 342, 343, 324, 0,
+	0, 0, 387, 0, 344, 0, 0, 339, 340, 341,
+	346, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	126, 386, 0, 0, 278, 0, 0, 384, 0, 191,
+	0, 227, 129, 143, 103, 89, 99, 0, 128, 169,
+	198, 202, 0, 0, 0, 112, 0, 200, 179, 243,
+	0, 181, 199, 147, 233, 192, 242, 252, 253, 211,
+	213, 214, 230, 250, 257, 220, 92, 229, 241, 108,
+	210, 215, 0, 94, 239, 226, 158, 138, 139, 93,
+	0, 196, 117, 124, 114, 171, 236, 237, 113, 259,
+	100, 249, 96, 101, 248, 165, 232, 240, 159, 152,
+	95, 238, 157, 151, 142, 121, 131, 189, 149, 190,
+	132, 162, 161, 163, 0, 0, 0, 224, 246, 260,
+	105, 0, 231, 255, 256, 0, 0, 106, 125, 120,
+	188, 164, 102, 134, 221, 141, 148, 195, 258, 178,
+	201, 109, 245, 222, 374, 385, 380, 381, 378, 379,
+	377, 376, 375, 388, 366, 367, 368, 369, 371, 0,
+	382, 383, 370, 88, 97, 145, 0, 193, 123, 0,
+	0, 0, 0, 111, 212, 244, 185, 127, 247, 0,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 90, 91, 98, 104, 110, 115, 119, 122,
+	130, 133, 135, 136, 137, 140, 150, 153, 154, 155,
+	156, 166, 167, 168, 170, 173, 174, 175, 176, 177,
+	180, 182, 183, 184, 186, 187, 194, 197, 203, 204,
+	205, 206, 207, 208, 209, 216, 217, 218, 219, 225,
+	228, 234, 235, 251, 254, 172, 0, 0, 0, 0,
+	333, 0, 0, 0, 118, 0, 330, 0, 0, 0,
+	144, 0, 373, 146, 0, 0, 223, 160, 0, 0,
+	0, 0, 364, 365, 0, 0, 0, 0, 0, 0,
+	0, 0, 61, 0, 0, 85, 86, 87, 352, 928,
+	354, 355, 356, 357, 0, 0, 107, 353, 358, 359,
+	360, 0, 0, 0, 328, 345, 0, 372, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 343, 324,
+	0, 0, 0, 387, 0, 344, 0, 0, 339, 340,
+	341, 346, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 386, 0, 0, 278, 0, 0, 384, 0,
+	191, 0, 227, 129, 143, 103, 89, 99, 0, 128,
+	169, 198, 202, 0, 0, 0, 112, 0, 200, 179,
+	243, 0, 181, 199, 147, 233, 192, 242, 252, 253,
+	211, 213, 214, 230, 250, 257, 220, 92, 229, 241,
+	108, 210, 215, 0, 94, 239, 226, 158, 138, 139,
+	93, 0, 196, 117, 124, 114, 171, 236, 237, 113,
+	259, 100, 249, 96, 101, 248, 165, 232, 240, 159,
+	152, 95, 238, 157, 151, 142, 121, 131, 189, 149,
+	190, 132, 162, 161, 163, 0, 0, 0, 224, 246,
+	260, 105, 0, 231, 255, 256, 0, 0, 106, 125,
+	120, 188, 164, 102, 134, 221, 141, 148, 195, 258,
+	178, 201, 109, 245, 222, 374, 385, 380, 381, 378,
+	379, 377, 376, 375, 388, 366, 367, 368, 369, 371,
+	0, 382, 383, 370, 88, 97, 145, 0, 193, 123,
+	0, 0, 0, 0, 111, 212, 244, 185, 127, 247,
+	0, 0, 116, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 91, 98, 104, 110, 115, 119,
+	122, 130, 133, 135, 136, 137, 140, 150, 153, 154,
+	155, 156, 166, 167, 168, 170, 173, 174, 175, 176,
+	177, 180, 182, 183, 184, 186, 187, 194, 197, 203,
+	204, 205, 206, 207, 208, 209, 216, 217, 218, 219,
+	225, 228, 234, 235, 251, 254, 172, 0, 0, 0,
+	0, 333, 0, 0, 0, 118, 0, 330, 0, 0,
+	0, 144, 0, 373, 146, 0, 0, 223, 160, 0,
+	0, 0, 0, 364, 365, 0, 0, 0, 0, 0,
+	0, 0, 0, 61, 0, 0, 85, 86, 87, 352,
+	925, 354, 355, 356, 357, 0, 0, 107, 353, 358,
+	359, 360, 0, 0, 0, 328, 345, 0, 372, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 342, 343,
+	324, 0, 0, 0, 387, 0, 344, 0, 0, 339,
+	340, 341, 346, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 126, 386, 0, 0, 278, 0, 0, 384,
+	0, 191, 0, 227, 129, 143, 103, 89, 99, 0,
+	128, 169, 198, 202, 0, 0, 0, 112, 0, 200,
+	179, 243, 0, 181, 199, 147, 233, 192, 242, 252,
+	253, 211, 213, 214, 230, 250, 257, 220, 92, 229,
+	241, 108, 210, 215, 0, 94, 239, 226, 158, 138,
+	139, 93, 0, 196, 117, 124, 114, 171, 236, 237,
+	113, 259, 100, 249, 96, 101, 248, 165, 232, 240,
+	159, 152, 95, 238, 157, 151, 142, 121, 131, 189,
+	149, 190, 132, 162, 161, 163, 0, 0, 0, 224,
+	246, 260, 105, 0, 231, 255, 256, 0, 0, 106,
+	125, 120, 188, 164, 102, 134, 221, 141, 148, 195,
+	258, 178, 201, 109, 245, 222, 374, 385, 380, 381,
+	378, 379, 377, 376, 375, 388, 366, 367, 368, 369,
+	371, 0, 382, 383, 370, 88, 97, 145, 0, 193,
+	123, 0, 0, 0, 0, 111, 212, 244, 185, 127,
+	247, 0, 0, 116, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 90, 91, 98, 104, 110, 115,
+	119, 122, 130, 133, 135, 136, 137, 140, 150, 153,
+	154, 155, 156, 166, 167, 168, 170, 173, 174, 175,
+	176, 177, 180, 182, 183, 184, 186, 187, 194, 197,
+	203, 204, 205, 206, 207, 208, 209, 216, 217, 218,
+	219, 225, 228, 234, 235, 251, 254, 172, 0, 0,
+	0, 0, 333, 0, 0, 0, 118, 0, 330, 0,
+	0, 0, 144, 0, 373, 146, 0, 0, 223, 160,
+	0, 0, 0, 0, 364, 365, 0, 0, 0, 0,
+	0, 0, 0, 0, 61, 0, 0, 85, 86, 87,
+	352, 351, 354, 355, 356, 357, 0, 0, 107, 353,
+	358, 359, 360, 0, 0, 0, 328, 345, 0, 372,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	343, 0, 0, 0, 0, 387, 0, 344, 0, 0,
+	339, 340, 341, 346, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 126, 386, 0, 0, 278, 0, 0,
+	384, 0, 191, 0, 227, 129, 143, 103, 89, 99,
+	0, 128, 169, 198, 202, 0, 0, 0, 112, 0,
+	200, 179, 243, 0, 181, 199, 147, 233, 192, 242,
+	252, 253, 211, 213, 214, 230, 250, 257, 220, 92,
+	229, 241, 108, 210, 215, 0, 94, 239, 226, 158,
+	138, 139, 93, 0, 196, 117, 124, 114, 171, 236,
+	237, 113, 259, 100, 249, 96, 101, 248, 165, 232,
+	240, 159, 152, 95, 238, 157, 151, 142, 121, 131,
+	189, 149, 190, 132, 162, 161, 163, 0, 0, 0,
+	224, 246, 260, 105, 0, 231, 255, 256, 0, 0,
+	106, 125, 120, 188, 164, 102, 134, 221, 141, 148,
+	195, 258, 178, 201, 109, 245, 222, 374, 385, 380,
+	381, 378, 379, 377, 376, 375, 388, 366, 367, 368,
+	369, 371, 0, 382, 383, 370, 88, 97, 145, 0,
+	193, 123, 0, 0, 0, 0, 111, 212, 244, 185,
+	127, 247, 0, 0, 116, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 90, 91, 98, 104, 110,
+	115, 119, 122, 130, 133, 135, 136, 137, 140, 150,
+	153, 154, 155, 156, 166, 167, 168, 170, 173, 174,
+	175, 176, 177, 180, 182, 183, 184, 186, 187, 194,
+	197, 203, 204, 205, 206, 207, 208, 209, 216, 217,
+	218, 219, 225, 228, 234, 235, 251, 254, 172, 0,
+	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
+	0, 0, 0, 144, 0, 373, 146, 0, 0, 223,
+	160, 0, 0, 0, 0, 364, 365, 0, 0, 0,
+	0, 0, 0, 0, 0, 61, 0, 0, 85, 86,
+	87, 352, 351, 354, 355, 356, 357, 0, 0, 107,
+	353, 358, 359, 360, 0, 0, 0, 0, 345, 0,
+	372, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	342, 343, 0, 0, 0, 0, 387, 0, 344, 0,
+	0, 339, 340, 341, 346, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 126, 386, 0, 0, 278, 0,
+	0, 384, 0, 191, 0, 227, 129, 143, 103, 89,
+	99, 0, 128, 169, 198, 202, 0, 0, 0, 112,
+	0, 200, 179, 243, 1630, 181, 199, 147, 233, 192,
+	242, 252, 253, 211, 213, 214, 230, 250, 257, 220,
+	92, 229, 241, 108, 210, 215, 0, 94, 239, 226,
+	158, 138, 139, 93, 0, 196, 117, 124, 114, 171,
+	236, 237, 113, 259, 100, 249, 96, 101, 248, 165,
+	232, 240, 159, 152, 95, 238, 157, 151, 142, 121,
+	131, 189, 149, 190, 132, 162, 161, 163, 0, 0,
+	0, 224, 246, 260, 105, 0, 231, 255, 256, 0,
+	0, 106, 125, 120, 188, 164, 102, 134, 221, 141,
+	148, 195, 258, 178, 201, 109, 245, 222, 374, 385,
+	380, 381, 378, 379, 377, 376, 375, 388, 366, 367,
+	368, 369, 371, 0, 382, 383, 370, 88, 97, 145,
+	0, 193, 123, 0, 0, 0, 0, 111, 212, 244,
+	185, 127, 247, 0, 0, 116, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 90, 91, 98, 104,
+	110, 115, 119, 122, 130, 133, 135, 136, 137, 140,
+	150, 153, 154, 155, 156, 166, 167, 168, 170, 173,
+	174, 175, 176, 177, 180, 182, 183, 184, 186, 187,
+	194, 197, 203, 204, 205, 206, 207, 208, 209, 216,
+	217, 218, 219, 225, 228, 234, 235, 251, 254, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	0, 0, 0, 0, 144, 0, 373, 146, 0, 0,
+	223, 160, 0, 0, 0, 0, 364, 365, 0, 0,
+	0, 0, 0, 0, 0, 0, 61, 0, 616, 85,
+	86, 87, 352, 351, 354, 355, 356, 357, 0, 0,
+	107, 353, 358, 359, 360, 0, 0, 0, 0, 345,
+	0, 372, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 343, 0, 0, 0, 0, 387, 0, 344,
+	0, 0, 339, 340, 341, 346, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 386, 0, 0, 278,
+	0, 0, 384, 0, 191, 0, 227, 129, 143, 103,
+	89, 99, 0, 128, 169, 198, 202, 0, 0, 0,
+	112, 0, 200, 179, 243, 0, 181, 199, 147, 233,
+	192, 242, 252, 253, 211, 213, 214, 230, 250, 257,
+	220, 92, 229, 241, 108, 210, 215, 0, 94, 239,
+	226, 158, 138, 139, 93, 0, 196, 117, 124, 114,
+	171, 236, 237, 113, 259, 100, 249, 96, 101, 248,
+	165, 232, 240, 159, 152, 95, 238, 157, 151, 142,
+	121, 131, 189, 149, 190, 132, 162, 161, 163, 0,
+	0, 0, 224, 246, 260, 105, 0, 231, 255, 256,
+	0, 0, 106, 125, 120, 188, 164, 102, 134, 221,
+	141, 148, 195, 258, 178, 201, 109, 245, 222, 374,
+	385, 380, 381, 378, 379, 377, 376, 375, 388, 366,
+	367, 368, 369, 371, 0, 382, 383, 370, 88, 97,
+	145, 0, 193, 123, 0, 0, 0, 0, 111, 212,
+	244, 185, 127, 247, 0, 0, 116, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 91, 98,
+	104, 110, 115, 119, 122, 130, 133, 135, 136, 137,
+	140, 150, 153, 154, 155, 156, 166, 167, 168, 170,
+	173, 174, 175, 176, 177, 180, 182, 183, 184, 186,
+	187, 194, 197, 203, 204, 205, 206, 207, 208, 209,
+	216, 217, 218, 219, 225, 228, 234, 235, 251, 254,
+	172, 0, 0, 0, 0, 0, 0, 0, 0, 118,
+	0, 0, 0, 0, 0, 144, 0, 373, 146, 0,
+	0, 223, 160, 0, 0, 0, 0, 364, 365, 0,
+	0, 0, 0, 0, 0, 0, 0, 61, 0, 0,
+	85, 86, 87, 352, 351, 354, 355, 356, 357, 0,
+	0, 107, 353, 358, 359, 360, 0, 0, 0, 0,
+	345, 0, 372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 342, 343, 0, 0, 0, 0, 387, 0,
+	344, 0, 0, 339, 340, 341, 346, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 126, 386, 0, 0,
+	278, 0, 0, 384, 0, 191, 0, 227, 129, 143,
+	103, 89, 99, 0, 128, 169, 198, 202, 0, 0,
+	0, 112, 0, 200, 179, 243, 0, 181, 199, 147,
+	233, 192, 242, 252, 253, 211, 213, 214, 230, 250,
+	257, 220, 92, 229, 241, 108, 210, 215, 0, 94,
+	239, 226, 158, 138, 139, 93, 0, 196, 117, 124,
+	114, 171, 236, 237, 113, 259, 100, 249, 96, 101,
+	248, 165, 232, 240, 159, 152, 95, 238, 157, 151,
+	142, 121, 131, 189, 149, 190, 132, 162, 161, 163,
+	0, 0, 0, 224, 246, 260, 105, 0, 231, 255,
+	256, 0, 0, 106, 125, 120, 188, 164, 102, 134,
+	221, 141, 148, 195, 258, 178, 201, 109, 245, 222,
+	374, 385, 380, 381, 378, 379, 377, 376, 375, 388,
+	366, 367, 368, 369, 371, 0, 382, 383, 370, 88,
+	97, 145, 0, 193, 123, 0, 0, 0, 0, 111,
+	212, 244, 185, 127, 247, 0, 0, 116, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 90, 91,
+	98, 104, 110, 115, 119, 122, 130, 133, 135, 136,
+	137, 140, 150, 153, 154, 155, 156, 166, 167, 168,
+	170, 173, 174, 175, 176, 177, 180, 182, 183, 184,
+	186, 187, 194, 197, 203, 204, 205, 206, 207, 208,
+	209, 216, 217, 218, 219, 225, 228, 234, 235, 251,
+	254, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	118, 0, 0, 0, 0, 0, 144, 0, 0, 146,
+	0, 0, 223, 160, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 85, 86, 87, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 636,
+	635, 645, 646, 638, 639, 640, 641, 642, 643, 644,
+	637, 0, 0, 647, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 126, 0, 0,
+	0, 278, 0, 0, 0, 0, 191, 0, 227, 129,
+	143, 103, 89, 99, 0, 128, 169, 198, 202, 0,
+	0, 0, 112, 0, 200, 179, 243, 0, 181, 199,
+	147, 233, 192, 242, 252, 253, 211, 213, 214, 230,
+	250, 257, 220, 92, 229, 241, 108, 210, 215, 0,
+	94, 239, 226, 158, 138, 139, 93, 0, 196, 117,
+	124, 114, 171, 236, 237, 113, 259, 100, 249, 96,
+	101, 248, 165, 232, 240, 159, 152, 95, 238, 157,
+	151, 142, 121, 131, 189, 149, 190, 132, 162, 161,
+	163, 0, 0, 0, 224, 246, 260, 105, 0, 231,
+	255, 256, 0, 0, 106, 125, 120, 188, 164, 102,
+	134, 221, 141, 148, 195, 258, 178, 201, 109, 245,
+	222, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	88, 97, 145, 0, 193, 123, 0, 0, 0, 0,
+	111, 212, 244, 185, 127, 247, 0, 0, 116, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 90,
+	91, 98, 104, 110, 115, 119, 122, 130, 133, 135,
+	136, 137, 140, 150, 153, 154, 155, 156, 166, 167,
+	168, 170, 173, 174, 175, 176, 177, 180, 182, 183,
+	184, 186, 187, 194, 197, 203, 204, 205, 206, 207,
+	208, 209, 216, 217, 218, 219, 225, 228, 234, 235,
+	251, 254, 172, 0, 0, 0, 623, 0, 0, 0,
+	0, 118, 0, 0, 0, 0, 0, 144, 0, 0,
+	146, 0, 0, 223, 160, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 85, 86, 87, 0, 625, 0, 0, 0,
+	0, 0, 0, 107, 0, 0, 0, 0, 0, 620,
+	619, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 621, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 126, 0,
+	0, 0, 278, 0, 0, 0, 0, 191, 0, 227,
+	129, 143, 103, 89, 99, 0, 128, 169, 198, 202,
+	0, 0, 0, 112, 0, 200, 179, 243, 0, 181,
+	199, 147, 233, 192, 242, 252, 253, 211, 213, 214,
+	230, 250, 257, 220, 92, 229, 241, 108, 210, 215,
+	0, 94, 239, 226, 158, 138, 139, 93, 0, 196,
+	117, 124, 114, 171, 236, 237, 113, 259, 100, 249,
+	96, 101, 248, 165, 232, 240, 159, 152, 95, 238,
+	157, 151, 142, 121, 131, 189, 149, 190, 132, 162,
+	161, 163, 0, 0, 0, 224, 246, 260, 105, 0,
+	231, 255, 256, 0, 0, 106, 125, 120, 188, 164,
+	102, 134, 221, 141, 148, 195, 258, 178, 201, 109,
+	245, 222, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 88, 97, 145, 0, 193, 123, 0, 0, 0,
+	0, 111, 212, 244, 185, 127, 247, 0, 0, 116,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	90, 91, 98, 104, 110, 115, 119, 122, 130, 133,
+	135, 136, 137, 140, 150, 153, 154, 155, 156, 166,
+	167, 168, 170, 173, 174, 175, 176, 177, 180, 182,
+	183, 184, 186, 187, 194, 197, 203, 204, 205, 206,
+	207, 208, 209, 216, 217, 218, 219, 225, 228, 234,
+	235, 251, 254, 172, 0, 0, 0, 0, 0, 0,
+	0, 0, 118, 0, 0, 0, 0, 0, 144, 0,
+	0, 146, 0, 0, 223, 160, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 85, 86, 87, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 0, 0, 0, 0,
+	77, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	79, 80, 0, 76, 0, 0, 0, 81, 191, 0,
+	227, 129, 143, 103, 89, 99, 0, 128, 169, 198,
+	202, 0, 0, 0, 112, 0, 200, 179, 243, 0,
+	181, 199, 147, 233, 192, 242, 252, 253, 211, 213,
+	214, 230, 250, 257, 220, 92, 229, 241, 108, 210,
+	215, 0, 94, 239, 226, 158, 138, 139, 93, 0,
+	196, 117, 124, 114, 171, 236, 237, 113, 259, 100,
+	249, 96, 101, 248, 165, 232, 240, 159, 152, 95,
+	238, 157, 151, 142, 121, 131, 189, 149, 190, 132,
+	162, 161, 163, 0, 0, 0, 224, 246, 260, 105,
+	0, 231, 255, 256, 0, 0, 106, 125, 120, 188,
+	164, 102, 134, 221, 141, 148, 195, 258, 178, 201,
+	109, 245, 222, 0, 78, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 88, 97, 145, 0, 193, 123, 0, 0,
+	0, 0, 111, 212, 244, 185, 127, 247, 0, 0,
+	116, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 90, 91, 98, 104, 110, 115, 119, 122, 130,
+	133, 135, 136, 137, 140, 150, 153, 154, 155, 156,
+	166, 167, 168, 170, 173, 174, 175, 176, 177, 180,
+	182, 183, 184, 186, 187, 194, 197, 203, 204, 205,
+	206, 207, 208, 209, 216, 217, 218, 219, 225, 228,
+	234, 235, 251, 254, 56, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 172, 0, 0, 0,
+	0, 0, 0, 0, 0, 118, 0, 0, 0, 0,
+	0, 144, 0, 0, 146, 0, 0, 223, 160, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 61, 0, 0, 85, 86, 87, 0,
+	0, 0, 0, 0, 0, 0, 0, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 126, 0, 0, 0, 278, 0, 0, 0,
+	0, 191, 0, 227, 129, 143, 103, 89, 99, 0,
+	128, 169, 198, 202, 0, 0, 0, 112, 0, 200,
+	179, 243, 0, 181, 199, 147, 233, 192, 242, 252,
+	253, 211, 213, 214, 230, 250, 257, 220, 92, 229,
+	241, 108, 210, 215, 0, 94, 239, 226, 158, 138,
+	139, 93, 0, 196, 117, 124, 114, 171, 236, 237,
+	113, 259, 100, 249, 96, 101, 248, 165, 232, 240,
+	159, 152, 95, 238, 157, 151, 142, 121, 131, 189,
+	149, 190, 132, 162, 161, 163, 0, 0, 0, 224,
+	246, 260, 105, 0, 231, 255, 256, 0, 0, 106,
+	125, 120, 188, 164, 102, 134, 221, 141, 148, 195,
+	258, 178, 201, 109, 245, 222, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 88, 97, 145, 57, 193,
+	123, 0, 0, 0, 0, 111, 212, 244, 185, 127,
+	247, 0, 0, 116, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 90, 91, 98, 104, 110, 115,
+	119, 122, 130, 133, 135, 136, 137, 140, 150, 153,
+	154, 155, 156, 166, 167, 168, 170, 173, 174, 175,
+	176, 177, 180, 182, 183, 184, 186, 187, 194, 197,
+	203, 204, 205, 206, 207, 208, 209, 216, 217, 218,
+	219, 225, 228, 234, 235, 251, 254, 172, 0, 0,
+	0, 970, 0, 0, 0, 0, 118, 0, 0, 0,
+	0, 0, 144, 0, 0, 146, 0, 0, 223, 160,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 85, 86, 87,
+	0, 972, 0, 0, 0, 0, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 126, 0, 0, 0, 278, 0, 0,
+	0, 0, 191, 0, 227, 129, 143, 103, 89, 99,
+	0, 128, 169, 198, 202, 0, 0, 0, 112, 0,
+	200, 179, 243, 0, 181, 199, 147, 233, 192, 242,
+	252, 253, 211, 213, 214, 230, 250, 257, 220, 92,
+	229, 241, 108, 210, 215, 0, 94, 239, 226, 158,
+	138, 139, 93, 0, 196, 117, 124, 114, 171, 236,
+	237, 113, 259, 100, 249, 96, 101, 248, 165, 232,
+	240, 159, 152, 95, 238, 157, 151, 142, 121, 131,
+	189, 149, 190, 132, 162, 161, 163, 0, 0, 0,
+	224, 246, 260, 105, 0, 231, 255, 256, 0, 0,
+	106, 125, 120, 188, 164, 102, 134, 221, 141, 148,
+	195, 258, 178, 201, 109, 245, 222, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 88, 97, 145, 0,
+	193, 123, 0, 0, 0, 0, 111, 212, 244, 185,
+	127, 247, 0, 0, 116, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 90, 91, 98, 104, 110,
+	115, 119, 122, 130, 133, 135, 136, 137, 140, 150,
+	153, 154, 155, 156, 166, 167, 168, 170, 173, 174,
+	175, 176, 177, 180, 182, 183, 184, 186, 187, 194,
+	197, 203, 204, 205, 206, 207, 208, 209, 216, 217,
+	218, 219, 225, 228, 234, 235, 251, 254, 172, 0,
+	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
+	0, 0, 0, 144, 0, 0, 146, 0, 0, 223,
+	160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 85, 86,
+	87, 0, 0, 1096, 0, 0, 1097, 0, 0, 107,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 126, 0, 0, 0, 278, 0,
+	0, 0, 0, 191, 0, 227, 129, 143, 103, 89,
+	99, 0, 128, 169, 198, 202, 0, 0, 0, 112,
+	0, 200, 179, 243, 0, 181, 199, 147, 233, 192,
+	242, 252, 253, 211, 213, 214, 230, 250, 257, 220,
+	92, 229, 241, 108, 210, 215, 0, 94, 239, 226,
+	158, 138, 139, 93, 0, 196, 117, 124, 114, 171,
+	236, 237, 113, 259, 100, 249, 96, 101, 248, 165,
+	232, 240, 159, 152, 95, 238, 157, 151, 142, 121,
+	131, 189, 149, 190, 132, 162, 161, 163, 0, 0,
+	0, 224, 246, 260, 105, 0, 231, 255, 256, 0,
+	0, 106, 125, 120, 188, 164, 102, 134, 221, 141,
+	148, 195, 258, 178, 201, 109, 245, 222, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 88, 97, 145,
+	0, 193, 123, 0, 0, 0, 0, 111, 212, 244,
+	185, 127, 247, 0, 0, 116, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 90, 91, 98, 104,
+	110, 115, 119, 122, 130, 133, 135, 136, 137, 140,
+	150, 153, 154, 155, 156, 166, 167, 168, 170, 173,
+	174, 175, 176, 177, 180, 182, 183, 184, 186, 187,
+	194, 197, 203, 204, 205, 206, 207, 208, 209, 216,
+	217, 218, 219, 225, 228, 234, 235, 251, 254, 172,
+	0, 0, 0, 970, 0, 0, 0, 0, 118, 0,
+	0, 0, 0, 0, 144, 0, 0, 146, 0, 0,
+	223, 160, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
-	94, 141, 249, 189, 119, 238, 0, 0, 112, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 87, 88, 95, 101, 107, 111, 115, 118, 123,
-	126, 129, 131, 132, 133, 136, 146, 149, 150, 151,
-	152, 162, 163, 164, 166, 169, 170, 171, 172, 173,
-	176, 178, 179, 180, 181, 182, 183, 190, 193, 199,
-	200, 201, 202, 203, 204, 205, 207, 208, 209, 210,
-	216, 219, 225, 226, 235, 242, 245,
+	86, 87, 0, 972, 0, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 0, 0, 0, 278,
+	0, 0, 0, 0, 191, 0, 227, 129, 143, 103,
+	89, 99, 0, 128, 169, 198, 202, 0, 0, 0,
+	112, 0, 200, 179, 243, 0, 968, 199, 147, 233,
+	192, 242, 252, 253, 211, 213, 214, 230, 250, 257,
+	220, 92, 229, 241, 108, 210, 215, 0, 94, 239,
+	226, 158, 138, 139, 93, 0, 196, 117, 124, 114,
+	171, 236, 237, 113, 259, 100, 249, 96, 101, 248,
+	165, 232, 240, 159, 152, 95, 238, 157, 151, 142,
+	121, 131, 189, 149, 190, 132, 162, 161, 163, 0,
+	0, 0, 224, 246, 260, 105, 0, 231, 255, 256,
+	0, 0, 106, 125, 120, 188, 164, 102, 134, 221,
+	141, 148, 195, 258, 178, 201, 109, 245, 222, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 88, 97,
+	145, 0, 193, 123, 0, 0, 0, 0, 111, 212,
+	244, 185, 127, 247, 0, 0, 116, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 91, 98,
+	104, 110, 115, 119, 122, 130, 133, 135, 136, 137,
+	140, 150, 153, 154, 155, 156, 166, 167, 168, 170,
+	173, 174, 175, 176, 177, 180, 182, 183, 184, 186,
+	187, 194, 197, 203, 204, 205, 206, 207, 208, 209,
+	216, 217, 218, 219, 225, 228, 234, 235, 251, 254,
+	172, 0, 0, 0, 0, 0, 0, 0, 0, 118,
+	0, 740, 0, 0, 0, 144, 0, 0, 146, 0,
+	0, 223, 160, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	85, 86, 87, 0, 739, 0, 0, 0, 0, 0,
+	0, 107, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 126, 0, 0, 0,
+	278, 0, 0, 0, 0, 191, 0, 227, 129, 143,
+	103, 89, 99, 0, 128, 169, 198, 202, 0, 0,
+	0, 112, 0, 200, 179, 243, 0, 181, 199, 147,
+	233, 192, 242, 252, 253, 211, 213, 214, 230, 250,
+	257, 220, 92, 229, 241, 108, 210, 215, 0, 94,
+	239, 226, 158, 138, 139, 93, 0, 196, 117, 124,
+	114, 171, 236, 237, 113, 259, 100, 249, 96, 101,
+	248, 165, 232, 240, 159, 152, 95, 238, 157, 151,
+	142, 121, 131, 189, 149, 190, 132, 162, 161, 163,
+	0, 0, 0, 224, 246, 260, 105, 0, 231, 255,
+	256, 0, 0, 106, 125, 120, 188, 164, 102, 134,
+	221, 141, 148, 195, 258, 178, 201, 109, 245, 222,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 88,
+	97, 145, 0, 193, 123, 0, 0, 0, 0, 111,
+	212, 244, 185, 127, 247, 0, 0, 116, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 90, 91,
+	98, 104, 110, 115, 119, 122, 130, 133, 135, 136,
+	137, 140, 150, 153, 154, 155, 156, 166, 167, 168,
+	170, 173, 174, 175, 176, 177, 180, 182, 183, 184,
+	186, 187, 194, 197, 203, 204, 205, 206, 207, 208,
+	209, 216, 217, 218, 219, 225, 228, 234, 235, 251,
+	254, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	118, 0, 0, 0, 0, 0, 144, 0, 0, 146,
+	0, 0, 223, 160, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	616, 85, 86, 87, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 126, 0, 0,
+	0, 278, 0, 0, 0, 0, 191, 0, 227, 129,
+	143, 103, 89, 99, 0, 128, 169, 198, 202, 0,
+	0, 0, 112, 0, 200, 179, 243, 0, 181, 199,
+	147, 233, 192, 242, 252, 253, 211, 213, 214, 230,
+	250, 257, 220, 92, 229, 241, 108, 210, 215, 0,
+	94, 239, 226, 158, 138, 139, 93, 0, 196, 117,
+	124, 114, 171, 236, 237, 113, 259, 100, 249, 96,
+	101, 248, 165, 232, 240, 159, 152, 95, 238, 157,
+	151, 142, 121, 131, 189, 149, 190, 132, 162, 161,
+	163, 0, 0, 0, 224, 246, 260, 105, 0, 231,
+	255, 256, 0, 0, 106, 125, 120, 188, 164, 102,
+	134, 221, 141, 148, 195, 258, 178, 201, 109, 245,
+	222, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	88, 97, 145, 0, 193, 123, 0, 0, 0, 0,
+	111, 212, 244, 185, 127, 247, 0, 0, 116, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 90,
+	91, 98, 104, 110, 115, 119, 122, 130, 133, 135,
+	136, 137, 140, 150, 153, 154, 155, 156, 166, 167,
+	168, 170, 173, 174, 175, 176, 177, 180, 182, 183,
+	184, 186, 187, 194, 197, 203, 204, 205, 206, 207,
+	208, 209, 216, 217, 218, 219, 225, 228, 234, 235,
+	251, 254, 172, 0, 0, 0, 0, 0, 0, 0,
+	0, 118, 0, 0, 0, 0, 0, 144, 0, 0,
+	146, 0, 0, 223, 160, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 61,
+	0, 0, 85, 86, 87, 0, 0, 0, 0, 0,
+	0, 0, 0, 107, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 126, 0,
+	0, 0, 278, 0, 0, 0, 0, 191, 0, 227,
+	129, 143, 103, 89, 99, 0, 128, 169, 198, 202,
+	0, 0, 0, 112, 0, 200, 179, 243, 0, 181,
+	199, 147, 233, 192, 242, 252, 253, 211, 213, 214,
+	230, 250, 257, 220, 92, 229, 241, 108, 210, 215,
+	0, 94, 239, 226, 158, 138, 139, 93, 0, 196,
+	117, 124, 114, 171, 236, 237, 113, 259, 100, 249,
+	96, 101, 248, 165, 232, 240, 159, 152, 95, 238,
+	157, 151, 142, 121, 131, 189, 149, 190, 132, 162,
+	161, 163, 0, 0, 0, 224, 246, 260, 105, 0,
+	231, 255, 256, 0, 0, 106, 125, 120, 188, 164,
+	102, 134, 221, 141, 148, 195, 258, 178, 201, 109,
+	245, 222, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 88, 97, 145, 0, 193, 123, 0, 0, 0,
+	0, 111, 212, 244, 185, 127, 247, 0, 0, 116,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	90, 91, 98, 104, 110, 115, 119, 122, 130, 133,
+	135, 136, 137, 140, 150, 153, 154, 155, 156, 166,
+	167, 168, 170, 173, 174, 175, 176, 177, 180, 182,
+	183, 184, 186, 187, 194, 197, 203, 204, 205, 206,
+	207, 208, 209, 216, 217, 218, 219, 225, 228, 234,
+	235, 251, 254, 172, 0, 0, 0, 0, 0, 0,
+	0, 0, 118, 0, 0, 0, 0, 0, 144, 0,
+	0, 146, 0, 0, 223, 160, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 85, 86, 87, 0, 972, 0, 0,
+	0, 0, 0, 0, 107, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	0, 0, 0, 278, 0, 0, 0, 0, 191, 0,
+	227, 129, 143, 103, 89, 99, 0, 128, 169, 198,
+	202, 0, 0, 0, 112, 0, 200, 179, 243, 0,
+	181, 199, 147, 233, 192, 242, 252, 253, 211, 213,
+	214, 230, 250, 257, 220, 92, 229, 241, 108, 210,
+	215, 0, 94, 239, 226, 158, 138, 139, 93, 0,
+	196, 117, 124, 114, 171, 236, 237, 113, 259, 100,
+	249, 96, 101, 248, 165, 232, 240, 159, 152, 95,
+	238, 157, 151, 142, 121, 131, 189, 149, 190, 132,
+	162, 161, 163, 0, 0, 0, 224, 246, 260, 105,
+	0, 231, 255, 256, 0, 0, 106, 125, 120, 188,
+	164, 102, 134, 221, 141, 148, 195, 258, 178, 201,
+	109, 245, 222, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 88, 97, 145, 0, 193, 123, 0, 0,
+	0, 0, 111, 212, 244, 185, 127, 247, 0, 0,
+	116, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 90, 91, 98, 104, 110, 115, 119, 122, 130,
+	133, 135, 136, 137, 140, 150, 153, 154, 155, 156,
+	166, 167, 168, 170, 173, 174, 175, 176, 177, 180,
+	182, 183, 184, 186, 187, 194, 197, 203, 204, 205,
+	206, 207, 208, 209, 216, 217, 218, 219, 225, 228,
+	234, 235, 251, 254, 172, 0, 0, 0, 0, 0,
+	0, 0, 0, 118, 0, 0, 0, 0, 0, 144,
+	0, 0, 146, 0, 0, 223, 160, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 86, 87, 0, 625, 0,
+	0, 0, 0, 0, 0, 107, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	126, 0, 0, 0, 278, 0, 0, 0, 0, 191,
+	0, 227, 129, 143, 103, 89, 99, 0, 128, 169,
+	198, 202, 0, 0, 0, 112, 0, 200, 179, 243,
+	0, 181, 199, 147, 233, 192, 242, 252, 253, 211,
+	213, 214, 230, 250, 257, 220, 92, 229, 241, 108,
+	210, 215, 0, 94, 239, 226, 158, 138, 139, 93,
+	0, 196, 117, 124, 114, 171, 236, 237, 113, 259,
+	100, 249, 96, 101, 248, 165, 232, 240, 159, 152,
+	95, 238, 157, 151, 142, 121, 131, 189, 149, 190,
+	132, 162, 161, 163, 0, 0, 0, 224, 246, 260,
+	105, 0, 231, 255, 256, 0, 0, 106, 125, 120,
+	188, 164, 102, 134, 221, 141, 148, 195, 258, 178,
+	201, 109, 245, 222, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 97, 145, 0, 193, 123, 0,
+	0, 0, 0, 111, 212, 244, 185, 127, 247, 0,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 90, 91, 98, 104, 110, 115, 119, 122,
+	130, 133, 135, 136, 137, 140, 150, 153, 154, 155,
+	156, 166, 167, 168, 170, 173, 174, 175, 176, 177,
+	180, 182, 183, 184, 186, 187, 194, 197, 203, 204,
+	205, 206, 207, 208, 209, 216, 217, 218, 219, 225,
+	228, 234, 235, 251, 254, 172, 0, 0, 0, 0,
+	0, 0, 0, 710, 118, 0, 0, 0, 0, 0,
+	144, 0, 0, 146, 0, 0, 223, 160, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 86, 87, 0, 0,
+	0, 0, 0, 0, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 278, 0, 0, 0, 0,
+	191, 0, 227, 129, 143, 103, 89, 99, 0, 128,
+	169, 198, 202, 0, 0, 0, 112, 0, 200, 179,
+	243, 0, 181, 199, 147, 233, 192, 242, 252, 253,
+	211, 213, 214, 230, 250, 257, 220, 92, 229, 241,
+	108, 210, 215, 0, 94, 239, 226, 158, 138, 139,
+	93, 0, 196, 117, 124, 114, 171, 236, 237, 113,
+	259, 100, 249, 96, 101, 248, 165, 232, 240, 159,
+	152, 95, 238, 157, 151, 142, 121, 131, 189, 149,
+	190, 132, 162, 161, 163, 0, 0, 0, 224, 246,
+	260, 105, 0, 231, 255, 256, 0, 0, 106, 125,
+	120, 188, 164, 102, 134, 221, 141, 148, 195, 258,
+	178, 201, 109, 245, 222, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 97, 145, 0, 193, 123,
+	0, 0, 0, 0, 111, 212, 244, 185, 127, 247,
+	0, 0, 116, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 91, 98, 104, 110, 115, 119,
+	122, 130, 133, 135, 136, 137, 140, 150, 153, 154,
+	155, 156, 166, 167, 168, 170, 173, 174, 175, 176,
+	177, 180, 182, 183, 184, 186, 187, 194, 197, 203,
+	204, 205, 206, 207, 208, 209, 216, 217, 218, 219,
+	225, 228, 234, 235, 251, 254, 392, 0, 0, 0,
+	0, 0, 0, 172, 0, 0, 0, 0, 0, 0,
+	0, 0, 118, 0, 0, 0, 0, 0, 144, 0,
+	0, 146, 0, 0, 223, 160, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 85, 86, 87, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	0, 0, 0, 278, 0, 0, 0, 0, 191, 0,
+	227, 129, 143, 103, 89, 99, 0, 128, 169, 198,
+	202, 0, 0, 0, 112, 0, 200, 179, 243, 0,
+	181, 199, 147, 233, 192, 242, 252, 253, 211, 213,
+	214, 230, 250, 257, 220, 92, 229, 241, 108, 210,
+	215, 0, 94, 239, 226, 158, 138, 139, 93, 0,
+	196, 117, 124, 114, 171, 236, 237, 113, 259, 100,
+	249, 96, 101, 248, 165, 232, 240, 159, 152, 95,
+	238, 157, 151, 142, 121, 131, 189, 149, 190, 132,
+	162, 161, 163, 0, 0, 0, 224, 246, 260, 105,
+	0, 231, 255, 256, 0, 0, 106, 125, 120, 188,
+	164, 102, 134, 221, 141, 148, 195, 258, 178, 201,
+	109, 245, 222, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 88, 97, 145, 0, 193, 123, 0, 0,
+	0, 0, 111, 212, 244, 185, 127, 247, 0, 0,
+	116, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 90, 91, 98, 104, 110, 115, 119, 122, 130,
+	133, 135, 136, 137, 140, 150, 153, 154, 155, 156,
+	166, 167, 168, 170, 173, 174, 175, 176, 177, 180,
+	182, 183, 184, 186, 187, 194, 197, 203, 204, 205,
+	206, 207, 208, 209, 216, 217, 218, 219, 225, 228,
+	234, 235, 251, 254, 172, 0, 0, 0, 0, 0,
+	0, 0, 0, 118, 0, 0, 0, 0, 0, 144,
+	0, 0, 146, 0, 0, 223, 160, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 86, 87, 0, 0, 0,
+	0, 0, 0, 0, 0, 107, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	126, 0, 273, 0, 278, 0, 0, 0, 0, 191,
+	0, 227, 129, 143, 103, 89, 99, 0, 128, 169,
+	198, 202, 0, 0, 0, 112, 0, 200, 179, 243,
+	0, 181, 199, 147, 233, 192, 242, 252, 253, 211,
+	213, 214, 230, 250, 257, 220, 92, 229, 241, 108,
+	210, 215, 0, 94, 239, 226, 158, 138, 139, 93,
+	0, 196, 117, 124, 114, 171, 236, 237, 113, 259,
+	100, 249, 96, 101, 248, 165, 232, 240, 159, 152,
+	95, 238, 157, 151, 142, 121, 131, 189, 149, 190,
+	132, 162, 161, 163, 0, 0, 0, 224, 246, 260,
+	105, 0, 231, 255, 256, 0, 0, 106, 125, 120,
+	188, 164, 102, 134, 221, 141, 148, 195, 258, 178,
+	201, 109, 245, 222, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 97, 145, 0, 193, 123, 0,
+	0, 0, 0, 111, 212, 244, 185, 127, 247, 0,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 90, 91, 98, 104, 110, 115, 119, 122,
+	130, 133, 135, 136, 137, 140, 150, 153, 154, 155,
+	156, 166, 167, 168, 170, 173, 174, 175, 176, 177,
+	180, 182, 183, 184, 186, 187, 194, 197, 203, 204,
+	205, 206, 207, 208, 209, 216, 217, 218, 219, 225,
+	228, 234, 235, 251, 254, 172, 0, 0, 0, 0,
+	0, 0, 0, 0, 118, 0, 0, 0, 0, 0,
+	144, 0, 0, 146, 0, 0, 223, 160, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 85, 86, 87, 0, 0,
+	0, 0, 0, 0, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 278, 0, 0, 0, 0,
+	191, 0, 227, 129, 143, 103, 89, 99, 0, 128,
+	169, 198, 202, 0, 0, 0, 112, 0, 200, 179,
+	243, 0, 181, 199, 147, 233, 192, 242, 252, 253,
+	211, 213, 214, 230, 250, 257, 220, 92, 229, 241,
+	108, 210, 215, 0, 94, 239, 226, 158, 138, 139,
+	93, 0, 196, 117, 124, 114, 171, 236, 237, 113,
+	259, 100, 249, 96, 101, 248, 165, 232, 240, 159,
+	152, 95, 238, 157, 151, 142, 121, 131, 189, 149,
+	190, 132, 162, 161, 163, 0, 0, 0, 224, 246,
+	260, 105, 0, 231, 255, 256, 0, 0, 106, 125,
+	120, 188, 164, 102, 134, 221, 141, 148, 195, 258,
+	178, 201, 109, 245, 222, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 97, 145, 0, 193, 123,
+	0, 0, 0, 0, 111, 212, 244, 185, 127, 247,
+	0, 0, 116, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 91, 98, 104, 110, 115, 119,
+	122, 130, 133, 135, 136, 137, 140, 150, 153, 154,
+	155, 156, 166, 167, 168, 170, 173, 174, 175, 176,
+	177, 180, 182, 183, 184, 186, 187, 194, 197, 203,
+	204, 205, 206, 207, 208, 209, 216, 217, 218, 219,
+	225, 228, 234, 235, 251, 254, 172, 0, 0, 0,
+	0, 0, 0, 0, 0, 118, 0, 0, 0, 0,
+	0, 144, 0, 0, 146, 0, 0, 223, 160, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 85, 86, 87, 0,
+	0, 0, 0, 0, 0, 0, 0, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 126, 0, 0, 0, 278, 0, 0, 0,
+	0, 191, 0, 227, 129, 143, 103, 89, 99, 0,
+	128, 169, 198, 202, 0, 0, 0, 112, 0, 200,
+	179, 243, 0, 1464, 199, 147, 233, 192, 242, 252,
+	253, 211, 213, 214, 230, 250, 257, 220, 92, 229,
+	241, 108, 210, 215, 0, 94, 239, 226, 158, 138,
+	139, 93, 0, 196, 117, 124, 114, 171, 236, 237,
+	113, 259, 100, 249, 96, 101, 248, 165, 232, 240,
+	159, 152, 95, 238, 157, 151, 142, 121, 131, 189,
+	149, 190, 132, 162, 161, 163, 0, 0, 0, 224,
+	246, 260, 105, 0, 231, 255, 256, 0, 0, 106,
+	125, 120, 188, 164, 102, 134, 221, 141, 148, 195,
+	258, 178, 201, 109, 245, 222, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 88, 97, 145, 0, 193,
+	123, 0, 0, 0, 0, 111, 212, 244, 185, 127,
+	247, 0, 0, 116, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 90, 91, 98, 104, 110, 115,
+	119, 122, 130, 133, 135, 136, 137, 140, 150, 153,
+	154, 155, 156, 166, 167, 168, 170, 173, 174, 175,
+	176, 177, 180, 182, 183, 184, 186, 187, 194, 197,
+	203, 204, 205, 206, 207, 208, 209, 216, 217, 218,
+	219, 225, 228, 234, 235, 251, 254, 172, 0, 0,
+	0, 0, 0, 0, 0, 0, 118, 0, 0, 0,
+	0, 0, 144, 0, 0, 146, 0, 0, 223, 160,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 85, 86, 87,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 126, 0, 0, 0, 278, 0, 0,
+	0, 0, 191, 0, 227, 129, 143, 103, 89, 99,
+	0, 128, 169, 198, 202, 0, 0, 0, 112, 0,
+	200, 179, 243, 0, 181, 199, 147, 233, 192, 242,
+	252, 253, 211, 213, 214, 230, 250, 257, 220, 92,
+	229, 241, 108, 210, 596, 0, 94, 239, 226, 158,
+	138, 139, 93, 0, 196, 117, 124, 114, 171, 236,
+	237, 113, 259, 100, 249, 96, 101, 248, 165, 232,
+	240, 159, 152, 95, 238, 157, 151, 142, 121, 131,
+	189, 149, 190, 132, 162, 161, 163, 0, 0, 0,
+	224, 246, 260, 105, 0, 231, 255, 256, 0, 0,
+	106, 125, 120, 188, 164, 102, 134, 221, 141, 148,
+	195, 258, 178, 201, 109, 245, 222, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 88, 97, 145, 0,
+	193, 123, 0, 0, 0, 0, 111, 212, 244, 185,
+	127, 247, 0, 0, 116, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 90, 91, 98, 104, 110,
+	115, 119, 122, 130, 133, 135, 136, 137, 140, 150,
+	153, 154, 155, 156, 166, 167, 168, 170, 173, 174,
+	175, 176, 177, 180, 182, 183, 184, 186, 187, 194,
+	197, 203, 204, 205, 206, 207, 208, 209, 216, 217,
+	218, 219, 225, 228, 234, 235, 251, 254,
 }
 var yyPact = [...]int{
 
-	2434, -1000, -263, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1673, -1000, -268, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 913, 958, -1000, -1000, -1000, -1000, -1000, -1000,
-	264, 11582, 44, 128, 37, 15585, 127, 1575, 15917, -1000,
-	20, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -65, -87,
-	-1000, 697, -1000, -1000, -1000, -1000, -1000, 907, 911, 765,
-	901, 808, -1000, 8250, 91, 91, 15253, 6922, -1000, -1000,
-	345, 15917, 120, 15917, -132, 89, 89, 89, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 857, -1000, -1000, -1000, -1000,
+	-1000, 499, 12305, 33, 161, 42, 16416, 160, 1543, 16757,
+	-1000, 51, -1000, 131, 16757, 40, -1000, -1000, -1000, -1000,
+	-1000, -37, -52, -1000, 998, 1065, -1000, 16757, -1000, -1000,
+	133, -1000, -1000, -1000, -1000, 9236, -1000, 118, 118, 16075,
+	7519, -1000, -1000, 316, 16757, 152, 16757, -101, 114, 114,
+	114, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2534,22 +2712,21 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 125, 15917, 497, 497, 211, -1000, 15917,
-	86, 497, 86, 86, 86, 15917, -1000, 171, -1000, -1000,
-	-1000, 15917, 497, 844, 303, 77, 4507, -1000, 946, 945,
-	-1000, 4507, 38, 4507, -50, 928, 24, -21, -1000, 4507,
+	-1000, 157, 16757, 636, 636, 244, -1000, 16757, 113, 636,
+	113, 113, 113, 16757, -1000, 216, -1000, -1000, -1000, 16757,
+	636, 939, 389, 141, 5041, -1000, 1043, 1042, -1000, 5041,
+	58, 5041, -13, 1022, 60, 46, -1000, 5041, -1000, -1000,
+	-1000, -1000, -1000, 17439, -1000, 16757, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 983, 995, 838, 1002, 904, 787,
+	-1000, 823, 559, 1034, -1000, 11964, 215, -1000, 10259, 1862,
+	792, -1000, -1000, 792, -1000, -1000, 192, -1000, -1000, 11282,
+	11282, 11282, 11282, 11282, 11282, 11282, 11282, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 343, 881, 9590, 9590, 913, -1000, 697,
-	-1000, -1000, -1000, 859, -1000, -1000, 297, 942, -1000, 11250,
-	170, -1000, 9590, 402, 687, -1000, -1000, 687, -1000, -1000,
-	158, -1000, -1000, 10586, 10586, 10586, 10586, 10586, 10586, 10586,
-	10586, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 687, -1000, 9258, 687, 687,
-	687, 687, 687, 687, 687, 687, 9590, 687, 687, 687,
-	687, 687, 687, 687, 687, 687, 687, 687, 687, 687,
-	687, 687, 687, 14914, 13918, 15917, 684, 664, -1000, -1000,
-	168, 678, 6577, -77, -1000, -1000, -1000, 254, 13254, -1000,
-	-1000, -1000, 843, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 792, -1000, 8554, 792, 792, 792, 792, 792, 792,
+	792, 792, 10259, 792, 792, 792, 792, 792, 792, 792,
+	792, 792, 792, 792, 792, 792, 792, 792, 792, 459,
+	15727, 14704, 16757, 780, 698, -1000, -1000, 214, 779, 7165,
+	-75, -1000, -1000, -1000, 335, 14022, -1000, -1000, -1000, 935,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2561,298 +2738,320 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 641, 15917, -1000, 1858, -1000, 497, 4507, 106,
-	497, 274, 497, 15917, 15917, 4507, 4507, 4507, 36, 78,
-	72, 15917, 683, 95, 15917, 892, 773, 15917, 497, 497,
-	-1000, 5887, -1000, 4507, 303, -1000, 468, 9590, 4507, 4507,
-	4507, 15917, 4507, 4507, -1000, -1000, -1000, 15917, 15917, -1000,
-	4507, 4507, -1000, 941, 307, -1000, -1000, -1000, -1000, 9590,
-	214, -1000, 770, -1000, -1000, -1000, -1000, -1000, -1000, 953,
-	202, 509, 164, 679, -1000, 373, 907, 343, 808, 12922,
-	786, -1000, -1000, -1000, 15917, -1000, 9590, 9590, 521, -1000,
-	14582, -1000, -1000, 5542, 212, 10586, 377, 288, 10586, 10586,
-	10586, 10586, 10586, 10586, 10586, 10586, 10586, 10586, 10586, 10586,
-	10586, 10586, 10586, 481, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 497, -1000, 697, 603, 603, 185, 185, 185,
-	185, 185, 185, 185, 10918, 7254, 343, 637, 379, 9258,
-	8250, 8250, 9590, 9590, 8914, 8582, 8250, 863, 267, 379,
-	15917, -1000, -1000, 10254, -1000, -1000, -1000, -1000, -1000, 343,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 15917, 15917, 8250,
-	8250, 8250, 8250, 8250, 54, 15917, -1000, 670, 861, -1000,
-	-1000, -1000, 898, 12258, 12590, 54, 665, 13918, 15917, -1000,
-	-1000, 13918, 15917, 5197, 6232, 678, -77, 651, -1000, -106,
-	-99, 7586, 179, -1000, -1000, -1000, -1000, 4162, 310, 501,
-	364, -38, -1000, -1000, -1000, 701, -1000, 701, 701, 701,
-	701, -7, -7, -7, -7, -1000, -1000, -1000, -1000, -1000,
-	734, 731, -1000, 701, 701, 701, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 729, 729, 729, 714, 714, 713,
-	-1000, 15917, 4507, 880, 4507, -1000, 73, -1000, -1000, -1000,
-	15917, 15917, 15917, 15917, 15917, 138, 15917, 15917, 677, -1000,
-	15917, 4507, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	379, -1000, -1000, -1000, -1000, -1000, -1000, 307, 307, -1000,
-	-1000, 15917, 303, 15917, 15917, 379, -1000, 449, 15917, -1000,
-	800, 9590, 9590, 5887, 9590, -1000, -1000, -1000, 881, -1000,
-	863, 919, -1000, 819, 818, 8250, -1000, -1000, 212, 339,
-	-1000, -1000, 411, -1000, -1000, -1000, -1000, 163, 687, -1000,
-	2114, -1000, -1000, -1000, -1000, 377, 10586, 10586, 10586, 320,
-	2114, 2099, 547, 416, 185, 285, 285, 207, 207, 207,
-	207, 207, 473, 473, -1000, -1000, -1000, 343, -1000, -1000,
-	-1000, 343, 8250, 8250, 675, -1000, -1000, 9590, -1000, 343,
-	628, 628, 302, 337, 259, 932, 628, 257, 930, 628,
-	628, 8250, 325, -1000, 9590, 343, -1000, 156, -1000, 761,
-	671, 669, 628, 343, 343, 628, 628, 681, 687, -1000,
-	15917, 13918, 13918, 13918, 13918, 13918, -1000, 792, 791, -1000,
-	785, 784, 799, 15917, -1000, 634, 12258, 197, 687, -1000,
-	14250, -1000, -1000, 927, 13918, 698, -1000, 698, -1000, 154,
-	-1000, -1000, 651, -77, -69, -1000, -1000, -1000, -1000, 379,
-	-1000, 537, 650, 3817, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 723, 497, -1000, 866, 203, 252, 497, 855, -1000,
-	-1000, -1000, 846, -1000, 281, -58, -1000, -1000, 384, -7,
-	-7, -1000, -1000, 179, 842, 179, 179, 179, 448, 448,
-	-1000, -1000, -1000, -1000, 383, -1000, -1000, -1000, 376, -1000,
-	768, 15917, 4507, -1000, -1000, -1000, -1000, 167, 167, 213,
+	-1000, -1000, 729, 16757, -1000, 247, -1000, 636, 5041, 138,
+	636, 403, 636, 16757, 16757, 5041, 5041, 5041, 70, 115,
+	126, 16757, 784, 127, 16757, 972, 850, 16757, 636, 636,
+	-1000, 6457, -1000, 5041, 389, -1000, 552, 10259, 5041, 5041,
+	5041, 16757, 5041, 5041, -1000, -1000, -1000, 16757, 16757, -1000,
+	5041, 5041, -1000, 1029, 383, -1000, -1000, -1000, -1000, 10259,
+	302, -1000, 849, 61, -1000, -1000, 16757, -1000, -1000, -1000,
+	949, 10259, 10259, 998, -1000, 133, -1000, -1000, -1000, 945,
+	-1000, -1000, 16757, 792, 16757, -1000, -1000, 16757, -1000, 10259,
+	10259, 572, -1000, 15386, -1000, -1000, 6103, 297, 213, 11282,
+	494, 435, 11282, 11282, 11282, 11282, 11282, 11282, 11282, 11282,
+	11282, 11282, 11282, 11282, 11282, 11282, 11282, 592, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 636, -1000, 133, 651,
+	651, 251, 251, 251, 251, 251, 251, 251, 11623, 7860,
+	559, 727, 294, 8554, 9236, 9236, 10259, 10259, 9918, 9577,
+	9236, 940, 384, 294, 16757, -1000, -1000, 10941, -1000, -1000,
+	-1000, -1000, -1000, 559, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 16757, 16757, 9236, 9236, 9236, 9236, 9236, -1000, 83,
+	16757, -1000, 766, 806, -1000, -1000, -1000, 977, 12658, 13681,
+	83, 739, 14704, 16757, -1000, -1000, 14704, 16757, 5749, 6811,
+	779, -75, 765, -1000, -69, -40, 8201, 245, -1000, -1000,
+	-1000, -1000, 4687, 275, 668, 429, -24, -1000, -1000, -1000,
+	807, -1000, 807, 807, 807, 807, 9, 9, 9, 9,
+	-1000, -1000, -1000, -1000, -1000, 829, 827, -1000, 807, 807,
+	807, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 825,
+	825, 825, 811, 811, 834, -1000, 16757, 5041, 970, 5041,
+	-1000, 91, -1000, -1000, -1000, 16757, 16757, 16757, 65, 16757,
+	16757, 64, 173, 16757, 16757, 748, -1000, 16757, 5041, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 294, -1000, -1000,
+	-1000, -1000, -1000, -1000, 383, 383, -1000, -1000, 16757, 389,
+	16757, 16757, 294, -1000, 550, 16757, -1000, -1000, -1000, 1056,
+	273, 681, 774, -1000, 461, 983, 559, 904, 13340, 843,
+	-1000, -1000, -1000, -1000, 630, -1000, -1000, 297, 296, -1000,
+	-1000, 436, -1000, -1000, -1000, -1000, 205, 792, -1000, 6457,
+	1990, -1000, -1000, -1000, -1000, 494, 11282, 11282, 11282, 392,
+	1990, 2045, 560, 1030, 251, 237, 237, 241, 241, 241,
+	241, 241, 416, 416, -1000, -1000, -1000, 559, -1000, -1000,
+	-1000, 559, 9236, 9236, 769, -1000, -1000, 10259, -1000, 559,
+	723, 723, 509, 513, 306, 1028, 723, 288, 1027, 723,
+	723, 9236, 363, -1000, 10259, 559, -1000, 200, -1000, 530,
+	768, 767, 723, 559, 559, 723, 723, 107, 792, -1000,
+	16757, 14704, 14704, 14704, 14704, 14704, -1000, 899, 880, -1000,
+	873, 870, 874, 16757, -1000, 725, 12658, 207, 792, -1000,
+	15045, -1000, -1000, 1019, 14704, 706, -1000, 706, -1000, 196,
+	-1000, -1000, 765, -75, -50, -1000, -1000, -1000, -1000, 294,
+	-1000, 688, 764, 4333, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 824, 636, -1000, 958, 225, 453, 636, 957, -1000,
+	-1000, -1000, 941, -1000, 430, -27, -1000, -1000, 531, 9,
+	9, -1000, -1000, 245, 929, 245, 245, 245, 547, 547,
+	-1000, -1000, -1000, -1000, 523, -1000, -1000, -1000, 503, -1000,
+	848, 16757, 5041, -1000, -1000, -1000, -1000, 773, 773, 285,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 53, 699, -1000, -1000, -1000, -1000, 19, 30, 93,
-	-1000, 4507, -1000, 303, 303, 307, -1000, -1000, -1000, -1000,
-	-1000, 806, 379, 379, 148, -1000, -1000, 15917, -1000, -1000,
-	-1000, -1000, 559, -1000, -1000, -1000, 4852, 8250, -1000, 320,
-	2114, 1822, -1000, 10586, 10586, -1000, -1000, 628, 628, 8250,
-	379, -1000, -1000, -1000, 29, 481, 29, 10586, 10586, -1000,
-	10586, 10586, -1000, -146, 702, 263, -1000, 9590, 358, -1000,
-	5887, -1000, 10586, 10586, -1000, -1000, -1000, -1000, -1000, 766,
-	15917, 687, -1000, 12258, 15917, 712, -1000, 248, 861, 720,
-	764, 762, -1000, -1000, -1000, -1000, 788, -1000, 771, -1000,
-	-1000, -1000, -1000, -1000, 117, 116, 115, 15917, -1000, 913,
-	9590, 698, -1000, -1000, 194, -1000, -1000, -115, -113, -1000,
-	-1000, -1000, 4162, -1000, 4162, 15917, 69, -1000, 497, 497,
-	-1000, -1000, -1000, 717, 760, 10586, -1000, -1000, -1000, 496,
-	179, 179, -1000, 233, -1000, -1000, -1000, 626, -1000, 624,
-	588, 622, 15917, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 82, 831, -1000, -1000, 16757, -1000, -1000, 16757, 43,
+	69, 125, -1000, 5041, -1000, 389, 389, 383, -1000, -1000,
+	-1000, -1000, -1000, -1000, 911, 10259, 10259, 10259, -1000, -1000,
+	-1000, 949, -1000, 940, 1013, -1000, 923, 922, 9236, -1000,
+	975, 16757, -1000, -1000, -1000, 5395, 9236, 190, -1000, 392,
+	1990, 2006, -1000, 11282, 11282, -1000, -161, 723, 723, 9236,
+	294, -1000, -1000, -1000, 124, 592, 124, 11282, 11282, -1000,
+	11282, 11282, -1000, -113, 772, 347, -1000, 10259, 475, -1000,
+	6457, -1000, 11282, 11282, -1000, -1000, -1000, -1000, -1000, 846,
+	16757, 792, -1000, 12658, 16757, 782, -1000, 334, 806, 819,
+	845, 1014, -1000, -1000, -1000, -1000, 879, -1000, 861, -1000,
+	-1000, -1000, -1000, -1000, 148, 144, 128, 16757, -1000, 998,
+	10259, 706, -1000, -1000, 264, -1000, -1000, -84, -53, -1000,
+	-1000, -1000, 4687, -1000, 4687, 16757, 98, -1000, 636, 636,
+	-1000, -1000, -1000, 816, 844, 11282, -1000, -1000, -1000, 649,
+	245, 245, -1000, 445, -1000, -1000, -1000, 720, -1000, 718,
+	761, 714, 16757, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 15917, -1000, -1000, -1000, -1000, -1000, 15917, -152,
-	497, 15917, 15917, 15917, 15917, -1000, -1000, -1000, 303, -1000,
-	5887, -1000, 927, 13918, -1000, -1000, 343, -1000, 10586, 2114,
-	2114, -1000, -1000, -1000, 343, 701, 701, -1000, 701, 714,
-	-1000, 701, 10, 701, 9, 343, 343, 1898, 1773, 1716,
-	1673, 687, -139, -1000, 379, 9590, -1000, 1610, 1484, -1000,
-	872, 570, 550, -1000, -1000, 7918, 343, 602, 144, 584,
-	-1000, 913, 15917, 9590, -1000, -1000, 9590, 711, -1000, 9590,
-	-1000, -1000, -1000, 687, 687, 687, 584, 907, 379, -1000,
-	-1000, -1000, -1000, 3817, -1000, 581, -1000, 701, -1000, -1000,
-	-1000, 15917, -33, 952, 2114, -1000, -1000, -1000, -1000, -1000,
-	-7, 424, -7, 338, -1000, 317, 4507, -1000, -1000, -1000,
-	-1000, 876, -1000, 5887, -1000, -1000, 694, 703, -1000, -1000,
-	-1000, 918, 579, -1000, 2114, -1000, -1000, 124, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 10586, 10586, 10586, 10586,
-	10586, 907, 423, 379, 10586, 10586, 854, -1000, 687, -1000,
-	-1000, 695, 15917, 15917, -1000, 15917, 907, -1000, 379, 379,
-	15917, 379, 13586, 15917, 15917, 11914, -1000, 161, 15917, -1000,
-	576, -1000, 191, -1000, -142, 179, -1000, 179, 494, 475,
-	-1000, 687, 565, -1000, 240, 15917, 15917, 915, 909, -1000,
-	-1000, 761, 761, 761, 761, 48, 343, -1000, 761, 761,
-	950, -1000, 687, -1000, 697, 135, -1000, -1000, -1000, 563,
-	553, -1000, 553, 553, 197, 161, -1000, 497, 221, 415,
-	-1000, 66, 15917, 292, 850, -1000, 849, -1000, -1000, -1000,
-	-1000, -1000, 52, 5887, 4162, 530, -1000, -1000, 9590, 9590,
-	-1000, -1000, -1000, -1000, 343, 65, -156, -1000, -1000, -1000,
-	15917, 550, 343, 15917, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 309, -1000, -1000, 15917, -1000, -1000, 374, -1000, -1000,
-	510, -1000, 15917, -1000, -1000, 699, 379, 525, -1000, 803,
-	-150, -169, 417, -1000, -1000, -1000, 691, -1000, -1000, 52,
-	817, -152, -1000, 797, -1000, 15917, -1000, 49, -1000, -154,
-	490, 41, -166, 751, 687, -170, 747, -1000, 939, 9922,
-	-1000, -1000, 949, 210, 210, 761, 343, -1000, -1000, -1000,
-	74, 382, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 16757, -1000, -1000, -1000, -1000, -1000, 16757, -123,
+	636, -1000, 122, 16757, 16757, 16757, 16757, -1000, -1000, -1000,
+	389, 909, 294, 294, -1000, -1000, 16757, -1000, -1000, -1000,
+	-1000, 781, 792, -1000, -1000, -1000, 559, 6457, -1000, 11282,
+	1990, 1990, -1000, 14704, -161, -161, -1000, 559, 807, 807,
+	-1000, 807, 811, -1000, 807, 34, 807, 25, 559, 559,
+	1935, 1897, 1777, 1708, 792, -108, -1000, 294, 10259, -1000,
+	1196, 833, -1000, 960, 600, 689, -1000, -1000, 8895, 559,
+	712, 187, 708, -1000, 998, 16757, 10259, -1000, -1000, 10259,
+	810, -1000, 10259, -1000, -1000, -1000, 792, 792, 792, 708,
+	983, 294, -1000, -1000, -1000, -1000, 4333, -1000, 675, -1000,
+	807, -1000, -1000, -1000, 16757, -14, 1053, 1990, -1000, -1000,
+	-1000, -1000, -1000, 9, 545, 9, 477, -1000, 469, 5041,
+	-1000, -1000, -1000, -1000, 962, -1000, 6457, -1000, -1000, 16757,
+	803, 832, -1000, -1000, -1000, -1000, -1000, 1019, 14704, -1000,
+	-1000, 1990, -1000, -1000, 17098, -1000, -1000, -1000, -1000, 162,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 11282, 11282,
+	11282, 11282, 11282, 983, 544, 294, 11282, 11282, 955, -1000,
+	792, -1000, -1000, 117, 16757, 16757, -1000, 16757, 983, -1000,
+	294, 294, 16757, 294, 14363, 16757, 16757, 12999, -1000, 188,
+	16757, -1000, 648, 221, -1000, -120, 245, -1000, 245, 584,
+	582, -1000, 792, 749, -1000, 330, 748, 16757, 16757, 1017,
+	702, 559, 81, 998, 990, -1000, -1000, 530, 530, 530,
+	530, 80, 559, -1000, 530, 530, 1046, -1000, 792, -1000,
+	133, 179, -1000, -1000, -1000, 644, 630, -1000, 630, 630,
+	207, 188, -1000, 636, 326, 542, -1000, 95, 456, 950,
+	-1000, 948, -1000, -1000, -1000, -1000, -1000, 79, 6457, 4687,
+	590, -1000, 1015, 993, -1000, 559, 990, -166, 10259, -1000,
+	-1000, -1000, -1000, 559, 103, -131, -1000, -1000, -1000, 16757,
+	689, 559, 16757, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	468, -1000, -1000, 16757, -1000, 533, -1000, -1000, 566, -1000,
+	16757, -1000, -1000, 831, -167, 10259, 10259, -1000, -1000, 59,
+	-1000, -1000, 677, -1000, 908, -121, -145, 680, -1000, -1000,
+	-1000, 802, -1000, -1000, 79, 916, -123, -1000, 16757, 294,
+	677, -1000, 45, -175, -179, -181, -1000, -1000, 11282, -1000,
+	907, -1000, 16757, -1000, 76, -1000, 561, -1000, 974, 407,
+	-1000, -1000, -1000, -1000, -1000, 11623, -124, 563, 74, 16757,
+	792, 45, -1000, -142, 841, 792, -1000, -1000, -1000, -154,
+	808, -1000, 1026, 10600, -1000, -1000, 1032, 220, 220, 530,
+	559, -1000, -1000, -1000, 104, 567, -1000, -1000, -1000, -1000,
+	-1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 1178, 26, 544, 1177, 1176, 1175, 1171, 1168, 1161,
-	1160, 1159, 1157, 1156, 1155, 1154, 1153, 1152, 1151, 1149,
-	1147, 1144, 1143, 1142, 1140, 1137, 96, 1134, 1133, 1132,
-	80, 1131, 77, 1122, 1121, 46, 252, 52, 48, 702,
-	1120, 29, 69, 61, 1119, 41, 1118, 1112, 82, 1111,
-	1109, 57, 1108, 1104, 94, 1103, 66, 1102, 14, 55,
-	1099, 1095, 1093, 1089, 76, 1208, 1086, 1085, 16, 1084,
-	1083, 93, 1081, 60, 15, 11, 13, 22, 1080, 43,
-	6, 1075, 73, 1074, 1073, 1072, 1070, 24, 1067, 63,
-	1066, 25, 62, 1065, 9, 75, 40, 23, 2, 83,
-	79, 1064, 30, 65, 54, 1063, 1062, 409, 1061, 1058,
-	51, 1057, 1056, 33, 1055, 99, 525, 1054, 1053, 1051,
-	1050, 56, 960, 1697, 17, 81, 1049, 1048, 1047, 2451,
-	47, 59, 18, 1046, 44, 53, 45, 1040, 1038, 42,
-	1037, 1036, 1035, 1033, 1031, 1030, 1029, 21, 1026, 1024,
-	1019, 20, 35, 1017, 1015, 64, 31, 1014, 1012, 1007,
-	49, 70, 1006, 1005, 58, 28, 1003, 1002, 993, 989,
-	985, 37, 7, 982, 19, 980, 12, 971, 34, 970,
-	8, 969, 10, 968, 5, 0, 967, 4, 50, 1,
-	966, 3, 965, 964, 1577, 190, 84, 950, 86,
+	0, 1310, 104, 990, 96, 1309, 1308, 1307, 1305, 85,
+	1291, 1290, 1289, 1287, 1285, 1283, 1282, 1281, 1280, 1279,
+	1277, 1276, 1275, 1274, 1273, 1272, 1269, 1266, 1264, 1263,
+	1262, 1261, 86, 1260, 1259, 1253, 79, 1247, 66, 1246,
+	1238, 49, 59, 54, 53, 1268, 1237, 26, 73, 64,
+	1235, 46, 1233, 1228, 28, 1227, 1226, 63, 1222, 1220,
+	2178, 1219, 88, 1217, 14, 34, 1215, 1213, 1211, 1210,
+	81, 38, 1209, 1205, 18, 1204, 1203, 97, 1198, 76,
+	8, 16, 24, 31, 1197, 141, 12, 1194, 65, 1192,
+	1191, 1189, 1188, 1187, 1186, 4, 35, 5, 17, 1185,
+	1184, 1183, 3, 1180, 21, 1179, 55, 1174, 25, 50,
+	1172, 7, 82, 47, 36, 13, 87, 67, 1171, 27,
+	83, 72, 1168, 1165, 621, 1162, 1160, 60, 1157, 1151,
+	43, 1150, 126, 510, 1149, 1148, 1147, 1146, 80, 944,
+	2124, 124, 84, 1145, 1144, 1141, 2894, 61, 62, 23,
+	1139, 44, 29, 51, 1125, 1124, 48, 1123, 1122, 1121,
+	1117, 1115, 1114, 1113, 121, 1112, 1111, 1110, 30, 20,
+	1109, 1107, 78, 32, 1104, 1101, 1100, 57, 68, 1099,
+	1098, 69, 41, 1092, 1090, 1089, 1088, 1086, 52, 11,
+	1085, 22, 1084, 19, 1083, 42, 1082, 9, 1081, 15,
+	1080, 6, 0, 1079, 10, 56, 1, 1078, 2, 1077,
+	1073, 1692, 1598, 89, 1037, 90,
 }
 var yyR1 = [...]int{
 
-	0, 192, 193, 193, 1, 1, 1, 1, 1, 1,
+	0, 209, 210, 210, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 185, 185, 185, 2, 2, 2,
-	6, 3, 4, 4, 5, 5, 7, 7, 29, 29,
-	8, 9, 9, 9, 9, 196, 196, 48, 48, 49,
-	49, 95, 95, 10, 10, 10, 10, 100, 100, 104,
-	104, 104, 105, 105, 105, 105, 137, 137, 11, 11,
-	11, 11, 11, 11, 11, 187, 187, 186, 184, 184,
-	183, 183, 182, 17, 167, 169, 169, 168, 168, 168,
-	168, 161, 140, 140, 140, 140, 143, 143, 141, 141,
-	141, 141, 141, 141, 141, 141, 141, 142, 142, 142,
-	142, 142, 144, 144, 144, 144, 144, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 146, 146, 146, 146, 146, 146, 146, 146,
-	160, 160, 147, 147, 155, 155, 156, 156, 156, 153,
-	153, 154, 154, 157, 157, 157, 149, 149, 150, 150,
-	158, 158, 151, 151, 151, 152, 152, 152, 159, 159,
-	159, 159, 159, 148, 148, 162, 162, 177, 177, 176,
-	176, 176, 166, 166, 173, 173, 173, 173, 173, 164,
-	164, 165, 165, 175, 175, 174, 163, 163, 178, 178,
-	178, 178, 190, 191, 189, 189, 189, 189, 189, 170,
-	170, 170, 171, 171, 171, 172, 172, 172, 12, 12,
-	12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-	12, 12, 12, 12, 12, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 181,
-	179, 179, 180, 180, 13, 18, 18, 14, 14, 14,
-	14, 14, 15, 15, 19, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 111, 111, 109, 109, 112, 112, 110,
-	110, 110, 113, 113, 113, 114, 114, 138, 138, 138,
-	21, 21, 23, 23, 24, 25, 22, 22, 22, 22,
-	22, 22, 22, 16, 197, 26, 27, 27, 28, 28,
-	28, 32, 32, 32, 30, 30, 30, 31, 31, 37,
-	37, 36, 36, 38, 38, 38, 38, 126, 126, 126,
-	125, 125, 40, 40, 41, 41, 42, 42, 43, 43,
-	43, 43, 57, 57, 94, 94, 96, 96, 44, 44,
-	44, 44, 45, 45, 46, 46, 47, 47, 133, 133,
-	132, 132, 132, 131, 131, 50, 50, 50, 52, 51,
-	51, 51, 51, 53, 53, 55, 55, 54, 54, 56,
-	58, 58, 58, 58, 58, 59, 59, 39, 39, 39,
-	39, 39, 39, 39, 108, 108, 61, 61, 60, 60,
-	60, 60, 60, 60, 60, 60, 60, 60, 72, 72,
-	72, 72, 72, 72, 62, 62, 62, 62, 62, 62,
-	62, 35, 35, 73, 73, 73, 79, 74, 74, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 69, 69, 69, 69, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 68, 68,
-	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 198, 198, 71, 70, 70, 70,
-	70, 70, 70, 70, 33, 33, 33, 33, 33, 136,
-	136, 139, 139, 139, 139, 139, 139, 139, 139, 139,
-	139, 139, 139, 139, 83, 83, 34, 34, 81, 81,
-	82, 84, 84, 80, 80, 80, 64, 64, 64, 64,
-	64, 64, 64, 64, 66, 66, 66, 85, 85, 86,
-	86, 87, 87, 88, 88, 89, 90, 90, 90, 91,
-	91, 91, 91, 92, 92, 92, 63, 63, 63, 63,
-	63, 63, 93, 93, 93, 93, 97, 97, 75, 75,
-	77, 77, 76, 78, 98, 98, 102, 99, 99, 103,
-	103, 103, 103, 101, 101, 101, 128, 128, 128, 106,
-	106, 115, 115, 116, 116, 107, 107, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 118, 118, 118,
-	119, 119, 120, 120, 120, 127, 127, 123, 123, 124,
-	124, 129, 129, 130, 130, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 194, 195, 134, 135, 135, 135,
+	1, 1, 1, 1, 1, 1, 202, 202, 202, 2,
+	2, 3, 3, 3, 10, 7, 8, 8, 9, 9,
+	4, 5, 5, 6, 6, 11, 11, 35, 35, 12,
+	13, 13, 13, 13, 213, 213, 54, 54, 55, 55,
+	112, 112, 14, 14, 14, 14, 117, 117, 121, 121,
+	121, 122, 122, 122, 122, 154, 154, 15, 15, 15,
+	15, 15, 15, 15, 204, 204, 203, 201, 201, 200,
+	200, 199, 21, 184, 186, 186, 185, 185, 185, 185,
+	178, 157, 157, 157, 157, 160, 160, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 159, 159, 159, 159,
+	159, 161, 161, 161, 161, 161, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 163, 163, 163, 163, 163, 163, 163, 163, 177,
+	177, 164, 164, 172, 172, 173, 173, 173, 170, 170,
+	171, 171, 174, 174, 174, 166, 166, 167, 167, 175,
+	175, 168, 168, 168, 169, 169, 169, 176, 176, 176,
+	176, 176, 165, 165, 179, 179, 194, 194, 193, 193,
+	193, 183, 183, 190, 190, 190, 190, 190, 181, 181,
+	182, 182, 192, 192, 191, 180, 180, 195, 195, 195,
+	195, 207, 208, 206, 206, 206, 206, 206, 187, 187,
+	187, 188, 188, 188, 189, 189, 189, 16, 16, 16,
+	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
+	16, 16, 16, 16, 16, 16, 205, 205, 205, 205,
+	205, 205, 205, 205, 205, 205, 205, 205, 205, 205,
+	198, 196, 196, 197, 197, 17, 22, 22, 18, 18,
+	18, 18, 18, 19, 19, 23, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 128, 128, 126, 126, 129,
+	129, 127, 127, 127, 130, 130, 130, 131, 131, 155,
+	155, 155, 25, 25, 27, 27, 28, 29, 29, 29,
+	30, 31, 26, 26, 26, 26, 26, 26, 26, 20,
+	214, 32, 33, 33, 34, 34, 34, 38, 38, 38,
+	36, 36, 36, 37, 37, 43, 43, 42, 42, 44,
+	44, 44, 44, 143, 143, 143, 142, 142, 46, 46,
+	47, 47, 48, 48, 49, 49, 49, 49, 63, 63,
+	111, 111, 113, 113, 50, 50, 50, 50, 51, 51,
+	52, 52, 53, 53, 150, 150, 149, 149, 149, 148,
+	148, 56, 56, 56, 58, 57, 57, 57, 57, 59,
+	59, 61, 61, 60, 60, 62, 64, 64, 64, 64,
+	64, 65, 65, 45, 45, 45, 45, 45, 45, 45,
+	125, 125, 67, 67, 66, 66, 66, 66, 66, 66,
+	66, 66, 66, 66, 78, 78, 78, 78, 78, 78,
+	68, 68, 68, 68, 68, 68, 68, 41, 41, 79,
+	79, 79, 85, 80, 80, 71, 71, 71, 71, 71,
+	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+	71, 71, 71, 71, 71, 71, 71, 75, 75, 75,
+	75, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	215, 215, 77, 76, 76, 76, 76, 76, 76, 76,
+	39, 39, 39, 39, 39, 153, 153, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
+	89, 89, 40, 40, 87, 87, 88, 90, 90, 86,
+	86, 86, 70, 70, 70, 70, 70, 70, 70, 70,
+	72, 72, 72, 91, 91, 92, 92, 93, 93, 94,
+	94, 95, 96, 96, 96, 97, 97, 98, 99, 99,
+	100, 100, 100, 101, 101, 102, 102, 102, 102, 102,
+	103, 103, 103, 104, 104, 105, 105, 106, 107, 107,
+	107, 108, 108, 108, 108, 109, 109, 109, 69, 69,
+	69, 69, 69, 69, 110, 110, 110, 110, 114, 114,
+	81, 81, 83, 83, 82, 84, 115, 115, 119, 116,
+	116, 120, 120, 120, 120, 118, 118, 118, 145, 145,
+	145, 123, 123, 132, 132, 133, 133, 124, 124, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 135,
+	135, 135, 136, 136, 137, 137, 137, 144, 144, 140,
+	140, 141, 141, 146, 146, 147, 147, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 211, 212, 151, 152, 152, 152,
 }
 var yyR2 = [...]int{
 
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 1, 1, 4, 6, 7,
-	5, 10, 1, 3, 1, 3, 7, 8, 1, 1,
-	9, 8, 7, 6, 6, 1, 1, 1, 3, 1,
-	3, 0, 4, 3, 4, 5, 4, 1, 3, 3,
-	2, 2, 2, 2, 2, 1, 1, 1, 2, 2,
-	8, 4, 6, 5, 5, 0, 2, 1, 0, 2,
-	1, 3, 3, 4, 4, 2, 4, 1, 3, 3,
-	3, 8, 3, 1, 1, 1, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
-	2, 2, 1, 2, 2, 2, 1, 4, 4, 2,
-	2, 3, 3, 3, 3, 1, 1, 1, 1, 1,
-	6, 6, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 0, 3, 0, 5, 0, 3, 5, 0,
-	1, 0, 1, 0, 1, 2, 0, 2, 0, 3,
-	0, 1, 0, 3, 3, 0, 2, 2, 0, 2,
-	1, 2, 1, 0, 2, 5, 4, 1, 2, 2,
-	3, 2, 0, 1, 2, 3, 3, 2, 2, 1,
-	1, 0, 1, 1, 3, 2, 3, 1, 10, 11,
-	11, 12, 3, 3, 1, 1, 2, 2, 2, 0,
-	1, 3, 1, 2, 3, 1, 1, 1, 6, 7,
-	7, 7, 7, 4, 5, 4, 4, 7, 5, 5,
-	5, 12, 7, 5, 9, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 7,
-	1, 3, 8, 8, 3, 3, 5, 4, 6, 5,
-	4, 4, 3, 2, 3, 4, 4, 3, 4, 4,
-	4, 4, 4, 4, 3, 2, 6, 6, 2, 3,
-	4, 3, 7, 5, 4, 2, 4, 4, 3, 3,
-	5, 2, 3, 1, 1, 0, 1, 1, 1, 0,
-	2, 2, 0, 2, 2, 0, 2, 0, 1, 1,
-	2, 1, 1, 2, 1, 1, 2, 2, 2, 2,
-	2, 3, 3, 2, 0, 2, 0, 2, 1, 2,
-	2, 0, 1, 1, 0, 1, 1, 0, 1, 0,
-	1, 1, 3, 1, 2, 3, 5, 0, 1, 2,
-	1, 1, 0, 2, 1, 3, 1, 1, 1, 3,
-	1, 3, 3, 7, 1, 3, 1, 3, 4, 4,
-	4, 3, 2, 4, 0, 1, 0, 2, 0, 1,
-	0, 1, 2, 1, 1, 1, 2, 2, 1, 2,
-	3, 2, 3, 2, 2, 2, 1, 1, 3, 3,
-	0, 5, 4, 5, 5, 0, 2, 1, 3, 3,
-	2, 3, 1, 2, 0, 3, 1, 1, 3, 3,
-	4, 4, 5, 3, 4, 5, 6, 2, 1, 2,
-	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 0, 2, 1, 1, 1, 3, 1, 3, 1,
-	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
-	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
-	1, 4, 5, 5, 6, 4, 4, 6, 6, 6,
-	8, 8, 8, 8, 9, 8, 5, 4, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 8, 8, 0, 2, 3, 4, 4, 4,
-	4, 4, 4, 4, 0, 3, 4, 7, 3, 1,
-	1, 2, 3, 3, 1, 2, 2, 1, 2, 1,
-	2, 2, 1, 2, 0, 1, 0, 2, 1, 2,
-	4, 0, 2, 1, 3, 5, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 0, 3, 0,
-	2, 0, 3, 1, 3, 2, 0, 1, 1, 0,
-	2, 4, 4, 0, 2, 4, 2, 1, 3, 5,
-	4, 6, 1, 3, 3, 5, 0, 5, 1, 3,
-	1, 2, 3, 1, 1, 3, 3, 1, 3, 3,
-	3, 3, 3, 1, 2, 1, 1, 1, 1, 1,
-	1, 0, 2, 0, 3, 0, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
-	1, 1, 0, 1, 1, 0, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 1, 1, 1, 1,
+	2, 4, 6, 7, 5, 2, 1, 3, 3, 6,
+	11, 1, 3, 1, 3, 7, 8, 1, 1, 9,
+	8, 7, 6, 6, 1, 1, 1, 3, 1, 3,
+	0, 4, 3, 4, 5, 4, 1, 3, 3, 2,
+	2, 2, 2, 2, 1, 1, 1, 2, 2, 8,
+	4, 6, 5, 5, 0, 2, 1, 0, 2, 1,
+	3, 3, 4, 4, 2, 4, 1, 3, 3, 3,
+	8, 3, 1, 1, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 2, 2,
+	2, 1, 2, 2, 2, 1, 4, 4, 2, 2,
+	3, 3, 3, 3, 1, 1, 1, 1, 1, 6,
+	6, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 0, 3, 0, 5, 0, 3, 5, 0, 1,
+	0, 1, 0, 1, 2, 0, 2, 0, 3, 0,
+	1, 0, 3, 3, 0, 2, 2, 0, 2, 1,
+	2, 1, 0, 2, 5, 4, 1, 2, 2, 3,
+	2, 0, 1, 2, 3, 3, 2, 2, 1, 1,
+	0, 1, 1, 3, 2, 3, 1, 10, 11, 11,
+	12, 3, 3, 1, 1, 2, 2, 2, 0, 1,
+	3, 1, 2, 3, 1, 1, 1, 6, 7, 7,
+	7, 7, 4, 5, 4, 4, 7, 5, 5, 5,
+	12, 7, 5, 9, 8, 6, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	7, 1, 3, 8, 8, 3, 3, 5, 4, 6,
+	5, 4, 4, 3, 2, 3, 4, 4, 3, 4,
+	4, 4, 4, 4, 4, 3, 2, 6, 6, 2,
+	3, 4, 3, 7, 5, 4, 2, 4, 4, 3,
+	3, 5, 4, 2, 3, 1, 1, 0, 1, 1,
+	1, 0, 2, 2, 0, 2, 2, 0, 2, 0,
+	1, 1, 2, 1, 1, 2, 1, 1, 3, 4,
+	2, 3, 2, 2, 2, 2, 2, 3, 3, 2,
+	0, 2, 0, 2, 1, 2, 2, 0, 1, 1,
+	0, 1, 1, 0, 1, 0, 1, 1, 3, 1,
+	2, 3, 5, 0, 1, 2, 1, 1, 0, 2,
+	1, 3, 1, 1, 1, 3, 1, 3, 3, 7,
+	1, 3, 1, 3, 4, 4, 4, 3, 2, 4,
+	0, 1, 0, 2, 0, 1, 0, 1, 2, 1,
+	1, 1, 2, 2, 1, 2, 3, 2, 3, 2,
+	2, 2, 1, 1, 3, 3, 0, 5, 4, 5,
+	5, 0, 2, 1, 3, 3, 2, 3, 1, 2,
+	0, 3, 1, 1, 3, 3, 4, 4, 5, 3,
+	4, 5, 6, 2, 1, 2, 1, 2, 1, 2,
+	1, 1, 1, 1, 1, 1, 1, 0, 2, 1,
+	1, 1, 3, 1, 3, 1, 1, 1, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 2, 2, 2, 2, 2,
+	2, 2, 3, 1, 1, 1, 1, 5, 6, 6,
+	6, 4, 4, 6, 6, 6, 8, 8, 8, 8,
+	9, 8, 5, 4, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 8, 8,
+	0, 2, 3, 4, 4, 4, 4, 4, 4, 4,
+	0, 3, 4, 7, 3, 1, 1, 2, 3, 3,
+	1, 2, 2, 1, 2, 1, 2, 2, 1, 2,
+	0, 1, 0, 2, 1, 2, 4, 0, 2, 1,
+	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 0, 3, 0, 2, 0, 2, 1,
+	3, 3, 0, 2, 2, 3, 4, 3, 0, 3,
+	0, 2, 5, 1, 1, 2, 2, 2, 2, 2,
+	1, 1, 3, 0, 3, 1, 3, 2, 0, 1,
+	1, 0, 2, 4, 4, 0, 2, 4, 2, 1,
+	3, 5, 4, 6, 1, 3, 3, 5, 0, 5,
+	1, 3, 1, 2, 3, 1, 1, 3, 3, 1,
+	3, 3, 3, 3, 3, 1, 2, 1, 1, 1,
+	1, 1, 1, 0, 2, 0, 3, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
+	1, 1, 1, 1, 0, 1, 1, 0, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2886,319 +3085,339 @@ var yyR2 = [...]int{
 }
 var yyChk = [...]int{
 
-	-1000, -192, -1, -2, -6, -7, -8, -9, -10, -11,
-	-12, -13, -14, -15, -19, -20, -21, -23, -24, -25,
-	-22, -16, -3, -4, 6, 7, -29, 9, 10, 30,
-	-17, 118, 119, 121, 120, 153, 122, 146, 51, 167,
-	168, 170, 171, 25, 147, 148, 151, 152, 31, 32,
-	124, -194, 8, 252, 55, -193, 350, -87, 15, -28,
-	5, -26, -197, -26, -26, -26, -26, -26, -167, -169,
-	55, 93, -120, 128, 75, 244, 125, 126, 132, -123,
-	-185, -122, 58, 59, 60, 262, 139, 294, 295, 167,
-	178, 172, 199, 191, 263, 296, 140, 189, 192, 231,
-	138, 297, 219, 226, 69, 170, 240, 298, 149, 187,
-	183, 299, 271, 181, 27, 300, 228, 204, 301, 267,
-	182, 227, 124, 302, 142, 136, 303, 205, 209, 304,
-	232, 305, 306, 307, 176, 177, 308, 234, 203, 137,
-	33, 264, 36, 157, 235, 207, 309, 202, 198, 310,
-	311, 312, 313, 201, 175, 197, 40, 211, 210, 212,
-	230, 194, 314, 315, 316, 143, 317, 184, 18, 318,
-	319, 320, 321, 322, 238, 152, 323, 155, 324, 325,
-	326, 327, 328, 329, 229, 206, 208, 133, 159, 266,
-	330, 236, 180, 331, 144, 156, 151, 239, 145, 332,
-	333, 334, 335, 336, 337, 338, 171, 339, 340, 341,
-	342, 166, 233, 242, 39, 216, 343, 174, 135, 344,
-	168, 163, 221, 195, 158, 345, 346, 185, 186, 200,
-	173, 196, 169, 160, 153, 347, 241, 217, 268, 193,
-	190, 164, 348, 161, 162, 349, 222, 223, 165, 265,
-	237, 188, 218, -107, 128, 244, 125, 223, 130, 126,
-	126, 127, 128, 244, 125, 126, -54, -129, -185, -122,
-	128, 126, 111, 192, 231, 118, 220, 228, 127, 33,
-	229, 159, -138, 126, -109, 219, 222, 223, 165, -185,
-	233, 232, 224, -129, 169, -134, -134, -134, -134, -134,
-	221, 221, -134, -2, -91, 17, 16, -5, -3, -194,
-	6, 20, 21, -32, 41, 42, -27, -38, 102, -39,
-	-129, -60, 77, -65, 29, -185, -122, 23, -64, -61,
-	-80, -78, -79, 111, 112, 113, 100, 101, 108, 78,
-	114, -69, -67, -68, -70, 62, 61, 70, 63, 64,
-	65, 66, 71, 72, 73, -123, -76, -194, 45, 46,
-	253, 254, 255, 256, 261, 257, 80, 35, 243, 251,
-	250, 249, 247, 248, 245, 246, 259, 260, 131, 244,
-	125, 106, 252, -107, -107, 11, -48, -49, -54, -56,
-	-129, -99, -137, 169, -103, 233, 232, -124, -101, -123,
-	-121, 231, 192, 230, 123, 269, 76, 22, 24, 214,
-	79, 111, 16, 80, 110, 253, 118, 49, 270, 245,
-	246, 243, 255, 256, 244, 220, 29, 10, 272, 25,
-	147, 21, 34, 104, 120, 83, 84, 150, 23, 148,
-	73, 275, 19, 52, 11, 13, 276, 277, 14, 131,
-	130, 95, 127, 47, 8, 114, 26, 92, 43, 278,
-	28, 279, 280, 281, 282, 45, 93, 17, 247, 248,
-	31, 283, 261, 154, 106, 50, 37, 77, 284, 285,
-	71, 286, 74, 53, 75, 15, 48, 287, 288, 289,
-	290, 94, 121, 252, 46, 291, 125, 6, 258, 30,
-	146, 44, 292, 126, 82, 259, 260, 129, 72, 5,
-	132, 32, 9, 51, 54, 249, 250, 251, 35, 81,
-	12, 293, -168, 93, -161, -185, -54, 127, -54, 252,
-	-116, 131, -116, -116, 126, -54, -185, -185, 118, 120,
-	123, 53, -18, -54, -115, 131, -185, -115, -115, -115,
-	-54, 115, -54, -185, 30, -113, 93, 12, 244, -185,
-	159, 126, 160, 128, -135, -194, -124, 11, 11, -135,
-	163, 164, -135, -112, -111, 226, 227, 221, 225, 12,
-	164, 221, 162, -135, -134, -134, -195, 57, -92, 19,
-	31, -39, -129, -88, -89, -39, -87, -2, -26, 37,
-	-30, 21, 34, 68, 11, -126, 76, 75, 92, -125,
-	22, -123, 62, 115, -39, -62, 95, 77, 93, 94,
-	79, 97, 96, 107, 100, 101, 102, 103, 104, 105,
-	106, 98, 99, 110, 85, 86, 87, 88, 89, 90,
-	91, -108, -194, -79, -194, 116, 117, -65, -65, -65,
-	-65, -65, -65, -65, -65, -194, -2, -74, -39, -194,
-	-194, -194, -194, -194, -194, -194, -194, -194, -83, -39,
-	-194, -198, -71, -194, -198, -71, -198, -71, -198, -194,
-	-198, -71, -198, -71, -198, -198, -71, -194, -194, -194,
-	-194, -194, -194, -194, -55, 26, -54, -41, -42, -43,
-	-44, -57, -79, -194, -54, -54, -48, -196, 56, 11,
-	54, -196, 56, 115, 56, -99, 169, -100, -104, 234,
-	236, 85, -128, -123, 62, 29, 30, 57, 56, -54,
-	-140, -143, -145, -144, -146, -141, -142, 189, 190, 111,
-	193, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 30, 149, 185, 186, 187, 188, 205, 206, 207,
-	208, 209, 210, 211, 212, 172, 191, 263, 173, 174,
-	175, 176, 177, 178, 180, 181, 182, 183, 184, -185,
-	-135, 128, -185, 77, -185, -54, -54, -135, -135, -135,
-	161, 161, 126, 126, 166, -54, 56, 129, -48, 23,
-	53, -54, -185, -185, -130, -129, -121, -135, -113, 62,
-	-39, -135, -135, -135, -54, -135, -135, -54, -54, -135,
-	-135, 11, -110, 11, 95, -39, -114, 93, 53, 9,
-	95, 56, 18, 115, 56, -90, 24, 25, -91, -195,
-	-32, -66, -123, 63, 66, -31, 44, -54, -39, -39,
-	-72, 71, 77, 72, 73, -125, 102, -130, -124, -121,
-	-65, -73, -76, -79, 67, 95, 93, 94, 79, -65,
-	-65, -65, -65, -65, -65, -65, -65, -65, -65, -65,
-	-65, -65, -65, -65, -136, -185, 62, -185, -64, -64,
-	-123, -37, 21, 34, -36, -38, -195, 56, -195, -2,
-	-36, -36, -39, -39, -80, 62, -36, -80, 62, -36,
-	-36, -30, -81, -82, 81, -80, -123, -129, -195, -65,
-	-123, -123, -36, -37, -37, -36, -36, -95, 155, -54,
-	30, 56, -50, -52, -51, -53, 43, 47, 49, 44,
-	45, 46, 50, -133, 22, -41, -194, -132, 155, -131,
-	22, -129, 62, -95, 54, -41, -54, -41, -56, -129,
-	102, -103, -100, 56, 235, 237, 238, 53, 74, -39,
-	-152, 110, -170, -171, -172, -124, 62, 63, -161, -162,
-	-163, -173, 141, -178, 133, 135, 132, -164, 142, 127,
-	28, 57, -157, 71, 77, -153, 217, -147, 55, -147,
-	-147, -147, -147, -151, 192, -151, -151, -151, 55, 55,
-	-147, -147, -147, -155, 55, -155, -155, -156, 55, -156,
-	-127, 54, -54, -135, 23, -135, -117, 123, 120, 121,
-	-181, 119, 214, 192, 69, 29, 15, 253, 155, 268,
-	-185, 156, -54, -54, -54, -54, -54, 123, 120, -54,
-	-54, -54, -135, -110, -110, -54, -113, -129, -129, 62,
-	-54, 39, -39, -39, -130, -89, -92, -106, 19, 11,
-	35, 35, -36, 71, 72, 73, 115, -194, -73, -65,
-	-65, -65, -35, 150, 76, -195, -195, -36, -36, 56,
-	-39, -195, -195, -195, 56, 54, 22, 11, 11, -195,
-	11, 11, -195, -195, -36, -84, -82, 83, -39, -195,
-	115, -195, 56, 56, -195, -195, -195, -195, -195, -63,
-	30, 35, -2, -194, -194, -98, -102, -80, -42, -43,
-	-43, -42, -43, 43, 43, 43, 48, 43, 48, 43,
-	-51, -129, -195, -58, 51, 130, 52, -194, -131, -59,
-	12, -41, -59, -59, 115, -104, -105, 239, 236, 242,
-	-185, 62, 56, -172, 85, 55, -185, 28, -164, -164,
-	-165, -185, -165, 28, -149, 29, 71, -154, 218, 63,
-	-151, -151, -152, 30, -152, -152, -152, -160, 62, -160,
-	63, 63, 53, -123, -135, -134, -188, 138, 134, 141,
+	-1000, -209, -1, -2, -10, -11, -12, -13, -14, -15,
+	-16, -17, -18, -19, -23, -24, -25, -27, -28, -29,
+	-30, -31, -26, -20, -3, -7, 7, -35, 9, 10,
+	30, -21, 118, 119, 121, 120, 153, 122, 146, 51,
+	170, 171, 173, 174, 175, 176, 25, 147, 148, 151,
+	152, 31, 32, 124, -4, -5, 6, 270, 8, 257,
+	-211, 55, -210, 359, -3, -32, -214, -32, -32, -32,
+	-32, -184, -186, 55, 93, -137, 128, 75, 249, 125,
+	126, 132, -140, -202, -139, 58, 59, 60, 267, 139,
+	306, 307, 170, 183, 177, 204, 196, 268, 308, 140,
+	194, 197, 236, 138, 309, 224, 231, 69, 173, 245,
+	310, 277, 149, 192, 188, 311, 285, 186, 27, 312,
+	233, 209, 313, 272, 187, 232, 124, 281, 142, 136,
+	314, 210, 214, 315, 237, 316, 317, 318, 181, 182,
+	319, 239, 208, 137, 33, 269, 36, 157, 240, 212,
+	320, 207, 203, 321, 322, 323, 324, 206, 180, 202,
+	40, 216, 215, 217, 235, 199, 325, 326, 327, 143,
+	328, 189, 18, 329, 330, 331, 332, 333, 243, 152,
+	334, 155, 335, 336, 337, 280, 338, 339, 234, 211,
+	213, 133, 159, 271, 340, 241, 185, 341, 144, 156,
+	151, 244, 145, 342, 343, 344, 345, 346, 347, 348,
+	174, 163, 278, 164, 165, 175, 349, 350, 351, 352,
+	169, 238, 247, 39, 221, 353, 179, 135, 354, 171,
+	166, 226, 200, 158, 355, 356, 190, 191, 205, 178,
+	201, 172, 160, 153, 279, 246, 222, 282, 198, 195,
+	167, 357, 161, 162, 358, 227, 228, 168, 242, 193,
+	223, -124, 128, 249, 125, 228, 130, 126, 126, 127,
+	128, 249, 125, 126, -60, -146, -202, -139, 128, 126,
+	111, 197, 236, 118, 225, 233, 127, 33, 234, 159,
+	-155, 126, -126, 224, 227, 228, 168, -202, 238, 237,
+	229, -146, 172, 129, -140, 175, -151, -151, -151, -151,
+	-151, 226, 226, -151, -104, 15, -34, 5, -32, -8,
+	-9, -146, -2, -44, 102, -45, -146, -66, 77, -71,
+	29, -202, -139, 23, -70, -67, -86, -84, -85, 111,
+	112, 113, 100, 101, 108, 78, 114, -75, -73, -74,
+	-76, 62, 61, 70, 63, 64, 65, 66, 71, 72,
+	73, -140, -82, -211, 45, 46, 258, 259, 260, 261,
+	266, 262, 80, 35, 248, 256, 255, 254, 252, 253,
+	250, 251, 264, 265, 131, 249, 125, 106, 257, -33,
+	-124, -124, 11, -54, -55, -60, -62, -146, -116, -154,
+	172, -120, 238, 237, -141, -118, -140, -138, 236, 197,
+	235, 123, 283, 76, 22, 24, 219, 79, 111, 16,
+	80, 110, 258, 118, 49, 284, 250, 251, 248, 260,
+	261, 249, 225, 29, 10, 286, 25, 147, 21, 34,
+	104, 120, 83, 84, 150, 23, 148, 73, 289, 19,
+	52, 11, 13, 290, 291, 14, 131, 130, 95, 127,
+	47, 8, 114, 26, 92, 43, 292, 28, 293, 294,
+	295, 296, 45, 93, 17, 252, 253, 31, 297, 266,
+	154, 106, 50, 37, 77, 298, 299, 71, 300, 74,
+	53, 75, 15, 48, 273, 301, 276, 302, 303, 94,
+	121, 257, 46, 304, 275, 125, 6, 263, 30, 146,
+	44, 305, 126, 82, 264, 265, 129, 72, 5, 132,
+	32, 9, 51, 54, 254, 255, 256, 35, 81, 12,
+	270, 274, -185, 93, -178, -202, -60, 127, -60, 257,
+	-133, 131, -133, -133, 126, -60, -202, -202, 118, 120,
+	123, 53, -22, -60, -132, 131, -202, -132, -132, -132,
+	-60, 115, -60, -202, 30, -130, 93, 12, 249, -202,
+	159, 126, 160, 128, -152, -211, -141, 11, 11, -152,
+	166, 167, -152, -129, -128, 231, 232, 226, 230, 12,
+	167, 226, 162, 163, -152, -140, 175, -140, -151, -151,
+	-108, 17, 16, -6, -4, -211, 6, 20, 21, -38,
+	41, 42, 56, 22, -211, -212, 57, 11, -143, 76,
+	75, 92, -142, 22, -140, 62, 115, -45, -146, -68,
+	95, 77, 93, 94, 79, 97, 96, 107, 100, 101,
+	102, 103, 104, 105, 106, 98, 99, 110, 85, 86,
+	87, 88, 89, 90, 91, -125, -211, -85, -211, 116,
+	117, -71, -71, -71, -71, -71, -71, -71, -71, -211,
+	-2, -80, -45, -211, -211, -211, -211, -211, -211, -211,
+	-211, -211, -89, -45, -211, -215, -77, -211, -215, -77,
+	-215, -77, -215, -211, -215, -77, -215, -77, -215, -215,
+	-77, -211, -211, -211, -211, -211, -211, -211, 68, -61,
+	26, -60, -47, -48, -49, -50, -63, -85, -211, -60,
+	-60, -54, -213, 56, 11, 54, -213, 56, 115, 56,
+	-116, 172, -117, -121, 239, 241, 85, -145, -140, 62,
+	29, 30, 57, 56, -60, -157, -160, -162, -161, -163,
+	-158, -159, 194, 195, 111, 198, 200, 201, 202, 203,
+	204, 205, 206, 207, 208, 209, 30, 149, 190, 191,
+	192, 193, 210, 211, 212, 213, 214, 215, 216, 217,
+	177, 196, 268, 178, 179, 180, 181, 182, 183, 185,
+	186, 187, 188, 189, -202, -152, 128, -202, 77, -202,
+	-60, -60, -152, -152, -152, 161, 161, 126, 163, 126,
+	169, 163, -60, 56, 129, -54, 23, 53, -60, -202,
+	-202, -147, -146, -138, -152, -130, 62, -45, -152, -152,
+	-152, -60, -152, -152, -60, -60, -152, -152, 11, -127,
+	11, 95, -45, -131, 93, 53, 165, -140, -109, 19,
+	31, -45, -105, -106, -45, -104, -2, -32, 37, -36,
+	21, 34, -9, -85, -111, -140, -60, -45, -45, -78,
+	71, 77, 72, 73, -142, 102, -147, -141, -138, 115,
+	-71, -79, -82, -85, 67, 95, 93, 94, 79, -71,
+	-71, -71, -71, -71, -71, -71, -71, -71, -71, -71,
+	-71, -71, -71, -71, -153, -202, 62, -202, -70, -70,
+	-140, -43, 21, 34, -42, -44, -212, 56, -212, -2,
+	-42, -42, -45, -45, -86, 62, -42, -86, 62, -42,
+	-42, -36, -87, -88, 81, -86, -140, -146, -212, -71,
+	-140, -140, -42, -43, -43, -42, -42, -112, 155, -60,
+	30, 56, -56, -58, -57, -59, 43, 47, 49, 44,
+	45, 46, 50, -150, 22, -47, -211, -149, 155, -148,
+	22, -146, 62, -112, 54, -47, -60, -47, -62, -146,
+	102, -120, -117, 56, 240, 242, 243, 53, 74, -45,
+	-169, 110, -187, -188, -189, -141, 62, 63, -178, -179,
+	-180, -190, 141, -195, 133, 135, 132, -181, 142, 127,
+	28, 57, -174, 71, 77, -170, 222, -164, 55, -164,
+	-164, -164, -164, -168, 197, -168, -168, -168, 55, 55,
+	-164, -164, -164, -172, 55, -172, -172, -173, 55, -173,
+	-144, 54, -60, -152, 23, -152, -134, 123, 120, 121,
+	-198, 119, 219, 197, 69, 29, 15, 258, 155, 282,
+	-202, 156, -60, -60, -60, 164, -60, -60, 164, 123,
+	120, -60, -60, -60, -152, -127, -127, -60, -130, -146,
+	-146, 62, -60, 9, 95, 56, 18, 56, -107, 24,
+	25, -108, -212, -38, -72, -140, 63, 66, -37, 44,
+	-212, 56, 71, 72, 73, 115, -211, -147, -79, -71,
+	-71, -71, -41, 150, 76, -212, -212, -42, -42, 56,
+	-45, -212, -212, -212, 56, 54, 22, 11, 11, -212,
+	11, 11, -212, -212, -42, -90, -88, 83, -45, -212,
+	115, -212, 56, 56, -212, -212, -212, -212, -212, -69,
+	30, 35, -2, -211, -211, -115, -119, -86, -48, -49,
+	-49, -48, -49, 43, 43, 43, 48, 43, 48, 43,
+	-57, -146, -212, -64, 51, 130, 52, -211, -148, -65,
+	12, -47, -65, -65, 115, -121, -122, 244, 241, 247,
+	-202, 62, 56, -189, 85, 55, -202, 28, -181, -181,
+	-182, -202, -182, 28, -166, 29, 71, -171, 223, 63,
+	-168, -168, -169, 30, -169, -169, -169, -177, 62, -177,
+	63, 63, 53, -140, -152, -151, -205, 138, 134, 141,
 	142, 136, 58, 59, 60, 127, 28, 133, 135, 155,
-	132, -188, -118, -119, 129, 22, 127, 28, 155, -187,
-	54, 161, 214, 161, 129, -135, -113, -113, -110, 40,
-	115, -54, -40, 11, 102, -124, -37, -35, 76, -65,
-	-65, -195, -195, -38, -139, 111, 189, 149, 187, 183,
-	203, 194, 216, 185, 217, -136, -139, -65, -65, -65,
-	-65, 262, -87, 84, -39, 82, -124, -65, -65, -97,
-	53, -98, -75, -77, -76, -194, -2, -93, -123, -96,
-	-123, -59, 56, 85, -46, -45, 53, 54, -47, 53,
-	-45, 43, 43, 127, 127, 127, -96, -87, -39, -59,
-	236, 240, 241, -171, -172, -175, -174, -123, -178, -165,
-	-165, 55, -150, 53, -65, 57, -152, -152, -185, 111,
-	57, 56, 57, 56, 57, 56, -54, -134, -134, -54,
-	-134, -123, -184, 265, -186, -185, -123, -123, -123, -54,
-	-113, -59, -41, -195, -65, -195, -147, -147, -147, -156,
-	-147, 177, -147, 177, -195, -195, 19, 19, 19, 19,
-	-194, -34, 258, -39, 56, 56, 27, -97, 56, -195,
-	-195, -195, 56, 115, -195, 56, -87, -102, -39, -39,
-	55, -39, -194, -194, -194, -195, -91, 57, 56, -147,
-	-94, -123, -158, 214, 9, -151, 62, -151, 63, 63,
-	-135, 26, -183, -182, -124, 55, 54, -85, 13, -151,
-	-185, -65, -65, -65, -65, -65, -91, 62, -65, -65,
-	28, -77, 35, -2, -194, -123, -123, -123, -91, -94,
-	-94, -195, -94, -94, -132, -177, -176, 54, 137, 69,
-	-174, 57, 56, -159, 133, 28, 132, -68, -152, -152,
-	57, 57, -194, 56, 85, -94, -54, -86, 14, 16,
-	-195, -195, -195, -195, -33, 95, 265, -195, -195, -195,
-	9, -75, -2, 115, 57, -195, -195, -195, -58, -176,
-	-185, -166, 85, 62, 144, -123, -148, 69, 28, 28,
-	-179, -180, 155, -182, -172, 57, -39, -74, -195, 263,
-	50, 266, -98, -195, -123, 63, -54, 62, -195, 56,
-	-123, -187, 40, 264, 267, 55, -180, 35, -184, 40,
-	-94, 157, 265, 57, 158, 266, -190, -191, 53, -194,
-	267, -191, 53, 10, 9, -65, 154, -189, 145, 140,
-	143, 30, -189, -195, -195, 139, 29, 71,
+	132, -205, -135, -136, 129, 22, 127, 28, 155, -204,
+	54, -60, -60, 161, 219, 161, 129, -152, -130, -130,
+	-127, 39, -45, -45, -106, -109, -123, 19, 11, 35,
+	35, -42, 22, -140, 102, -141, -43, 115, -41, 76,
+	-71, -71, -96, 273, -212, -212, -44, -156, 111, 194,
+	149, 192, 188, 208, 199, 221, 190, 222, -153, -156,
+	-71, -71, -71, -71, 267, -104, 84, -45, 82, -141,
+	-71, -71, -114, 53, -115, -81, -83, -82, -211, -2,
+	-110, -140, -113, -140, -65, 56, 85, -52, -51, 53,
+	54, -53, 53, -51, 43, 43, 127, 127, 127, -113,
+	-104, -45, -65, 241, 245, 246, -188, -189, -192, -191,
+	-140, -195, -182, -182, 55, -167, 53, -71, 57, -169,
+	-169, -202, 111, 57, 56, 57, 56, 57, 56, -60,
+	-151, -151, -60, -151, -140, -201, 270, -203, -202, 129,
+	-140, -140, -140, -60, -130, 40, -60, -46, 11, -85,
+	-212, -71, -140, -97, -211, -96, -96, -212, -164, -164,
+	-164, -173, -164, 182, -164, 182, -212, -212, 19, 19,
+	19, 19, -211, -40, 263, -45, 56, 56, 27, -114,
+	56, -212, -212, -212, 56, 115, -212, 56, -104, -119,
+	-45, -45, 55, -45, -211, -211, -211, -212, -108, 57,
+	56, -164, -111, -175, 219, 9, -168, 62, -168, 63,
+	63, -152, 26, -200, -199, -141, -54, 55, 54, -65,
+	-47, -98, -140, -99, 155, -168, -202, -71, -71, -71,
+	-71, -71, -108, 62, -71, -71, 28, -83, 35, -2,
+	-211, -140, -140, -140, -108, -111, -111, -212, -111, -111,
+	-149, -194, -193, 54, 137, 69, -191, 57, -176, 133,
+	28, 132, -74, -169, -169, 57, 57, -211, 56, 85,
+	-111, -60, -91, 13, -212, -98, 155, -104, 16, -212,
+	-212, -212, -212, -39, 95, 270, -212, -212, -212, 9,
+	-81, -2, 115, 57, -212, -212, -212, -64, -193, -202,
+	-183, 85, 62, 144, -165, 69, 28, 28, -196, -197,
+	155, -199, -189, 57, -92, 14, 16, -212, -100, -101,
+	275, 276, -80, -212, 268, 50, 271, -115, -212, -140,
+	63, -60, 62, -212, 56, -140, -204, -93, 274, -45,
+	-80, -102, 79, 277, 279, -103, 63, 66, 114, 40,
+	269, 272, 55, -197, 35, -201, -94, -95, -140, -102,
+	278, 280, 281, 280, 281, -71, 40, -111, 157, 56,
+	22, 76, -140, 270, 57, 158, -95, -97, -102, 271,
+	-207, -208, 53, -211, 272, -208, 53, 10, 9, -71,
+	154, -206, 145, 140, 143, 30, -206, -212, -212, 139,
+	29, 71,
 }
 var yyDef = [...]int{
 
-	23, -2, 2, -2, 5, 6, 7, 8, 9, 10,
+	25, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 571, 0, 324, 324, 324, 324, 324, 324,
-	0, 642, 625, 0, 0, 0, 0, -2, 311, 312,
-	0, 314, 315, 944, 944, 944, 944, 944, 0, 0,
-	944, 0, 38, 39, 942, 1, 3, 579, 0, 0,
-	328, 331, 326, 0, 625, 625, 0, 0, 68, 69,
-	0, 0, 0, 931, 0, 623, 623, 623, 643, 644,
-	647, 648, 24, 25, 26, 773, 774, 775, 776, 777,
-	778, 779, 780, 781, 782, 783, 784, 785, 786, 787,
-	788, 789, 790, 791, 792, 793, 794, 795, 796, 797,
-	798, 799, 800, 801, 802, 803, 804, 805, 806, 807,
-	808, 809, 810, 811, 812, 813, 814, 815, 816, 817,
-	818, 819, 820, 821, 822, 823, 824, 825, 826, 827,
-	828, 829, 830, 831, 832, 833, 834, 835, 836, 837,
-	838, 839, 840, 841, 842, 843, 844, 845, 846, 847,
-	848, 849, 850, 851, 852, 853, 854, 855, 856, 857,
-	858, 859, 860, 861, 862, 863, 864, 865, 866, 867,
-	868, 869, 870, 871, 872, 873, 874, 875, 876, 877,
-	878, 879, 880, 881, 882, 883, 884, 885, 886, 887,
-	888, 889, 890, 891, 892, 893, 894, 895, 896, 897,
-	898, 899, 900, 901, 902, 903, 904, 905, 906, 907,
-	908, 909, 910, 911, 912, 913, 914, 915, 916, 917,
-	918, 919, 920, 921, 922, 923, 924, 925, 926, 927,
-	928, 929, 930, 932, 933, 934, 935, 936, 937, 938,
-	939, 940, 941, 0, 0, 0, 0, 0, 626, 0,
-	621, 0, 621, 621, 621, 0, 263, 397, 651, 652,
-	931, 0, 0, 0, 302, 0, 945, 275, 0, 0,
-	278, 945, 0, 945, 0, 285, 0, 0, 291, 945,
-	308, 309, 296, 310, 313, 316, 317, 318, 319, 320,
-	944, 944, 323, 32, 583, 0, 0, 571, 34, 0,
-	324, 329, 330, 334, 332, 333, 325, 0, 343, 347,
-	0, 407, 0, 412, 414, -2, -2, 0, 449, 450,
-	451, 452, 453, 0, 0, 0, 0, 0, 0, 0,
-	0, 477, 478, 479, 480, 556, 557, 558, 559, 560,
-	561, 562, 563, 416, 417, 553, 603, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 544, 0, 514, 514,
-	514, 514, 514, 514, 514, 514, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 47, 49,
-	397, 53, 0, 920, 607, -2, -2, 0, 0, 649,
-	650, -2, 786, -2, 655, 656, 657, 658, 659, 660,
-	661, 662, 663, 664, 665, 666, 667, 668, 669, 670,
-	671, 672, 673, 674, 675, 676, 677, 678, 679, 680,
-	681, 682, 683, 684, 685, 686, 687, 688, 689, 690,
-	691, 692, 693, 694, 695, 696, 697, 698, 699, 700,
-	701, 702, 703, 704, 705, 706, 707, 708, 709, 710,
-	711, 712, 713, 714, 715, 716, 717, 718, 719, 720,
-	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
-	731, 732, 733, 734, 735, 736, 737, 738, 739, 740,
-	741, 742, 743, 744, 745, 746, 747, 748, 749, 750,
-	751, 752, 753, 754, 755, 756, 757, 758, 759, 760,
-	761, 762, 763, 764, 765, 766, 767, 768, 769, 770,
-	771, 772, 0, 0, 87, 0, 85, 0, 945, 0,
-	0, 0, 0, 0, 0, 945, 945, 945, 0, 0,
-	0, 0, 254, 0, 0, 0, 0, 0, 0, 0,
-	262, 0, 264, 945, 302, 267, 0, 0, 945, 945,
-	945, 0, 945, 945, 274, 946, 947, 0, 0, 279,
-	945, 945, 281, 0, 299, 297, 298, 293, 294, 0,
-	305, 288, 289, 292, 321, 322, 33, 943, 27, 0,
-	0, 580, 0, 572, 573, 576, 579, 32, 331, 0,
-	337, 335, 336, 327, 0, 344, 0, 0, 0, 348,
-	0, 350, 351, 0, 410, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 434, 435, 436, 437, 438, 439,
-	440, 413, 0, 427, 0, 0, 0, 469, 470, 471,
-	472, 473, 474, 475, 0, 339, 32, 0, 447, 0,
-	0, 0, 0, 0, 0, 0, 0, 334, 0, 545,
-	0, 498, 506, 0, 499, 507, 500, 508, 501, 0,
-	502, 509, 503, 510, 504, 505, 511, 0, 0, 0,
-	339, 339, 0, 0, 51, 0, 396, 0, 354, 356,
-	357, 358, -2, 0, 380, -2, 0, 0, 0, 45,
-	46, 0, 0, 0, 0, 54, 920, 56, 57, 0,
-	0, 0, 165, 616, 617, 618, 614, 209, 0, 0,
-	153, 149, 93, 94, 95, 142, 97, 142, 142, 142,
-	142, 162, 162, 162, 162, 125, 126, 127, 128, 129,
-	0, 0, 112, 142, 142, 142, 116, 132, 133, 134,
-	135, 136, 137, 138, 139, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 144, 144, 144, 146, 146, 645,
-	71, 0, 945, 0, 945, 83, 0, 223, 225, 226,
-	0, 0, 0, 0, 0, 0, 0, 0, 257, 622,
-	0, 945, 260, 261, 398, 653, 654, 265, 266, 303,
-	304, 268, 269, 270, 271, 272, 273, 299, 299, 280,
-	284, 0, 302, 0, 0, 286, 287, 0, 0, 584,
-	0, 0, 0, 0, 0, 575, 577, 578, 583, 35,
-	334, 0, 564, 0, 0, 0, 338, 30, 408, 409,
-	411, 428, 0, 430, 432, 349, 345, 0, 554, -2,
-	418, 419, 443, 444, 445, 0, 0, 0, 0, 441,
-	423, 0, 454, 455, 456, 457, 458, 459, 460, 461,
-	462, 463, 464, 465, 468, 529, 530, 0, 466, 467,
-	476, 0, 0, 0, 340, 341, 446, 0, 602, 32,
-	0, 0, 0, 0, 451, 556, 0, 451, 556, 0,
-	0, 0, 551, 548, 0, 0, 553, 0, 515, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 395,
-	0, 0, 0, 0, 0, 0, 385, 0, 0, 388,
-	0, 0, 0, 0, 379, 0, 0, 400, 865, 381,
-	0, 383, 384, 405, 0, 405, 48, 405, 50, 0,
-	399, 608, 55, 0, 0, 60, 61, 609, 610, 611,
-	612, 0, 84, 210, 212, 215, 216, 217, 88, 89,
-	90, 0, 0, 197, 0, 0, 191, 191, 0, 189,
-	190, 86, 156, 154, 0, 151, 150, 96, 0, 162,
-	162, 119, 120, 165, 0, 165, 165, 165, 0, 0,
-	113, 114, 115, 107, 0, 108, 109, 110, 0, 111,
-	0, 0, 945, 73, 624, 74, 944, 0, 0, 637,
-	224, 627, 628, 629, 630, 631, 632, 633, 634, 635,
-	636, 0, 75, 228, 230, 229, 233, 0, 0, 0,
-	255, 945, 259, 302, 302, 299, 283, 300, 301, 306,
-	290, 0, 581, 582, 0, 574, 28, 0, 619, 620,
-	565, 566, 352, 429, 431, 433, 0, 339, 420, 441,
-	424, 0, 421, 0, 0, 415, 481, 0, 0, 0,
-	448, -2, 485, 486, 0, 0, 0, 0, 0, 522,
-	0, 0, 523, 0, 571, 0, 549, 0, 0, 497,
-	0, 516, 0, 0, 517, 518, 519, 520, 521, 596,
-	0, 0, -2, 0, 0, 405, 604, 0, 355, 374,
-	376, 0, 371, 386, 387, 389, 0, 391, 0, 393,
-	394, 359, 361, 362, 0, 0, 0, 0, 382, 571,
-	0, 405, 43, 44, 0, 58, 59, 0, 0, 65,
-	166, 167, 0, 213, 0, 0, 0, 184, 191, 191,
-	187, 192, 188, 0, 158, 0, 155, 92, 152, 0,
-	165, 165, 121, 0, 122, 123, 124, 0, 140, 0,
-	0, 0, 0, 646, 72, 218, 944, 235, 236, 237,
-	238, 239, 240, 241, 242, 243, 244, 245, 246, 247,
-	248, 944, 0, 944, 638, 639, 640, 641, 0, 78,
-	0, 0, 0, 0, 0, 258, 276, 277, 302, 585,
-	0, 29, 405, 0, 346, 555, 0, 422, 0, 442,
-	425, 482, 483, 342, 0, 142, 142, 534, 142, 146,
-	537, 142, 539, 142, 542, 0, 0, 0, 0, 0,
-	0, 0, 546, 496, 552, 0, 554, 0, 0, 36,
-	0, 596, 586, 598, 600, 0, 32, 0, 592, 0,
-	366, 571, 0, 0, 368, 375, 0, 0, 369, 0,
-	370, 390, 392, 0, 0, 0, 0, 579, 406, 42,
-	62, 63, 64, 211, 214, 0, 193, 142, 196, 185,
-	186, 0, 160, 0, 157, 143, 117, 118, 163, 164,
-	162, 0, 162, 0, 147, 0, 945, 219, 220, 221,
-	222, 0, 227, 0, 76, 77, 0, 0, 232, 256,
-	282, 567, 353, 484, 426, 487, 531, 162, 535, 536,
-	538, 540, 541, 543, 489, 488, 0, 0, 0, 0,
-	0, 579, 0, 550, 0, 0, 0, 37, 0, 601,
-	-2, 0, 0, 0, 52, 0, 579, 605, 606, 372,
-	0, 377, 0, 0, 0, 380, 41, 176, 0, 195,
-	0, 364, 168, 161, 0, 165, 141, 165, 0, 0,
-	70, 0, 79, 80, 0, 0, 0, 569, 0, 532,
-	533, 0, 0, 0, 0, 524, 0, 547, 0, 0,
-	0, 599, 0, -2, 0, 594, 593, 367, 40, 0,
-	0, 402, 0, 0, 400, 175, 177, 0, 182, 0,
-	194, 0, 0, 173, 0, 170, 172, 159, 130, 131,
-	145, 148, 0, 0, 0, 0, 234, 31, 0, 0,
-	490, 492, 491, 493, 0, 0, 0, 495, 512, 513,
-	0, 589, 32, 0, 373, 401, 403, 404, 363, 178,
-	179, 0, 183, 181, 0, 365, 91, 0, 169, 171,
-	0, 250, 0, 81, 82, 75, 570, 568, 494, 0,
-	0, 0, 597, -2, 595, 180, 0, 174, 249, 0,
-	0, 78, 525, 0, 528, 0, 251, 0, 231, 526,
-	0, 0, 0, 198, 0, 0, 199, 200, 0, 0,
-	527, 201, 0, 0, 0, 0, 0, 202, 204, 205,
-	0, 0, 203, 252, 253, 206, 207, 208,
+	21, 22, 23, 24, -2, 0, 340, 340, 340, 340,
+	340, 0, 684, 667, 0, 0, 0, 0, -2, 323,
+	324, 0, 326, 327, 0, 0, 994, 994, 994, 994,
+	994, 0, 0, 994, 613, 0, 340, 0, 47, 48,
+	0, 992, 1, 3, -2, 0, 342, 667, 667, 0,
+	0, 77, 78, 0, 0, 0, 982, 0, 665, 665,
+	665, 685, 686, 689, 690, 26, 27, 28, 818, 819,
+	820, 821, 822, 823, 824, 825, 826, 827, 828, 829,
+	830, 831, 832, 833, 834, 835, 836, 837, 838, 839,
+	840, 841, 842, 843, 844, 845, 846, 847, 848, 849,
+	850, 851, 852, 853, 854, 855, 856, 857, 858, 859,
+	860, 861, 862, 863, 864, 865, 866, 867, 868, 869,
+	870, 871, 872, 873, 874, 875, 876, 877, 878, 879,
+	880, 881, 882, 883, 884, 885, 886, 887, 888, 889,
+	890, 891, 892, 893, 894, 895, 896, 897, 898, 899,
+	900, 901, 902, 903, 904, 905, 906, 907, 908, 909,
+	910, 911, 912, 913, 914, 915, 916, 917, 918, 919,
+	920, 921, 922, 923, 924, 925, 926, 927, 928, 929,
+	930, 931, 932, 933, 934, 935, 936, 937, 938, 939,
+	940, 941, 942, 943, 944, 945, 946, 947, 948, 949,
+	950, 951, 952, 953, 954, 955, 956, 957, 958, 959,
+	960, 961, 962, 963, 964, 965, 966, 967, 968, 969,
+	970, 971, 972, 973, 974, 975, 976, 977, 978, 979,
+	980, 981, 983, 984, 985, 986, 987, 988, 989, 990,
+	991, 0, 0, 0, 0, 0, 668, 0, 663, 0,
+	663, 663, 663, 0, 274, 413, 693, 694, 982, 0,
+	0, 0, 314, 0, 995, 286, 0, 0, 289, 995,
+	0, 995, 0, 296, 0, 0, 303, 995, 320, 321,
+	308, 322, 325, 0, 330, 0, 332, 333, 334, 335,
+	336, 994, 994, 339, 621, 0, 0, 344, 347, 35,
+	36, 0, 0, 0, 359, 363, 0, 423, 0, 428,
+	430, -2, -2, 0, 465, 466, 467, 468, 469, 0,
+	0, 0, 0, 0, 0, 0, 0, 493, 494, 495,
+	496, 572, 573, 574, 575, 576, 577, 578, 579, 432,
+	433, 569, 645, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 560, 0, 530, 530, 530, 530, 530, 530,
+	530, 530, 0, 0, 0, 0, 0, 0, 0, 341,
+	0, 0, 0, 0, 0, 56, 58, 413, 62, 0,
+	971, 649, -2, -2, 0, 0, 691, 692, -2, 831,
+	-2, 697, 698, 699, 700, 701, 702, 703, 704, 705,
+	706, 707, 708, 709, 710, 711, 712, 713, 714, 715,
+	716, 717, 718, 719, 720, 721, 722, 723, 724, 725,
+	726, 727, 728, 729, 730, 731, 732, 733, 734, 735,
+	736, 737, 738, 739, 740, 741, 742, 743, 744, 745,
+	746, 747, 748, 749, 750, 751, 752, 753, 754, 755,
+	756, 757, 758, 759, 760, 761, 762, 763, 764, 765,
+	766, 767, 768, 769, 770, 771, 772, 773, 774, 775,
+	776, 777, 778, 779, 780, 781, 782, 783, 784, 785,
+	786, 787, 788, 789, 790, 791, 792, 793, 794, 795,
+	796, 797, 798, 799, 800, 801, 802, 803, 804, 805,
+	806, 807, 808, 809, 810, 811, 812, 813, 814, 815,
+	816, 817, 0, 0, 96, 0, 94, 0, 995, 0,
+	0, 0, 0, 0, 0, 995, 995, 995, 0, 0,
+	0, 0, 265, 0, 0, 0, 0, 0, 0, 0,
+	273, 0, 275, 995, 314, 278, 0, 0, 995, 995,
+	995, 0, 995, 995, 285, 996, 997, 0, 0, 290,
+	995, 995, 292, 0, 311, 309, 310, 305, 306, 0,
+	317, 299, 300, 0, 304, 328, 945, 331, 337, 338,
+	625, 0, 0, 613, 43, 0, 340, 345, 346, 350,
+	348, 349, 0, 0, 0, 42, 993, 0, 360, 0,
+	0, 0, 364, 0, 366, 367, 0, 426, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 450, 451,
+	452, 453, 454, 455, 456, 429, 0, 443, 0, 0,
+	0, 485, 486, 487, 488, 489, 490, 491, 0, 355,
+	0, 0, 463, 0, 0, 0, 0, 0, 0, 0,
+	0, 350, 0, 561, 0, 514, 522, 0, 515, 523,
+	516, 524, 517, 0, 518, 525, 519, 526, 520, 521,
+	527, 0, 0, 0, 355, 355, 0, 0, 343, 60,
+	0, 412, 0, 370, 372, 373, 374, -2, 0, 396,
+	-2, 0, 0, 0, 54, 55, 0, 0, 0, 0,
+	63, 971, 65, 66, 0, 0, 0, 174, 658, 659,
+	660, 656, 218, 0, 0, 162, 158, 102, 103, 104,
+	151, 106, 151, 151, 151, 151, 171, 171, 171, 171,
+	134, 135, 136, 137, 138, 0, 0, 121, 151, 151,
+	151, 125, 141, 142, 143, 144, 145, 146, 147, 148,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 153,
+	153, 153, 155, 155, 687, 80, 0, 995, 0, 995,
+	92, 0, 232, 234, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 268, 664, 0, 995, 271,
+	272, 414, 695, 696, 276, 277, 315, 316, 279, 280,
+	281, 282, 283, 284, 311, 311, 291, 295, 0, 314,
+	0, 0, 297, 298, 0, 0, 302, 329, 31, 0,
+	0, 622, 614, 615, 618, 621, 0, 347, 0, 353,
+	351, 352, 37, 38, 0, 380, 34, 424, 425, 427,
+	444, 0, 446, 448, 365, 361, 0, 570, -2, 0,
+	434, 435, 459, 460, 461, 0, 0, 0, 0, 457,
+	439, 0, 470, 471, 472, 473, 474, 475, 476, 477,
+	478, 479, 480, 481, 484, 545, 546, 0, 482, 483,
+	492, 0, 0, 0, 356, 357, 462, 0, 644, 0,
+	0, 0, 0, 0, 467, 572, 0, 467, 572, 0,
+	0, 0, 567, 564, 0, 0, 569, 0, 531, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 401, 0, 0, 404,
+	0, 0, 0, 0, 395, 0, 0, 416, 911, 397,
+	0, 399, 400, 421, 0, 421, 57, 421, 59, 0,
+	415, 650, 64, 0, 0, 69, 70, 651, 652, 653,
+	654, 0, 93, 219, 221, 224, 225, 226, 97, 98,
+	99, 0, 0, 206, 0, 0, 200, 200, 0, 198,
+	199, 95, 165, 163, 0, 160, 159, 105, 0, 171,
+	171, 128, 129, 174, 0, 174, 174, 174, 0, 0,
+	122, 123, 124, 116, 0, 117, 118, 119, 0, 120,
+	0, 0, 995, 82, 666, 83, 994, 0, 0, 679,
+	233, 669, 670, 671, 672, 673, 674, 675, 676, 677,
+	678, 0, 84, 237, 239, 0, 238, 242, 0, 0,
+	0, 0, 266, 995, 270, 314, 314, 311, 294, 312,
+	313, 318, 301, 626, 0, 0, 0, 0, 617, 619,
+	620, 625, 44, 350, 0, 580, 0, 0, 0, 354,
+	0, 0, 445, 447, 449, 0, 355, 0, 436, 457,
+	440, 0, 437, 0, 0, 431, 592, 0, 0, 0,
+	464, -2, 501, 502, 0, 0, 0, 0, 0, 538,
+	0, 0, 539, 0, 613, 0, 565, 0, 0, 513,
+	0, 532, 0, 0, 533, 534, 535, 536, 537, 638,
+	0, 0, 629, 0, 0, 421, 646, 0, 371, 390,
+	392, 0, 387, 402, 403, 405, 0, 407, 0, 409,
+	410, 375, 377, 378, 0, 0, 0, 0, 398, 613,
+	0, 421, 52, 53, 0, 67, 68, 0, 0, 74,
+	175, 176, 0, 222, 0, 0, 0, 193, 200, 200,
+	196, 201, 197, 0, 167, 0, 164, 101, 161, 0,
+	174, 174, 130, 0, 131, 132, 133, 0, 149, 0,
+	0, 0, 0, 688, 81, 227, 994, 246, 247, 248,
+	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 994, 0, 994, 680, 681, 682, 683, 0, 87,
+	0, 245, 0, 0, 0, 0, 0, 269, 287, 288,
+	314, 0, 623, 624, 616, 32, 0, 661, 662, 581,
+	582, 368, 0, 381, 362, 571, 0, 0, 438, 0,
+	458, 441, 497, 0, 592, 592, 358, 0, 151, 151,
+	550, 151, 155, 553, 151, 555, 151, 558, 0, 0,
+	0, 0, 0, 0, 0, 562, 512, 568, 0, 570,
+	0, 0, 45, 0, 638, 628, 640, 642, 0, 0,
+	0, 634, 0, 382, 613, 0, 0, 384, 391, 0,
+	0, 385, 0, 386, 406, 408, 0, 0, 0, 0,
+	621, 422, 51, 71, 72, 73, 220, 223, 0, 202,
+	151, 205, 194, 195, 0, 169, 0, 166, 152, 126,
+	127, 172, 173, 171, 0, 171, 0, 156, 0, 995,
+	228, 229, 230, 231, 0, 236, 0, 85, 86, 0,
+	0, 0, 241, 267, 293, 627, 33, 421, 0, 39,
+	500, 442, 593, 594, 598, 498, 499, 503, 547, 171,
+	551, 552, 554, 556, 557, 559, 505, 504, 0, 0,
+	0, 0, 0, 621, 0, 566, 0, 0, 0, 46,
+	0, 643, -2, 0, 0, 0, 61, 0, 621, 647,
+	648, 388, 0, 393, 0, 0, 0, 396, 50, 185,
+	0, 204, 0, 177, 170, 0, 174, 150, 174, 0,
+	0, 79, 0, 88, 89, 0, 244, 0, 0, 583,
+	369, 0, 598, 613, 911, 548, 549, 0, 0, 0,
+	0, 540, 0, 563, 0, 0, 0, 641, 0, 632,
+	0, 636, 635, 383, 49, 0, 0, 418, 0, 0,
+	416, 184, 186, 0, 191, 0, 203, 0, 182, 0,
+	179, 181, 168, 139, 140, 154, 157, 0, 0, 0,
+	0, 243, 585, 0, 595, 0, 0, 600, 0, 506,
+	508, 507, 509, 0, 0, 0, 511, 528, 529, 0,
+	631, 0, 0, 389, 417, 419, 420, 379, 187, 188,
+	0, 192, 190, 0, 100, 0, 178, 180, 0, 261,
+	0, 90, 91, 84, 587, 0, 0, 596, 597, 0,
+	603, 604, 599, 510, 0, 0, 0, 639, -2, 637,
+	189, 0, 183, 260, 0, 0, 87, 40, 0, 586,
+	584, 601, 0, 0, 0, 0, 610, 611, 0, 541,
+	0, 544, 0, 262, 0, 240, 588, 589, 0, 0,
+	605, 606, 607, 608, 609, 0, 542, 0, 0, 0,
+	0, 0, 612, 0, 207, 0, 590, 591, 602, 0,
+	208, 209, 0, 0, 543, 210, 0, 0, 0, 0,
+	0, 211, 213, 214, 0, 0, 212, 263, 264, 215,
+	216, 217,
 }
 var yyTok1 = [...]int{
 
@@ -3207,7 +3426,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 78, 3, 3, 3, 105, 97, 3,
 	55, 57, 102, 100, 56, 101, 115, 103, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 350,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 359,
 	86, 85, 87, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -3261,7 +3480,8 @@ var yyTok3 = [...]int{
 	57660, 335, 57661, 336, 57662, 337, 57663, 338, 57664, 339,
 	57665, 340, 57666, 341, 57667, 342, 57668, 343, 57669, 344,
 	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
-	0,
+	57675, 350, 57676, 351, 57677, 352, 57678, 353, 57679, 354,
+	57680, 355, 57681, 356, 57682, 357, 57683, 358, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -3603,53 +3823,66 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:324
+//line sql.y:346
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:329
+//line sql.y:351
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:330
+//line sql.y:352
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:334
+//line sql.y:356
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 23:
+	case 25:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:356
+//line sql.y:380
 		{
 			setParseTree(yylex, nil)
 		}
-	case 24:
+	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:362
+//line sql.y:386
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), NoAt)
 		}
-	case 25:
+	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:366
+//line sql.y:390
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), SingleAt)
 		}
-	case 26:
+	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:370
+//line sql.y:394
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), DoubleAt)
 		}
-	case 27:
+	case 29:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:400
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 30:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:404
+		{
+			yyDollar[2].selStmt.SetWith(yyDollar[1].with)
+			yyVAL.selStmt = yyDollar[2].selStmt
+		}
+	case 31:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:376
+//line sql.y:412
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3657,57 +3890,87 @@ yydefault:
 			sel.Lock = yyDollar[4].str
 			yyVAL.selStmt = sel
 		}
-	case 28:
+	case 32:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:384
+//line sql.y:420
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 29:
+	case 33:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:388
+//line sql.y:424
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
-	case 30:
+	case 34:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:394
+//line sql.y:430
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 31:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:401
+	case 35:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:436
 		{
-			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+			yyVAL.with = yyDollar[2].with
 		}
-	case 32:
+	case 36:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:442
+		{
+			yyVAL.with = With{yyDollar[1].cte}
+		}
+	case 37:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:446
+		{
+			yyVAL.with = append(yyDollar[1].with, yyDollar[3].cte)
+		}
+	case 38:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:452
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Subquery: yyDollar[3].subquery}
+		}
+	case 39:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:456
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[3].columns, Subquery: yyDollar[6].subquery}
+		}
+	case 40:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:463
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr), Windows: yyDollar[11].namedWindows}
+		}
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:407
+//line sql.y:469
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 33:
+	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:411
+//line sql.y:473
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 34:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:417
+//line sql.y:479
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 35:
+	case 44:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:421
+//line sql.y:483
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 36:
+	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:428
+//line sql.y:490
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3719,9 +3982,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 37:
+	case 46:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:440
+//line sql.y:502
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3731,334 +3994,334 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 38:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:452
+//line sql.y:514
 		{
 			yyVAL.str = InsertStr
 		}
-	case 39:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:456
+//line sql.y:518
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 40:
+	case 49:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:462
+//line sql.y:524
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 41:
+	case 50:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:468
+//line sql.y:530
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 42:
+	case 51:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:472
+//line sql.y:534
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 43:
+	case 52:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:476
+//line sql.y:538
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 44:
+	case 53:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:480
+//line sql.y:542
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 45:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:485
+//line sql.y:547
 		{
 		}
-	case 46:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:486
+//line sql.y:548
 		{
 		}
-	case 47:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:490
+//line sql.y:552
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 48:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:494
+//line sql.y:556
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 49:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:500
+//line sql.y:562
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 50:
+	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:504
+//line sql.y:566
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 51:
+	case 60:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:509
+//line sql.y:571
 		{
 			yyVAL.partitions = nil
 		}
-	case 52:
+	case 61:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:513
+//line sql.y:575
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 53:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:519
+//line sql.y:581
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 54:
+	case 63:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:523
+//line sql.y:585
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 55:
+	case 64:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:527
+//line sql.y:589
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 56:
+	case 65:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:531
+//line sql.y:593
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 57:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:537
+//line sql.y:599
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 58:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:541
+//line sql.y:603
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 59:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:547
+//line sql.y:609
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
 		}
-	case 60:
+	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:551
+//line sql.y:613
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
 		}
-	case 61:
+	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:555
+//line sql.y:617
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
 		}
-	case 62:
+	case 71:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:561
+//line sql.y:623
 		{
 			yyVAL.str = IsolationLevelRepeatableRead
 		}
-	case 63:
+	case 72:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:565
+//line sql.y:627
 		{
 			yyVAL.str = IsolationLevelReadCommitted
 		}
-	case 64:
+	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:569
+//line sql.y:631
 		{
 			yyVAL.str = IsolationLevelReadUncommitted
 		}
-	case 65:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:573
+//line sql.y:635
 		{
 			yyVAL.str = IsolationLevelSerializable
 		}
-	case 66:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:579
+//line sql.y:641
 		{
 			yyVAL.str = SessionStr
 		}
-	case 67:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:583
+//line sql.y:645
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 68:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:589
+//line sql.y:651
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 69:
+	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:594
+//line sql.y:656
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 70:
+	case 79:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:600
+//line sql.y:662
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
-	case 71:
+	case 80:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:605
+//line sql.y:667
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 72:
+	case 81:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:609
+//line sql.y:671
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
-	case 73:
+	case 82:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:613
+//line sql.y:675
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].colIdent.String())}
 		}
-	case 74:
+	case 83:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:617
+//line sql.y:679
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].colIdent.String())}
 		}
-	case 75:
+	case 84:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:622
+//line sql.y:684
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 76:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:626
+//line sql.y:688
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 77:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:632
+//line sql.y:694
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 78:
+	case 87:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:637
+//line sql.y:699
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 79:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:642
+//line sql.y:704
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 80:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:648
+//line sql.y:710
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 81:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:653
+//line sql.y:715
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 82:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:659
+//line sql.y:721
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 83:
+	case 92:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:665
+//line sql.y:727
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 84:
+	case 93:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:672
+//line sql.y:734
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 85:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:679
+//line sql.y:741
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 86:
+	case 95:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:683
+//line sql.y:745
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 87:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:689
+//line sql.y:751
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 88:
+	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:694
+//line sql.y:756
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 89:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:698
+//line sql.y:760
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 90:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:702
+//line sql.y:764
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 91:
+	case 100:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:708
+//line sql.y:770
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -4068,826 +4331,826 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
-	case 92:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:719
+//line sql.y:781
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 96:
+	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:730
+//line sql.y:792
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 97:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:735
+//line sql.y:797
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 98:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:741
+//line sql.y:803
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 99:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:745
+//line sql.y:807
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 100:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:749
+//line sql.y:811
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:753
+//line sql.y:815
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 102:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:757
+//line sql.y:819
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 103:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:761
+//line sql.y:823
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 104:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:765
+//line sql.y:827
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 105:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:769
+//line sql.y:831
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 106:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:773
+//line sql.y:835
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 107:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:779
+//line sql.y:841
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 108:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:785
+//line sql.y:847
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 109:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:791
+//line sql.y:853
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 110:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:797
+//line sql.y:859
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 111:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:803
+//line sql.y:865
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 112:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:811
+//line sql.y:873
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 113:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:815
+//line sql.y:877
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 114:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:819
+//line sql.y:881
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 115:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:823
+//line sql.y:885
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 116:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:827
+//line sql.y:889
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 117:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:833
+//line sql.y:895
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 118:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:837
+//line sql.y:899
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 119:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:841
+//line sql.y:903
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 120:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:845
+//line sql.y:907
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 121:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:849
+//line sql.y:911
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 122:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:853
+//line sql.y:915
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 123:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:857
+//line sql.y:919
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 124:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:861
+//line sql.y:923
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 125:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:865
+//line sql.y:927
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 126:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:869
+//line sql.y:931
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 127:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:873
+//line sql.y:935
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 128:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:877
+//line sql.y:939
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 129:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:881
+//line sql.y:943
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 139:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:885
+//line sql.y:947
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 131:
+	case 140:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:890
+//line sql.y:952
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 132:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:896
+//line sql.y:958
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 133:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:900
+//line sql.y:962
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 134:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:904
+//line sql.y:966
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 135:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:908
+//line sql.y:970
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 136:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:912
+//line sql.y:974
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 137:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:916
+//line sql.y:978
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 138:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:920
+//line sql.y:982
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 139:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:924
+//line sql.y:986
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 140:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:930
+//line sql.y:992
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 141:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:935
+//line sql.y:997
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 142:
+	case 151:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:940
+//line sql.y:1002
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 143:
+	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:944
+//line sql.y:1006
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 144:
+	case 153:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:949
+//line sql.y:1011
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 145:
+	case 154:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:953
+//line sql.y:1015
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 146:
+	case 155:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:961
+//line sql.y:1023
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 147:
+	case 156:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:965
+//line sql.y:1027
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 148:
+	case 157:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:971
+//line sql.y:1033
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 149:
+	case 158:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:979
+//line sql.y:1041
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 150:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:983
+//line sql.y:1045
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 151:
+	case 160:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:988
+//line sql.y:1050
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 152:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:992
+//line sql.y:1054
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 153:
+	case 162:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:998
+//line sql.y:1060
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 154:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1002
+//line sql.y:1064
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 155:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1006
+//line sql.y:1068
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 156:
+	case 165:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1011
+//line sql.y:1073
 		{
 			yyVAL.optVal = nil
 		}
-	case 157:
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1015
+//line sql.y:1077
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 158:
+	case 167:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1020
+//line sql.y:1082
 		{
 			yyVAL.optVal = nil
 		}
-	case 159:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1024
+//line sql.y:1086
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 160:
+	case 169:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1029
+//line sql.y:1091
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 161:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1033
+//line sql.y:1095
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 162:
+	case 171:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1038
+//line sql.y:1100
 		{
 			yyVAL.str = ""
 		}
-	case 163:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1042
+//line sql.y:1104
 		{
 			yyVAL.str = string(yyDollar[3].colIdent.String())
 		}
-	case 164:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1046
+//line sql.y:1108
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 165:
+	case 174:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1051
+//line sql.y:1113
 		{
 			yyVAL.str = ""
 		}
-	case 166:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1055
+//line sql.y:1117
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 167:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1059
+//line sql.y:1121
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 168:
+	case 177:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1064
+//line sql.y:1126
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 169:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1068
+//line sql.y:1130
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 170:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1072
+//line sql.y:1134
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 171:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1076
+//line sql.y:1138
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 172:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1080
+//line sql.y:1142
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 173:
+	case 182:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1085
+//line sql.y:1147
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 174:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1089
+//line sql.y:1151
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 175:
+	case 184:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1095
+//line sql.y:1157
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 176:
+	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1099
+//line sql.y:1161
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 177:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1105
+//line sql.y:1167
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 178:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1109
+//line sql.y:1171
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 179:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1115
+//line sql.y:1177
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].colIdent.String())}
 		}
-	case 180:
+	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1119
+//line sql.y:1181
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 181:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1124
+//line sql.y:1186
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 182:
+	case 191:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1130
+//line sql.y:1192
 		{
 			yyVAL.str = ""
 		}
-	case 183:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1134
+//line sql.y:1196
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 184:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1140
+//line sql.y:1202
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 185:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1144
+//line sql.y:1206
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 186:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1148
+//line sql.y:1210
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 187:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1152
+//line sql.y:1214
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 188:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1156
+//line sql.y:1218
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 189:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1162
+//line sql.y:1224
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 190:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1166
+//line sql.y:1228
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 191:
+	case 200:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1171
+//line sql.y:1233
 		{
 			yyVAL.str = ""
 		}
-	case 192:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1175
+//line sql.y:1237
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 193:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1181
+//line sql.y:1243
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 194:
+	case 203:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1185
+//line sql.y:1247
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 195:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1191
+//line sql.y:1253
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 196:
+	case 205:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1197
+//line sql.y:1259
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 197:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1201
+//line sql.y:1263
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 198:
+	case 207:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1208
+//line sql.y:1270
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 199:
+	case 208:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1212
+//line sql.y:1274
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 200:
+	case 209:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1216
+//line sql.y:1278
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 201:
+	case 210:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1220
+//line sql.y:1282
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 202:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1226
+//line sql.y:1288
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 203:
+	case 212:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1232
+//line sql.y:1294
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 204:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1238
+//line sql.y:1300
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 205:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1242
+//line sql.y:1304
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 206:
+	case 215:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1246
+//line sql.y:1308
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 207:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1250
+//line sql.y:1312
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 208:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1254
+//line sql.y:1316
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 209:
+	case 218:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1259
+//line sql.y:1321
 		{
 			yyVAL.str = ""
 		}
-	case 210:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1263
+//line sql.y:1325
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 211:
+	case 220:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1267
+//line sql.y:1329
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 212:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1275
+//line sql.y:1337
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 213:
+	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1279
+//line sql.y:1341
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 214:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1283
+//line sql.y:1345
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 215:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1289
+//line sql.y:1351
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 216:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1293
+//line sql.y:1355
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 217:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1297
+//line sql.y:1359
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 218:
+	case 227:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1303
+//line sql.y:1365
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 219:
+	case 228:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1307
+//line sql.y:1369
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 220:
+	case 229:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1311
+//line sql.y:1373
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 221:
+	case 230:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1315
+//line sql.y:1377
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
-	case 222:
+	case 231:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1320
+//line sql.y:1382
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 223:
+	case 232:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1325
+//line sql.y:1387
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 224:
+	case 233:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1329
+//line sql.y:1391
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 225:
+	case 234:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1333
+//line sql.y:1395
 		{
 			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].colIdent.String())}
 		}
-	case 226:
+	case 235:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1337
+//line sql.y:1399
 		{
 			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].colIdent.String())}
 		}
-	case 227:
+	case 236:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1341
+//line sql.y:1403
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateVindexStr,
@@ -4899,9 +5162,9 @@ yydefault:
 				},
 			}
 		}
-	case 228:
+	case 237:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1353
+//line sql.y:1415
 		{
 			yyVAL.statement = &DDL{
 				Action: DropVindexStr,
@@ -4911,21 +5174,21 @@ yydefault:
 				},
 			}
 		}
-	case 229:
+	case 238:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1363
+//line sql.y:1425
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 230:
+	case 239:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1367
+//line sql.y:1429
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 231:
+	case 240:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1371
+//line sql.y:1433
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -4938,9 +5201,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 232:
+	case 241:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1384
+//line sql.y:1446
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -4950,15 +5213,15 @@ yydefault:
 				},
 			}
 		}
-	case 233:
+	case 242:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1394
+//line sql.y:1456
 		{
 			yyVAL.statement = &DDL{Action: AddSequenceStr, Table: yyDollar[5].tableName}
 		}
-	case 234:
+	case 243:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1398
+//line sql.y:1460
 		{
 			yyVAL.statement = &DDL{
 				Action: AddAutoIncStr,
@@ -4969,59 +5232,71 @@ yydefault:
 				},
 			}
 		}
-	case 249:
+	case 244:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1471
+		{
+			yyVAL.statement = &DDL{Action: AddRoutingRuleStr, Table: yyDollar[6].tableName, ToTables: yyDollar[8].tableNames}
+		}
+	case 245:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1475
+		{
+			yyVAL.statement = &DDL{Action: DropRoutingRuleStr, Table: yyDollar[6].tableName}
+		}
+	case 260:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1427
+//line sql.y:1497
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 250:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1433
+//line sql.y:1503
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 251:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1437
+//line sql.y:1507
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 252:
+	case 263:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1443
+//line sql.y:1513
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 253:
+	case 264:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1447
+//line sql.y:1517
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 254:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1453
+//line sql.y:1523
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 255:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1459
+//line sql.y:1529
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 256:
+	case 267:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1463
+//line sql.y:1533
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 257:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1471
+//line sql.y:1541
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5029,16 +5304,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
 		}
-	case 258:
+	case 269:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1479
+//line sql.y:1549
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 259:
+	case 270:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1484
+//line sql.y:1554
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5046,152 +5321,152 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 260:
+	case 271:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1492
+//line sql.y:1562
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].colIdent.String())}
 		}
-	case 261:
+	case 272:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1496
+//line sql.y:1566
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].colIdent.String())}
 		}
-	case 262:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1502
+//line sql.y:1572
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 263:
+	case 274:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1506
+//line sql.y:1576
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 264:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1511
+//line sql.y:1581
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 265:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1517
+//line sql.y:1587
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String())}
 		}
-	case 266:
+	case 277:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1522
+//line sql.y:1592
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Type: CharsetStr, ShowTablesOpt: showTablesOpt}
 		}
-	case 267:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1527
+//line sql.y:1597
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 268:
+	case 279:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1532
+//line sql.y:1602
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 269:
+	case 280:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1537
+//line sql.y:1607
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String())}
 		}
-	case 270:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1541
+//line sql.y:1611
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 271:
+	case 282:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1545
+//line sql.y:1615
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
-	case 272:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1549
+//line sql.y:1619
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 273:
+	case 284:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1553
+//line sql.y:1623
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 274:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1557
+//line sql.y:1627
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 275:
+	case 286:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1561
+//line sql.y:1631
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 276:
+	case 287:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1565
+//line sql.y:1635
 		{
 			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[5].str, Filter: yyDollar[6].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[4].tableName}
 		}
-	case 277:
+	case 288:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1570
+//line sql.y:1640
 		{
 			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[5].str, Filter: yyDollar[6].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[4].tableName}
 		}
-	case 278:
+	case 289:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1575
+//line sql.y:1645
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 279:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1579
+//line sql.y:1649
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 280:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1583
+//line sql.y:1653
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 281:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1587
+//line sql.y:1657
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 282:
+	case 293:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1591
+//line sql.y:1661
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 283:
+	case 294:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1596
+//line sql.y:1666
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -5201,651 +5476,681 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 284:
+	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1606
+//line sql.y:1676
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 285:
+	case 296:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1610
+//line sql.y:1680
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 286:
+	case 297:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1614
+//line sql.y:1684
 		{
 			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
 			showCollationFilterOpt := yyDollar[4].expr
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
 		}
-	case 287:
+	case 298:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1620
+//line sql.y:1690
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Scope: string(yyDollar[2].bytes), Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 288:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1625
+//line sql.y:1695
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 289:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1629
+//line sql.y:1699
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 290:
+	case 301:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1633
+//line sql.y:1703
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 291:
+	case 302:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1707
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes)}
+		}
+	case 303:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1637
+//line sql.y:1711
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 292:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1651
+//line sql.y:1725
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].colIdent.String())}
 		}
-	case 293:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1657
+//line sql.y:1731
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 294:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1661
+//line sql.y:1735
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 295:
+	case 307:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1667
+//line sql.y:1741
 		{
 			yyVAL.str = ""
 		}
-	case 296:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1671
+//line sql.y:1745
 		{
 			yyVAL.str = "full "
 		}
-	case 297:
+	case 309:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1677
+//line sql.y:1751
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 298:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1681
+//line sql.y:1755
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 299:
+	case 311:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1687
+//line sql.y:1761
 		{
 			yyVAL.str = ""
 		}
-	case 300:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1691
+//line sql.y:1765
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 301:
+	case 313:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1695
+//line sql.y:1769
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 302:
+	case 314:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1701
+//line sql.y:1775
 		{
 			yyVAL.showFilter = nil
 		}
-	case 303:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1705
+//line sql.y:1779
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 304:
+	case 316:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1709
+//line sql.y:1783
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 305:
+	case 317:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1715
+//line sql.y:1789
 		{
 			yyVAL.showFilter = nil
 		}
-	case 306:
+	case 318:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1719
+//line sql.y:1793
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 307:
+	case 319:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1725
+//line sql.y:1799
 		{
 			yyVAL.str = ""
 		}
-	case 308:
+	case 320:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1729
+//line sql.y:1803
 		{
 			yyVAL.str = SessionStr
 		}
-	case 309:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1733
+//line sql.y:1807
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 310:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1739
+//line sql.y:1813
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 311:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1743
+//line sql.y:1817
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 312:
+	case 324:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1749
+//line sql.y:1823
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 313:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1753
+//line sql.y:1827
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 314:
+	case 326:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1759
+//line sql.y:1833
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 315:
+	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1765
+//line sql.y:1839
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 316:
+	case 328:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1843
+		{
+			yyVAL.statement = &SRollback{Name: yyDollar[3].colIdent}
+		}
+	case 329:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1847
+		{
+			yyVAL.statement = &SRollback{Name: yyDollar[4].colIdent}
+		}
+	case 330:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1853
+		{
+			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
+		}
+	case 331:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1859
+		{
+			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
+		}
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1771
+//line sql.y:1865
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 317:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1775
+//line sql.y:1869
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 318:
+	case 334:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1779
+//line sql.y:1873
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 319:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1783
+//line sql.y:1877
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 320:
+	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1787
+//line sql.y:1881
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 321:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1791
+//line sql.y:1885
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 322:
+	case 338:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1795
+//line sql.y:1889
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 323:
+	case 339:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1801
+//line sql.y:1895
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 324:
+	case 340:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1805
+//line sql.y:1899
 		{
 			setAllowComments(yylex, true)
 		}
-	case 325:
+	case 341:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1809
+//line sql.y:1903
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 326:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1815
+//line sql.y:1909
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 327:
+	case 343:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1819
+//line sql.y:1913
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 328:
+	case 344:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1825
+//line sql.y:1919
 		{
 			yyVAL.str = UnionStr
 		}
-	case 329:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1829
+//line sql.y:1923
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 330:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1833
+//line sql.y:1927
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 331:
+	case 347:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1838
+//line sql.y:1932
 		{
 			yyVAL.str = ""
 		}
-	case 332:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1842
+//line sql.y:1936
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 333:
+	case 349:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1846
+//line sql.y:1940
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 334:
+	case 350:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1851
+//line sql.y:1945
 		{
 			yyVAL.str = ""
 		}
-	case 335:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1855
+//line sql.y:1949
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 336:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1859
+//line sql.y:1953
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 337:
+	case 353:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1864
+//line sql.y:1958
 		{
 			yyVAL.str = ""
 		}
-	case 338:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1868
+//line sql.y:1962
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 339:
+	case 355:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1873
+//line sql.y:1967
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 340:
+	case 356:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1877
+//line sql.y:1971
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 341:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1883
+//line sql.y:1977
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 342:
+	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1887
+//line sql.y:1981
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 343:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1893
+//line sql.y:1987
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 344:
+	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1897
+//line sql.y:1991
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 345:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1901
+//line sql.y:1995
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 346:
+	case 362:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1905
+//line sql.y:1999
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 347:
+	case 363:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1910
+//line sql.y:2004
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 348:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1914
+//line sql.y:2008
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 349:
+	case 365:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1918
+//line sql.y:2012
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 351:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1925
+//line sql.y:2019
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 352:
+	case 368:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1930
+//line sql.y:2024
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 353:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1934
+//line sql.y:2028
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 354:
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1940
+//line sql.y:2034
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 355:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1944
+//line sql.y:2038
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 358:
+	case 374:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1954
+//line sql.y:2048
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 359:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1958
+//line sql.y:2052
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 360:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1962
+//line sql.y:2056
 		{
 			// missed alias for subquery
 			yylex.Error("Every derived table must have its own alias")
 			return 1
 		}
-	case 361:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1968
+//line sql.y:2062
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 362:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1974
+//line sql.y:2068
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 363:
+	case 379:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1978
+//line sql.y:2072
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 364:
+	case 380:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1984
+//line sql.y:2078
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 365:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1988
+//line sql.y:2082
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 366:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1994
+//line sql.y:2088
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 367:
+	case 383:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1998
+//line sql.y:2092
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 368:
+	case 384:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2011
+//line sql.y:2105
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 369:
+	case 385:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2015
+//line sql.y:2109
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 370:
+	case 386:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2019
+//line sql.y:2113
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 371:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2023
+//line sql.y:2117
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 372:
+	case 388:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2029
+//line sql.y:2123
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 373:
+	case 389:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2031
+//line sql.y:2125
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 374:
+	case 390:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2035
+//line sql.y:2129
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 375:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2037
+//line sql.y:2131
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 376:
+	case 392:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2041
+//line sql.y:2135
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 377:
+	case 393:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2043
+//line sql.y:2137
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 378:
+	case 394:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2046
+//line sql.y:2140
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 379:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2048
+//line sql.y:2142
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 380:
+	case 396:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2051
+//line sql.y:2145
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 381:
+	case 397:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2055
+//line sql.y:2149
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 382:
+	case 398:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2059
+//line sql.y:2153
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 384:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2066
+//line sql.y:2160
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 385:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2072
+//line sql.y:2166
 		{
 			yyVAL.str = JoinStr
 		}
-	case 386:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2076
+//line sql.y:2170
 		{
 			yyVAL.str = JoinStr
 		}
-	case 387:
+	case 403:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2080
+//line sql.y:2174
 		{
 			yyVAL.str = JoinStr
 		}
-	case 388:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2086
+//line sql.y:2180
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 389:
+	case 405:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2092
+//line sql.y:2186
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 390:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2096
+//line sql.y:2190
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 391:
+	case 407:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2100
+//line sql.y:2194
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 392:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2104
+//line sql.y:2198
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 393:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2110
+//line sql.y:2204
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 394:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2114
+//line sql.y:2208
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -5853,471 +6158,471 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 395:
+	case 411:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2124
+//line sql.y:2218
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 396:
+	case 412:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2128
+//line sql.y:2222
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 397:
+	case 413:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2134
+//line sql.y:2228
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 398:
+	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2138
+//line sql.y:2232
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 399:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2144
+//line sql.y:2238
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 400:
+	case 416:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2149
+//line sql.y:2243
 		{
 			yyVAL.indexHints = nil
 		}
-	case 401:
+	case 417:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2153
+//line sql.y:2247
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 402:
+	case 418:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2157
+//line sql.y:2251
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr}
 		}
-	case 403:
+	case 419:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2161
+//line sql.y:2255
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 404:
+	case 420:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2165
+//line sql.y:2259
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 405:
+	case 421:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2170
+//line sql.y:2264
 		{
 			yyVAL.expr = nil
 		}
-	case 406:
+	case 422:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2174
+//line sql.y:2268
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 407:
+	case 423:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2180
+//line sql.y:2274
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 408:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2184
+//line sql.y:2278
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 409:
+	case 425:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2188
+//line sql.y:2282
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 410:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2192
+//line sql.y:2286
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 411:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2196
+//line sql.y:2290
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 412:
+	case 428:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2200
+//line sql.y:2294
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 413:
+	case 429:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2204
+//line sql.y:2298
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 414:
+	case 430:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2210
+//line sql.y:2304
 		{
 			yyVAL.str = ""
 		}
-	case 415:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2214
+//line sql.y:2308
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 416:
+	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2220
+//line sql.y:2314
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 417:
+	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2224
+//line sql.y:2318
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 418:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2230
+//line sql.y:2324
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 419:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2234
+//line sql.y:2328
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 420:
+	case 436:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2238
+//line sql.y:2332
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 421:
+	case 437:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2242
+//line sql.y:2336
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 422:
+	case 438:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2246
+//line sql.y:2340
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 423:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2250
+//line sql.y:2344
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 424:
+	case 440:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2254
+//line sql.y:2348
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 425:
+	case 441:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2258
+//line sql.y:2352
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 426:
+	case 442:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2262
+//line sql.y:2356
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 427:
+	case 443:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2266
+//line sql.y:2360
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 428:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2272
+//line sql.y:2366
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 429:
+	case 445:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2276
+//line sql.y:2370
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 430:
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2280
+//line sql.y:2374
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 431:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2284
+//line sql.y:2378
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 432:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2288
+//line sql.y:2382
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 433:
+	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2292
+//line sql.y:2386
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 434:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2298
+//line sql.y:2392
 		{
 			yyVAL.str = EqualStr
 		}
-	case 435:
+	case 451:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2302
+//line sql.y:2396
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 436:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2306
+//line sql.y:2400
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 437:
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2310
+//line sql.y:2404
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 438:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2314
+//line sql.y:2408
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 439:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2318
+//line sql.y:2412
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 440:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2322
+//line sql.y:2416
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 441:
+	case 457:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2327
+//line sql.y:2421
 		{
 			yyVAL.expr = nil
 		}
-	case 442:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2331
+//line sql.y:2425
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 443:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2337
+//line sql.y:2431
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 444:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2341
+//line sql.y:2435
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 445:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2345
+//line sql.y:2439
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 446:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2351
+//line sql.y:2445
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 447:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2357
+//line sql.y:2451
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 448:
+	case 464:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2361
+//line sql.y:2455
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 449:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2367
+//line sql.y:2461
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 450:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2371
+//line sql.y:2465
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 451:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2375
+//line sql.y:2469
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 452:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2379
+//line sql.y:2473
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 453:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2383
+//line sql.y:2477
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 454:
+	case 470:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2387
+//line sql.y:2481
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 455:
+	case 471:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2391
+//line sql.y:2485
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 456:
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2395
+//line sql.y:2489
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 457:
+	case 473:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2399
+//line sql.y:2493
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 458:
+	case 474:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2403
+//line sql.y:2497
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 459:
+	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2407
+//line sql.y:2501
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 460:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2411
+//line sql.y:2505
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 461:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2415
+//line sql.y:2509
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 462:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2419
+//line sql.y:2513
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 463:
+	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2423
+//line sql.y:2517
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 464:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2427
+//line sql.y:2521
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 465:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2431
+//line sql.y:2525
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 466:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2435
+//line sql.y:2529
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 467:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2439
+//line sql.y:2533
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 468:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2443
+//line sql.y:2537
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 469:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2447
+//line sql.y:2541
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 470:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2451
+//line sql.y:2545
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 471:
+	case 487:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2455
+//line sql.y:2549
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
-	case 472:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2459
+//line sql.y:2553
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6325,9 +6630,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 473:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2467
+//line sql.y:2561
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6341,21 +6646,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 474:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2481
+//line sql.y:2575
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 475:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2485
+//line sql.y:2579
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 476:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2489
+//line sql.y:2583
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6363,497 +6668,497 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 481:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2507
+	case 497:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2601
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overClause}
 		}
-	case 482:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2511
+	case 498:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2605
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs, Over: yyDollar[6].overClause}
 		}
-	case 483:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2515
+	case 499:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2609
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs, Over: yyDollar[6].overClause}
 		}
-	case 484:
+	case 500:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2519
+//line sql.y:2613
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 485:
+	case 501:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2529
+//line sql.y:2623
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 486:
+	case 502:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2533
+//line sql.y:2627
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 487:
+	case 503:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2537
+//line sql.y:2631
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 488:
+	case 504:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2541
+//line sql.y:2635
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 489:
+	case 505:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2545
+//line sql.y:2639
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 490:
+	case 506:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2549
+//line sql.y:2643
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 491:
+	case 507:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2553
+//line sql.y:2647
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 492:
+	case 508:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2557
+//line sql.y:2651
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 493:
+	case 509:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2561
+//line sql.y:2655
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 494:
+	case 510:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2565
+//line sql.y:2659
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 495:
+	case 511:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2569
+//line sql.y:2663
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
 		}
-	case 496:
+	case 512:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2573
+//line sql.y:2667
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 497:
+	case 513:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2577
+//line sql.y:2671
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 498:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2587
+//line sql.y:2681
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 499:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2591
+//line sql.y:2685
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 500:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2595
+//line sql.y:2689
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 501:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2600
+//line sql.y:2694
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 502:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2605
+//line sql.y:2699
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 503:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2610
+//line sql.y:2704
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 504:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2616
+//line sql.y:2710
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 505:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2621
+//line sql.y:2715
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 506:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2626
+//line sql.y:2720
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 507:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2630
+//line sql.y:2724
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 508:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2634
+//line sql.y:2728
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 509:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2639
+//line sql.y:2733
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 510:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2644
+//line sql.y:2738
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 511:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2649
+//line sql.y:2743
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 512:
+	case 528:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2653
+//line sql.y:2747
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 513:
+	case 529:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2657
+//line sql.y:2751
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 516:
+	case 532:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2667
+//line sql.y:2761
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 517:
+	case 533:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2677
+//line sql.y:2771
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 518:
+	case 534:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2681
+//line sql.y:2775
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 519:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2685
+//line sql.y:2779
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 520:
+	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2689
+//line sql.y:2783
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 521:
+	case 537:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2693
+//line sql.y:2787
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 522:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2697
+//line sql.y:2791
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 523:
+	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2701
+//line sql.y:2795
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 524:
+	case 540:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2707
+//line sql.y:2801
 		{
 			yyVAL.str = ""
 		}
-	case 525:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2711
+//line sql.y:2805
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 526:
+	case 542:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2715
+//line sql.y:2809
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 527:
+	case 543:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2719
+//line sql.y:2813
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 528:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2723
+//line sql.y:2817
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 529:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2729
+//line sql.y:2823
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 530:
+	case 546:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2733
+//line sql.y:2827
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 531:
+	case 547:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2739
+//line sql.y:2833
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 532:
+	case 548:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2743
+//line sql.y:2837
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 533:
+	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2747
+//line sql.y:2841
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].colIdent.String())}
 		}
-	case 534:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2751
+//line sql.y:2845
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 535:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2755
+//line sql.y:2849
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 536:
+	case 552:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2759
+//line sql.y:2853
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 537:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2765
+//line sql.y:2859
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 538:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2769
+//line sql.y:2863
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 539:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2773
+//line sql.y:2867
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 540:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2777
+//line sql.y:2871
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 541:
+	case 557:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2781
+//line sql.y:2875
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 542:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2785
+//line sql.y:2879
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 543:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2789
+//line sql.y:2883
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 544:
+	case 560:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2794
+//line sql.y:2888
 		{
 			yyVAL.expr = nil
 		}
-	case 545:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2798
+//line sql.y:2892
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 546:
+	case 562:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2803
+//line sql.y:2897
 		{
 			yyVAL.str = string("")
 		}
-	case 547:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2807
+//line sql.y:2901
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 548:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2813
+//line sql.y:2907
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 549:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2817
+//line sql.y:2911
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 550:
+	case 566:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2823
+//line sql.y:2917
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 551:
+	case 567:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2828
+//line sql.y:2922
 		{
 			yyVAL.expr = nil
 		}
-	case 552:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2832
+//line sql.y:2926
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 553:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2838
+//line sql.y:2932
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 554:
+	case 570:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2842
+//line sql.y:2936
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 555:
+	case 571:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2846
+//line sql.y:2940
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 556:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2852
+//line sql.y:2946
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 557:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2856
+//line sql.y:2950
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 558:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2860
+//line sql.y:2954
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 559:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2864
+//line sql.y:2958
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 560:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2868
+//line sql.y:2962
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 561:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2872
+//line sql.y:2966
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 562:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2876
+//line sql.y:2970
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 563:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2880
+//line sql.y:2974
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 564:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2886
+//line sql.y:2980
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -6862,239 +7167,396 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 565:
+	case 581:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2895
+//line sql.y:2989
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 566:
+	case 582:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2899
+//line sql.y:2993
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 567:
+	case 583:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2904
+//line sql.y:2998
 		{
 			yyVAL.exprs = nil
 		}
-	case 568:
+	case 584:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2908
+//line sql.y:3002
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 569:
+	case 585:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2913
+//line sql.y:3007
 		{
 			yyVAL.expr = nil
 		}
-	case 570:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2917
+//line sql.y:3011
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 571:
+	case 587:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2922
+//line sql.y:3016
+		{
+			yyVAL.namedWindows = nil
+		}
+	case 588:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3020
+		{
+			yyVAL.namedWindows = yyDollar[2].namedWindows
+		}
+	case 589:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3026
+		{
+			yyVAL.namedWindows = NamedWindows{yyDollar[1].namedWindow}
+		}
+	case 590:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3030
+		{
+			yyVAL.namedWindows = append(yyDollar[1].namedWindows, yyDollar[3].namedWindow)
+		}
+	case 591:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3036
+		{
+			yyVAL.namedWindow = &NamedWindow{Name: yyDollar[1].colIdent, WindowSpec: yyDollar[3].windowSpec}
+		}
+	case 592:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3041
+		{
+			yyVAL.overClause = nil
+		}
+	case 593:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3045
+		{
+			yyVAL.overClause = &OverClause{WindowName: yyDollar[2].colIdent}
+		}
+	case 594:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3049
+		{
+			yyVAL.overClause = &OverClause{WindowSpec: yyDollar[2].windowSpec}
+		}
+	case 595:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3055
+		{
+			yyVAL.windowSpec = yyDollar[2].windowSpec
+		}
+	case 596:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3059
+		{
+			yyDollar[3].windowSpec.Name = yyDollar[2].colIdent
+			yyVAL.windowSpec = yyDollar[3].windowSpec
+		}
+	case 597:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3066
+		{
+			yyVAL.windowSpec = &WindowSpecification{PartitionClause: yyDollar[1].exprs, OrderClause: yyDollar[2].orderBy, FrameClause: yyDollar[3].frameClause}
+		}
+	case 598:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3071
+		{
+			yyVAL.exprs = nil
+		}
+	case 599:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3075
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 600:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3080
+		{
+			yyVAL.frameClause = nil
+		}
+	case 601:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3084
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[2].framePoint}
+		}
+	case 602:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3088
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[3].framePoint, End: yyDollar[5].framePoint}
+		}
+	case 603:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3094
+		{
+			yyVAL.str = RowsStr
+		}
+	case 604:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3098
+		{
+			yyVAL.str = RangeStr
+		}
+	case 605:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3104
+		{
+			yyVAL.framePoint = &FramePoint{Type: CurrentRowStr}
+		}
+	case 606:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3108
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedPrecedingStr}
+		}
+	case 607:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3112
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedFollowingStr}
+		}
+	case 608:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3116
+		{
+			yyVAL.framePoint = &FramePoint{Type: ExprPrecedingStr, Expr: yyDollar[1].expr}
+		}
+	case 609:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3120
+		{
+			yyVAL.framePoint = &FramePoint{Type: ExprFollowingStr, Expr: yyDollar[1].expr}
+		}
+	case 610:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3130
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 611:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3134
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 612:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3138
+		{
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 613:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3143
 		{
 			yyVAL.orderBy = nil
 		}
-	case 572:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2926
+//line sql.y:3147
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 573:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2932
+//line sql.y:3153
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 574:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2936
+//line sql.y:3157
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 575:
+	case 617:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2942
+//line sql.y:3163
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 576:
+	case 618:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2947
+//line sql.y:3168
 		{
 			yyVAL.str = AscScr
 		}
-	case 577:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2951
+//line sql.y:3172
 		{
 			yyVAL.str = AscScr
 		}
-	case 578:
+	case 620:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2955
+//line sql.y:3176
 		{
 			yyVAL.str = DescScr
 		}
-	case 579:
+	case 621:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2960
+//line sql.y:3181
 		{
 			yyVAL.limit = nil
 		}
-	case 580:
+	case 622:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2964
+//line sql.y:3185
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 581:
+	case 623:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2968
+//line sql.y:3189
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 582:
+	case 624:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2972
+//line sql.y:3193
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 583:
+	case 625:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2977
+//line sql.y:3198
 		{
 			yyVAL.str = ""
 		}
-	case 584:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2981
+//line sql.y:3202
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 585:
+	case 627:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2985
+//line sql.y:3206
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 586:
+	case 628:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2998
+//line sql.y:3219
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 587:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3002
+//line sql.y:3223
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 588:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3006
+//line sql.y:3227
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 589:
+	case 631:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3011
+//line sql.y:3232
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 590:
+	case 632:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3015
+//line sql.y:3236
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 591:
+	case 633:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3019
+//line sql.y:3240
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 592:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3026
+//line sql.y:3247
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 593:
+	case 635:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3030
+//line sql.y:3251
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 594:
+	case 636:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3034
+//line sql.y:3255
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 595:
+	case 637:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3038
+//line sql.y:3259
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 596:
+	case 638:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3043
+//line sql.y:3264
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 597:
+	case 639:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3047
+//line sql.y:3268
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 598:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3053
+//line sql.y:3274
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 599:
+	case 641:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3057
+//line sql.y:3278
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 600:
+	case 642:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3063
+//line sql.y:3284
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 601:
+	case 643:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3067
+//line sql.y:3288
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 602:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3073
+//line sql.y:3294
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 603:
+	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3079
+//line sql.y:3300
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -7102,312 +7564,312 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 604:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3089
+//line sql.y:3310
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 605:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3093
+//line sql.y:3314
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 606:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3099
+//line sql.y:3320
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 607:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3105
+//line sql.y:3326
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 608:
+	case 650:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3109
+//line sql.y:3330
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 609:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3115
+//line sql.y:3336
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 610:
+	case 652:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3119
+//line sql.y:3340
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 611:
+	case 653:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3123
+//line sql.y:3344
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 612:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3127
+//line sql.y:3348
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 614:
+	case 656:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3134
+//line sql.y:3355
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 616:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3141
+//line sql.y:3362
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 617:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3145
+//line sql.y:3366
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 618:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3149
+//line sql.y:3370
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 621:
+	case 663:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3158
+//line sql.y:3379
 		{
 			yyVAL.byt = 0
 		}
-	case 622:
+	case 664:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3160
+//line sql.y:3381
 		{
 			yyVAL.byt = 1
 		}
-	case 623:
+	case 665:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3163
+//line sql.y:3384
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 624:
+	case 666:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3165
+//line sql.y:3386
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 625:
+	case 667:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3168
+//line sql.y:3389
 		{
 			yyVAL.str = ""
 		}
-	case 626:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3170
+//line sql.y:3391
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 627:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3174
+//line sql.y:3395
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 628:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3176
+//line sql.y:3397
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 629:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3178
+//line sql.y:3399
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 630:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3180
+//line sql.y:3401
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 631:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3182
+//line sql.y:3403
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 632:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3184
+//line sql.y:3405
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 633:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3186
+//line sql.y:3407
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 634:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3188
+//line sql.y:3409
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 635:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3190
+//line sql.y:3411
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 636:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3192
+//line sql.y:3413
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 637:
+	case 679:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3195
+//line sql.y:3416
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 638:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3197
+//line sql.y:3418
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 639:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3199
+//line sql.y:3420
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 640:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3203
+//line sql.y:3424
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 641:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3205
+//line sql.y:3426
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 642:
+	case 684:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3208
+//line sql.y:3429
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 643:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3210
+//line sql.y:3431
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 644:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3212
+//line sql.y:3433
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 645:
+	case 687:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3215
+//line sql.y:3436
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 646:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3217
+//line sql.y:3438
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 647:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3221
+//line sql.y:3442
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 648:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3225
+//line sql.y:3446
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 650:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3232
+//line sql.y:3453
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 651:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3238
+//line sql.y:3459
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 652:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3242
+//line sql.y:3463
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 654:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3249
+//line sql.y:3470
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 942:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3562
+//line sql.y:3791
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 943:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3571
+//line sql.y:3800
 		{
 			decNesting(yylex)
 		}
-	case 944:
+	case 994:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3576
+//line sql.y:3805
 		{
 			skipToEnd(yylex)
 		}
-	case 945:
+	case 995:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3581
+//line sql.y:3810
 		{
 			skipToEnd(yylex)
 		}
-	case 946:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3585
+//line sql.y:3814
 		{
 			skipToEnd(yylex)
 		}
-	case 947:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3589
+//line sql.y:3818
 		{
 			skipToEnd(yylex)
 		}
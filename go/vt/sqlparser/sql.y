@@ -54,6 +54,8 @@ func skipToEnd(yylex interface{}) {
   empty         struct{}
   statement     Statement
   selStmt       SelectStatement
+  with          With
+  cte           *CommonTableExpr
   ddl           *DDL
   ins           *Insert
   byt           byte
@@ -83,6 +85,12 @@ func skipToEnd(yylex interface{}) {
   whens         []*When
   when          *When
   orderBy       OrderBy
+  overClause    *OverClause
+  windowSpec    *WindowSpecification
+  frameClause   *FrameClause
+  framePoint    *FramePoint
+  namedWindows  NamedWindows
+  namedWindow   *NamedWindow
   order         *Order
   limit         *Limit
   updateExprs   UpdateExprs
@@ -163,12 +171,12 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> ACTION CASCADE CONSTRAINT FOREIGN NO REFERENCES RESTRICT
 %token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
-%token <bytes> VINDEX VINDEXES
+%token <bytes> VINDEX VINDEXES ROUTING RULE RULES
 %token <bytes> STATUS VARIABLES WARNINGS
 %token <bytes> SEQUENCE
 
 // Transaction Tokens
-%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK
+%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE
 
 // Type Tokens
 %token <bytes> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
@@ -201,22 +209,27 @@ func skipToEnd(yylex interface{}) {
 // Match
 %token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
+// Window functions
+%token <bytes> OVER WINDOW ROWS RANGE CURRENT ROW UNBOUNDED PRECEDING FOLLOWING
+
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <bytes> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
-%token <bytes> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
-%token <bytes> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
+%token <bytes> NTH_VALUE NTILE OF PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM
+%token <bytes> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <bytes> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
-%token <bytes> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRECEDING PRIVILEGE_CHECKS_USER PROCESS
+%token <bytes> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRIVILEGE_CHECKS_USER PROCESS
 %token <bytes> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
-%token <bytes> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
+%token <bytes> THREAD_PRIORITY TIES VCPU VISIBLE
 
 %type <statement> command
-%type <selStmt> select_statement base_select union_lhs union_rhs
+%type <selStmt> select_statement simple_select_statement base_select union_lhs union_rhs
+%type <with> with_clause cte_list
+%type <cte> common_table_expr
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement
 %type <ddl> create_table_prefix rename_list
 %type <statement> analyze_statement show_statement use_statement other_statement
-%type <statement> begin_statement commit_statement rollback_statement
+%type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement
 %type <bytes2> comment_opt comment_list
 %type <str> union_op insert_or_replace
 %type <str> distinct_opt straight_join_opt cache_opt match_option separator_opt
@@ -252,6 +265,15 @@ func skipToEnd(yylex interface{}) {
 %type <expr> expression_opt else_expression_opt
 %type <exprs> group_by_opt
 %type <expr> having_opt
+%type <namedWindows> window_clause_opt named_window_list
+%type <namedWindow> named_window
+%type <overClause> over_clause_opt
+%type <windowSpec> window_spec window_spec_body
+%type <exprs> window_partition_opt
+%type <frameClause> frame_clause_opt
+%type <str> frame_units
+%type <framePoint> frame_point
+%type <expr> frame_expr
 %type <orderBy> order_by_opt order_list
 %type <order> order
 %type <str> asc_desc_opt
@@ -350,6 +372,8 @@ command:
 | begin_statement
 | commit_statement
 | rollback_statement
+| savepoint_statement
+| release_statement
 | other_statement
 | flush_statement
 | /*empty*/
@@ -372,6 +396,18 @@ id_or_var:
   }
 
 select_statement:
+  simple_select_statement
+  {
+    $$ = $1
+  }
+| with_clause simple_select_statement
+  {
+    $2.SetWith($1)
+    $$ = $2
+  }
+
+// simple_select_statement is a SELECT or UNION without a WITH clause.
+simple_select_statement:
   base_select order_by_opt limit_opt lock_opt
   {
     sel := $1.(*Select)
@@ -395,15 +431,41 @@ stream_statement:
     $$ = &Stream{Comments: Comments($2), SelectExpr: $3, Table: $5}
   }
 
+with_clause:
+  WITH cte_list
+  {
+    $$ = $2
+  }
+
+cte_list:
+  common_table_expr
+  {
+    $$ = With{$1}
+  }
+| cte_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Subquery: $3}
+  }
+| table_id openb column_list closeb AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: $3, Subquery: $6}
+  }
+
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-  SELECT comment_opt cache_opt distinct_opt straight_join_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+  SELECT comment_opt cache_opt distinct_opt straight_join_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
-    $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10)}
+    $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10), Windows: $11}
   }
 
 union_lhs:
-  select_statement
+  simple_select_statement
   {
     $$ = $1
   }
@@ -1405,6 +1467,14 @@ alter_statement:
         },
     }
   }
+| ALTER VSCHEMA ADD ROUTING RULE table_name TO table_name_list
+  {
+    $$ = &DDL{Action: AddRoutingRuleStr, Table: $6, ToTables: $8}
+  }
+| ALTER VSCHEMA DROP ROUTING RULE table_name
+  {
+    $$ = &DDL{Action: DropRoutingRuleStr, Table: $6}
+  }
 
 alter_object_type:
   CHECK
@@ -1633,6 +1703,10 @@ show_statement:
   {
     $$ = &Show{Type: string($2) + " " + string($3), OnTable: $5}
   }
+| SHOW VSCHEMA ROUTING RULES
+  {
+    $$ = &Show{Type: string($2) + " " + string($3) + " " + string($4)}
+  }
 | SHOW WARNINGS
   {
     $$ = &Show{Type: string($2)}
@@ -1765,6 +1839,26 @@ rollback_statement:
   {
     $$ = &Rollback{}
   }
+| ROLLBACK TO sql_id
+  {
+    $$ = &SRollback{Name: $3}
+  }
+| ROLLBACK TO SAVEPOINT sql_id
+  {
+    $$ = &SRollback{Name: $4}
+  }
+
+savepoint_statement:
+  SAVEPOINT sql_id
+  {
+    $$ = &Savepoint{Name: $2}
+  }
+
+release_statement:
+  RELEASE SAVEPOINT sql_id
+  {
+    $$ = &Release{Name: $3}
+  }
 
 other_statement:
   DESC skip_to_end
@@ -2503,18 +2597,18 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_clause_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
-| sql_id openb DISTINCT select_expression_list closeb
+| sql_id openb DISTINCT select_expression_list closeb over_clause_opt
   {
-    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
+    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4, Over: $6}
   }
-| sql_id openb DISTINCTROW select_expression_list closeb
+| sql_id openb DISTINCTROW select_expression_list closeb over_clause_opt
   {
-    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
-  }  
+    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4, Over: $6}
+  }
 | table_id '.' reserved_sql_id openb select_expression_list_opt closeb
   {
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
@@ -2918,6 +3012,133 @@ having_opt:
     $$ = $2
   }
 
+window_clause_opt:
+  {
+    $$ = nil
+  }
+| WINDOW named_window_list
+  {
+    $$ = $2
+  }
+
+named_window_list:
+  named_window
+  {
+    $$ = NamedWindows{$1}
+  }
+| named_window_list ',' named_window
+  {
+    $$ = append($1, $3)
+  }
+
+named_window:
+  sql_id AS window_spec
+  {
+    $$ = &NamedWindow{Name: $1, WindowSpec: $3}
+  }
+
+over_clause_opt:
+  {
+    $$ = nil
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+| OVER window_spec
+  {
+    $$ = &OverClause{WindowSpec: $2}
+  }
+
+window_spec:
+  openb window_spec_body closeb
+  {
+    $$ = $2
+  }
+| openb sql_id window_spec_body closeb
+  {
+    $3.Name = $2
+    $$ = $3
+  }
+
+window_spec_body:
+  window_partition_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpecification{PartitionClause: $1, OrderClause: $2, FrameClause: $3}
+  }
+
+window_partition_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_units frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_units BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_units:
+  ROWS
+  {
+    $$ = RowsStr
+  }
+| RANGE
+  {
+    $$ = RangeStr
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowStr}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingStr}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingStr}
+  }
+| frame_expr PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingStr, Expr: $1}
+  }
+| frame_expr FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingStr, Expr: $1}
+  }
+
+/*
+  MySQL only accepts a constant or a parameter as the frame offset,
+  or an interval for RANGE frames.
+*/
+frame_expr:
+  INTEGRAL
+  {
+    $$ = NewIntVal($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewValArg($1)
+  }
+| INTERVAL value_expression sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
 order_by_opt:
   {
     $$ = nil
@@ -3345,6 +3566,7 @@ reserved_keyword:
 | OUTER
 | OVER
 | PERCENT_RANK
+| RANGE
 | RANK
 | RECURSIVE
 | REGEXP
@@ -3352,6 +3574,7 @@ reserved_keyword:
 | REPLACE
 | RIGHT
 | ROW_NUMBER
+| ROWS
 | SCHEMA
 | SELECT
 | SEPARATOR
@@ -3377,6 +3600,7 @@ reserved_keyword:
 | VALUES
 | WHEN
 | WHERE
+| WITH
 | WINDOW
 
 /*
@@ -3410,6 +3634,7 @@ non_reserved_keyword:
 | COMMIT
 | COMMITTED
 | COMPONENT
+| CURRENT
 | DATE
 | DATETIME
 | DECIMAL
@@ -3509,6 +3734,11 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROUTING
+| ROW
+| RULE
+| RULES
+| SAVEPOINT
 | SECONDARY
 | SECONDARY_ENGINE
 | SECONDARY_LOAD
@@ -3552,7 +3782,6 @@ non_reserved_keyword:
 | VITESS_METADATA
 | VSCHEMA
 | WARNINGS
-| WITH
 | WRITE
 | YEAR
 | ZEROFILL
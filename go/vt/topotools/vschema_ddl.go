@@ -223,3 +223,60 @@ func ApplyVSchemaDDL(ksName string, ks *vschemapb.Keyspace, ddl *sqlparser.DDL)
 
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected vindex ddl operation %s", ddl.Action)
 }
+
+// ApplyRoutingRulesDDL applies the given ADD or DROP ROUTING RULE
+// statement to the routing rules and returns the modified object.
+func ApplyRoutingRulesDDL(rr *vschemapb.RoutingRules, ddl *sqlparser.DDL) (*vschemapb.RoutingRules, error) {
+	if rr == nil {
+		rr = new(vschemapb.RoutingRules)
+	}
+
+	fromTable := routingRuleTableName(ddl.Table)
+	index := -1
+	for i, rule := range rr.Rules {
+		if rule.FromTable == fromTable {
+			index = i
+			break
+		}
+	}
+
+	switch ddl.Action {
+	case sqlparser.AddRoutingRuleStr:
+		if index != -1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "routing rule for %s already exists", fromTable)
+		}
+
+		toTables := make([]string, 0, len(ddl.ToTables))
+		for _, toTable := range ddl.ToTables {
+			if toTable.Qualifier.IsEmpty() {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "table %s must be qualified", toTable.Name.String())
+			}
+			toTables = append(toTables, routingRuleTableName(toTable))
+		}
+
+		rr.Rules = append(rr.Rules, &vschemapb.RoutingRule{
+			FromTable: fromTable,
+			ToTables:  toTables,
+		})
+		return rr, nil
+
+	case sqlparser.DropRoutingRuleStr:
+		if index == -1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "routing rule for %s does not exist", fromTable)
+		}
+
+		rr.Rules = append(rr.Rules[:index], rr.Rules[index+1:]...)
+		return rr, nil
+	}
+
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected routing rule ddl operation %s", ddl.Action)
+}
+
+// routingRuleTableName returns the unescaped name of the table as
+// used by the routing rules, like ks.t or t@replica.
+func routingRuleTableName(table sqlparser.TableName) string {
+	if table.Qualifier.IsEmpty() {
+		return table.Name.String()
+	}
+	return fmt.Sprintf("%s.%s", table.Qualifier.String(), table.Name.String())
+}
@@ -466,6 +466,15 @@ func (itc *internalTabletConn) VStreamResults(ctx context.Context, target *query
 	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
+// GetSchema is part of the QueryService interface.
+func (itc *internalTabletConn) GetSchema(ctx context.Context, target *querypb.Target) ([]*binlogdatapb.MinimalTable, error) {
+	tables, err := itc.tablet.qsc.QueryService().GetSchema(ctx, target)
+	if err != nil {
+		return nil, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+	}
+	return tables, nil
+}
+
 //
 // TabletManagerClient implementation
 //
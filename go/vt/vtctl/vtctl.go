@@ -309,10 +309,10 @@ var commands = []commandGroup{
 				"[-ping-tablets] <keyspace name>",
 				"Validates that all nodes reachable from the specified keyspace are consistent."},
 			{"Reshard", commandReshard,
-				"[-skip_schema_copy] <keyspace.workflow> <source_shards> <target_shards>",
+				"[-skip_schema_copy] [-copy_concurrency=<n>] <keyspace.workflow> <source_shards> <target_shards>",
 				"Start a Resharding process. Example: Reshard ks.workflow001 '0' '-80,80-'"},
 			{"Migrate", commandMigrate,
-				"[-cell=<cell>] [-tablet_types=<source_tablet_types>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
+				"[-cell=<cell>] [-tablet_types=<source_tablet_types>] [-copy_concurrency=<n>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
 				`Start a table(s) migration, table_specs is a list of tables or the tables section of the vschema for the target keyspace. Example: '{"t1":{"column_vindexes": [{""column": "id1", "name": "hash"}]}, "t2":{"column_vindexes": [{""column": "id2", "name": "hash"}]}}`},
 			{"CreateLookupVindex", commandCreateLookupVindex,
 				"[-cell=<cell>] [-tablet_types=<source_tablet_types>] <keyspace> <json_spec>",
@@ -320,9 +320,15 @@ var commands = []commandGroup{
 			{"ExternalizeVindex", commandExternalizeVindex,
 				"<keyspace>.<vindex>",
 				`Externalize a backfilled vindex.`},
+			{"VerifyLookupVindex", commandVerifyLookupVindex,
+				"[-cell=<cell>] [-tablet_types=replica] [-repair] <keyspace>.<vindex>",
+				`Compare an owned lookup vindex with its owner table, and report the missing, extra and mismatched entries. If -repair is set, every difference is read again from the masters, and the lookup table is updated to match the owner table.`},
 			{"Materialize", commandMaterialize,
 				`<json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
 				"Performs materialization based on the json spec."},
+			{"MaterializeReferenceTables", commandMaterializeReferenceTables,
+				"[-cell=<cell>] [-tablet_types=<source_tablet_types>] [-keyspaces=<keyspace1>,<keyspace2>] <source_keyspace>",
+				"Starts the missing workflows that copy the reference tables whose source is <source_keyspace> into each target keyspace. The workflow of each table is named reference_<table>. By default, all keyspaces are checked for such tables."},
 			{"SplitClone", commandSplitClone,
 				"<keyspace> <from_shards> <to_shards>",
 				"Start the SplitClone process to perform horizontal resharding. Example: SplitClone ks '0' '-80,80-'"},
@@ -332,6 +338,9 @@ var commands = []commandGroup{
 			{"VDiff", commandVDiff,
 				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
 				"Perform a diff of all tables in the workflow"},
+			{"Workflow", commandWorkflow,
+				"<keyspace.workflow> <action>",
+				"Perform an action on the streams of a workflow. The only supported action is 'progress': report the copy progress, rate and ETA of every table, aggregated across all target shards."},
 			{"MigrateServedTypes", commandMigrateServedTypes,
 				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] <keyspace/shard> <served tablet type>",
 				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration."},
@@ -396,6 +405,9 @@ var commands = []commandGroup{
 			{"ValidateSchemaKeyspace", commandValidateSchemaKeyspace,
 				"[-exclude_tables=''] [-include-views] <keyspace name>",
 				"Validates that the master schema from shard 0 matches the schema on all of the other tablets in the keyspace."},
+			{"ValidateVSchema", commandValidateVSchema,
+				"<keyspace>",
+				"Validates that the tables, columns and lookup tables named in the VSchema of the keyspace exist on the master of every shard, and that the vindex columns have compatible types."},
 			{"ApplySchema", commandApplySchema,
 				"[-allow_long_unavailability] [-wait_slave_timeout=10s] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
 				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to slaves via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected."},
@@ -424,8 +436,8 @@ var commands = []commandGroup{
 				"<keyspace>",
 				"Displays the VTGate routing schema."},
 			{"ApplyVSchema", commandApplyVSchema,
-				"{-vschema=<vschema> || -vschema_file=<vschema file> || -sql=<sql> || -sql_file=<sql file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run] <keyspace>",
-				"Applies the VTGate routing schema to the provided keyspace. Shows the result after application."},
+				"{-vschema=<vschema> || -vschema_file=<vschema file> || -sql=<sql> || -sql_file=<sql file>} [-cells=c1,c2,...] [-skip_rebuild] [-skip_reference_tables] [-dry-run] [-validate] <keyspace>",
+				"Applies the VTGate routing schema to the provided keyspace. Shows the result after application. Then starts the missing workflows that copy the reference tables of the keyspace from their source, unless -skip_reference_tables is set."},
 			{"GetRoutingRules", commandGetRoutingRules,
 				"",
 				"Displays the VSchema routing rules."},
@@ -1802,6 +1814,7 @@ func commandValidateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlag
 
 func commandReshard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	skipSchemaCopy := subFlags.Bool("skip_schema_copy", false, "Skip copying of schema to targets")
+	copyConcurrency := subFlags.Int64("copy_concurrency", 0, "Number of tables, or chunks of tables, that each stream copies in parallel.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1814,13 +1827,14 @@ func commandReshard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.F
 	}
 	source := strings.Split(subFlags.Arg(1), ",")
 	target := strings.Split(subFlags.Arg(2), ",")
-	return wr.Reshard(ctx, keyspace, workflow, source, target, *skipSchemaCopy)
+	return wr.Reshard(ctx, keyspace, workflow, source, target, *skipSchemaCopy, *copyConcurrency)
 }
 
 func commandMigrate(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	workflow := subFlags.String("workflow", "", "Workflow name. Will be used to later migrate traffic.")
 	cell := subFlags.String("cell", "", "Cell to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
+	copyConcurrency := subFlags.Int64("copy_concurrency", 0, "Number of tables, or chunks of tables, that each stream copies in parallel.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1833,7 +1847,7 @@ func commandMigrate(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.F
 	source := subFlags.Arg(0)
 	target := subFlags.Arg(1)
 	tableSpecs := subFlags.Arg(2)
-	return wr.Migrate(ctx, *workflow, source, target, tableSpecs, *cell, *tabletTypes)
+	return wr.Migrate(ctx, *workflow, source, target, tableSpecs, *cell, *tabletTypes, *copyConcurrency)
 }
 
 func commandCreateLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -1863,6 +1877,25 @@ func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFla
 	return wr.ExternalizeVindex(ctx, subFlags.Arg(0))
 }
 
+func commandVerifyLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cell := subFlags.String("cell", "", "Cell to stream the owner and lookup tables from.")
+	tabletTypes := subFlags.String("tablet_types", "", "Tablet types to stream the owner and lookup tables from.")
+	repair := subFlags.Bool("repair", false, "Write the corrections to the lookup table.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("one argument is required: keyspace.vindex")
+	}
+	keyspace, vindex, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	_, err = wr.VerifyLookupVindex(ctx, keyspace, vindex, *cell, *tabletTypes, *repair,
+		*HealthCheckTopologyRefresh, *HealthcheckRetryDelay, *HealthCheckTimeout)
+	return err
+}
+
 func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -1877,6 +1910,23 @@ func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	return wr.Materialize(ctx, ms)
 }
 
+func commandMaterializeReferenceTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cell := subFlags.String("cell", "", "Cell to replicate the reference tables from.")
+	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate the reference tables from.")
+	keyspaces := subFlags.String("keyspaces", "", "Comma separated list of target keyspaces. Defaults to all keyspaces.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("a single argument is required: <source_keyspace>")
+	}
+	var targetKeyspaces []string
+	if *keyspaces != "" {
+		targetKeyspaces = strings.Split(*keyspaces, ",")
+	}
+	return wr.MaterializeReferenceTables(ctx, subFlags.Arg(0), targetKeyspaces, *cell, *tabletTypes)
+}
+
 func commandSplitClone(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -1925,6 +1975,26 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	return err
 }
 
+func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("<keyspace.workflow> and <action> are required")
+	}
+	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	switch action := subFlags.Arg(1); action {
+	case "progress":
+		_, err = wr.WorkflowProgress(ctx, keyspace, workflow)
+		return err
+	default:
+		return fmt.Errorf("invalid action for Workflow: %s", action)
+	}
+}
+
 func splitKeyspaceWorkflow(in string) (keyspace, workflow string, err error) {
 	splits := strings.Split(in, ".")
 	if len(splits) != 2 {
@@ -2271,6 +2341,16 @@ func commandValidateSchemaKeyspace(ctx context.Context, wr *wrangler.Wrangler, s
 	return wr.ValidateSchemaKeyspace(ctx, keyspace, excludeTableArray, *includeViews)
 }
 
+func commandValidateVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ValidateVSchema command")
+	}
+	return wr.ValidateVSchema(ctx, subFlags.Arg(0), nil)
+}
+
 func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	allowLongUnavailability := subFlags.Bool("allow_long_unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
 	sql := subFlags.String("sql", "", "A list of semicolon-delimited SQL commands")
@@ -2464,6 +2544,8 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	sqlFile := subFlags.String("sql_file", "", "A vschema ddl SQL statement (e.g. `add vindex`, `alter table t add vindex hash(id)`, etc)")
 	dryRun := subFlags.Bool("dry-run", false, "If set, do not save the altered vschema, simply echo to console.")
 	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	validate := subFlags.Bool("validate", false, "If set, validate the vschema against the schema of the masters of the keyspace, and do not save it if there are inconsistencies.")
+	skipReferenceTables := subFlags.Bool("skip_reference_tables", false, "If set, do not start the workflows that copy the reference tables of the keyspace from their source.")
 	var cells flagutil.StringListValue
 	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
 
@@ -2548,6 +2630,12 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		wr.Logger().Printf("New VSchema object:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", b)
 	}
 
+	if *validate {
+		if err := wr.ValidateVSchema(ctx, keyspace, vs); err != nil {
+			return err
+		}
+	}
+
 	if *dryRun {
 		wr.Logger().Printf("Dry run: Skipping update of VSchema\n")
 		return nil
@@ -2566,9 +2654,14 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 
 	if *skipRebuild {
 		wr.Logger().Warningf("Skipping rebuild of SrvVSchema, will need to run RebuildVSchemaGraph for changes to take effect")
+	} else if err := wr.TopoServer().RebuildSrvVSchema(ctx, cells); err != nil {
+		return err
+	}
+
+	if *skipReferenceTables {
 		return nil
 	}
-	return wr.TopoServer().RebuildSrvVSchema(ctx, cells)
+	return wr.MaterializeReferenceTablesOf(ctx, keyspace, "", "")
 }
 
 func commandApplyRoutingRules(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
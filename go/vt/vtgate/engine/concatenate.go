@@ -0,0 +1,170 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/xsec-lab/go/sqltypes"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+var _ Primitive = (*Concatenate)(nil)
+
+// errColumnCountMismatch is returned if the sources of a
+// Concatenate don't return the same number of columns.
+var errColumnCountMismatch = errors.New("the used SELECT statements have a different number of columns")
+
+// Concatenate is a primitive that returns the rows of all its
+// sources, one source after the other. It's used for UNION ALL.
+// The field names of the result are the ones of the first source.
+type Concatenate struct {
+	Sources []Primitive
+}
+
+// MarshalJSON serializes the Concatenate into a JSON representation.
+// It's used for testing and diagnostics.
+func (c *Concatenate) MarshalJSON() ([]byte, error) {
+	marshalConcatenate := struct {
+		Opcode  string
+		Sources []Primitive
+	}{
+		Opcode:  "Concatenate",
+		Sources: c.Sources,
+	}
+	return json.Marshal(marshalConcatenate)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (c *Concatenate) RouteType() string {
+	return "Concatenate"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (c *Concatenate) GetKeyspaceName() string {
+	var keyspaces []string
+	seen := make(map[string]bool)
+	for _, source := range c.Sources {
+		keyspace := source.GetKeyspaceName()
+		if seen[keyspace] {
+			continue
+		}
+		seen[keyspace] = true
+		keyspaces = append(keyspaces, keyspace)
+	}
+	return strings.Join(keyspaces, "_")
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (c *Concatenate) GetTableName() string {
+	tables := make([]string, 0, len(c.Sources))
+	for _, source := range c.Sources {
+		tables = append(tables, source.GetTableName())
+	}
+	return strings.Join(tables, "_")
+}
+
+// Execute performs a non-streaming exec.
+func (c *Concatenate) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	for i, source := range c.Sources {
+		// The fields of the first source are needed to
+		// validate the column count of the other ones.
+		qr, err := source.Execute(vcursor, bindVars, wantfields || i == 0)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			result.Fields = qr.Fields
+		} else if err := checkColumnCount(result.Fields, qr.Fields); err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+	}
+	if !wantfields {
+		result.Fields = nil
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+// The fields are sent only once, from the first source.
+func (c *Concatenate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var fields []*querypb.Field
+	for i, source := range c.Sources {
+		first := i == 0
+		err := source.StreamExecute(vcursor, bindVars, wantfields || first, func(qr *sqltypes.Result) error {
+			if len(qr.Fields) != 0 {
+				if first {
+					fields = qr.Fields
+				} else if err := checkColumnCount(fields, qr.Fields); err != nil {
+					return err
+				}
+				if !first || !wantfields {
+					// Only the fields of the first source are sent.
+					if len(qr.Rows) == 0 {
+						return nil
+					}
+					qr = &sqltypes.Result{Rows: qr.Rows}
+				}
+			}
+			return callback(qr)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFields fetches the field info.
+func (c *Concatenate) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	var result *sqltypes.Result
+	for i, source := range c.Sources {
+		qr, err := source.GetFields(vcursor, bindVars)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			result = qr
+			continue
+		}
+		if err := checkColumnCount(result.Fields, qr.Fields); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Inputs returns the input primitives for this concatenation.
+func (c *Concatenate) Inputs() []Primitive {
+	return c.Sources
+}
+
+func checkColumnCount(want, got []*querypb.Field) error {
+	if want == nil || got == nil {
+		return nil
+	}
+	if len(want) != len(got) {
+		return errColumnCountMismatch
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xsec-lab/go/sqltypes"
+)
+
+func TestConcatenateExecute(t *testing.T) {
+	assert := assert.New(t)
+	fields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|varchar",
+	)
+	c := &Concatenate{
+		Sources: []Primitive{
+			&fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "1|a", "2|b")}},
+			&fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("id2|col2", "int64|varchar"),
+				"2|b",
+				"3|c",
+			)}},
+			&fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields)}},
+		},
+	}
+
+	result, err := c.Execute(nil, nil, true)
+	assert.NoError(err)
+	assert.Equal(sqltypes.MakeTestResult(fields, "1|a", "2|b", "2|b", "3|c"), result)
+
+	for _, source := range c.Sources {
+		source.(*fakePrimitive).rewind()
+	}
+	result, err = c.Execute(nil, nil, false)
+	assert.NoError(err)
+	want := sqltypes.MakeTestResult(fields, "1|a", "2|b", "2|b", "3|c")
+	want.Fields = nil
+	assert.Equal(want, result)
+}
+
+func TestConcatenateStreamExecute(t *testing.T) {
+	assert := assert.New(t)
+	fields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|varchar",
+	)
+	c := &Concatenate{
+		Sources: []Primitive{
+			&fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "1|a", "2|b", "3|c")}},
+			&fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "4|d")}},
+		},
+	}
+
+	var results []*sqltypes.Result
+	err := c.StreamExecute(nil, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	assert.NoError(err)
+	wantResults := sqltypes.MakeTestStreamingResults(
+		fields,
+		"1|a",
+		"2|b",
+		"---",
+		"3|c",
+		"---",
+		"4|d",
+	)
+	assert.Equal(wantResults, results)
+}
+
+func TestConcatenateGetFields(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|varchar",
+	)
+	c := &Concatenate{
+		Sources: []Primitive{
+			&fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields)}},
+			&fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("id2|col2", "int64|varchar"),
+			)}},
+		},
+	}
+
+	result, err := c.GetFields(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(fields), result)
+}
+
+func TestConcatenateColumnCountMismatch(t *testing.T) {
+	newConcatenate := func() *Concatenate {
+		return &Concatenate{
+			Sources: []Primitive{
+				&fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields("id|col", "int64|varchar"),
+					"1|a",
+				)}},
+				&fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields("id", "int64"),
+					"2",
+				)}},
+			},
+		}
+	}
+
+	want := "the used SELECT statements have a different number of columns"
+	_, err := newConcatenate().Execute(nil, nil, false)
+	assert.EqualError(t, err, want)
+
+	err = newConcatenate().StreamExecute(nil, nil, false, func(*sqltypes.Result) error { return nil })
+	assert.EqualError(t, err, want)
+
+	_, err = newConcatenate().GetFields(nil, nil)
+	assert.EqualError(t, err, want)
+}
+
+func TestConcatenateSourceFail(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id",
+		"int64",
+	)
+	c := &Concatenate{
+		Sources: []Primitive{
+			&fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "1")}},
+			&fakePrimitive{sendErr: errors.New("source fail")},
+		},
+	}
+
+	want := "source fail"
+	_, err := c.Execute(nil, nil, false)
+	assert.EqualError(t, err, want)
+
+	c.Sources[0].(*fakePrimitive).rewind()
+	err = c.StreamExecute(nil, nil, false, func(*sqltypes.Result) error { return nil })
+	assert.EqualError(t, err, want)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/xsec-lab/go/sqltypes"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+var _ Primitive = (*Distinct)(nil)
+
+// Distinct is a primitive that removes the duplicate rows
+// returned by its input. The rows that were already seen are
+// kept in memory, and the first occurrence of every row is
+// returned in the order of the input. The number of distinct
+// rows cannot exceed the max memory rows of the vcursor.
+type Distinct struct {
+	// Cols specifies the columns that are compared to
+	// determine if two rows are duplicates. For text columns,
+	// this is the column of the corresponding weight_string.
+	Cols []int
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// MarshalJSON serializes the Distinct into a JSON representation.
+// It's used for testing and diagnostics.
+func (d *Distinct) MarshalJSON() ([]byte, error) {
+	marshalDistinct := struct {
+		Opcode              string
+		Cols                []int
+		TruncateColumnCount int `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "Distinct",
+		Cols:                d.Cols,
+		TruncateColumnCount: d.TruncateColumnCount,
+		Input:               d.Input,
+	}
+	return json.Marshal(marshalDistinct)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (d *Distinct) RouteType() string {
+	return d.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (d *Distinct) GetKeyspaceName() string {
+	return d.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (d *Distinct) GetTableName() string {
+	return d.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (d *Distinct) SetTruncateColumnCount(count int) {
+	d.TruncateColumnCount = count
+}
+
+// Execute is a Primitive function.
+func (d *Distinct) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := d.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	rows, err := d.filter(vcursor, seen, result.Rows)
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{
+		Fields:       result.Fields,
+		Rows:         rows,
+		RowsAffected: uint64(len(rows)),
+	}
+	return out.Truncate(d.TruncateColumnCount), nil
+}
+
+// StreamExecute is a Primitive function.
+// The rows are sent as they come, except for the duplicates.
+func (d *Distinct) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	seen := make(map[string]bool)
+	return d.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		rows, err := d.filter(vcursor, seen, qr.Rows)
+		if err != nil {
+			return err
+		}
+		if len(qr.Fields) == 0 && len(rows) == 0 {
+			return nil
+		}
+		out := &sqltypes.Result{Fields: qr.Fields, Rows: rows}
+		return callback(out.Truncate(d.TruncateColumnCount))
+	})
+}
+
+// GetFields is a Primitive function.
+func (d *Distinct) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := d.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Truncate(d.TruncateColumnCount), nil
+}
+
+// Inputs returns the Primitive input for this distinct.
+func (d *Distinct) Inputs() []Primitive {
+	return []Primitive{d.Input}
+}
+
+// filter returns the rows that are not in seen, and adds them to it.
+func (d *Distinct) filter(vcursor VCursor, seen map[string]bool, rows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var out [][]sqltypes.Value
+	for _, row := range rows {
+		// The key is built the same way as for a hash aggregation,
+		// which treats NULL values as equal.
+		key, err := hashAggregateKey(row, d.Cols, nil)
+		if err != nil {
+			return nil, err
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if len(seen) > vcursor.MaxMemoryRows() {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		out = append(out, row)
+	}
+	return out, nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xsec-lab/go/sqltypes"
+)
+
+func TestDistinctExecute(t *testing.T) {
+	assert := assert.New(t)
+	fields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|varbinary",
+	)
+	d := &Distinct{
+		Cols: []int{0, 1},
+		Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a",
+			"2|b",
+			"1|a",
+			"1|b",
+			"null|a",
+			"2|b",
+			"null|a",
+		)}},
+	}
+
+	result, err := d.Execute(&noopVCursor{}, nil, false)
+	assert.NoError(err)
+	want := sqltypes.MakeTestResult(
+		fields,
+		"1|a",
+		"2|b",
+		"1|b",
+		"null|a",
+	)
+	assert.Equal(want, result)
+}
+
+func TestDistinctExecuteTruncate(t *testing.T) {
+	assert := assert.New(t)
+	d := &Distinct{
+		Cols:                []int{1},
+		TruncateColumnCount: 1,
+		Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|weight_string(col)",
+				"varchar|varbinary",
+			),
+			"a|A",
+			"A|A",
+			"b|B",
+		)}},
+	}
+
+	result, err := d.Execute(&noopVCursor{}, nil, false)
+	assert.NoError(err)
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col",
+			"varchar",
+		),
+		"a",
+		"b",
+	)
+	assert.Equal(want, result)
+}
+
+func TestDistinctStreamExecute(t *testing.T) {
+	assert := assert.New(t)
+	fields := sqltypes.MakeTestFields(
+		"id",
+		"int64",
+	)
+	d := &Distinct{
+		Cols: []int{0},
+		Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1",
+			"2",
+			// This batch only has duplicates.
+			"1",
+			"2",
+			"3",
+			"1",
+		)}},
+	}
+
+	var results []*sqltypes.Result
+	err := d.StreamExecute(&noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	assert.NoError(err)
+	wantResults := sqltypes.MakeTestStreamingResults(
+		fields,
+		"1",
+		"2",
+		"---",
+		"3",
+	)
+	assert.Equal(wantResults, results)
+}
+
+func TestDistinctMemoryLimit(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id",
+		"int64",
+	)
+	rows := make([]string, 0, testMaxMemoryRows+1)
+	for i := 0; i <= testMaxMemoryRows; i++ {
+		rows = append(rows, fmt.Sprintf("%d", i))
+	}
+	d := &Distinct{
+		Cols:  []int{0},
+		Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, rows...)}},
+	}
+
+	want := "in-memory row count exceeded allowed limit of 100"
+	_, err := d.Execute(&noopVCursor{}, nil, false)
+	assert.EqualError(t, err, want)
+
+	d.Input.(*fakePrimitive).rewind()
+	err = d.StreamExecute(&noopVCursor{}, nil, false, func(*sqltypes.Result) error { return nil })
+	assert.EqualError(t, err, want)
+
+	// Duplicates don't count towards the limit.
+	d.Input = &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, append(rows[:testMaxMemoryRows], "0")...)}}
+	_, err = d.Execute(&noopVCursor{}, nil, false)
+	assert.NoError(t, err)
+}
+
+func TestDistinctInputFail(t *testing.T) {
+	d := &Distinct{Input: &fakePrimitive{sendErr: errors.New("input fail")}}
+
+	want := "input fail"
+	_, err := d.Execute(&noopVCursor{}, nil, false)
+	assert.EqualError(t, err, want)
+
+	err = d.StreamExecute(&noopVCursor{}, nil, false, func(*sqltypes.Result) error { return nil })
+	assert.EqualError(t, err, want)
+
+	_, err = d.GetFields(nil, nil)
+	assert.EqualError(t, err, want)
+}
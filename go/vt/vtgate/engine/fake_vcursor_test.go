@@ -74,6 +74,10 @@ func (t noopVCursor) ExecuteStandalone(query string, bindvars map[string]*queryp
 	panic("unimplemented")
 }
 
+func (t noopVCursor) PrefetchedSequenceValues(gen *Generate, count int64) (int64, bool, error) {
+	panic("unimplemented")
+}
+
 func (t noopVCursor) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	panic("unimplemented")
 }
@@ -156,6 +160,10 @@ func (f *loggingVCursor) ExecuteStandalone(query string, bindvars map[string]*qu
 	return f.nextResult()
 }
 
+func (f *loggingVCursor) PrefetchedSequenceValues(gen *Generate, count int64) (int64, bool, error) {
+	return 0, false, nil
+}
+
 func (f *loggingVCursor) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	f.log = append(f.log, fmt.Sprintf("StreamExecuteMulti %s %s", query, printResolvedShardsBindVars(rss, bindVars)))
 	r, err := f.nextResult()
@@ -0,0 +1,386 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/proto/vtrpc"
+	"github.com/xsec-lab/go/vt/vterrors"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+var _ Primitive = (*HashAggregate)(nil)
+
+// HashAggregate is a primitive that aggregates the rows of the underlying
+// primitive in an in-memory hash table keyed by the Keys. Unlike
+// OrderedAggregate, it does not require the rows to be sorted, which
+// allows a scatter route to return the partial aggregates of every
+// shard without an ORDER BY. The groups are returned in the order
+// in which they were first seen.
+//
+// The number of groups, plus the number of distinct values tracked
+// for distinct aggregates, cannot exceed the max memory rows of the
+// vcursor.
+type HashAggregate struct {
+	// HasDistinct is true if one of the aggregates is distinct.
+	HasDistinct bool `json:",omitempty"`
+	// Aggregates specifies the aggregation parameters for each
+	// aggregation function: function opcode and input column number.
+	Aggregates []AggregateParams
+
+	// Keys specifies the input values that must be used for
+	// the aggregation key.
+	Keys []int
+
+	// WeightStrings maps the keys and the columns of the distinct
+	// aggregates whose type was not known to be hashable at plan time
+	// to the column of their weight_string. The weight_string is hashed
+	// instead of the values that cannot be hashed, like text.
+	WeightStrings map[int]int `json:",omitempty"`
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// MarshalJSON serializes the HashAggregate into a JSON representation.
+// It's used for testing and diagnostics.
+func (ha *HashAggregate) MarshalJSON() ([]byte, error) {
+	marshalHashAggregate := struct {
+		Opcode              string
+		HasDistinct         bool `json:",omitempty"`
+		Aggregates          []AggregateParams
+		Keys                []int
+		WeightStrings       map[int]int `json:",omitempty"`
+		TruncateColumnCount int         `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "HashAggregate",
+		HasDistinct:         ha.HasDistinct,
+		Aggregates:          ha.Aggregates,
+		Keys:                ha.Keys,
+		WeightStrings:       ha.WeightStrings,
+		TruncateColumnCount: ha.TruncateColumnCount,
+		Input:               ha.Input,
+	}
+	return json.Marshal(marshalHashAggregate)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (ha *HashAggregate) RouteType() string {
+	return ha.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (ha *HashAggregate) GetKeyspaceName() string {
+	return ha.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (ha *HashAggregate) GetTableName() string {
+	return ha.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (ha *HashAggregate) SetTruncateColumnCount(count int) {
+	ha.TruncateColumnCount = count
+}
+
+// Execute is a Primitive function.
+func (ha *HashAggregate) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := ha.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	fields := ha.convertFields(result.Fields)
+	table := newHashAggregateTable(ha, vcursor.MaxMemoryRows())
+	if err := table.add(fields, result.Rows); err != nil {
+		return nil, err
+	}
+	rows, err := table.result()
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{
+		Fields:       fields,
+		Rows:         rows,
+		RowsAffected: uint64(len(rows)),
+	}
+	return out.Truncate(ha.TruncateColumnCount), nil
+}
+
+// StreamExecute is a Primitive function.
+// The rows can only be sent once all the input has been aggregated.
+func (ha *HashAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var fields []*querypb.Field
+	table := newHashAggregateTable(ha, vcursor.MaxMemoryRows())
+
+	cb := func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(ha.TruncateColumnCount))
+	}
+
+	err := ha.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			fields = ha.convertFields(qr.Fields)
+			if err := cb(&sqltypes.Result{Fields: fields}); err != nil {
+				return err
+			}
+		}
+		return table.add(fields, qr.Rows)
+	})
+	if err != nil {
+		return err
+	}
+
+	rows, err := table.result()
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return cb(&sqltypes.Result{Rows: rows})
+}
+
+// GetFields is a Primitive function.
+func (ha *HashAggregate) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := ha.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr = &sqltypes.Result{Fields: ha.convertFields(qr.Fields)}
+	return qr.Truncate(ha.TruncateColumnCount), nil
+}
+
+// Inputs returns the Primitive input for this aggregation
+func (ha *HashAggregate) Inputs() []Primitive {
+	return []Primitive{ha.Input}
+}
+
+func (ha *HashAggregate) convertFields(fields []*querypb.Field) []*querypb.Field {
+	if !ha.HasDistinct || fields == nil {
+		return fields
+	}
+
+	for _, aggr := range ha.Aggregates {
+		if !aggr.isDistinct() {
+			continue
+		}
+		fields[aggr.Col] = &querypb.Field{
+			Name: aggr.Alias,
+			Type: opcodeType[aggr.Opcode],
+		}
+	}
+	return fields
+}
+
+// hashAggregateTable is the hash table of a HashAggregate execution.
+type hashAggregateTable struct {
+	ha      *HashAggregate
+	maxRows int
+	// size is the number of groups and distinct values in memory.
+	size   int
+	groups map[string]*hashAggregateGroup
+	// order keeps track of the order in which the groups were seen.
+	order []*hashAggregateGroup
+}
+
+// hashAggregateGroup is the aggregation state of a single group.
+type hashAggregateGroup struct {
+	row []sqltypes.Value
+	// distincts has the set of values already aggregated
+	// for each distinct aggregate. It's nil otherwise.
+	distincts []map[string]bool
+}
+
+func newHashAggregateTable(ha *HashAggregate, maxRows int) *hashAggregateTable {
+	return &hashAggregateTable{
+		ha:      ha,
+		maxRows: maxRows,
+		groups:  make(map[string]*hashAggregateGroup),
+	}
+}
+
+// add aggregates the rows into their groups.
+func (t *hashAggregateTable) add(fields []*querypb.Field, rows [][]sqltypes.Value) error {
+	for _, row := range rows {
+		key, err := hashAggregateKey(row, t.ha.Keys, t.ha.WeightStrings)
+		if err != nil {
+			return err
+		}
+		group, ok := t.groups[key]
+		if !ok {
+			group, err = t.newGroup(row)
+			if err != nil {
+				return err
+			}
+			t.groups[key] = group
+			t.order = append(t.order, group)
+			t.size++
+		} else if err := t.merge(fields, group, row); err != nil {
+			return err
+		}
+		if t.size > t.maxRows {
+			return vterrors.Errorf(vtrpc.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", t.maxRows)
+		}
+	}
+	return nil
+}
+
+// result returns the aggregated rows.
+func (t *hashAggregateTable) result() ([][]sqltypes.Value, error) {
+	if len(t.order) == 0 && len(t.ha.Keys) == 0 {
+		// When doing aggregation without grouping keys, we need to produce a single row containing zero-value for the
+		// different aggregation functions
+		row := make([]sqltypes.Value, len(t.ha.Aggregates))
+		for i, aggr := range t.ha.Aggregates {
+			value, err := createEmptyValueFor(aggr.Opcode)
+			if err != nil {
+				return nil, err
+			}
+			row[i] = value
+		}
+		return [][]sqltypes.Value{row}, nil
+	}
+	rows := make([][]sqltypes.Value, 0, len(t.order))
+	for _, group := range t.order {
+		rows = append(rows, group.row)
+	}
+	return rows, nil
+}
+
+// newGroup creates the group for the first row that has its key.
+func (t *hashAggregateTable) newGroup(row []sqltypes.Value) (*hashAggregateGroup, error) {
+	group := &hashAggregateGroup{row: sqltypes.CopyRow(row)}
+	if !t.ha.HasDistinct {
+		return group, nil
+	}
+	group.distincts = make([]map[string]bool, len(t.ha.Aggregates))
+	for i, aggr := range t.ha.Aggregates {
+		if !aggr.isDistinct() {
+			continue
+		}
+		group.distincts[i] = make(map[string]bool)
+		value := row[aggr.Col]
+		switch aggr.Opcode {
+		case AggregateCountDistinct:
+			// Type is int64. Ok to call MakeTrusted.
+			if value.IsNull() {
+				group.row[aggr.Col] = countZero
+			} else {
+				group.row[aggr.Col] = countOne
+			}
+		case AggregateSumDistinct:
+			var err error
+			group.row[aggr.Col], err = sqltypes.Cast(value, opcodeType[aggr.Opcode])
+			if err != nil {
+				group.row[aggr.Col] = sumZero
+			}
+		}
+		if value.IsNull() {
+			continue
+		}
+		hash, err := hashValue(row, aggr.Col, t.ha.WeightStrings)
+		if err != nil {
+			return nil, err
+		}
+		group.distincts[i][hash] = true
+		t.size++
+	}
+	return group, nil
+}
+
+// merge aggregates the row into the group.
+func (t *hashAggregateTable) merge(fields []*querypb.Field, group *hashAggregateGroup, row []sqltypes.Value) error {
+	result := group.row
+	for i, aggr := range t.ha.Aggregates {
+		if aggr.isDistinct() {
+			value := row[aggr.Col]
+			if value.IsNull() {
+				continue
+			}
+			hash, err := hashValue(row, aggr.Col, t.ha.WeightStrings)
+			if err != nil {
+				return err
+			}
+			if group.distincts[i][hash] {
+				continue
+			}
+			group.distincts[i][hash] = true
+			t.size++
+		}
+		var err error
+		switch aggr.Opcode {
+		case AggregateCount, AggregateSum:
+			result[aggr.Col] = sqltypes.NullsafeAdd(result[aggr.Col], row[aggr.Col], fields[aggr.Col].Type)
+		case AggregateMin:
+			result[aggr.Col], err = sqltypes.Min(result[aggr.Col], row[aggr.Col])
+		case AggregateMax:
+			result[aggr.Col], err = sqltypes.Max(result[aggr.Col], row[aggr.Col])
+		case AggregateCountDistinct:
+			result[aggr.Col] = sqltypes.NullsafeAdd(result[aggr.Col], countOne, opcodeType[aggr.Opcode])
+		case AggregateSumDistinct:
+			result[aggr.Col] = sqltypes.NullsafeAdd(result[aggr.Col], row[aggr.Col], opcodeType[aggr.Opcode])
+		default:
+			return fmt.Errorf("BUG: Unexpected opcode: %v", aggr.Opcode)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hashAggregateKey builds the hash table key for the specified columns
+// of the row. Unlike in a join, NULL values form a group of their own.
+func hashAggregateKey(row []sqltypes.Value, cols []int, weightStrings map[int]int) (string, error) {
+	parts := make([]string, len(cols))
+	for i, col := range cols {
+		part, err := hashValue(row, col, weightStrings)
+		if err != nil {
+			return "", err
+		}
+		// Length-prefix every part so that the concatenation is unambiguous.
+		// NULL is the only value that has an empty hash code.
+		parts[i] = fmt.Sprintf("%d:%s", len(part), part)
+	}
+	return strings.Join(parts, ""), nil
+}
+
+// hashValue returns the hash code of the column of the row. If the
+// value cannot be hashed, the hash code of its weight_string is
+// returned instead, if the column has one.
+func hashValue(row []sqltypes.Value, col int, weightStrings map[int]int) (string, error) {
+	hash, err := sqltypes.NullsafeHashcode(row[col])
+	if err == nil {
+		return hash, nil
+	}
+	wsCol, ok := weightStrings[col]
+	if !ok {
+		return "", err
+	}
+	return sqltypes.NullsafeHashcode(row[wsCol])
+}
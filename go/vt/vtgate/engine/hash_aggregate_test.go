@@ -0,0 +1,406 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xsec-lab/go/sqltypes"
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+func TestHashAggregateExecute(t *testing.T) {
+	assert := assert.New(t)
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)|sum(a)|min(b)|max(b)",
+		"varbinary|decimal|decimal|int64|int64",
+	)
+	// The partial aggregates of two shards, in no particular order.
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"b|2|3|5|7",
+			"a|1|1|2|2",
+			"null|1|1|1|1",
+			"a|3|4|1|3",
+			"b|1|1|4|9",
+			"null|1|2|6|6",
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}, {
+			Opcode: AggregateSum,
+			Col:    2,
+		}, {
+			Opcode: AggregateMin,
+			Col:    3,
+		}, {
+			Opcode: AggregateMax,
+			Col:    4,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := ha.Execute(&noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"b|3|4|4|9",
+		"a|4|5|1|3",
+		"null|2|3|1|6",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregateExecuteTruncate(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|count(*)|weight_string(col)",
+				"varchar|decimal|varbinary",
+			),
+			"a|1|A",
+			"C|3|C",
+			"A|1|A",
+			"b|2|B",
+			"c|4|C",
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}},
+		Keys:                []int{2},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	result, err := ha.Execute(&noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|count(*)",
+			"varchar|decimal",
+		),
+		"a|2",
+		"C|7",
+		"b|2",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregateStreamExecute(t *testing.T) {
+	assert := assert.New(t)
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"varbinary|decimal",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"b|2",
+			"c|3",
+			"a|1",
+			"c|4",
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	var results []*sqltypes.Result
+	err := ha.StreamExecute(&noopVCursor{}, nil, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	assert.NoError(err)
+
+	wantResults := sqltypes.MakeTestStreamingResults(
+		fields,
+		"a|2",
+		"b|2",
+		"c|7",
+	)
+	assert.Equal(wantResults, results)
+}
+
+func TestHashAggregateExecuteCountDistinct(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2|count(*)",
+				"varbinary|decimal|int64",
+			),
+			// Duplicate values across shards must be counted once.
+			"a|1|1",
+			"b|null|1",
+			"a|2|2",
+			"b|1|1",
+			"a|1|3",
+			"b|null|2",
+			"c|null|1",
+			"b|1|4",
+		)},
+	}
+
+	ha := &HashAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCountDistinct,
+			Col:    1,
+			Alias:  "count(distinct col2)",
+		}, {
+			Opcode: AggregateSum,
+			Col:    2,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := ha.Execute(&noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		[]*querypb.Field{{
+			Name: "col1",
+			Type: querypb.Type_VARBINARY,
+		}, {
+			Name: "count(distinct col2)",
+			Type: querypb.Type_INT64,
+		}, {
+			Name: "count(*)",
+			Type: querypb.Type_INT64,
+		}},
+		"a|2|6",
+		"b|1|8",
+		"c|0|1",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregateExecuteWeightStrings(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2|count(*)|weight_string(col1)|weight_string(col2)",
+				"varchar|varchar|int64|varbinary|varbinary",
+			),
+			// The text values are grouped and counted
+			// by their weight_string, like in mysql.
+			"a|x|1|A|X",
+			"A|X|2|A|X",
+			"b|y|1|B|Y",
+			"a|Y|3|A|Y",
+			"B|null|1|B|null",
+		)},
+	}
+
+	ha := &HashAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCountDistinct,
+			Col:    1,
+			Alias:  "count(distinct col2)",
+		}, {
+			Opcode: AggregateSum,
+			Col:    2,
+		}},
+		Keys:                []int{0},
+		WeightStrings:       map[int]int{0: 3, 1: 4},
+		TruncateColumnCount: 3,
+		Input:               fp,
+	}
+
+	result, err := ha.Execute(&noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		[]*querypb.Field{{
+			Name: "col1",
+			Type: querypb.Type_VARCHAR,
+		}, {
+			Name: "count(distinct col2)",
+			Type: querypb.Type_INT64,
+		}, {
+			Name: "count(*)",
+			Type: querypb.Type_INT64,
+		}},
+		"a|2|6",
+		"b|1|2",
+	)
+	assert.Equal(wantResult, result)
+
+	// Without the weight_string, text values can't be hashed.
+	ha.WeightStrings = nil
+	fp.rewind()
+	_, err = ha.Execute(&noopVCursor{}, nil, false)
+	assert.EqualError(err, "types are not hashable: VARCHAR")
+}
+
+func TestHashAggregateExecuteSumDistinct(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2",
+				"varbinary|decimal",
+			),
+			"a|1",
+			"a|2",
+			"a|1",
+			"b|3",
+			"a|2",
+			"b|3",
+		)},
+	}
+
+	ha := &HashAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateSumDistinct,
+			Col:    1,
+			Alias:  "sum(distinct col2)",
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := ha.Execute(&noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		[]*querypb.Field{{
+			Name: "col1",
+			Type: querypb.Type_VARBINARY,
+		}, {
+			Name: "sum(distinct col2)",
+			Type: querypb.Type_DECIMAL,
+		}},
+		"a|3",
+		"b|3",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregateNoInputAndNoGroupingKeys(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"count(*)|sum(col)",
+				"int64|decimal",
+			),
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    0,
+		}, {
+			Opcode: AggregateSum,
+			Col:    1,
+		}},
+		Input: fp,
+	}
+
+	result, err := ha.Execute(&noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"count(*)|sum(col)",
+			"int64|decimal",
+		),
+		"0|null",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregateMemoryLimit(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"int64|decimal",
+	)
+	rows := make([]string, 0, testMaxMemoryRows+1)
+	for i := 0; i <= testMaxMemoryRows; i++ {
+		rows = append(rows, fmt.Sprintf("%d|1", i))
+	}
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}},
+		Keys: []int{0},
+		Input: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, rows...)},
+		},
+	}
+
+	want := "in-memory row count exceeded allowed limit of 100"
+	_, err := ha.Execute(&noopVCursor{}, nil, false)
+	assert.EqualError(t, err, want)
+
+	ha.Input = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, rows...)},
+	}
+	err = ha.StreamExecute(&noopVCursor{}, nil, false, func(*sqltypes.Result) error { return nil })
+	assert.EqualError(t, err, want)
+
+	// Reaching the limit is allowed.
+	ha.Input = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, rows[:testMaxMemoryRows]...)},
+	}
+	_, err = ha.Execute(&noopVCursor{}, nil, false)
+	assert.NoError(t, err)
+}
+
+func TestHashAggregateInputFail(t *testing.T) {
+	ha := &HashAggregate{Input: &fakePrimitive{sendErr: errors.New("input fail")}}
+
+	want := "input fail"
+	_, err := ha.Execute(&noopVCursor{}, nil, false)
+	assert.EqualError(t, err, want)
+
+	err = ha.StreamExecute(&noopVCursor{}, nil, false, func(_ *sqltypes.Result) error { return nil })
+	assert.EqualError(t, err, want)
+
+	_, err = ha.GetFields(nil, nil)
+	assert.EqualError(t, err, want)
+}
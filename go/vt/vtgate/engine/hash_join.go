@@ -0,0 +1,254 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/xsec-lab/go/sqltypes"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+var _ Primitive = (*HashJoin)(nil)
+
+// HashJoin specifies the parameters for a hash join primitive.
+// Unlike Join, the RHS is executed only once: its rows are
+// loaded into an in-memory hash table keyed by the join columns,
+// and the LHS rows are then matched against it as they arrive.
+// Because the LHS is read in order, the ordering of the LHS
+// is preserved in the result.
+type HashJoin struct {
+	Opcode JoinOpcode
+	// Left and Right are the LHS and RHS primitives
+	// of the Join. They can be any primitive.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left
+	// or right results should be used to build the
+	// return result. The convention is the same
+	// as for Join.
+	Cols []int `json:",omitempty"`
+
+	// LeftKeys and RightKeys are the offsets of the join columns
+	// in the left and right results. A left row matches a right
+	// row if all the values at LeftKeys[i] and RightKeys[i] are equal.
+	LeftKeys, RightKeys []int
+}
+
+// MarshalJSON serializes the HashJoin into a JSON representation.
+// It's used for testing and diagnostics.
+func (hj *HashJoin) MarshalJSON() ([]byte, error) {
+	marshalHashJoin := struct {
+		Opcode    string
+		Left      Primitive `json:",omitempty"`
+		Right     Primitive `json:",omitempty"`
+		Cols      []int     `json:",omitempty"`
+		LeftKeys  []int
+		RightKeys []int
+	}{
+		Opcode:    "Hash" + hj.Opcode.String(),
+		Left:      hj.Left,
+		Right:     hj.Right,
+		Cols:      hj.Cols,
+		LeftKeys:  hj.LeftKeys,
+		RightKeys: hj.RightKeys,
+	}
+	return json.Marshal(marshalHashJoin)
+}
+
+// Execute performs a non-streaming exec.
+func (hj *HashJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rresult, err := hj.Right.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	table, err := hj.buildTable(vcursor, rresult.Rows)
+	if err != nil {
+		return nil, err
+	}
+	lresult, err := hj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	if wantfields {
+		result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+	}
+	result.Rows, err = hj.probe(vcursor, table, lresult.Rows)
+	if err != nil {
+		return nil, err
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (hj *HashJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var rfields []*querypb.Field
+	var rrows [][]sqltypes.Value
+	err := hj.Right.StreamExecute(vcursor, bindVars, wantfields, func(rresult *sqltypes.Result) error {
+		if len(rresult.Fields) != 0 {
+			rfields = rresult.Fields
+		}
+		rrows = append(rrows, rresult.Rows...)
+		if len(rrows) > vcursor.MaxMemoryRows() {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	table, err := hj.buildTable(vcursor, rrows)
+	if err != nil {
+		return err
+	}
+	return hj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if len(lresult.Fields) != 0 && wantfields {
+			wantfields = false
+			if rfields == nil {
+				rresult, err := hj.Right.GetFields(vcursor, bindVars)
+				if err != nil {
+					return err
+				}
+				rfields = rresult.Fields
+			}
+			result.Fields = joinFields(lresult.Fields, rfields, hj.Cols)
+		}
+		rows, err := hj.probe(vcursor, table, lresult.Rows)
+		if err != nil {
+			return err
+		}
+		result.Rows = rows
+		if result.Fields == nil && len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result)
+	})
+}
+
+// GetFields fetches the field info.
+func (hj *HashJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, hj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this join
+func (hj *HashJoin) Inputs() []Primitive {
+	return []Primitive{hj.Left, hj.Right}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (hj *HashJoin) RouteType() string {
+	return "HashJoin"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (hj *HashJoin) GetKeyspaceName() string {
+	if hj.Left.GetKeyspaceName() == hj.Right.GetKeyspaceName() {
+		return hj.Left.GetKeyspaceName()
+	}
+	return hj.Left.GetKeyspaceName() + "_" + hj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (hj *HashJoin) GetTableName() string {
+	return hj.Left.GetTableName() + "_" + hj.Right.GetTableName()
+}
+
+// buildTable builds the hash table for the RHS rows. Rows
+// that have a NULL in any of the join columns can never
+// match, and are therefore left out.
+func (hj *HashJoin) buildTable(vcursor VCursor, rows [][]sqltypes.Value) (map[string][][]sqltypes.Value, error) {
+	if len(rows) > vcursor.MaxMemoryRows() {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	table := make(map[string][][]sqltypes.Value, len(rows))
+	for _, row := range rows {
+		key, ok, err := hashJoinKey(row, hj.RightKeys)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		table[key] = append(table[key], row)
+	}
+	return table, nil
+}
+
+// probe matches the LHS rows against the hash table and
+// returns the joined rows.
+func (hj *HashJoin) probe(vcursor VCursor, table map[string][][]sqltypes.Value, lrows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	for _, lrow := range lrows {
+		key, ok, err := hashJoinKey(lrow, hj.LeftKeys)
+		if err != nil {
+			return nil, err
+		}
+		var matches [][]sqltypes.Value
+		if ok {
+			matches = table[key]
+		}
+		for _, rrow := range matches {
+			rows = append(rows, joinRows(lrow, rrow, hj.Cols))
+		}
+		if hj.Opcode == LeftJoin && len(matches) == 0 {
+			rows = append(rows, joinRows(lrow, nil, hj.Cols))
+		}
+		if len(rows) > vcursor.MaxMemoryRows() {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	return rows, nil
+}
+
+// hashJoinKey builds the hash table key for the specified columns
+// of the row. It returns false if any of the values is NULL.
+func hashJoinKey(row []sqltypes.Value, cols []int) (string, bool, error) {
+	if len(cols) == 1 {
+		if row[cols[0]].IsNull() {
+			return "", false, nil
+		}
+		key, err := sqltypes.NullsafeHashcode(row[cols[0]])
+		return key, err == nil, err
+	}
+	parts := make([]string, len(cols))
+	for i, col := range cols {
+		if row[col].IsNull() {
+			return "", false, nil
+		}
+		part, err := sqltypes.NullsafeHashcode(row[col])
+		if err != nil {
+			return "", false, err
+		}
+		// Length-prefix every part so that the concatenation is unambiguous.
+		parts[i] = fmt.Sprintf("%d:%s", len(part), part)
+	}
+	return strings.Join(parts, ""), true, nil
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/xsec-lab/go/sqltypes"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+func newHashJoinInputs() (*fakePrimitive, *fakePrimitive) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varchar",
+				),
+				"1|a|aa",
+				"2|b|bb",
+				"3|c|cc",
+				"null|d|dd",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col4|col5|col6",
+					"int64|varchar|varchar",
+				),
+				"1|e|ee",
+				"3|f|ff",
+				"3|g|gg",
+				"null|h|hh",
+			),
+		},
+	}
+	return leftPrim, rightPrim
+}
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinInputs()
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	// Normal join
+	jn := &HashJoin{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, -2, 1, 2},
+		LeftKeys:  []int{0},
+		RightKeys: []int{0},
+	}
+	r, err := jn.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|int64|varchar",
+		),
+		"1|a|1|e",
+		"3|c|3|f",
+		"3|c|3|g",
+	))
+
+	// Left Join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	jn.Opcode = LeftJoin
+	r, err = jn.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|int64|varchar",
+		),
+		"1|a|1|e",
+		"2|b|null|null",
+		"3|c|3|f",
+		"3|c|3|g",
+		"null|d|null|null",
+	))
+}
+
+func TestHashJoinExecuteMultiKey(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varbinary",
+				),
+				"1|a",
+				"1|b",
+				"2|a",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4|col5",
+					"varbinary|uint64|int64",
+				),
+				"a|1|10",
+				"a|2|20",
+				"c|1|30",
+			),
+		},
+	}
+
+	jn := &HashJoin{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, -2, 3},
+		LeftKeys:  []int{0, 1},
+		RightKeys: []int{1, 0},
+	}
+	r, err := jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col5",
+			"int64|varbinary|int64",
+		),
+		"1|a|10",
+		"2|a|20",
+	))
+}
+
+func TestHashJoinExecuteMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 3
+	defer func() { testMaxMemoryRows = save }()
+
+	leftPrim, rightPrim := newHashJoinInputs()
+	jn := &HashJoin{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, -2, 1, 2},
+		LeftKeys:  []int{0},
+		RightKeys: []int{0},
+	}
+	_, err := jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	expectError(t, "jn.Execute", err, "in-memory row count exceeded allowed limit of 3")
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	_, err = wrapStreamExecute(jn, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	expectError(t, "jn.StreamExecute", err, "in-memory row count exceeded allowed limit of 3")
+}
+
+func TestHashJoinExecuteErrors(t *testing.T) {
+	// Error on right query
+	jn := &HashJoin{
+		Opcode: NormalJoin,
+		Left:   &fakePrimitive{},
+		Right: &fakePrimitive{
+			sendErr: errors.New("right err"),
+		},
+	}
+	_, err := jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	expectError(t, "jn.Execute", err, "right err")
+
+	// Error on left query
+	_, rightPrim := newHashJoinInputs()
+	jn = &HashJoin{
+		Opcode: NormalJoin,
+		Left: &fakePrimitive{
+			sendErr: errors.New("left err"),
+		},
+		Right:     rightPrim,
+		LeftKeys:  []int{0},
+		RightKeys: []int{0},
+	}
+	_, err = jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	expectError(t, "jn.Execute", err, "left err")
+
+	// Unhashable join column
+	leftPrim, rightPrim := newHashJoinInputs()
+	jn = &HashJoin{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, 1},
+		LeftKeys:  []int{1},
+		RightKeys: []int{1},
+	}
+	_, err = jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	expectError(t, "jn.Execute", err, "types are not hashable: VARCHAR")
+}
+
+func TestHashJoinStreamExecute(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinInputs()
+
+	jn := &HashJoin{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, -2, 1, 2},
+		LeftKeys:  []int{0},
+		RightKeys: []int{0},
+	}
+	r, err := wrapStreamExecute(jn, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	expectResult(t, "jn.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|int64|varchar",
+		),
+		"1|a|1|e",
+		"3|c|3|f",
+		"3|c|3|g",
+	))
+
+	// Left Join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	jn.Opcode = LeftJoin
+	r, err = wrapStreamExecute(jn, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "jn.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|int64|varchar",
+		),
+		"1|a|1|e",
+		"2|b|null|null",
+		"3|c|3|f",
+		"3|c|3|g",
+		"null|d|null|null",
+	))
+}
+
+func TestHashJoinGetFields(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinInputs()
+
+	jn := &HashJoin{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, -2, 1, 2},
+		LeftKeys:  []int{0},
+		RightKeys: []int{0},
+	}
+	r, err := jn.GetFields(noopVCursor{}, map[string]*querypb.BindVariable{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`GetFields `,
+		`Execute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`GetFields `,
+		`Execute  true`,
+	})
+	expectResult(t, "jn.GetFields", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|int64|varchar",
+		),
+	))
+}
+
+func TestHashJoinMarshalJSON(t *testing.T) {
+	jn := &HashJoin{
+		Opcode:    LeftJoin,
+		Cols:      []int{-1, 1},
+		LeftKeys:  []int{0},
+		RightKeys: []int{1},
+	}
+	b, err := json.Marshal(jn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"Opcode":"HashLeftJoin","Cols":[-1,1],"LeftKeys":[0],"RightKeys":[1]}`
+	if got := string(b); got != want {
+		t.Errorf("json.Marshal(HashJoin): %s, want %s", got, want)
+	}
+}
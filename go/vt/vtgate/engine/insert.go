@@ -312,21 +312,7 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 
 	// If generation is needed, generate the requested number of values (as one call).
 	if count != 0 {
-		rss, _, err := vcursor.ResolveDestinations(ins.Generate.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
-		if err != nil {
-			return 0, vterrors.Wrap(err, "processGenerate")
-		}
-		if len(rss) != 1 {
-			return 0, vterrors.Wrapf(err, "processGenerate len(rss)=%v", len(rss))
-		}
-		bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}
-		qr, err := vcursor.ExecuteStandalone(ins.Generate.Query, bindVars, rss[0])
-		if err != nil {
-			return 0, err
-		}
-		// If no rows are returned, it's an internal error, and the code
-		// must panic, which will be caught and reported.
-		insertID, err = sqltypes.ToInt64(qr.Rows[0][0])
+		insertID, err = ins.Generate.nextValues(vcursor, count)
 		if err != nil {
 			return 0, err
 		}
@@ -345,6 +331,51 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 	return insertID, nil
 }
 
+// nextValues reserves count consecutive values of the sequence and
+// returns the first one. The values are served from the blocks that
+// vtgate prefetched, if prefetching is enabled.
+func (gen *Generate) nextValues(vcursor VCursor, count int64) (int64, error) {
+	if insertID, ok, err := vcursor.PrefetchedSequenceValues(gen, count); ok || err != nil {
+		return insertID, err
+	}
+	return gen.Fetch(vcursor, count)
+}
+
+// Fetch reserves count consecutive values of the sequence from the
+// sequence table, and returns the first one. If the sequence keyspace
+// is sharded, every shard allocates values from a disjoint range, and
+// the shards are tried in turn until one of them succeeds.
+func (gen *Generate) Fetch(vcursor VCursor, count int64) (int64, error) {
+	dest := key.Destination(key.DestinationAnyShard{})
+	if gen.Keyspace.Sharded {
+		dest = key.DestinationAllShards{}
+	}
+	rss, _, err := vcursor.ResolveDestinations(gen.Keyspace.Name, nil, []key.Destination{dest})
+	if err != nil {
+		return 0, vterrors.Wrap(err, "processGenerate")
+	}
+	if len(rss) == 0 || (!gen.Keyspace.Sharded && len(rss) != 1) {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "processGenerate len(rss)=%v", len(rss))
+	}
+	start := 0
+	if len(rss) > 1 {
+		start = key.AnyShardPicker.PickShard(len(rss))
+	}
+	bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}
+	var errs []error
+	for i := range rss {
+		qr, err := vcursor.ExecuteStandalone(gen.Query, bindVars, rss[(start+i)%len(rss)])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		// If no rows are returned, it's an internal error, and the code
+		// must panic, which will be caught and reported.
+		return sqltypes.ToInt64(qr.Rows[0][0])
+	}
+	return 0, vterrors.Aggregate(errs)
+}
+
 // getInsertShardedRoute performs all the vindex related work
 // and returns a map of shard to queries.
 // Using the primary vindex, it computes the target keyspace ids.
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/key"
 	"github.com/xsec-lab/go/vt/vtgate/vindexes"
 
 	querypb "github.com/xsec-lab/go/vt/proto/query"
@@ -321,6 +322,63 @@ func TestInsertShardedFail(t *testing.T) {
 	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: could not map [INT64(1)] to a keyspace id")
 }
 
+// lastShardPicker picks the last shard.
+type lastShardPicker struct{}
+
+func (lastShardPicker) PickShard(shardCount int) int {
+	return shardCount - 1
+}
+
+func TestGenerateFetchSharded(t *testing.T) {
+	saved := key.AnyShardPicker
+	key.AnyShardPicker = lastShardPicker{}
+	defer func() { key.AnyShardPicker = saved }()
+
+	gen := &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: true,
+		},
+		Query: "dummy_generate",
+	}
+
+	// The last shard is down, so the values come from the first one.
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{
+			nil,
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"nextval",
+					"int64",
+				),
+				"4",
+			),
+		},
+		resultErr: errors.New("shard down"),
+	}
+	insertID, err := gen.Fetch(vc, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if insertID != 4 {
+		t.Errorf("Fetch: %d, want 4", insertID)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks2 [] Destinations:DestinationAllShards()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"2"  ks2 20-`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"2"  ks2 -20`,
+	})
+
+	// All the shards are down.
+	vc = &loggingVCursor{
+		shards:    []string{"-20", "20-"},
+		resultErr: errors.New("shard down"),
+	}
+	_, err = gen.Fetch(vc, 2)
+	expectError(t, "Fetch", err, "shard down\nshard down")
+}
+
 func TestInsertShardedGenerate(t *testing.T) {
 	invschema := &vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
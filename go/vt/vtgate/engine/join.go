@@ -46,10 +46,23 @@ type Join struct {
 	// be built from the LHS result before invoking
 	// the RHS subqquery.
 	Vars map[string]int `json:",omitempty"`
+
+	// BatchSize, if set, makes the join execute the RHS once
+	// for up to BatchSize LHS rows instead of once per row.
+	// The values of the LeftKey column of the batch are sent as
+	// the ListVar list, and the RHS rows are matched back to the
+	// LHS rows on the RightKey column. Vars must be empty.
+	BatchSize int    `json:",omitempty"`
+	ListVar   string `json:",omitempty"`
+	LeftKey   int    `json:",omitempty"`
+	RightKey  int    `json:",omitempty"`
 }
 
 // Execute performs a non-streaming exec.
 func (jn *Join) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	if jn.BatchSize > 0 {
+		return jn.executeBatched(vcursor, bindVars, wantfields)
+	}
 	joinVars := make(map[string]*querypb.BindVariable)
 	lresult, err := jn.Left.Execute(vcursor, bindVars, wantfields)
 	if err != nil {
@@ -97,6 +110,9 @@ func (jn *Join) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariab
 
 // StreamExecute performs a streaming exec.
 func (jn *Join) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	if jn.BatchSize > 0 {
+		return jn.streamExecuteBatched(vcursor, bindVars, wantfields, callback)
+	}
 	joinVars := make(map[string]*querypb.BindVariable)
 	err := jn.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
 		for _, lrow := range lresult.Rows {
@@ -163,6 +179,9 @@ func (jn *Join) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVari
 	for k := range jn.Vars {
 		joinVars[k] = sqltypes.NullBindVariable
 	}
+	if jn.BatchSize > 0 {
+		joinVars[jn.ListVar] = batchListVariable([]sqltypes.Value{sqltypes.NULL})
+	}
 	rresult, err := jn.Right.GetFields(vcursor, combineVars(bindVars, joinVars))
 	if err != nil {
 		return nil, err
@@ -171,6 +190,204 @@ func (jn *Join) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVari
 	return result, nil
 }
 
+// executeBatched performs a non-streaming exec in batches.
+func (jn *Join) executeBatched(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := jn.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	var rfields []*querypb.Field
+	for start := 0; start < len(lresult.Rows); start += jn.BatchSize {
+		end := start + jn.BatchSize
+		if end > len(lresult.Rows) {
+			end = len(lresult.Rows)
+		}
+		rows, fields, err := jn.joinBatch(vcursor, bindVars, lresult.Rows[start:end], wantfields && rfields == nil)
+		if err != nil {
+			return nil, err
+		}
+		if fields != nil {
+			rfields = fields
+		}
+		result.Rows = append(result.Rows, rows...)
+		if len(result.Rows) > vcursor.MaxMemoryRows() {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	if wantfields {
+		if rfields == nil {
+			rresult, err := jn.Right.GetFields(vcursor, combineVars(bindVars, map[string]*querypb.BindVariable{
+				jn.ListVar: batchListVariable([]sqltypes.Value{sqltypes.NULL}),
+			}))
+			if err != nil {
+				return nil, err
+			}
+			rfields = rresult.Fields
+		}
+		result.Fields = joinFields(lresult.Fields, rfields, jn.Cols)
+	}
+	return result, nil
+}
+
+// streamExecuteBatched performs a streaming exec in batches.
+// The LHS rows are accumulated until a batch is complete. The
+// fields are sent along with the results of the first batch.
+func (jn *Join) streamExecuteBatched(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var lfields []*querypb.Field
+	var batch [][]sqltypes.Value
+	sendBatch := func(lrows [][]sqltypes.Value) error {
+		rows, rfields, err := jn.joinBatch(vcursor, bindVars, lrows, wantfields)
+		if err != nil {
+			return err
+		}
+		result := &sqltypes.Result{Rows: rows}
+		if wantfields {
+			wantfields = false
+			if rfields == nil {
+				rresult, err := jn.Right.GetFields(vcursor, combineVars(bindVars, map[string]*querypb.BindVariable{
+					jn.ListVar: batchListVariable([]sqltypes.Value{sqltypes.NULL}),
+				}))
+				if err != nil {
+					return err
+				}
+				rfields = rresult.Fields
+			}
+			result.Fields = joinFields(lfields, rfields, jn.Cols)
+		}
+		if len(result.Fields) == 0 && len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result)
+	}
+	err := jn.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		if len(lresult.Fields) != 0 {
+			lfields = lresult.Fields
+		}
+		batch = append(batch, lresult.Rows...)
+		for len(batch) >= jn.BatchSize {
+			if err := sendBatch(batch[:jn.BatchSize]); err != nil {
+				return err
+			}
+			batch = batch[jn.BatchSize:]
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(batch) == 0 && !wantfields {
+		return nil
+	}
+	return sendBatch(batch)
+}
+
+// joinBatch executes the RHS for a batch of LHS rows and
+// returns the joined rows. The fields of the RHS are returned
+// if wantfields is set and the RHS was executed.
+// If the LHS values or the RHS values can't be hashed, the rows
+// can't be matched in memory. In that case, the RHS is executed
+// once per row with a single value list, and all the returned
+// rows match. The RHS values are only known once the batch is
+// executed: the planner only avoids the columns that are known
+// to be text.
+func (jn *Join) joinBatch(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lrows [][]sqltypes.Value, wantfields bool) ([][]sqltypes.Value, []*querypb.Field, error) {
+	keys := make([]string, len(lrows))
+	var values []sqltypes.Value
+	seen := make(map[string]bool)
+	for i, lrow := range lrows {
+		key, ok, err := hashJoinKey(lrow, []int{jn.LeftKey})
+		if err != nil {
+			return jn.joinRowByRow(vcursor, bindVars, lrows, wantfields)
+		}
+		if !ok {
+			// NULL values never match.
+			continue
+		}
+		keys[i] = key
+		if !seen[key] {
+			seen[key] = true
+			values = append(values, lrow[jn.LeftKey])
+		}
+	}
+
+	var rfields []*querypb.Field
+	matches := make(map[string][][]sqltypes.Value)
+	if len(values) != 0 {
+		rresult, err := jn.Right.Execute(vcursor, combineVars(bindVars, map[string]*querypb.BindVariable{
+			jn.ListVar: batchListVariable(values),
+		}), wantfields)
+		if err != nil {
+			return nil, nil, err
+		}
+		rfields = rresult.Fields
+		for _, rrow := range rresult.Rows {
+			key, ok, err := hashJoinKey(rrow, []int{jn.RightKey})
+			if err != nil {
+				return jn.joinRowByRow(vcursor, bindVars, lrows, wantfields)
+			}
+			if ok {
+				matches[key] = append(matches[key], rrow)
+			}
+		}
+	}
+
+	// The keys of the NULL values are empty, and never match.
+	var rows [][]sqltypes.Value
+	for i, lrow := range lrows {
+		rrows := matches[keys[i]]
+		for _, rrow := range rrows {
+			rows = append(rows, joinRows(lrow, rrow, jn.Cols))
+		}
+		if jn.Opcode == LeftJoin && len(rrows) == 0 {
+			rows = append(rows, joinRows(lrow, nil, jn.Cols))
+		}
+	}
+	return rows, rfields, nil
+}
+
+// joinRowByRow executes the RHS once for every LHS row of
+// the batch. It's the fallback of joinBatch.
+func (jn *Join) joinRowByRow(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lrows [][]sqltypes.Value, wantfields bool) ([][]sqltypes.Value, []*querypb.Field, error) {
+	var rows [][]sqltypes.Value
+	var rfields []*querypb.Field
+	for _, lrow := range lrows {
+		var rrows [][]sqltypes.Value
+		if !lrow[jn.LeftKey].IsNull() {
+			rresult, err := jn.Right.Execute(vcursor, combineVars(bindVars, map[string]*querypb.BindVariable{
+				jn.ListVar: batchListVariable([]sqltypes.Value{lrow[jn.LeftKey]}),
+			}), wantfields)
+			if err != nil {
+				return nil, nil, err
+			}
+			if wantfields {
+				wantfields = false
+				rfields = rresult.Fields
+			}
+			rrows = rresult.Rows
+		}
+		for _, rrow := range rrows {
+			rows = append(rows, joinRows(lrow, rrow, jn.Cols))
+		}
+		if jn.Opcode == LeftJoin && len(rrows) == 0 {
+			rows = append(rows, joinRows(lrow, nil, jn.Cols))
+		}
+	}
+	return rows, rfields, nil
+}
+
+func batchListVariable(values []sqltypes.Value) *querypb.BindVariable {
+	bv := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, len(values)),
+	}
+	for i, v := range values {
+		bv.Values[i] = sqltypes.ValueToProto(v)
+	}
+	return bv
+}
+
 // Inputs returns the input primitives for this join
 func (jn *Join) Inputs() []Primitive {
 	return []Primitive{jn.Left, jn.Right}
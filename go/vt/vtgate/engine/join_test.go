@@ -500,3 +500,401 @@ func TestGetFieldsErrors(t *testing.T) {
 	_, err = jn.GetFields(nil, map[string]*querypb.BindVariable{})
 	expectError(t, "jn.GetFields", err, "right err")
 }
+
+func TestJoinExecuteBatched(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|int64",
+				),
+				"1|10",
+				"2|20",
+				"3|null",
+				"4|10",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3|col4",
+		"varchar|int64",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"a|10",
+				"b|10",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"c|10",
+			),
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	// Normal join
+	jn := &Join{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, 1},
+		BatchSize: 2,
+		ListVar:   "col2_list",
+		LeftKey:   1,
+		RightKey:  1,
+	}
+	r, err := jn.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" col2_list: type:TUPLE values:<type:INT64 value:"10" > values:<type:INT64 value:"20" >  true`,
+		`Execute a: type:INT64 value:"10" col2_list: type:TUPLE values:<type:INT64 value:"10" >  false`,
+	})
+	wantFields := sqltypes.MakeTestFields(
+		"col1|col3",
+		"int64|varchar",
+	)
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"1|a",
+		"1|b",
+		"4|c",
+	))
+
+	// Left Join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	jn.Opcode = LeftJoin
+	r, err = jn.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" col2_list: type:TUPLE values:<type:INT64 value:"10" > values:<type:INT64 value:"20" >  true`,
+		`Execute a: type:INT64 value:"10" col2_list: type:TUPLE values:<type:INT64 value:"10" >  false`,
+	})
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"1|a",
+		"1|b",
+		"2|null",
+		"3|null",
+		"4|c",
+	))
+}
+
+func TestJoinExecuteBatchedNoValues(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|int64",
+				),
+				"1|null",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"varchar|int64",
+				),
+			),
+		},
+	}
+	jn := &Join{
+		Opcode:    LeftJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, 1},
+		BatchSize: 10,
+		ListVar:   "col2_list",
+		LeftKey:   1,
+		RightKey:  1,
+	}
+	r, err := jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`GetFields col2_list: type:TUPLE values:<> `,
+		`Execute col2_list: type:TUPLE values:<>  true`,
+	})
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col3",
+			"int64|varchar",
+		),
+		"1|null",
+	))
+}
+
+func TestJoinExecuteBatchedRowByRow(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|null",
+				"3|b",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3|col4",
+		"int64|varchar",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"10|A",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"20|b",
+				"30|B",
+			),
+		},
+	}
+	jn := &Join{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, 1},
+		BatchSize: 10,
+		ListVar:   "col2_list",
+		LeftKey:   1,
+		RightKey:  1,
+	}
+	r, err := jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The varchar values can't be hashed. So, the rows are sent one at a time.
+	rightPrim.ExpectLog(t, []string{
+		`Execute col2_list: type:TUPLE values:<type:VARCHAR value:"a" >  true`,
+		`Execute col2_list: type:TUPLE values:<type:VARCHAR value:"b" >  false`,
+	})
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col3",
+			"int64|int64",
+		),
+		"1|10",
+		"3|20",
+		"3|30",
+	))
+}
+
+func TestJoinExecuteBatchedUnhashableRightKey(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|int64",
+				),
+				"1|10",
+				"2|20",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3|col4",
+		"int64|varchar",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"100|10",
+				"200|20",
+				"300|20.0",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"100|10",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"200|20",
+				"300|20.0",
+			),
+		},
+	}
+	jn := &Join{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, 1},
+		BatchSize: 10,
+		ListVar:   "col2_list",
+		LeftKey:   1,
+		RightKey:  1,
+	}
+	r, err := jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The varchar column can't be hashed. So, the batch result is
+	// discarded and the rows are sent one at a time.
+	rightPrim.ExpectLog(t, []string{
+		`Execute col2_list: type:TUPLE values:<type:INT64 value:"10" > values:<type:INT64 value:"20" >  true`,
+		`Execute col2_list: type:TUPLE values:<type:INT64 value:"10" >  true`,
+		`Execute col2_list: type:TUPLE values:<type:INT64 value:"20" >  false`,
+	})
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col3",
+			"int64|int64",
+		),
+		"1|100",
+		"2|200",
+		"2|300",
+	))
+}
+
+func TestJoinStreamExecuteBatched(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|int64",
+				),
+				"1|10",
+				"2|20",
+				"3|30",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3|col4",
+		"varchar|int64",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"a|20",
+				"b|10",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"c|30",
+			),
+		},
+	}
+	jn := &Join{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, 1},
+		BatchSize: 2,
+		ListVar:   "col2_list",
+		LeftKey:   1,
+		RightKey:  1,
+	}
+	r, err := wrapStreamExecute(jn, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute col2_list: type:TUPLE values:<type:INT64 value:"10" > values:<type:INT64 value:"20" >  true`,
+		`Execute col2_list: type:TUPLE values:<type:INT64 value:"30" >  false`,
+	})
+	// The order of the left rows is preserved.
+	expectResult(t, "jn.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col3",
+			"int64|varchar",
+		),
+		"1|b",
+		"2|a",
+		"3|c",
+	))
+
+	// Without LHS rows, the fields come from a GetFields.
+	leftPrim = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|int64",
+				),
+			),
+		},
+	}
+	rightPrim = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+		},
+	}
+	jn.Left = leftPrim
+	jn.Right = rightPrim
+	r, err = wrapStreamExecute(jn, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`GetFields col2_list: type:TUPLE values:<> `,
+		`Execute col2_list: type:TUPLE values:<>  true`,
+	})
+	expectResult(t, "jn.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col3",
+			"int64|varchar",
+		),
+	))
+}
+
+func TestJoinExecuteBatchedErrors(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|int64",
+				),
+				"1|10",
+			),
+		},
+	}
+	jn := &Join{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     &fakePrimitive{sendErr: errors.New("right err")},
+		Cols:      []int{-1, 1},
+		BatchSize: 2,
+		ListVar:   "col2_list",
+		LeftKey:   1,
+		RightKey:  1,
+	}
+	_, err := jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	expectError(t, "jn.Execute", err, "right err")
+
+	leftPrim.rewind()
+	_, err = wrapStreamExecute(jn, noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "jn.StreamExecute", err, "right err")
+}
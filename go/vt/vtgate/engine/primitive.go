@@ -65,6 +65,12 @@ type VCursor interface {
 	ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error)
 	StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error
 
+	// PrefetchedSequenceValues reserves count consecutive values of the
+	// sequence of gen from the values that were fetched ahead of time,
+	// and returns the first one. ok is false if the values must be
+	// fetched from the sequence table instead.
+	PrefetchedSequenceValues(gen *Generate, count int64) (insertID int64, ok bool, err error)
+
 	// Keyspace ID level functions.
 	ExecuteKeyspaceID(keyspace string, ksid []byte, query string, bindVars map[string]*querypb.BindVariable, isDML, autocommit bool) (*sqltypes.Result, error)
 
@@ -0,0 +1,181 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/vtgate/evalengine"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+var _ Primitive = (*Projection)(nil)
+
+// Projection is a primitive that evaluates expressions on the
+// rows returned by its input. It's used for the expressions that
+// can't be pushed down to a route, like arithmetic on the results
+// of a scatter aggregation, or on the columns of both sides of
+// a left join.
+type Projection struct {
+	// Cols are the names of the returned columns.
+	Cols []string
+	// Exprs are the expressions that produce the returned columns.
+	// The columns of the input rows are passed through as an
+	// evalengine.Column, which also preserves their field.
+	Exprs []evalengine.Expr
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// MarshalJSON serializes the Projection into a JSON representation.
+// It's used for testing and diagnostics.
+func (p *Projection) MarshalJSON() ([]byte, error) {
+	exprs := make([]string, len(p.Exprs))
+	for i, expr := range p.Exprs {
+		exprs[i] = expr.String()
+	}
+	marshalProjection := struct {
+		Opcode              string
+		Cols                []string
+		Exprs               []string
+		TruncateColumnCount int `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "Projection",
+		Cols:                p.Cols,
+		Exprs:               exprs,
+		TruncateColumnCount: p.TruncateColumnCount,
+		Input:               p.Input,
+	}
+	return json.Marshal(marshalProjection)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (p *Projection) RouteType() string {
+	return p.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (p *Projection) GetKeyspaceName() string {
+	return p.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (p *Projection) GetTableName() string {
+	return p.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (p *Projection) SetTruncateColumnCount(count int) {
+	p.TruncateColumnCount = count
+}
+
+// Execute is a Primitive function.
+func (p *Projection) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := p.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := p.project(bindVars, result.Rows)
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{
+		Fields:       p.fields(result.Fields),
+		Rows:         rows,
+		RowsAffected: result.RowsAffected,
+	}
+	return out.Truncate(p.TruncateColumnCount), nil
+}
+
+// StreamExecute is a Primitive function.
+func (p *Projection) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return p.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		rows, err := p.project(bindVars, qr.Rows)
+		if err != nil {
+			return err
+		}
+		out := &sqltypes.Result{Fields: p.fields(qr.Fields), Rows: rows}
+		return callback(out.Truncate(p.TruncateColumnCount))
+	})
+}
+
+// GetFields is a Primitive function.
+func (p *Projection) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := p.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{Fields: p.fields(qr.Fields)}
+	return out.Truncate(p.TruncateColumnCount), nil
+}
+
+// Inputs returns the Primitive input for this projection.
+func (p *Projection) Inputs() []Primitive {
+	return []Primitive{p.Input}
+}
+
+// project evaluates the expressions for every row.
+func (p *Projection) project(bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	if rows == nil {
+		return nil, nil
+	}
+	out := make([][]sqltypes.Value, 0, len(rows))
+	env := evalengine.ExpressionEnv{BindVars: bindVars}
+	for _, row := range rows {
+		env.Row = row
+		newRow := make([]sqltypes.Value, len(p.Exprs))
+		for i, expr := range p.Exprs {
+			val, err := expr.Evaluate(env)
+			if err != nil {
+				return nil, err
+			}
+			newRow[i] = val
+		}
+		out = append(out, newRow)
+	}
+	return out, nil
+}
+
+// fields returns the fields of the projected columns. The fields
+// of the input columns are reused, and the fields of the computed
+// columns are built from the type of their expression.
+func (p *Projection) fields(inputFields []*querypb.Field) []*querypb.Field {
+	if inputFields == nil {
+		return nil
+	}
+	fields := make([]*querypb.Field, len(p.Exprs))
+	for i, expr := range p.Exprs {
+		if col, ok := expr.(*evalengine.Column); ok && col.Offset < len(inputFields) {
+			fields[i] = inputFields[col.Offset]
+			continue
+		}
+		fields[i] = &querypb.Field{
+			Name: p.Cols[i],
+			Type: expr.Type(inputFields),
+		}
+	}
+	return fields
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/vtgate/evalengine"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+func newTestProjection(input Primitive) *Projection {
+	return &Projection{
+		Cols: []string{"id", "count(*) * 2", "coalesce(name, :default)"},
+		Exprs: []evalengine.Expr{
+			&evalengine.Column{Offset: 0},
+			&evalengine.ArithmeticExpr{
+				Op:    evalengine.Multiply,
+				Left:  &evalengine.Column{Offset: 1},
+				Right: &evalengine.Literal{Val: sqltypes.NewInt64(2)},
+			},
+			mustFuncExpr("coalesce", &evalengine.Column{Offset: 2}, &evalengine.BindVariable{Key: "default"}),
+		},
+		Input: input,
+	}
+}
+
+func mustFuncExpr(name string, args ...evalengine.Expr) evalengine.Expr {
+	expr, err := evalengine.NewFuncExpr(name, args)
+	if err != nil {
+		panic(err)
+	}
+	return expr
+}
+
+func newProjectionInput() *fakePrimitive {
+	return &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|count(*)|name",
+				"int64|int64|varchar",
+			),
+			"1|3|a",
+			"2|5|null",
+		)},
+	}
+}
+
+var projectionBindVars = map[string]*querypb.BindVariable{
+	"default": sqltypes.StringBindVariable("none"),
+}
+
+func TestProjectionExecute(t *testing.T) {
+	fp := newProjectionInput()
+	proj := newTestProjection(fp)
+
+	result, err := proj.Execute(noopVCursor{}, projectionBindVars, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fp.ExpectLog(t, []string{
+		`Execute default: type:VARCHAR value:"none"  true`,
+	})
+	wantResult := sqltypes.MakeTestResult(
+		[]*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "count(*) * 2", Type: sqltypes.Int64},
+			{Name: "coalesce(name, :default)", Type: sqltypes.VarChar},
+		},
+		"1|6|a",
+		"2|10|none",
+	)
+	expectResult(t, "proj.Execute", result, wantResult)
+}
+
+func TestProjectionStreamExecute(t *testing.T) {
+	fp := newProjectionInput()
+	proj := newTestProjection(fp)
+	proj.TruncateColumnCount = 2
+
+	result, err := wrapStreamExecute(proj, noopVCursor{}, projectionBindVars, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		[]*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "count(*) * 2", Type: sqltypes.Int64},
+		},
+		"1|6",
+		"2|10",
+	)
+	expectResult(t, "proj.StreamExecute", result, wantResult)
+}
+
+func TestProjectionGetFields(t *testing.T) {
+	fp := newProjectionInput()
+	proj := newTestProjection(fp)
+
+	result, err := proj.GetFields(noopVCursor{}, projectionBindVars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "count(*) * 2", Type: sqltypes.Int64},
+			{Name: "coalesce(name, :default)", Type: sqltypes.VarChar},
+		},
+	}
+	expectResult(t, "proj.GetFields", result, wantResult)
+}
+
+func TestProjectionErrors(t *testing.T) {
+	proj := newTestProjection(&fakePrimitive{sendErr: errors.New("input err")})
+	_, err := proj.Execute(noopVCursor{}, projectionBindVars, false)
+	assert.EqualError(t, err, "input err")
+
+	_, err = wrapStreamExecute(proj, noopVCursor{}, projectionBindVars, false)
+	assert.EqualError(t, err, "input err")
+
+	_, err = proj.GetFields(noopVCursor{}, projectionBindVars)
+	assert.EqualError(t, err, "input err")
+
+	// Missing bind variable.
+	proj = newTestProjection(newProjectionInput())
+	_, err = proj.Execute(noopVCursor{}, nil, false)
+	assert.EqualError(t, err, "missing bind var default")
+}
+
+func TestProjectionMarshal(t *testing.T) {
+	proj := newTestProjection(&fakePrimitive{})
+	b, err := json.Marshal(proj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"Opcode":"Projection","Cols":["id","count(*) * 2","coalesce(name, :default)"],` +
+		`"Exprs":["[COLUMN 0]","[COLUMN 1] * 2","coalesce([COLUMN 2], :default)"],"Input":{}}`
+	assert.Equal(t, want, string(b))
+}
@@ -65,8 +65,12 @@ type Route struct {
 	FieldQuery string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	// A MultiColumn vindex can be used only by SelectEqualUnique
+	// and SelectEqual.
+	Vindex vindexes.Vindex
 	// Values specifies the vindex values to use for routing.
+	// For a MultiColumn vindex, there is one value for each
+	// of its leading columns that are used.
 	Values []sqltypes.PlanValue
 
 	// OrderBy specifies the key order for merge sorting. This will be
@@ -181,6 +185,15 @@ const (
 	SelectDBA
 	// SelectReference is for fetching from a reference table.
 	SelectReference
+	// SelectPrefix is for routing a query that has a
+	// LIKE 'prefix%' clause using a Prefixable Vindex.
+	// Requires: A Vindex, and a single Value, the pattern.
+	SelectPrefix
+	// SelectRange is for routing a query that has a
+	// range clause, like BETWEEN or >=, using a Rangeable Vindex.
+	// Requires: A Vindex, and two Values, the lower and upper
+	// bounds. A NULL bound leaves the range open on its side.
+	SelectRange
 )
 
 var routeName = map[RouteOpcode]string{
@@ -192,6 +205,8 @@ var routeName = map[RouteOpcode]string{
 	SelectNext:        "SelectNext",
 	SelectDBA:         "SelectDBA",
 	SelectReference:   "SelectReference",
+	SelectPrefix:      "SelectPrefix",
+	SelectRange:       "SelectRange",
 }
 
 var (
@@ -250,6 +265,10 @@ func (route *Route) execute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 		rss, bvs, err = route.paramsSelectEqual(vcursor, bindVars)
 	case SelectIN:
 		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
+	case SelectPrefix:
+		rss, bvs, err = route.paramsSelectPrefix(vcursor, bindVars)
+	case SelectRange:
+		rss, bvs, err = route.paramsSelectRange(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, fmt.Errorf("unsupported query route: %v", route)
@@ -309,6 +328,10 @@ func (route *Route) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.
 		rss, bvs, err = route.paramsSelectEqual(vcursor, bindVars)
 	case SelectIN:
 		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
+	case SelectPrefix:
+		rss, bvs, err = route.paramsSelectPrefix(vcursor, bindVars)
+	case SelectRange:
+		rss, bvs, err = route.paramsSelectRange(vcursor, bindVars)
 	default:
 		return fmt.Errorf("query %q cannot be used for streaming", route.Query)
 	}
@@ -394,6 +417,9 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 }
 
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	if vindex, ok := route.Vindex.(vindexes.MultiColumn); ok {
+		return route.paramsSelectMultiColumn(vcursor, vindex, bindVars)
+	}
 	key, err := route.Values[0].ResolveValue(bindVars)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
@@ -409,6 +435,33 @@ func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*quer
 	return rss, multiBindVars, nil
 }
 
+// paramsSelectMultiColumn maps the values of the leading columns of a
+// MultiColumn vindex. If some of the columns have no value, the vindex
+// maps them to a key range.
+func (route *Route) paramsSelectMultiColumn(vcursor VCursor, vindex vindexes.MultiColumn, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	row := make([]sqltypes.Value, len(route.Values))
+	for i, pv := range route.Values {
+		v, err := pv.ResolveValue(bindVars)
+		if err != nil {
+			return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
+		}
+		row[i] = v
+	}
+	destinations, err := vindex.Map(vcursor, [][]sqltypes.Value{row})
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, destinations)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
+	}
+	multiBindVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range multiBindVars {
+		multiBindVars[i] = bindVars
+	}
+	return rss, multiBindVars, nil
+}
+
 func (route *Route) paramsSelectIn(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
 	keys, err := route.Values[0].ResolveList(bindVars)
 	if err != nil {
@@ -421,7 +474,84 @@ func (route *Route) paramsSelectIn(vcursor VCursor, bindVars map[string]*querypb
 	return rss, shardVars(bindVars, values), nil
 }
 
-func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+func (route *Route) paramsSelectPrefix(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	pattern, err := route.Values[0].ResolveValue(bindVars)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectPrefix")
+	}
+	vindex, ok := route.Vindex.(vindexes.Prefixable)
+	if !ok {
+		return nil, nil, fmt.Errorf("paramsSelectPrefix: vindex %s cannot map prefixes", route.Vindex)
+	}
+	destinations, err := vindex.MapPrefix(vcursor, []sqltypes.Value{sqltypes.NewVarBinary(likePrefix(pattern.ToString()))})
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectPrefix")
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, destinations)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectPrefix")
+	}
+	multiBindVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range multiBindVars {
+		multiBindVars[i] = bindVars
+	}
+	return rss, multiBindVars, nil
+}
+
+func (route *Route) paramsSelectRange(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	from, err := route.Values[0].ResolveValue(bindVars)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectRange")
+	}
+	to, err := route.Values[1].ResolveValue(bindVars)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectRange")
+	}
+	vindex, ok := route.Vindex.(vindexes.Rangeable)
+	if !ok {
+		return nil, nil, fmt.Errorf("paramsSelectRange: vindex %s cannot map ranges", route.Vindex)
+	}
+	destination, err := vindex.MapRange(vcursor, from, to)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectRange")
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{destination})
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectRange")
+	}
+	multiBindVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range multiBindVars {
+		multiBindVars[i] = bindVars
+	}
+	return rss, multiBindVars, nil
+}
+
+// likePrefix returns the literal prefix of a LIKE pattern,
+// which is the part before the first wildcard. The prefix is
+// matched byte for byte, which is only correct for columns with
+// a binary collation: the planner doesn't route the other ones.
+func likePrefix(pattern string) string {
+	prefix := make([]byte, 0, len(pattern))
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '%', '_':
+			return string(prefix)
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+		}
+		prefix = append(prefix, pattern[i])
+	}
+	return string(prefix)
+}
+
+func resolveShards(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	single, ok := vindex.(vindexes.SingleColumn)
+	if !ok {
+		return nil, nil, fmt.Errorf("vindex %s is not a single column vindex", vindex)
+	}
+
 	// Convert vindexKeys to []*querypb.Value
 	ids := make([]*querypb.Value, len(vindexKeys))
 	for i, vik := range vindexKeys {
@@ -429,7 +559,7 @@ func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vind
 	}
 
 	// Map using the Vindex
-	destinations, err := vindex.Map(vcursor, vindexKeys)
+	destinations, err := single.Map(vcursor, vindexKeys)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -425,6 +425,162 @@ func TestSelectINNonUnique(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectPrefix(t *testing.T) {
+	vindex, _ := vindexes.NewPrefixHash("", map[string]string{
+		"segments": "4",
+	})
+	sel := NewRoute(
+		SelectPrefix,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []sqltypes.PlanValue{{Key: "pattern"}}
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	bv := map[string]*querypb.BindVariable{"pattern": sqltypes.StringBindVariable("acme%")}
+	result, err := sel.Execute(vc, bv, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(0cec-0ced)`,
+		`ExecuteMultiShard ks.-20: dummy_select {pattern: type:VARCHAR value:"acme%" } ks.20-: dummy_select {pattern: type:VARCHAR value:"acme%" } false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	// A prefix shorter than the first segment can't be narrowed.
+	vc.Rewind()
+	bv = map[string]*querypb.BindVariable{"pattern": sqltypes.StringBindVariable("ac%")}
+	result, err = wrapStreamExecute(sel, vc, bv, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(-)`,
+		`StreamExecuteMulti dummy_select ks.-20: {pattern: type:VARCHAR value:"ac%" } ks.20-: {pattern: type:VARCHAR value:"ac%" } `,
+	})
+	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
+}
+
+func TestSelectRange(t *testing.T) {
+	vindex, _ := vindexes.NewTimeRange("", map[string]string{
+		"ranges": "2019-01-01=-80,2020-01-01=80-",
+	})
+	sel := NewRoute(
+		SelectRange,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []sqltypes.PlanValue{{Key: "from"}, {}}
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	bv := map[string]*querypb.BindVariable{"from": sqltypes.StringBindVariable("2019-03-01")}
+	result, err := sel.Execute(vc, bv, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(0000000000005ea6-)`,
+		`ExecuteMultiShard ks.-20: dummy_select {from: type:VARCHAR value:"2019-03-01" } ks.20-: dummy_select {from: type:VARCHAR value:"2019-03-01" } false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	// The upper bound is included in the range.
+	vc.Rewind()
+	sel.Values = []sqltypes.PlanValue{{}, {Value: sqltypes.NewVarChar("2019-02-15")}}
+	result, err = wrapStreamExecute(sel, vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(-0000000000005ea6)`,
+		`StreamExecuteMulti dummy_select ks.-20: {} ks.20-: {} `,
+	})
+	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
+}
+
+func TestSelectEqualMultiColumn(t *testing.T) {
+	vindex, _ := vindexes.NewMultiCol("", map[string]string{
+		"column_count": "2",
+		"column_bytes": "1,7",
+	})
+	sel := NewRoute(
+		SelectEqual,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex
+	sel.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}}
+
+	// The value of the leading column maps to a key range.
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(16-17)`,
+		`ExecuteMultiShard ks.-20: dummy_select {} ks.20-: dummy_select {} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	// The values of all the columns map to a keyspace id.
+	sel.Opcode = SelectEqualUnique
+	sel.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Key: "id"}}
+	vc.Rewind()
+	bv := map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(2)}
+	result, err = wrapStreamExecute(sel, vc, bv, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(1606e7ea22ce9270)`,
+		`StreamExecuteMulti dummy_select ks.-20: {id: type:INT64 value:"2" } `,
+	})
+	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
+}
+
+func TestLikePrefix(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{
+		{in: "acme%", out: "acme"},
+		{in: "ac_me%", out: "ac"},
+		{in: "acme", out: "acme"},
+		{in: "%acme", out: ""},
+		{in: `ac\%me%`, out: "ac%me"},
+		{in: `acme\`, out: `acme\`},
+	}
+	for _, tc := range testcases {
+		if got := likePrefix(tc.in); got != tc.out {
+			t.Errorf("likePrefix(%s): %s, want %s", tc.in, got, tc.out)
+		}
+	}
+}
+
 func TestSelectNext(t *testing.T) {
 	sel := NewRoute(
 		SelectNext,
@@ -0,0 +1,233 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"github.com/xsec-lab/go/sqltypes"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+var _ Primitive = (*SemiJoin)(nil)
+
+// SemiJoin is a primitive that executes a correlated subquery
+// for every row returned by the outer query, and returns the
+// outer rows that satisfy the subquery construct. This is used
+// for EXISTS, NOT EXISTS and IN subqueries that can't be merged
+// into the route of the outer query.
+type SemiJoin struct {
+	Opcode SemiJoinOpcode
+	// Left is the primitive of the outer query, and Right
+	// is the primitive of the correlated subquery.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left results
+	// should be used to build the returned result.
+	Cols []int `json:",omitempty"`
+
+	// Vars defines the list of bind vars that need to
+	// be built from the LHS row before invoking
+	// the RHS subquery.
+	Vars map[string]int `json:",omitempty"`
+
+	// CompareCol is the column of the left results that's
+	// compared against the SubqueryCol of the subquery results
+	// for an InSemiJoin. For text columns, these are the columns
+	// of the corresponding weight_string.
+	CompareCol  int `json:",omitempty"`
+	SubqueryCol int `json:",omitempty"`
+}
+
+// SemiJoinOpcode is a number representing the opcode
+// for the SemiJoin primitive.
+type SemiJoinOpcode int
+
+// This is the list of SemiJoinOpcode values.
+const (
+	// NormalSemiJoin returns the left rows for which the
+	// subquery returns rows: EXISTS (subquery).
+	NormalSemiJoin = SemiJoinOpcode(iota)
+	// AntiSemiJoin returns the left rows for which the
+	// subquery returns no rows: NOT EXISTS (subquery).
+	AntiSemiJoin
+	// InSemiJoin returns the left rows whose CompareCol value
+	// is returned by the subquery: a IN (subquery).
+	InSemiJoin
+)
+
+var semiJoinName = map[SemiJoinOpcode]string{
+	NormalSemiJoin: "SemiJoin",
+	AntiSemiJoin:   "AntiSemiJoin",
+	InSemiJoin:     "InSemiJoin",
+}
+
+func (code SemiJoinOpcode) String() string {
+	return semiJoinName[code]
+}
+
+// MarshalJSON serializes the SemiJoinOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code SemiJoinOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (sj *SemiJoin) RouteType() string {
+	return sj.Opcode.String()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (sj *SemiJoin) GetKeyspaceName() string {
+	if sj.Left.GetKeyspaceName() == sj.Right.GetKeyspaceName() {
+		return sj.Left.GetKeyspaceName()
+	}
+	return sj.Left.GetKeyspaceName() + "_" + sj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (sj *SemiJoin) GetTableName() string {
+	return sj.Left.GetTableName() + "_" + sj.Right.GetTableName()
+}
+
+// Execute performs a non-streaming exec.
+func (sj *SemiJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := sj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	if wantfields {
+		result.Fields = semiJoinFields(lresult.Fields, sj.Cols)
+	}
+	rows, err := sj.filter(vcursor, bindVars, lresult.Rows)
+	if err != nil {
+		return nil, err
+	}
+	result.Rows = rows
+	result.RowsAffected = uint64(len(rows))
+	if len(result.Rows) > vcursor.MaxMemoryRows() {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+// The subquery is executed for every left row as it comes.
+func (sj *SemiJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return sj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		rows, err := sj.filter(vcursor, bindVars, lresult.Rows)
+		if err != nil {
+			return err
+		}
+		if len(lresult.Fields) == 0 && len(rows) == 0 {
+			return nil
+		}
+		return callback(&sqltypes.Result{
+			Fields: semiJoinFields(lresult.Fields, sj.Cols),
+			Rows:   rows,
+		})
+	})
+}
+
+// GetFields fetches the field info.
+func (sj *SemiJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := sj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: semiJoinFields(lresult.Fields, sj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this semi-join
+func (sj *SemiJoin) Inputs() []Primitive {
+	return []Primitive{sj.Left, sj.Right}
+}
+
+// filter executes the subquery for every row and returns
+// the ones that satisfy the construct.
+func (sj *SemiJoin) filter(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lrows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	joinVars := make(map[string]*querypb.BindVariable)
+	for _, lrow := range lrows {
+		if sj.Opcode == InSemiJoin && lrow[sj.CompareCol].IsNull() {
+			// NULL IN (subquery) is never true.
+			continue
+		}
+		for k, col := range sj.Vars {
+			joinVars[k] = sqltypes.ValueBindVariable(lrow[col])
+		}
+		rresult, err := sj.Right.Execute(vcursor, combineVars(bindVars, joinVars), false)
+		if err != nil {
+			return nil, err
+		}
+		var keep bool
+		switch sj.Opcode {
+		case NormalSemiJoin:
+			keep = len(rresult.Rows) != 0
+		case AntiSemiJoin:
+			keep = len(rresult.Rows) == 0
+		case InSemiJoin:
+			keep, err = containsValue(rresult.Rows, sj.SubqueryCol, lrow[sj.CompareCol])
+			if err != nil {
+				return nil, err
+			}
+		}
+		if keep {
+			rows = append(rows, semiJoinRow(lrow, sj.Cols))
+		}
+	}
+	return rows, nil
+}
+
+// containsValue returns true if the column col of any
+// of the rows is equal to val. NULL values never match.
+func containsValue(rows [][]sqltypes.Value, col int, val sqltypes.Value) (bool, error) {
+	for _, row := range rows {
+		if row[col].IsNull() {
+			continue
+		}
+		cmp, err := sqltypes.NullsafeCompare(val, row[col])
+		if err != nil {
+			return false, err
+		}
+		if cmp == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func semiJoinFields(lfields []*querypb.Field, cols []int) []*querypb.Field {
+	if lfields == nil {
+		return nil
+	}
+	fields := make([]*querypb.Field, len(cols))
+	for i, index := range cols {
+		fields[i] = lfields[index]
+	}
+	return fields
+}
+
+func semiJoinRow(lrow []sqltypes.Value, cols []int) []sqltypes.Value {
+	row := make([]sqltypes.Value, len(cols))
+	for i, index := range cols {
+		row[i] = lrow[index]
+	}
+	return row
+}
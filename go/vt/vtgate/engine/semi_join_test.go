@@ -0,0 +1,280 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xsec-lab/go/sqltypes"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+func newSemiJoinPrims() (leftPrim, rightPrim *fakePrimitive) {
+	leftPrim = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|col|user_id",
+					"int64|varbinary|int64",
+				),
+				"1|a|10",
+				"2|b|20",
+				"3|null|30",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col",
+		"varbinary",
+	)
+	rightPrim = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"a",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"null",
+				"c",
+			),
+		},
+	}
+	return leftPrim, rightPrim
+}
+
+func TestSemiJoinExecute(t *testing.T) {
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+	wantFields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|varbinary",
+	)
+	leftPrim, rightPrim := newSemiJoinPrims()
+	sj := &SemiJoin{
+		Opcode: NormalSemiJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0, 1},
+		Vars: map[string]int{
+			"user_id": 2,
+		},
+	}
+	r, err := sj.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" user_id: type:INT64 value:"10"  false`,
+		`Execute a: type:INT64 value:"10" user_id: type:INT64 value:"20"  false`,
+		`Execute a: type:INT64 value:"10" user_id: type:INT64 value:"30"  false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"1|a",
+		"3|null",
+	))
+
+	// Anti semi-join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = AntiSemiJoin
+	r, err = sj.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"2|b",
+	))
+
+	// In semi-join: the NULL value of the last row
+	// is skipped without executing the subquery.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = InSemiJoin
+	sj.CompareCol = 1
+	r, err = sj.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" user_id: type:INT64 value:"10"  false`,
+		`Execute a: type:INT64 value:"10" user_id: type:INT64 value:"20"  false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"1|a",
+	))
+}
+
+func TestSemiJoinExecuteNoResult(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|user_id",
+					"int64|int64",
+				),
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{}
+	sj := &SemiJoin{
+		Opcode: NormalSemiJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0},
+		Vars: map[string]int{
+			"user_id": 1,
+		},
+	}
+	r, err := sj.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, nil)
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+	))
+}
+
+func TestSemiJoinStreamExecute(t *testing.T) {
+	leftPrim, rightPrim := newSemiJoinPrims()
+	sj := &SemiJoin{
+		Opcode: NormalSemiJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0, 1},
+		Vars: map[string]int{
+			"user_id": 2,
+		},
+	}
+	r, err := wrapStreamExecute(sj, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute user_id: type:INT64 value:"10"  false`,
+		`Execute user_id: type:INT64 value:"20"  false`,
+		`Execute user_id: type:INT64 value:"30"  false`,
+	})
+	expectResult(t, "sj.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|col",
+			"int64|varbinary",
+		),
+		"1|a",
+		"3|null",
+	))
+}
+
+func TestSemiJoinGetFields(t *testing.T) {
+	leftPrim, rightPrim := newSemiJoinPrims()
+	sj := &SemiJoin{
+		Opcode: AntiSemiJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{1},
+		Vars: map[string]int{
+			"user_id": 2,
+		},
+	}
+	r, err := sj.GetFields(noopVCursor{}, map[string]*querypb.BindVariable{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, nil)
+	expectResult(t, "sj.GetFields", r, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields(
+			"col",
+			"varbinary",
+		),
+	})
+}
+
+func TestSemiJoinErrors(t *testing.T) {
+	leftPrim, _ := newSemiJoinPrims()
+	sj := &SemiJoin{
+		Opcode: NormalSemiJoin,
+		Left:   leftPrim,
+		Right:  &fakePrimitive{sendErr: errors.New("right err")},
+		Cols:   []int{0},
+	}
+	_, err := sj.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	assert.EqualError(t, err, "right err")
+
+	leftPrim.rewind()
+	_, err = wrapStreamExecute(sj, noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	assert.EqualError(t, err, "right err")
+
+	sj.Left = &fakePrimitive{sendErr: errors.New("left err")}
+	_, err = sj.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	assert.EqualError(t, err, "left err")
+
+	_, err = sj.GetFields(noopVCursor{}, map[string]*querypb.BindVariable{})
+	assert.EqualError(t, err, "left err")
+}
+
+func TestSemiJoinInUncomparable(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col",
+					"varchar",
+				),
+				"a",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col",
+					"varchar",
+				),
+				"a",
+			),
+		},
+	}
+	sj := &SemiJoin{
+		Opcode: InSemiJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0},
+	}
+	_, err := sj.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	assert.EqualError(t, err, "types are not comparable: VARCHAR vs VARCHAR")
+}
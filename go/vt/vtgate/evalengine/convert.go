@@ -0,0 +1,303 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/sqlparser"
+)
+
+// ColumnResolver returns the offset of the input column that
+// provides the value of expr. ok is false if expr is not provided
+// by an input column, in which case it gets converted as usual.
+type ColumnResolver func(expr sqlparser.Expr) (offset int, ok bool, err error)
+
+// Convert converts a sqlparser expression into an Expr that can be
+// evaluated by vtgate. resolve is invoked for every node of the
+// expression before it's converted, which allows the caller to
+// replace columns and aggregate functions by the values of its input.
+// Column names that are not resolved produce an error.
+func Convert(expr sqlparser.Expr, resolve ColumnResolver) (Expr, error) {
+	offset, ok, err := resolve(expr)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return &Column{Offset: offset}, nil
+	}
+	switch node := expr.(type) {
+	case *sqlparser.SQLVal:
+		return convertSQLVal(node)
+	case *sqlparser.NullVal:
+		return &Literal{Val: sqltypes.NULL}, nil
+	case sqlparser.BoolVal:
+		return &Literal{Val: boolValue(bool(node))}, nil
+	case *sqlparser.ParenExpr:
+		return Convert(node.Expr, resolve)
+	case *sqlparser.BinaryExpr:
+		op, ok := arithmeticOps[node.Operator]
+		if !ok {
+			break
+		}
+		left, right, err := convertPair(node.Left, node.Right, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &ArithmeticExpr{Op: op, Left: left, Right: right}, nil
+	case *sqlparser.UnaryExpr:
+		val, err := Convert(node.Expr, resolve)
+		if err != nil {
+			return nil, err
+		}
+		switch node.Operator {
+		case sqlparser.UPlusStr:
+			return val, nil
+		case sqlparser.UMinusStr:
+			return &ArithmeticExpr{Op: Subtract, Left: &Literal{Val: sqltypes.NewInt64(0)}, Right: val}, nil
+		}
+	case *sqlparser.ComparisonExpr:
+		return convertComparison(node, resolve)
+	case *sqlparser.RangeCond:
+		return convertRange(node, resolve)
+	case *sqlparser.AndExpr:
+		left, right, err := convertPair(node.Left, node.Right, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &LogicalExpr{Op: And, Left: left, Right: right}, nil
+	case *sqlparser.OrExpr:
+		left, right, err := convertPair(node.Left, node.Right, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &LogicalExpr{Op: Or, Left: left, Right: right}, nil
+	case *sqlparser.NotExpr:
+		val, err := Convert(node.Expr, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{Expr: val}, nil
+	case *sqlparser.IsExpr:
+		op, ok := isOps[node.Operator]
+		if !ok {
+			break
+		}
+		val, err := Convert(node.Expr, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &IsExpr{Op: op, Expr: val}, nil
+	case *sqlparser.CaseExpr:
+		return convertCase(node, resolve)
+	case *sqlparser.FuncExpr:
+		return convertFunc(node, resolve)
+	case *sqlparser.SubstrExpr:
+		var str sqlparser.Expr = node.Name
+		if node.StrVal != nil {
+			str = node.StrVal
+		}
+		argExprs := []sqlparser.Expr{str, node.From}
+		if node.To != nil {
+			argExprs = append(argExprs, node.To)
+		}
+		args, err := convertList(argExprs, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return NewFuncExpr("substring", args)
+	case *sqlparser.ColName:
+		return nil, fmt.Errorf("unsupported: column %s cannot be evaluated", sqlparser.String(node))
+	}
+	return nil, fmt.Errorf("unsupported: expression %s cannot be evaluated", sqlparser.String(expr))
+}
+
+var arithmeticOps = map[string]ArithmeticOp{
+	sqlparser.PlusStr:  Add,
+	sqlparser.MinusStr: Subtract,
+	sqlparser.MultStr:  Multiply,
+	sqlparser.DivStr:   Divide,
+}
+
+var comparisonOps = map[string]ComparisonOp{
+	sqlparser.EqualStr:         Equal,
+	sqlparser.NotEqualStr:      NotEqual,
+	sqlparser.LessThanStr:      LessThan,
+	sqlparser.LessEqualStr:     LessEqual,
+	sqlparser.GreaterThanStr:   GreaterThan,
+	sqlparser.GreaterEqualStr:  GreaterEqual,
+	sqlparser.NullSafeEqualStr: NullSafeEqual,
+}
+
+var isOps = map[string]IsOp{
+	sqlparser.IsNullStr:     IsNull,
+	sqlparser.IsNotNullStr:  IsNotNull,
+	sqlparser.IsTrueStr:     IsTrue,
+	sqlparser.IsNotTrueStr:  IsNotTrue,
+	sqlparser.IsFalseStr:    IsFalse,
+	sqlparser.IsNotFalseStr: IsNotFalse,
+}
+
+func convertSQLVal(node *sqlparser.SQLVal) (Expr, error) {
+	switch node.Type {
+	case sqlparser.StrVal:
+		return &Literal{Val: sqltypes.MakeTrusted(sqltypes.VarBinary, node.Val)}, nil
+	case sqlparser.IntVal:
+		val, err := sqltypes.NewIntegral(string(node.Val))
+		if err != nil {
+			return nil, err
+		}
+		return &Literal{Val: val}, nil
+	case sqlparser.FloatVal:
+		f, err := strconv.ParseFloat(string(node.Val), 64)
+		if err != nil {
+			return nil, err
+		}
+		return &Literal{Val: sqltypes.NewFloat64(f)}, nil
+	case sqlparser.HexVal:
+		b, err := node.HexDecode()
+		if err != nil {
+			return nil, err
+		}
+		return &Literal{Val: sqltypes.MakeTrusted(sqltypes.VarBinary, b)}, nil
+	case sqlparser.ValArg:
+		return &BindVariable{Key: string(node.Val[1:])}, nil
+	}
+	return nil, fmt.Errorf("unsupported: value %s cannot be evaluated", sqlparser.String(node))
+}
+
+func convertComparison(node *sqlparser.ComparisonExpr, resolve ColumnResolver) (Expr, error) {
+	switch node.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := node.Right.(sqlparser.ValTuple)
+		if !ok {
+			break
+		}
+		left, err := Convert(node.Left, resolve)
+		if err != nil {
+			return nil, err
+		}
+		values, err := convertList(tuple, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &InExpr{Left: left, Values: values, Not: node.Operator == sqlparser.NotInStr}, nil
+	default:
+		op, ok := comparisonOps[node.Operator]
+		if !ok {
+			break
+		}
+		left, right, err := convertPair(node.Left, node.Right, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &ComparisonExpr{Op: op, Left: left, Right: right}, nil
+	}
+	return nil, fmt.Errorf("unsupported: expression %s cannot be evaluated", sqlparser.String(node))
+}
+
+// convertRange converts a BETWEEN into a pair of comparisons.
+func convertRange(node *sqlparser.RangeCond, resolve ColumnResolver) (Expr, error) {
+	left, err := Convert(node.Left, resolve)
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := convertPair(node.From, node.To, resolve)
+	if err != nil {
+		return nil, err
+	}
+	if node.Operator == sqlparser.NotBetweenStr {
+		return &LogicalExpr{
+			Op:    Or,
+			Left:  &ComparisonExpr{Op: LessThan, Left: left, Right: from},
+			Right: &ComparisonExpr{Op: GreaterThan, Left: left, Right: to},
+		}, nil
+	}
+	return &LogicalExpr{
+		Op:    And,
+		Left:  &ComparisonExpr{Op: GreaterEqual, Left: left, Right: from},
+		Right: &ComparisonExpr{Op: LessEqual, Left: left, Right: to},
+	}, nil
+}
+
+func convertCase(node *sqlparser.CaseExpr, resolve ColumnResolver) (Expr, error) {
+	result := &CaseExpr{}
+	var err error
+	if node.Expr != nil {
+		if result.Expr, err = Convert(node.Expr, resolve); err != nil {
+			return nil, err
+		}
+	}
+	for _, when := range node.Whens {
+		cond, val, err := convertPair(when.Cond, when.Val, resolve)
+		if err != nil {
+			return nil, err
+		}
+		result.Whens = append(result.Whens, When{Cond: cond, Val: val})
+	}
+	if node.Else != nil {
+		if result.Else, err = Convert(node.Else, resolve); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func convertFunc(node *sqlparser.FuncExpr, resolve ColumnResolver) (Expr, error) {
+	if !node.Qualifier.IsEmpty() || node.Distinct || node.Over != nil || node.IsAggregate() {
+		return nil, fmt.Errorf("unsupported: function %s cannot be evaluated", sqlparser.String(node))
+	}
+	argExprs := make([]sqlparser.Expr, 0, len(node.Exprs))
+	for _, selExpr := range node.Exprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: function %s cannot be evaluated", sqlparser.String(node))
+		}
+		argExprs = append(argExprs, aliased.Expr)
+	}
+	args, err := convertList(argExprs, resolve)
+	if err != nil {
+		return nil, err
+	}
+	return NewFuncExpr(node.Name.Lowered(), args)
+}
+
+func convertPair(left, right sqlparser.Expr, resolve ColumnResolver) (Expr, Expr, error) {
+	lexpr, err := Convert(left, resolve)
+	if err != nil {
+		return nil, nil, err
+	}
+	rexpr, err := Convert(right, resolve)
+	if err != nil {
+		return nil, nil, err
+	}
+	return lexpr, rexpr, nil
+}
+
+func convertList(exprs []sqlparser.Expr, resolve ColumnResolver) ([]Expr, error) {
+	result := make([]Expr, 0, len(exprs))
+	for _, expr := range exprs {
+		val, err := Convert(expr, resolve)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, val)
+	}
+	return result, nil
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/sqlparser"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+// testColumns are the columns of testRow. They're
+// resolved by name by testResolver.
+var testColumns = []string{"a", "b", "c", "d", "t"}
+
+var testRow = []sqltypes.Value{
+	sqltypes.NewInt64(1),
+	sqltypes.NewVarBinary("abc"),
+	sqltypes.NULL,
+	sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2019-03-07 10:11:12")),
+	sqltypes.NewVarChar(" Hello "),
+}
+
+func testResolver(expr sqlparser.Expr) (int, bool, error) {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return 0, false, nil
+	}
+	for i, name := range testColumns {
+		if col.Name.EqualString(name) {
+			return i, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func convertTestExpr(t *testing.T, in string) (Expr, error) {
+	t.Helper()
+	stmt, err := sqlparser.Parse("select " + in + " from dual")
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", in, err)
+	}
+	expr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+	return Convert(expr, testResolver)
+}
+
+func TestConvertEvaluate(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{
+		// Literals and columns.
+		{"1", "INT64(1)"},
+		{"18446744073709551615", "UINT64(18446744073709551615)"},
+		{"1.5", "FLOAT64(1.5)"},
+		{"'abc'", `VARBINARY("abc")`},
+		{"null", "NULL"},
+		{"true", "INT64(1)"},
+		{":v", "INT64(10)"},
+		{"b", `VARBINARY("abc")`},
+		{"(a)", "INT64(1)"},
+
+		// Arithmetic.
+		{"a + 2", "INT64(3)"},
+		{"a - 3", "INT64(-2)"},
+		{"-a", "INT64(-1)"},
+		{"a * 2.5", "FLOAT64(2.5)"},
+		{"a / 4", "FLOAT64(0.25)"},
+		{"a / 0", "NULL"},
+		{"a + c", "NULL"},
+		{"(a + 1) * :v", "INT64(20)"},
+
+		// Comparisons and logic.
+		{"a = 1", "INT64(1)"},
+		{"a != 1", "INT64(0)"},
+		{"a < :v", "INT64(1)"},
+		{"a >= 2", "INT64(0)"},
+		{"a = c", "NULL"},
+		{"c <=> null", "INT64(1)"},
+		{"a in (3, 1)", "INT64(1)"},
+		{"a in (3, null)", "NULL"},
+		{"a not in (3, 4)", "INT64(1)"},
+		{"a between 0 and 1", "INT64(1)"},
+		{"a not between 0 and 1", "INT64(0)"},
+		{"a = 1 and c = 1", "NULL"},
+		{"a = 2 and c = 1", "INT64(0)"},
+		{"a = 1 or c = 1", "INT64(1)"},
+		{"not a", "INT64(0)"},
+		{"not c", "NULL"},
+		{"c is null", "INT64(1)"},
+		{"a is not null", "INT64(1)"},
+		{"a is true", "INT64(1)"},
+		{"c is not false", "INT64(1)"},
+
+		// Control flow.
+		{"case a when 1 then 'one' when 2 then 'two' end", `VARBINARY("one")`},
+		{"case a when 3 then 'three' end", "NULL"},
+		{"case when a > 1 then 'big' else 'small' end", `VARBINARY("small")`},
+		{"if(a = 1, b, 'x')", `VARBINARY("abc")`},
+		{"if(c, b, 'x')", `VARBINARY("x")`},
+		{"ifnull(c, a)", "INT64(1)"},
+		{"nullif(a, 1)", "NULL"},
+		{"nullif(a, 2)", "INT64(1)"},
+		{"coalesce(c, null, b)", `VARBINARY("abc")`},
+
+		// Strings.
+		{"concat(b, '-', a)", `VARBINARY("abc-1")`},
+		{"concat(b, c)", "NULL"},
+		{"concat(t, b)", `VARCHAR(" Hello abc")`},
+		{"concat_ws(',', b, c, a)", `VARBINARY("abc,1")`},
+		{"upper(b)", `VARBINARY("ABC")`},
+		{"lcase(t)", `VARCHAR(" hello ")`},
+		{"length(t)", "INT64(7)"},
+		{"char_length('héllo')", "INT64(5)"},
+		{"length('héllo')", "INT64(6)"},
+		{"trim(t)", `VARCHAR("Hello")`},
+		{"ltrim(t)", `VARCHAR("Hello ")`},
+		{"rtrim(t)", `VARCHAR(" Hello")`},
+		{"left(b, 2)", `VARBINARY("ab")`},
+		{"right(b, 5)", `VARBINARY("abc")`},
+		{"replace(b, 'b', 'xx')", `VARBINARY("axxc")`},
+		{"substring(b, 2)", `VARBINARY("bc")`},
+		{"substr(b, -2, 1)", `VARBINARY("b")`},
+		{"substring(b from 2 for 1)", `VARBINARY("b")`},
+		{"substring('abcdef', 0)", `VARBINARY("")`},
+		{"mid(b, 5, 1)", `VARBINARY("")`},
+
+		// Numbers.
+		{"abs(-5)", "INT64(5)"},
+		{"abs(-1.5)", "FLOAT64(1.5)"},
+		{"greatest(a, 3, 2)", "INT64(3)"},
+		{"least(a, 3, 2)", "INT64(1)"},
+		{"least(a, c)", "NULL"},
+
+		// Dates.
+		{"date(d)", `DATE("2019-03-07")`},
+		{"year(d)", "INT64(2019)"},
+		{"month(d)", "INT64(3)"},
+		{"day(d)", "INT64(7)"},
+		{"dayofweek(d)", "INT64(5)"},
+		{"hour(d)", "INT64(10)"},
+		{"minute(d)", "INT64(11)"},
+		{"second(d)", "INT64(12)"},
+		{"hour('838:59:59')", "INT64(838)"},
+		{"datediff(d, '2019-02-28')", "INT64(7)"},
+		{"year('invalid')", "NULL"},
+	}
+	env := ExpressionEnv{
+		BindVars: map[string]*querypb.BindVariable{
+			"v": sqltypes.Int64BindVariable(10),
+		},
+		Row: testRow,
+	}
+	for _, tc := range testcases {
+		expr, err := convertTestExpr(t, tc.in)
+		if err != nil {
+			t.Errorf("Convert(%s): %v", tc.in, err)
+			continue
+		}
+		got, err := expr.Evaluate(env)
+		if err != nil {
+			t.Errorf("Evaluate(%s): %v", tc.in, err)
+			continue
+		}
+		if got.String() != tc.out {
+			t.Errorf("Evaluate(%s): %s, want %s", tc.in, got.String(), tc.out)
+		}
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	testcases := []struct {
+		in, err string
+	}{
+		{"e", "unsupported: column e cannot be evaluated"},
+		{"a like 'x%'", "unsupported: expression a like 'x%' cannot be evaluated"},
+		{"a in (select 1 from dual)", "unsupported: expression a in (select 1 from dual) cannot be evaluated"},
+		{"a % 2", "unsupported: expression a % 2 cannot be evaluated"},
+		{"count(a)", "unsupported: function count(a) cannot be evaluated"},
+		{"now()", "unsupported: function now"},
+		{"concat()", "incorrect parameter count in the call to concat"},
+		{"if(a, 1)", "incorrect parameter count in the call to if"},
+	}
+	for _, tc := range testcases {
+		_, err := convertTestExpr(t, tc.in)
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("Convert(%s): %v, want %s", tc.in, err, tc.err)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	testcases := []struct {
+		in, err string
+	}{
+		{":missing", "missing bind var missing"},
+		{"t < 'a'", "types are not comparable: VARCHAR vs VARBINARY"},
+		{"abs(-9223372036854775808)", "BIGINT value is out of range in abs(-9223372036854775808)"},
+	}
+	for _, tc := range testcases {
+		expr, err := convertTestExpr(t, tc.in)
+		if err != nil {
+			t.Errorf("Convert(%s): %v", tc.in, err)
+			continue
+		}
+		_, err = expr.Evaluate(ExpressionEnv{Row: testRow})
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("Evaluate(%s): %v, want %s", tc.in, err, tc.err)
+		}
+	}
+}
@@ -0,0 +1,698 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package evalengine evaluates SQL expressions in vtgate. It's used
+// to compute the expressions of a query that can't be pushed down to
+// the tablets, because their inputs come from different routes, or
+// are the results of aggregations performed by vtgate. The vstreamer
+// also uses it to evaluate the expressions of its filters.
+package evalengine
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/vterrors"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+	vtrpcpb "github.com/xsec-lab/go/vt/proto/vtrpc"
+)
+
+// ExpressionEnv is the environment in which an expression
+// gets evaluated: the bind variables of the query, and the
+// row whose columns the expression references.
+type ExpressionEnv struct {
+	BindVars map[string]*querypb.BindVariable
+	Row      []sqltypes.Value
+}
+
+// Expr is an expression that can be evaluated by vtgate.
+type Expr interface {
+	// Evaluate returns the value of the expression.
+	Evaluate(env ExpressionEnv) (sqltypes.Value, error)
+
+	// Type returns the type of the values returned by the
+	// expression, given the fields of the input rows.
+	Type(fields []*querypb.Field) querypb.Type
+
+	// String returns a SQL-like representation of the expression.
+	// It's used for testing and diagnostics.
+	String() string
+}
+
+var (
+	_ Expr = (*Literal)(nil)
+	_ Expr = (*BindVariable)(nil)
+	_ Expr = (*Column)(nil)
+	_ Expr = (*ArithmeticExpr)(nil)
+	_ Expr = (*ComparisonExpr)(nil)
+	_ Expr = (*InExpr)(nil)
+	_ Expr = (*LogicalExpr)(nil)
+	_ Expr = (*NotExpr)(nil)
+	_ Expr = (*IsExpr)(nil)
+	_ Expr = (*CaseExpr)(nil)
+)
+
+// Literal is a constant value.
+type Literal struct {
+	Val sqltypes.Value
+}
+
+// Evaluate satisfies the Expr interface.
+func (l *Literal) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	return l.Val, nil
+}
+
+// Type satisfies the Expr interface.
+func (l *Literal) Type(fields []*querypb.Field) querypb.Type {
+	return l.Val.Type()
+}
+
+// String satisfies the Expr interface.
+func (l *Literal) String() string {
+	buf := &bytes.Buffer{}
+	l.Val.EncodeSQL(buf)
+	return buf.String()
+}
+
+// BindVariable is a reference to a bind variable of the query.
+type BindVariable struct {
+	Key string
+}
+
+// Evaluate satisfies the Expr interface.
+func (b *BindVariable) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	bv, ok := env.BindVars[b.Key]
+	if !ok {
+		return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "missing bind var %s", b.Key)
+	}
+	return sqltypes.BindVariableToValue(bv)
+}
+
+// Type satisfies the Expr interface. The type of a bind
+// variable is only known at execution time. VARBINARY is
+// returned because it can represent any value.
+func (b *BindVariable) Type(fields []*querypb.Field) querypb.Type {
+	return sqltypes.VarBinary
+}
+
+// String satisfies the Expr interface.
+func (b *BindVariable) String() string {
+	return ":" + b.Key
+}
+
+// Column is a reference to a column of the input row.
+type Column struct {
+	Offset int
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *Column) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	if c.Offset >= len(env.Row) {
+		return sqltypes.NULL, fmt.Errorf("column %d is out of range for a row of %d columns", c.Offset, len(env.Row))
+	}
+	return env.Row[c.Offset], nil
+}
+
+// Type satisfies the Expr interface.
+func (c *Column) Type(fields []*querypb.Field) querypb.Type {
+	if c.Offset >= len(fields) {
+		return sqltypes.Null
+	}
+	return fields[c.Offset].Type
+}
+
+// String satisfies the Expr interface.
+func (c *Column) String() string {
+	return fmt.Sprintf("[COLUMN %d]", c.Offset)
+}
+
+// ArithmeticOp is an arithmetic operator.
+type ArithmeticOp int
+
+// This is the list of ArithmeticOp values.
+const (
+	Add = ArithmeticOp(iota)
+	Subtract
+	Multiply
+	Divide
+)
+
+var arithmeticName = map[ArithmeticOp]string{
+	Add:      "+",
+	Subtract: "-",
+	Multiply: "*",
+	Divide:   "/",
+}
+
+// ArithmeticExpr is an arithmetic operation on two numbers.
+// It's performed by the functions of sqltypes/arithmetic.go.
+type ArithmeticExpr struct {
+	Op          ArithmeticOp
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (a *ArithmeticExpr) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lval, err := a.Left.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	rval, err := a.Right.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch a.Op {
+	case Add:
+		return sqltypes.Add(lval, rval)
+	case Subtract:
+		return sqltypes.Subtract(lval, rval)
+	case Multiply:
+		return sqltypes.Multiply(lval, rval)
+	case Divide:
+		return sqltypes.Divide(lval, rval)
+	}
+	return sqltypes.NULL, fmt.Errorf("unexpected arithmetic operator: %d", a.Op)
+}
+
+// Type satisfies the Expr interface.
+// A division always returns a FLOAT64. The other operations
+// return a FLOAT64 if any operand is not integral, and an
+// UINT64 if both operands are unsigned.
+func (a *ArithmeticExpr) Type(fields []*querypb.Field) querypb.Type {
+	ltype, rtype := a.Left.Type(fields), a.Right.Type(fields)
+	switch {
+	case a.Op == Divide:
+		return sqltypes.Float64
+	case !sqltypes.IsIntegral(ltype) || !sqltypes.IsIntegral(rtype):
+		return sqltypes.Float64
+	case sqltypes.IsUnsigned(ltype) && sqltypes.IsUnsigned(rtype):
+		return sqltypes.Uint64
+	}
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (a *ArithmeticExpr) String() string {
+	return fmt.Sprintf("%s %s %s", formatOperand(a.Left), arithmeticName[a.Op], formatOperand(a.Right))
+}
+
+// ComparisonOp is a comparison operator.
+type ComparisonOp int
+
+// This is the list of ComparisonOp values.
+const (
+	Equal = ComparisonOp(iota)
+	NotEqual
+	LessThan
+	LessEqual
+	GreaterThan
+	GreaterEqual
+	NullSafeEqual
+)
+
+var comparisonName = map[ComparisonOp]string{
+	Equal:         "=",
+	NotEqual:      "!=",
+	LessThan:      "<",
+	LessEqual:     "<=",
+	GreaterThan:   ">",
+	GreaterEqual:  ">=",
+	NullSafeEqual: "<=>",
+}
+
+// ComparisonExpr compares two values with sqltypes.NullsafeCompare.
+// The result is 1 or 0, or NULL if any of the values is NULL,
+// except for the null-safe equality.
+// Text values can't be compared because their collation
+// is not known to vtgate.
+type ComparisonExpr struct {
+	Op          ComparisonOp
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *ComparisonExpr) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lval, err := c.Left.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	rval, err := c.Right.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if c.Op != NullSafeEqual && (lval.IsNull() || rval.IsNull()) {
+		return sqltypes.NULL, nil
+	}
+	cmp, err := sqltypes.NullsafeCompare(lval, rval)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	var result bool
+	switch c.Op {
+	case Equal, NullSafeEqual:
+		result = cmp == 0
+	case NotEqual:
+		result = cmp != 0
+	case LessThan:
+		result = cmp < 0
+	case LessEqual:
+		result = cmp <= 0
+	case GreaterThan:
+		result = cmp > 0
+	case GreaterEqual:
+		result = cmp >= 0
+	default:
+		return sqltypes.NULL, fmt.Errorf("unexpected comparison operator: %d", c.Op)
+	}
+	return boolValue(result), nil
+}
+
+// Type satisfies the Expr interface.
+func (c *ComparisonExpr) Type(fields []*querypb.Field) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (c *ComparisonExpr) String() string {
+	return fmt.Sprintf("%s %s %s", formatOperand(c.Left), comparisonName[c.Op], formatOperand(c.Right))
+}
+
+// InExpr checks if a value is in a list of values.
+// If the value is not found, the result is NULL if
+// the value or one of the list values is NULL.
+type InExpr struct {
+	Left   Expr
+	Values []Expr
+	Not    bool
+}
+
+// Evaluate satisfies the Expr interface.
+func (in *InExpr) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lval, err := in.Left.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if lval.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	hasNull := false
+	for _, expr := range in.Values {
+		val, err := expr.Evaluate(env)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if val.IsNull() {
+			hasNull = true
+			continue
+		}
+		cmp, err := sqltypes.NullsafeCompare(lval, val)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if cmp == 0 {
+			return boolValue(!in.Not), nil
+		}
+	}
+	if hasNull {
+		return sqltypes.NULL, nil
+	}
+	return boolValue(in.Not), nil
+}
+
+// Type satisfies the Expr interface.
+func (in *InExpr) Type(fields []*querypb.Field) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (in *InExpr) String() string {
+	op := "in"
+	if in.Not {
+		op = "not in"
+	}
+	return fmt.Sprintf("%s %s (%s)", formatOperand(in.Left), op, formatList(in.Values))
+}
+
+// LogicalOp is a logical operator.
+type LogicalOp int
+
+// This is the list of LogicalOp values.
+const (
+	And = LogicalOp(iota)
+	Or
+)
+
+var logicalName = map[LogicalOp]string{
+	And: "and",
+	Or:  "or",
+}
+
+// LogicalExpr is an AND or OR of two conditions,
+// with the three-valued logic of SQL.
+type LogicalExpr struct {
+	Op          LogicalOp
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (l *LogicalExpr) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lbool, err := evaluateBool(l.Left, env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	// Short-circuit if the result is already known.
+	if (l.Op == And && lbool == boolFalse) || (l.Op == Or && lbool == boolTrue) {
+		return lbool.value(), nil
+	}
+	rbool, err := evaluateBool(l.Right, env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch {
+	case l.Op == And && rbool == boolFalse:
+		return boolFalse.value(), nil
+	case l.Op == Or && rbool == boolTrue:
+		return boolTrue.value(), nil
+	case lbool == boolNull || rbool == boolNull:
+		return sqltypes.NULL, nil
+	}
+	return rbool.value(), nil
+}
+
+// Type satisfies the Expr interface.
+func (l *LogicalExpr) Type(fields []*querypb.Field) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (l *LogicalExpr) String() string {
+	return fmt.Sprintf("%s %s %s", formatOperand(l.Left), logicalName[l.Op], formatOperand(l.Right))
+}
+
+// NotExpr negates a condition. NOT NULL is NULL.
+type NotExpr struct {
+	Expr Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (n *NotExpr) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	b, err := evaluateBool(n.Expr, env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch b {
+	case boolTrue:
+		return boolFalse.value(), nil
+	case boolFalse:
+		return boolTrue.value(), nil
+	}
+	return sqltypes.NULL, nil
+}
+
+// Type satisfies the Expr interface.
+func (n *NotExpr) Type(fields []*querypb.Field) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (n *NotExpr) String() string {
+	return "not " + formatOperand(n.Expr)
+}
+
+// IsOp is the operator of an IsExpr.
+type IsOp int
+
+// This is the list of IsOp values.
+const (
+	IsNull = IsOp(iota)
+	IsNotNull
+	IsTrue
+	IsNotTrue
+	IsFalse
+	IsNotFalse
+)
+
+var isName = map[IsOp]string{
+	IsNull:     "is null",
+	IsNotNull:  "is not null",
+	IsTrue:     "is true",
+	IsNotTrue:  "is not true",
+	IsFalse:    "is false",
+	IsNotFalse: "is not false",
+}
+
+// IsExpr is an IS [NOT] NULL, IS [NOT] TRUE or IS [NOT] FALSE
+// test. Its result is never NULL.
+type IsExpr struct {
+	Op   IsOp
+	Expr Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (is *IsExpr) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	b, err := evaluateBool(is.Expr, env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	var result bool
+	switch is.Op {
+	case IsNull:
+		result = b == boolNull
+	case IsNotNull:
+		result = b != boolNull
+	case IsTrue:
+		result = b == boolTrue
+	case IsNotTrue:
+		result = b != boolTrue
+	case IsFalse:
+		result = b == boolFalse
+	case IsNotFalse:
+		result = b != boolFalse
+	default:
+		return sqltypes.NULL, fmt.Errorf("unexpected is operator: %d", is.Op)
+	}
+	return boolValue(result), nil
+}
+
+// Type satisfies the Expr interface.
+func (is *IsExpr) Type(fields []*querypb.Field) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (is *IsExpr) String() string {
+	return fmt.Sprintf("%s %s", formatOperand(is.Expr), isName[is.Op])
+}
+
+// When is a WHEN clause of a CaseExpr.
+type When struct {
+	Cond, Val Expr
+}
+
+// CaseExpr is a CASE expression. If Expr is set, the WHEN
+// values are compared with it. Otherwise, the WHEN values
+// are conditions. If nothing matches, the result is Else,
+// or NULL if Else is not set.
+type CaseExpr struct {
+	Expr  Expr
+	Whens []When
+	Else  Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *CaseExpr) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	var val sqltypes.Value
+	if c.Expr != nil {
+		var err error
+		if val, err = c.Expr.Evaluate(env); err != nil {
+			return sqltypes.NULL, err
+		}
+	}
+	for _, when := range c.Whens {
+		var matched bool
+		if c.Expr != nil {
+			cond, err := when.Cond.Evaluate(env)
+			if err != nil {
+				return sqltypes.NULL, err
+			}
+			if !val.IsNull() && !cond.IsNull() {
+				cmp, err := sqltypes.NullsafeCompare(val, cond)
+				if err != nil {
+					return sqltypes.NULL, err
+				}
+				matched = cmp == 0
+			}
+		} else {
+			b, err := evaluateBool(when.Cond, env)
+			if err != nil {
+				return sqltypes.NULL, err
+			}
+			matched = b == boolTrue
+		}
+		if matched {
+			return when.Val.Evaluate(env)
+		}
+	}
+	if c.Else == nil {
+		return sqltypes.NULL, nil
+	}
+	return c.Else.Evaluate(env)
+}
+
+// Type satisfies the Expr interface.
+func (c *CaseExpr) Type(fields []*querypb.Field) querypb.Type {
+	types := make([]querypb.Type, 0, len(c.Whens)+1)
+	for _, when := range c.Whens {
+		types = append(types, when.Val.Type(fields))
+	}
+	if c.Else != nil {
+		types = append(types, c.Else.Type(fields))
+	}
+	return mergeTypes(types)
+}
+
+// String satisfies the Expr interface.
+func (c *CaseExpr) String() string {
+	buf := &strings.Builder{}
+	buf.WriteString("case")
+	if c.Expr != nil {
+		fmt.Fprintf(buf, " %s", c.Expr)
+	}
+	for _, when := range c.Whens {
+		fmt.Fprintf(buf, " when %s then %s", when.Cond, when.Val)
+	}
+	if c.Else != nil {
+		fmt.Fprintf(buf, " else %s", c.Else)
+	}
+	buf.WriteString(" end")
+	return buf.String()
+}
+
+// boolean is the result of a condition.
+type boolean int
+
+const (
+	boolFalse = boolean(iota)
+	boolTrue
+	boolNull
+)
+
+func (b boolean) value() sqltypes.Value {
+	switch b {
+	case boolTrue:
+		return boolValue(true)
+	case boolFalse:
+		return boolValue(false)
+	}
+	return sqltypes.NULL
+}
+
+func boolValue(b bool) sqltypes.Value {
+	if b {
+		return sqltypes.NewInt64(1)
+	}
+	return sqltypes.NewInt64(0)
+}
+
+// evaluateBool evaluates expr as a condition. Numbers are true
+// if they're not zero. Other values are converted to numbers,
+// which means that non-numeric strings are false.
+func evaluateBool(expr Expr, env ExpressionEnv) (boolean, error) {
+	val, err := expr.Evaluate(env)
+	if err != nil {
+		return boolNull, err
+	}
+	return valueBool(val)
+}
+
+// EvaluateBool evaluates expr as a condition. It returns true
+// only if the condition is true: false and NULL both return false.
+func EvaluateBool(expr Expr, env ExpressionEnv) (bool, error) {
+	b, err := evaluateBool(expr, env)
+	if err != nil {
+		return false, err
+	}
+	return b == boolTrue, nil
+}
+
+func valueBool(val sqltypes.Value) (boolean, error) {
+	if val.IsNull() {
+		return boolNull, nil
+	}
+	f, err := sqltypes.ToFloat64(val)
+	if err != nil {
+		return boolNull, err
+	}
+	if f != 0 {
+		return boolTrue, nil
+	}
+	return boolFalse, nil
+}
+
+// isNumber returns true if typ is a numeric type.
+func isNumber(typ querypb.Type) bool {
+	return sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ) || typ == sqltypes.Decimal
+}
+
+// mergeTypes returns the type that can represent values of all
+// the specified types. NULL values are compatible with any type.
+func mergeTypes(types []querypb.Type) querypb.Type {
+	result := sqltypes.Null
+	for _, typ := range types {
+		switch {
+		case typ == sqltypes.Null || typ == result:
+		case result == sqltypes.Null:
+			result = typ
+		case isNumber(result) && isNumber(typ):
+			switch {
+			case sqltypes.IsFloat(result) || sqltypes.IsFloat(typ):
+				result = sqltypes.Float64
+			case result == sqltypes.Decimal || typ == sqltypes.Decimal:
+				result = sqltypes.Decimal
+			case sqltypes.IsUnsigned(result) && sqltypes.IsUnsigned(typ):
+				result = sqltypes.Uint64
+			default:
+				result = sqltypes.Int64
+			}
+		case sqltypes.IsText(result) || sqltypes.IsText(typ):
+			result = sqltypes.VarChar
+		default:
+			result = sqltypes.VarBinary
+		}
+	}
+	return result
+}
+
+// formatOperand formats an operand of an operator, adding
+// parentheses if the operand is itself an operation.
+func formatOperand(expr Expr) string {
+	switch expr.(type) {
+	case *ArithmeticExpr, *ComparisonExpr, *InExpr, *LogicalExpr, *NotExpr, *IsExpr:
+		return "(" + expr.String() + ")"
+	}
+	return expr.String()
+}
+
+func formatList(exprs []Expr) string {
+	parts := make([]string, len(exprs))
+	for i, expr := range exprs {
+		parts[i] = expr.String()
+	}
+	return strings.Join(parts, ", ")
+}
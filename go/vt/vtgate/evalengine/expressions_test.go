@@ -0,0 +1,133 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/xsec-lab/go/sqltypes"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+func TestExprType(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"a|b|c|d|t",
+		"int64|varbinary|null_type|datetime|varchar",
+	)
+	testcases := []struct {
+		in  string
+		out querypb.Type
+	}{
+		{"a", sqltypes.Int64},
+		{"'abc'", sqltypes.VarBinary},
+		{":v", sqltypes.VarBinary},
+		{"a + 1", sqltypes.Int64},
+		{"a + 1.5", sqltypes.Float64},
+		{"a / 2", sqltypes.Float64},
+		{"18446744073709551615 - 18446744073709551614", sqltypes.Uint64},
+		{"a = 1", sqltypes.Int64},
+		{"a in (1, 2)", sqltypes.Int64},
+		{"a is null", sqltypes.Int64},
+		{"case when a then b else t end", sqltypes.VarChar},
+		{"case when a then c else a end", sqltypes.Int64},
+		{"if(a, 1, 2.5)", sqltypes.Float64},
+		{"coalesce(c, b)", sqltypes.VarBinary},
+		{"ifnull(a, b)", sqltypes.VarBinary},
+		{"concat(a, b)", sqltypes.VarBinary},
+		{"concat(a, t)", sqltypes.VarChar},
+		{"length(b)", sqltypes.Int64},
+		{"abs(a)", sqltypes.Int64},
+		{"abs(b)", sqltypes.Float64},
+		{"date(d)", sqltypes.Date},
+	}
+	for _, tc := range testcases {
+		expr, err := convertTestExpr(t, tc.in)
+		if err != nil {
+			t.Errorf("Convert(%s): %v", tc.in, err)
+			continue
+		}
+		if got := expr.Type(fields); got != tc.out {
+			t.Errorf("Type(%s): %v, want %v", tc.in, got, tc.out)
+		}
+	}
+}
+
+func TestExprString(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{
+		{"a + 1", "[COLUMN 0] + 1"},
+		{"(a + 1) * :v", "([COLUMN 0] + 1) * :v"},
+		{"'abc'", "'abc'"},
+		{"a not in (1, null)", "[COLUMN 0] not in (1, null)"},
+		{"not a = 1 or c is null", "(not ([COLUMN 0] = 1)) or ([COLUMN 2] is null)"},
+		{"case a when 1 then b else 'x' end", "case [COLUMN 0] when 1 then [COLUMN 1] else 'x' end"},
+		{"coalesce(c, b)", "coalesce([COLUMN 2], [COLUMN 1])"},
+		{"substring(b, 2)", "substr([COLUMN 1], 2)"},
+	}
+	for _, tc := range testcases {
+		expr, err := convertTestExpr(t, tc.in)
+		if err != nil {
+			t.Errorf("Convert(%s): %v", tc.in, err)
+			continue
+		}
+		if got := expr.String(); got != tc.out {
+			t.Errorf("String(%s): %s, want %s", tc.in, got, tc.out)
+		}
+	}
+}
+
+func TestEvaluateBool(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out bool
+	}{
+		{"a = 1", true},
+		{"a = 2", false},
+		{"c = 1", false},
+		{"b", false},
+		{"a + 1", true},
+	}
+	for _, tc := range testcases {
+		expr, err := convertTestExpr(t, tc.in)
+		if err != nil {
+			t.Errorf("Convert(%s): %v", tc.in, err)
+			continue
+		}
+		got, err := EvaluateBool(expr, ExpressionEnv{Row: testRow})
+		if err != nil {
+			t.Errorf("EvaluateBool(%s): %v", tc.in, err)
+			continue
+		}
+		if got != tc.out {
+			t.Errorf("EvaluateBool(%s): %v, want %v", tc.in, got, tc.out)
+		}
+	}
+}
+
+func TestColumnOutOfRange(t *testing.T) {
+	expr := &Column{Offset: 2}
+	_, err := expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{sqltypes.NULL}})
+	want := "column 2 is out of range for a row of 1 columns"
+	if err == nil || err.Error() != want {
+		t.Errorf("Evaluate: %v, want %s", err, want)
+	}
+	if typ := expr.Type(nil); typ != sqltypes.Null {
+		t.Errorf("Type: %v, want NULL_TYPE", typ)
+	}
+}
@@ -0,0 +1,568 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/vterrors"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+	vtrpcpb "github.com/xsec-lab/go/vt/proto/vtrpc"
+)
+
+var _ Expr = (*FuncExpr)(nil)
+
+// FuncExpr is a call to a builtin function.
+type FuncExpr struct {
+	Name string
+	Args []Expr
+
+	fn *builtin
+}
+
+// builtin describes a builtin function. maxArgs is -1
+// if the function accepts any number of arguments.
+type builtin struct {
+	minArgs, maxArgs int
+	eval             func(args []sqltypes.Value) (sqltypes.Value, error)
+	typ              func(types []querypb.Type) querypb.Type
+}
+
+// builtins is the list of functions supported by the engine.
+// The functions that can't be evaluated exactly like MySQL does,
+// because of collations or time zones, are intentionally left out.
+var builtins = map[string]*builtin{
+	"if":           {3, 3, evalIf, typeIf},
+	"ifnull":       {2, 2, evalCoalesce, mergeTypes},
+	"nullif":       {2, 2, evalNullif, typeFirst},
+	"coalesce":     {1, -1, evalCoalesce, mergeTypes},
+	"concat":       {1, -1, evalConcat, typeString},
+	"concat_ws":    {2, -1, evalConcatWs, typeString},
+	"lower":        {1, 1, evalLower, typeString},
+	"lcase":        {1, 1, evalLower, typeString},
+	"upper":        {1, 1, evalUpper, typeString},
+	"ucase":        {1, 1, evalUpper, typeString},
+	"length":       {1, 1, evalLength, typeInt64},
+	"octet_length": {1, 1, evalLength, typeInt64},
+	"char_length":  {1, 1, evalCharLength, typeInt64},
+	"trim":         {1, 1, evalTrim, typeString},
+	"ltrim":        {1, 1, evalLtrim, typeString},
+	"rtrim":        {1, 1, evalRtrim, typeString},
+	"left":         {2, 2, evalLeft, typeString},
+	"right":        {2, 2, evalRight, typeString},
+	"replace":      {3, 3, evalReplace, typeString},
+	"substring":    {2, 3, evalSubstring, typeString},
+	"substr":       {2, 3, evalSubstring, typeString},
+	"mid":          {3, 3, evalSubstring, typeString},
+	"abs":          {1, 1, evalAbs, typeNumber},
+	"greatest":     {2, -1, evalGreatest, mergeTypes},
+	"least":        {2, -1, evalLeast, mergeTypes},
+	"date":         {1, 1, evalDate, typeDate},
+	"year":         {1, 1, evalYear, typeInt64},
+	"month":        {1, 1, evalMonth, typeInt64},
+	"day":          {1, 1, evalDay, typeInt64},
+	"dayofmonth":   {1, 1, evalDay, typeInt64},
+	"dayofweek":    {1, 1, evalDayOfWeek, typeInt64},
+	"hour":         {1, 1, evalHour, typeInt64},
+	"minute":       {1, 1, evalMinute, typeInt64},
+	"second":       {1, 1, evalSecond, typeInt64},
+	"datediff":     {2, 2, evalDatediff, typeInt64},
+}
+
+// NewFuncExpr returns a FuncExpr for the specified builtin function.
+// An error is returned if the function is not supported, or if the
+// number of arguments is invalid.
+func NewFuncExpr(name string, args []Expr) (*FuncExpr, error) {
+	name = strings.ToLower(name)
+	fn, ok := builtins[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported: function %s", name)
+	}
+	if len(args) < fn.minArgs || (fn.maxArgs != -1 && len(args) > fn.maxArgs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect parameter count in the call to %s", name)
+	}
+	return &FuncExpr{
+		Name: name,
+		Args: args,
+		fn:   fn,
+	}, nil
+}
+
+// Evaluate satisfies the Expr interface.
+func (f *FuncExpr) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	args := make([]sqltypes.Value, len(f.Args))
+	for i, arg := range f.Args {
+		val, err := arg.Evaluate(env)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		args[i] = val
+	}
+	return f.fn.eval(args)
+}
+
+// Type satisfies the Expr interface.
+func (f *FuncExpr) Type(fields []*querypb.Field) querypb.Type {
+	types := make([]querypb.Type, len(f.Args))
+	for i, arg := range f.Args {
+		types[i] = arg.Type(fields)
+	}
+	return f.fn.typ(types)
+}
+
+// String satisfies the Expr interface.
+func (f *FuncExpr) String() string {
+	return fmt.Sprintf("%s(%s)", f.Name, formatList(f.Args))
+}
+
+func typeIf(types []querypb.Type) querypb.Type {
+	return mergeTypes(types[1:])
+}
+
+func typeFirst(types []querypb.Type) querypb.Type {
+	return types[0]
+}
+
+func typeInt64(types []querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+func typeDate(types []querypb.Type) querypb.Type {
+	return sqltypes.Date
+}
+
+// typeString returns VARCHAR if any of the arguments is
+// a text, and VARBINARY otherwise.
+func typeString(types []querypb.Type) querypb.Type {
+	for _, typ := range types {
+		if sqltypes.IsText(typ) {
+			return sqltypes.VarChar
+		}
+	}
+	return sqltypes.VarBinary
+}
+
+func typeNumber(types []querypb.Type) querypb.Type {
+	if isNumber(types[0]) {
+		return types[0]
+	}
+	return sqltypes.Float64
+}
+
+// hasNull returns true if any of the values is NULL.
+// Most functions return NULL in that case.
+func hasNull(args []sqltypes.Value) bool {
+	for _, arg := range args {
+		if arg.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// stringValue builds a string value whose type is
+// VARCHAR if any of the arguments is a text.
+func stringValue(s string, args ...sqltypes.Value) sqltypes.Value {
+	for _, arg := range args {
+		if arg.IsText() {
+			return sqltypes.NewVarChar(s)
+		}
+	}
+	return sqltypes.NewVarBinary(s)
+}
+
+func evalIf(args []sqltypes.Value) (sqltypes.Value, error) {
+	b, err := valueBool(args[0])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if b == boolTrue {
+		return args[1], nil
+	}
+	return args[2], nil
+}
+
+func evalCoalesce(args []sqltypes.Value) (sqltypes.Value, error) {
+	for _, arg := range args {
+		if !arg.IsNull() {
+			return arg, nil
+		}
+	}
+	return sqltypes.NULL, nil
+}
+
+func evalNullif(args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() || args[1].IsNull() {
+		return args[0], nil
+	}
+	cmp, err := sqltypes.NullsafeCompare(args[0], args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if cmp == 0 {
+		return sqltypes.NULL, nil
+	}
+	return args[0], nil
+}
+
+func evalConcat(args []sqltypes.Value) (sqltypes.Value, error) {
+	if hasNull(args) {
+		return sqltypes.NULL, nil
+	}
+	buf := &strings.Builder{}
+	for _, arg := range args {
+		buf.Write(arg.ToBytes())
+	}
+	return stringValue(buf.String(), args...), nil
+}
+
+// evalConcatWs skips the NULL values, but returns
+// NULL if the separator is NULL.
+func evalConcatWs(args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() {
+		return sqltypes.NULL, nil
+	}
+	var parts []string
+	for _, arg := range args[1:] {
+		if !arg.IsNull() {
+			parts = append(parts, arg.ToString())
+		}
+	}
+	return stringValue(strings.Join(parts, args[0].ToString()), args...), nil
+}
+
+func evalLower(args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() {
+		return sqltypes.NULL, nil
+	}
+	return stringValue(strings.ToLower(args[0].ToString()), args...), nil
+}
+
+func evalUpper(args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() {
+		return sqltypes.NULL, nil
+	}
+	return stringValue(strings.ToUpper(args[0].ToString()), args...), nil
+}
+
+func evalLength(args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() {
+		return sqltypes.NULL, nil
+	}
+	return sqltypes.NewInt64(int64(args[0].Len())), nil
+}
+
+func evalCharLength(args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() {
+		return sqltypes.NULL, nil
+	}
+	return sqltypes.NewInt64(int64(utf8.RuneCount(args[0].ToBytes()))), nil
+}
+
+func evalTrim(args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() {
+		return sqltypes.NULL, nil
+	}
+	return stringValue(strings.Trim(args[0].ToString(), " "), args[0]), nil
+}
+
+func evalLtrim(args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() {
+		return sqltypes.NULL, nil
+	}
+	return stringValue(strings.TrimLeft(args[0].ToString(), " "), args[0]), nil
+}
+
+func evalRtrim(args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() {
+		return sqltypes.NULL, nil
+	}
+	return stringValue(strings.TrimRight(args[0].ToString(), " "), args[0]), nil
+}
+
+func evalLeft(args []sqltypes.Value) (sqltypes.Value, error) {
+	if hasNull(args) {
+		return sqltypes.NULL, nil
+	}
+	n, err := sqltypes.ToInt64(args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	runes := []rune(args[0].ToString())
+	switch {
+	case n < 0:
+		n = 0
+	case n > int64(len(runes)):
+		n = int64(len(runes))
+	}
+	return stringValue(string(runes[:n]), args[0]), nil
+}
+
+func evalRight(args []sqltypes.Value) (sqltypes.Value, error) {
+	if hasNull(args) {
+		return sqltypes.NULL, nil
+	}
+	n, err := sqltypes.ToInt64(args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	runes := []rune(args[0].ToString())
+	switch {
+	case n < 0:
+		n = 0
+	case n > int64(len(runes)):
+		n = int64(len(runes))
+	}
+	return stringValue(string(runes[int64(len(runes))-n:]), args[0]), nil
+}
+
+func evalReplace(args []sqltypes.Value) (sqltypes.Value, error) {
+	if hasNull(args) {
+		return sqltypes.NULL, nil
+	}
+	return stringValue(strings.Replace(args[0].ToString(), args[1].ToString(), args[2].ToString(), -1), args...), nil
+}
+
+// evalSubstring follows the MySQL semantics: positions start at 1,
+// a negative position counts from the end of the string, and
+// a position of 0 returns an empty string.
+func evalSubstring(args []sqltypes.Value) (sqltypes.Value, error) {
+	if hasNull(args) {
+		return sqltypes.NULL, nil
+	}
+	runes := []rune(args[0].ToString())
+	size := int64(len(runes))
+	pos, err := sqltypes.ToInt64(args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	length := size
+	if len(args) == 3 {
+		if length, err = sqltypes.ToInt64(args[2]); err != nil {
+			return sqltypes.NULL, err
+		}
+	}
+	switch {
+	case pos > 0:
+		pos--
+	case pos < 0:
+		pos += size
+	default:
+		pos = size
+	}
+	if pos < 0 || pos >= size || length <= 0 {
+		return stringValue("", args[0]), nil
+	}
+	end := pos + length
+	if end > size {
+		end = size
+	}
+	return stringValue(string(runes[pos:end]), args[0]), nil
+}
+
+func evalAbs(args []sqltypes.Value) (sqltypes.Value, error) {
+	val := args[0]
+	switch {
+	case val.IsNull():
+		return sqltypes.NULL, nil
+	case val.IsUnsigned():
+		return val, nil
+	case val.IsSigned():
+		n, err := sqltypes.ToInt64(val)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if n == math.MinInt64 {
+			return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "BIGINT value is out of range in abs(%d)", n)
+		}
+		if n < 0 {
+			n = -n
+		}
+		return sqltypes.NewInt64(n), nil
+	case val.Type() == sqltypes.Decimal:
+		return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(strings.TrimPrefix(val.ToString(), "-"))), nil
+	}
+	f, err := sqltypes.ToFloat64(val)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return sqltypes.NewFloat64(math.Abs(f)), nil
+}
+
+func evalGreatest(args []sqltypes.Value) (sqltypes.Value, error) {
+	return pickValue(args, func(cmp int) bool { return cmp > 0 })
+}
+
+func evalLeast(args []sqltypes.Value) (sqltypes.Value, error) {
+	return pickValue(args, func(cmp int) bool { return cmp < 0 })
+}
+
+// pickValue returns the value that's preferred over all the others.
+// The result is NULL if any of the values is NULL.
+func pickValue(args []sqltypes.Value, prefer func(cmp int) bool) (sqltypes.Value, error) {
+	if hasNull(args) {
+		return sqltypes.NULL, nil
+	}
+	result := args[0]
+	for _, arg := range args[1:] {
+		cmp, err := sqltypes.NullsafeCompare(arg, result)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if prefer(cmp) {
+			result = arg
+		}
+	}
+	return result, nil
+}
+
+func evalDate(args []sqltypes.Value) (sqltypes.Value, error) {
+	return evalDateTime(args[0], func(t time.Time) sqltypes.Value {
+		return sqltypes.MakeTrusted(sqltypes.Date, []byte(t.Format(dateLayout)))
+	})
+}
+
+func evalYear(args []sqltypes.Value) (sqltypes.Value, error) {
+	return evalDateTime(args[0], func(t time.Time) sqltypes.Value {
+		return sqltypes.NewInt64(int64(t.Year()))
+	})
+}
+
+func evalMonth(args []sqltypes.Value) (sqltypes.Value, error) {
+	return evalDateTime(args[0], func(t time.Time) sqltypes.Value {
+		return sqltypes.NewInt64(int64(t.Month()))
+	})
+}
+
+func evalDay(args []sqltypes.Value) (sqltypes.Value, error) {
+	return evalDateTime(args[0], func(t time.Time) sqltypes.Value {
+		return sqltypes.NewInt64(int64(t.Day()))
+	})
+}
+
+// evalDayOfWeek returns 1 for Sunday, 2 for Monday, and so on.
+func evalDayOfWeek(args []sqltypes.Value) (sqltypes.Value, error) {
+	return evalDateTime(args[0], func(t time.Time) sqltypes.Value {
+		return sqltypes.NewInt64(int64(t.Weekday()) + 1)
+	})
+}
+
+func evalHour(args []sqltypes.Value) (sqltypes.Value, error) {
+	return evalTimeOfDay(args[0], func(h, m, s int64) int64 { return h })
+}
+
+func evalMinute(args []sqltypes.Value) (sqltypes.Value, error) {
+	return evalTimeOfDay(args[0], func(h, m, s int64) int64 { return m })
+}
+
+func evalSecond(args []sqltypes.Value) (sqltypes.Value, error) {
+	return evalTimeOfDay(args[0], func(h, m, s int64) int64 { return s })
+}
+
+// evalDatediff returns the number of days between two dates.
+// The time parts of the values are ignored.
+func evalDatediff(args []sqltypes.Value) (sqltypes.Value, error) {
+	if hasNull(args) {
+		return sqltypes.NULL, nil
+	}
+	t1, ok := parseDateTime(args[0])
+	if !ok {
+		return sqltypes.NULL, nil
+	}
+	t2, ok := parseDateTime(args[1])
+	if !ok {
+		return sqltypes.NULL, nil
+	}
+	d1 := time.Date(t1.Year(), t1.Month(), t1.Day(), 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(t2.Year(), t2.Month(), t2.Day(), 0, 0, 0, 0, time.UTC)
+	return sqltypes.NewInt64(int64(d1.Sub(d2).Hours() / 24)), nil
+}
+
+const (
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02 15:04:05.999999"
+)
+
+// evalDateTime applies fn to the value parsed as a date or a
+// datetime. Like in MySQL, invalid values produce a NULL.
+func evalDateTime(val sqltypes.Value, fn func(t time.Time) sqltypes.Value) (sqltypes.Value, error) {
+	if val.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	t, ok := parseDateTime(val)
+	if !ok {
+		return sqltypes.NULL, nil
+	}
+	return fn(t), nil
+}
+
+func parseDateTime(val sqltypes.Value) (time.Time, bool) {
+	s := strings.TrimSpace(val.ToString())
+	for _, layout := range []string{dateTimeLayout, dateLayout} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// evalTimeOfDay applies fn to the hours, minutes and seconds
+// of the value, which can be a time or a datetime. The hours
+// of a time can exceed 24.
+func evalTimeOfDay(val sqltypes.Value, fn func(h, m, s int64) int64) (sqltypes.Value, error) {
+	if val.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	if t, ok := parseDateTime(val); ok {
+		return sqltypes.NewInt64(fn(int64(t.Hour()), int64(t.Minute()), int64(t.Second()))), nil
+	}
+	h, m, s, ok := parseTime(val.ToString())
+	if !ok {
+		return sqltypes.NULL, nil
+	}
+	return sqltypes.NewInt64(fn(h, m, s)), nil
+}
+
+// parseTime parses a time of the form [-]hh:mm:ss[.fraction].
+func parseTime(s string) (h, m, sec int64, ok bool) {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(s), "-"), ":")
+	if len(parts) != 3 {
+		return 0, 0, 0, false
+	}
+	if i := strings.IndexByte(parts[2], '.'); i >= 0 {
+		parts[2] = parts[2][:i]
+	}
+	var values [3]int64
+	for i, part := range parts {
+		v, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return 0, 0, 0, false
+		}
+		values[i] = int64(v)
+	}
+	if values[1] > 59 || values[2] > 59 {
+		return 0, 0, 0, false
+	}
+	return values[0], values[1], values[2], true
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/xsec-lab/go/mysql"
 	"github.com/xsec-lab/go/sqltypes"
 	"github.com/xsec-lab/go/stats"
+	"github.com/xsec-lab/go/sync2"
 	"github.com/xsec-lab/go/vt/callerid"
 	"github.com/xsec-lab/go/vt/key"
 	"github.com/xsec-lab/go/vt/log"
@@ -96,6 +97,17 @@ type Executor struct {
 	vschemaStats *VSchemaStats
 
 	vm VSchemaManager
+
+	// warmingUp is set while the plan cache is warmed up on startup.
+	warmingUp sync2.AtomicBool
+
+	// lookupCaches invalidates the caches of the lookup vindexes.
+	// It's protected by mu.
+	lookupCaches *lookupCacheInvalidator
+
+	// sequences has the values of the sequences that are fetched
+	// ahead of time, if enabled. It's protected by mu.
+	sequences *sequenceCache
 }
 
 var executorOnce sync.Once
@@ -254,6 +266,12 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 		return e.handleCommit(ctx, safeSession, sql, bindVars, logStats)
 	case *sqlparser.Rollback:
 		return e.handleRollback(ctx, safeSession, sql, bindVars, logStats)
+	case *sqlparser.Savepoint:
+		return e.handleSavepoint(ctx, safeSession, specStmt, logStats)
+	case *sqlparser.SRollback:
+		return e.handleSRollback(ctx, safeSession, specStmt, logStats)
+	case *sqlparser.Release:
+		return e.handleRelease(ctx, safeSession, specStmt, logStats)
 	case *sqlparser.Set:
 		return e.handleSet(ctx, safeSession, sql, bindVars, logStats)
 	case *sqlparser.Show:
@@ -418,7 +436,9 @@ func (e *Executor) handleDDL(ctx context.Context, safeSession *SafeSession, sql
 			sqlparser.AddColVindexStr,
 			sqlparser.DropColVindexStr,
 			sqlparser.AddSequenceStr,
-			sqlparser.AddAutoIncStr:
+			sqlparser.AddAutoIncStr,
+			sqlparser.AddRoutingRuleStr,
+			sqlparser.DropRoutingRuleStr:
 
 			err := e.handleVSchemaDDL(ctx, safeSession, dest, destKeyspace, destTabletType, ddl, logStats)
 			logStats.ExecuteTime = time.Since(execStart)
@@ -440,6 +460,11 @@ func (e *Executor) handleDDL(ctx context.Context, safeSession *SafeSession, sql
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 	result, err := e.destinationExec(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
 	logStats.ExecuteTime = time.Since(execStart)
+	if err == nil {
+		if st := e.vm.schemaTracker(); st != nil {
+			st.Notify(destKeyspace)
+		}
+	}
 
 	e.updateQueryCounts("DDL", "", "", int64(logStats.ShardQueries))
 
@@ -458,6 +483,25 @@ func (e *Executor) handleVSchemaDDL(ctx context.Context, safeSession *SafeSessio
 
 	}
 
+	// Routing rules are global, and don't belong to a keyspace. They're
+	// read from the topo because the watched SrvVSchema may be behind.
+	if ddl.Action == sqlparser.AddRoutingRuleStr || ddl.Action == sqlparser.DropRoutingRuleStr {
+		topoServer, err := e.serv.GetTopoServer()
+		if err != nil {
+			return err
+		}
+		rr, err := topoServer.GetRoutingRules(ctx)
+		if err != nil {
+			return err
+		}
+		rr, err = topotools.ApplyRoutingRulesDDL(rr, ddl)
+		if err != nil {
+			return err
+		}
+		vschema.RoutingRules = rr
+		return e.vm.UpdateRoutingRules(ctx, vschema)
+	}
+
 	// Resolve the keyspace either from the table qualifier or the target keyspace
 	var ksName string
 	if !ddl.Table.IsEmpty() {
@@ -517,6 +561,36 @@ func (e *Executor) handleRollback(ctx context.Context, safeSession *SafeSession,
 	return &sqltypes.Result{}, err
 }
 
+func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession, stmt *sqlparser.Savepoint, logStats *LogStats) (*sqltypes.Result, error) {
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	logStats.ShardQueries = uint32(len(safeSession.ShardSessions))
+	e.updateQueryCounts("Savepoint", "", "", int64(logStats.ShardQueries))
+	err := e.txConn.Savepoint(ctx, safeSession, stmt.Name.String())
+	logStats.ExecuteTime = time.Since(execStart)
+	return &sqltypes.Result{}, err
+}
+
+func (e *Executor) handleSRollback(ctx context.Context, safeSession *SafeSession, stmt *sqlparser.SRollback, logStats *LogStats) (*sqltypes.Result, error) {
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	logStats.ShardQueries = uint32(len(safeSession.ShardSessions))
+	e.updateQueryCounts("SavepointRollback", "", "", int64(logStats.ShardQueries))
+	err := e.txConn.RollbackToSavepoint(ctx, safeSession, stmt.Name.String())
+	logStats.ExecuteTime = time.Since(execStart)
+	return &sqltypes.Result{}, err
+}
+
+func (e *Executor) handleRelease(ctx context.Context, safeSession *SafeSession, stmt *sqlparser.Release, logStats *LogStats) (*sqltypes.Result, error) {
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	logStats.ShardQueries = uint32(len(safeSession.ShardSessions))
+	e.updateQueryCounts("Release", "", "", int64(logStats.ShardQueries))
+	err := e.txConn.ReleaseSavepoint(ctx, safeSession, stmt.Name.String())
+	logStats.ExecuteTime = time.Since(execStart)
+	return &sqltypes.Result{}, err
+}
+
 func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (*sqltypes.Result, error) {
 	vals, scope, err := sqlparser.ExtractSetValues(sql)
 	execStart := time.Now()
@@ -1049,6 +1123,21 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 			Rows:         rows,
 			RowsAffected: uint64(len(rows)),
 		}, nil
+	case "vschema routing rules":
+		vschema := e.vm.GetCurrentSrvVschema()
+		if vschema == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+		}
+
+		rows := make([][]sqltypes.Value, 0, len(vschema.GetRoutingRules().GetRules()))
+		for _, rule := range vschema.GetRoutingRules().GetRules() {
+			rows = append(rows, buildVarCharRow(rule.FromTable, strings.Join(rule.ToTables, ", ")))
+		}
+		return &sqltypes.Result{
+			Fields:       buildVarCharFields("From", "To"),
+			Rows:         rows,
+			RowsAffected: uint64(len(rows)),
+		}, nil
 	case "vschema vindexes":
 		vschema := e.vm.GetCurrentSrvVschema()
 		if vschema == nil {
@@ -1418,6 +1507,9 @@ func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 	e.vschema = vschema
 	e.vschemaStats = stats
 	e.plans.Clear()
+	if e.lookupCaches != nil {
+		e.lookupCaches.watch(vschema)
+	}
 
 	if vschemaCounters != nil {
 		vschemaCounters.Add("Reload", 1)
@@ -1449,7 +1541,7 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		return nil, errors.New("vschema not initialized")
 	}
 	keyspace := vcursor.keyspace
-	planKey := keyspace + vindexes.TabletTypeSuffix[vcursor.tabletType] + ":" + sql
+	planKey := planCacheKey(keyspace, vcursor.tabletType, sql)
 	if plan, ok := e.plans.Get(planKey); ok {
 		return plan.(*engine.Plan), nil
 	}
@@ -1481,7 +1573,7 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		logStats.BindVariables = bindVars
 	}
 
-	planKey = keyspace + vindexes.TabletTypeSuffix[vcursor.tabletType] + ":" + normalized
+	planKey = planCacheKey(keyspace, vcursor.tabletType, normalized)
 	if plan, ok := e.plans.Get(planKey); ok {
 		return plan.(*engine.Plan), nil
 	}
@@ -1504,6 +1596,7 @@ func skipQueryPlanCache(safeSession *SafeSession) bool {
 }
 
 // ServeHTTP shows the current plans in the query cache.
+// See serveQueryPlans for the other operations on the query cache.
 func (e *Executor) ServeHTTP(response http.ResponseWriter, request *http.Request) {
 	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
 		acl.SendError(response, err)
@@ -1512,7 +1605,7 @@ func (e *Executor) ServeHTTP(response http.ResponseWriter, request *http.Request
 
 	switch request.URL.Path {
 	case pathQueryPlans:
-		returnAsJSON(response, e.plans.Items())
+		e.serveQueryPlans(response, request)
 	case pathVSchema:
 		returnAsJSON(response, e.VSchema())
 	case pathScatterStats:
@@ -1724,7 +1817,7 @@ func (e *Executor) prepare(ctx context.Context, safeSession *SafeSession, sql st
 	case sqlparser.StmtSelect:
 		return e.handlePrepare(ctx, safeSession, sql, bindVars, destKeyspace, destTabletType, logStats)
 	case sqlparser.StmtDDL, sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback, sqlparser.StmtSet, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete,
-		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment:
+		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtSavepoint, sqlparser.StmtSRollback, sqlparser.StmtRelease:
 		return nil, nil
 	case sqlparser.StmtShow:
 		res, err := e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
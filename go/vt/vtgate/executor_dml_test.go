@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -1069,6 +1070,33 @@ func TestInsertGeneratorUnsharded(t *testing.T) {
 	}
 }
 
+func TestInsertGeneratorPrefetch(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	executor.startSequencePrefetch(10, time.Second)
+
+	_, err := executorExec(executor, "insert into main1(id, name) values (null, 'myname')", nil)
+	require.NoError(t, err)
+	_, err = executorExec(executor, "insert into main1(id, name) values (null, 'myname')", nil)
+	require.NoError(t, err)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select next :n values from user_seq",
+		BindVariables: map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(10)},
+	}, {
+		Sql: "insert into main1(id, name) values (:__seq0, 'myname')",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__seq0": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		Sql: "insert into main1(id, name) values (:__seq0, 'myname')",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__seq0": sqltypes.Int64BindVariable(2),
+		},
+	}}
+	if !reflect.DeepEqual(sbclookup.Queries, wantQueries) {
+		t.Errorf("sbclookup.Queries: \n%#v, want \n%#v\n", sbclookup.Queries, wantQueries)
+	}
+}
+
 func TestInsertAutoincUnsharded(t *testing.T) {
 	router, _, _, sbclookup := createExecutorEnv()
 
@@ -0,0 +1,250 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/acl"
+	"github.com/xsec-lab/go/timer"
+	"github.com/xsec-lab/go/vt/log"
+	"github.com/xsec-lab/go/vt/servenv"
+	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/topo/topoproto"
+	"github.com/xsec-lab/go/vt/vtgate/vindexes"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
+)
+
+var (
+	planCacheFile          = flag.String("plan_cache_file", "", "If set, the queries of the plan cache are saved to this file periodically and on shutdown. On startup, they're re-planned before vtgate reports itself as healthy.")
+	planCacheSaveInterval  = flag.Duration("plan_cache_save_interval", 5*time.Minute, "How often the queries of the plan cache are saved to -plan_cache_file.")
+	planCacheWarmupTimeout = flag.Duration("plan_cache_warmup_timeout", 1*time.Minute, "Maximum time to wait for the vschema before the plan cache warm-up is abandoned.")
+)
+
+// CachedQuery is a query whose plan is in the plan cache. It's the
+// unit that's saved to -plan_cache_file, and that's exported and
+// imported through /debug/query_plans.
+type CachedQuery struct {
+	Keyspace   string
+	TabletType string
+	Query      string
+}
+
+// WarmupResult is the result of re-planning a list of CachedQuery.
+type WarmupResult struct {
+	Planned int
+	Failed  int
+}
+
+// planCacheKey returns the key of a plan in the plan cache.
+func planCacheKey(keyspace string, tabletType topodatapb.TabletType, sql string) string {
+	return keyspace + vindexes.TabletTypeSuffix[tabletType] + ":" + sql
+}
+
+// parsePlanCacheKey is the inverse of planCacheKey.
+func parsePlanCacheKey(key string) (CachedQuery, bool) {
+	colon := strings.Index(key, ":")
+	if colon == -1 {
+		return CachedQuery{}, false
+	}
+	target := key[:colon]
+	at := strings.LastIndex(target, "@")
+	if at == -1 {
+		return CachedQuery{}, false
+	}
+	return CachedQuery{
+		Keyspace:   target[:at],
+		TabletType: target[at+1:],
+		Query:      key[colon+1:],
+	}, true
+}
+
+// CachedQueries returns the queries whose plans are in the plan cache.
+func (e *Executor) CachedQueries() []CachedQuery {
+	keys := e.plans.Keys()
+	queries := make([]CachedQuery, 0, len(keys))
+	for _, key := range keys {
+		if query, ok := parsePlanCacheKey(key); ok {
+			queries = append(queries, query)
+		}
+	}
+	return queries
+}
+
+// WarmPlanCache plans the specified queries and adds them to the
+// plan cache. Queries that fail to plan are skipped: they usually
+// reference tables that were removed from the vschema.
+func (e *Executor) WarmPlanCache(ctx context.Context, queries []CachedQuery) WarmupResult {
+	var result WarmupResult
+	for _, query := range queries {
+		if err := e.warmPlan(ctx, query); err != nil {
+			log.Warningf("Plan cache warm-up failed for %v: %v", query, err)
+			result.Failed++
+			continue
+		}
+		result.Planned++
+	}
+	return result
+}
+
+func (e *Executor) warmPlan(ctx context.Context, query CachedQuery) error {
+	tabletType, err := topoproto.ParseTabletType(query.TabletType)
+	if err != nil {
+		return err
+	}
+	vcursor := newVCursorImpl(ctx, NewSafeSession(nil), query.Keyspace, tabletType, sqlparser.MarginComments{}, e, nil)
+	_, err = e.getPlan(vcursor, query.Query, sqlparser.MarginComments{}, map[string]*querypb.BindVariable{}, false, nil)
+	return err
+}
+
+// warmUpPlanCacheFromFile waits for the vschema to be loaded, and
+// re-plans the queries saved in the specified file. The executor
+// reports itself as warming up until this is done.
+func (e *Executor) warmUpPlanCacheFromFile(ctx context.Context, path string, timeout time.Duration) {
+	e.warmingUp.Set(true)
+	defer e.warmingUp.Set(false)
+
+	queries, err := loadCachedQueries(path)
+	if err != nil {
+		log.Errorf("Could not load the plan cache file %v: %v", path, err)
+		return
+	}
+	if len(queries) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for e.VSchema() == nil {
+		select {
+		case <-ctx.Done():
+			log.Errorf("Plan cache warm-up abandoned: vschema not loaded after %v", timeout)
+			return
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	start := time.Now()
+	result := e.WarmPlanCache(ctx, queries)
+	log.Infof("Plan cache warm-up done in %v: %d queries planned, %d failed", time.Since(start), result.Planned, result.Failed)
+}
+
+// initPlanCachePersistence warms up the plan cache from the
+// specified file, and saves the queries of the plan cache to
+// that file periodically and on shutdown.
+func (e *Executor) initPlanCachePersistence(ctx context.Context, path string, saveInterval, warmupTimeout time.Duration) {
+	// Set before the warm-up starts, so that vtgate doesn't
+	// report itself as healthy in the meantime.
+	e.warmingUp.Set(true)
+	go e.warmUpPlanCacheFromFile(ctx, path, warmupTimeout)
+
+	save := func() {
+		// Saving during the warm-up would lose the queries
+		// that haven't been re-planned yet.
+		if e.warmingUp.Get() {
+			return
+		}
+		if err := saveCachedQueries(path, e.CachedQueries()); err != nil {
+			log.Errorf("Could not save the plan cache file %v: %v", path, err)
+		}
+	}
+	saveTimer := timer.NewTimer(saveInterval)
+	saveTimer.Start(save)
+	servenv.OnTermSync(func() {
+		saveTimer.Stop()
+		save()
+	})
+}
+
+// loadCachedQueries reads the queries saved by saveCachedQueries.
+// A missing file is not an error.
+func loadCachedQueries(path string) ([]CachedQuery, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var queries []CachedQuery
+	if err := json.Unmarshal(data, &queries); err != nil {
+		return nil, err
+	}
+	return queries, nil
+}
+
+// saveCachedQueries saves the queries to the specified file.
+// The file is replaced atomically to not leave a truncated
+// file behind if vtgate dies while saving. The queries contain
+// the literals of the application, so only the owner can read
+// the file.
+func saveCachedQueries(path string, queries []CachedQuery) error {
+	data, err := json.MarshalIndent(queries, "", " ")
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	// WriteFile keeps the mode of an existing file.
+	if err := os.Remove(tmpPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// serveQueryPlans handles /debug/query_plans. By default, it shows
+// the plans of the plan cache. With ?export=true, it returns the
+// queries of the plan cache. A POST of such a list plans the queries
+// and adds them to the plan cache, which allows warming up a vtgate
+// with the queries of another one.
+func (e *Executor) serveQueryPlans(response http.ResponseWriter, request *http.Request) {
+	if request.Method == http.MethodPost {
+		if err := acl.CheckAccessHTTP(request, acl.ADMIN); err != nil {
+			acl.SendError(response, err)
+			return
+		}
+		var queries []CachedQuery
+		if err := json.NewDecoder(request.Body).Decode(&queries); err != nil {
+			response.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(response, "could not parse the list of queries: %v", err)
+			return
+		}
+		if e.VSchema() == nil {
+			response.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(response, "vschema not initialized")
+			return
+		}
+		returnAsJSON(response, e.WarmPlanCache(request.Context(), queries))
+		return
+	}
+	if request.FormValue("export") == "true" {
+		returnAsJSON(response, e.CachedQueries())
+		return
+	}
+	returnAsJSON(response, e.plans.Items())
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
+)
+
+func TestParsePlanCacheKey(t *testing.T) {
+	testcases := []struct {
+		keyspace   string
+		tabletType topodatapb.TabletType
+		sql        string
+		want       CachedQuery
+	}{{
+		keyspace:   KsTestSharded,
+		tabletType: topodatapb.TabletType_MASTER,
+		sql:        "select id from user where a = :vtg1",
+		want:       CachedQuery{Keyspace: KsTestSharded, TabletType: "master", Query: "select id from user where a = :vtg1"},
+	}, {
+		tabletType: topodatapb.TabletType_REPLICA,
+		sql:        "select 'a:b@c' from dual",
+		want:       CachedQuery{TabletType: "replica", Query: "select 'a:b@c' from dual"},
+	}}
+	for _, tc := range testcases {
+		got, ok := parsePlanCacheKey(planCacheKey(tc.keyspace, tc.tabletType, tc.sql))
+		assert.True(t, ok)
+		assert.Equal(t, tc.want, got)
+	}
+
+	_, ok := parsePlanCacheKey("no target")
+	assert.False(t, ok)
+	_, ok = parsePlanCacheKey("ks:select 1 from dual")
+	assert.False(t, ok)
+}
+
+func fillPlanCache(t *testing.T, e *Executor) {
+	t.Helper()
+	for _, sql := range []string{
+		"select id from music_user_map where id = 1",
+		"select id from user where id = 1",
+		"select id from user where name = 'foo'",
+	} {
+		_, err := executorExec(e, sql, nil)
+		require.NoError(t, err)
+	}
+}
+
+func sortedPlanKeys(e *Executor) []string {
+	keys := e.plans.Keys()
+	sort.Strings(keys)
+	return keys
+}
+
+func TestPlanCacheSaveAndWarmUp(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	fillPlanCache(t, executor)
+	want := sortedPlanKeys(executor)
+	// The lookup of name_user_map is cached too.
+	require.Len(t, want, 4)
+
+	dir, err := ioutil.TempDir("", "plan_cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := path.Join(dir, "plans.json")
+	require.NoError(t, saveCachedQueries(file, executor.CachedQueries()))
+	info, err := os.Stat(file)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// Warming up waits for the vschema.
+	executor, _, _, _ = createExecutorEnv()
+	vschema := executor.VSchema()
+	executor.SaveVSchema(nil, nil)
+	done := make(chan struct{})
+	go func() {
+		executor.warmUpPlanCacheFromFile(context.Background(), file, 10*time.Second)
+		close(done)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	assert.True(t, executor.warmingUp.Get())
+	executor.SaveVSchema(vschema, nil)
+	<-done
+	assert.False(t, executor.warmingUp.Get())
+	assert.Equal(t, want, sortedPlanKeys(executor))
+
+	// A missing file is not an error.
+	executor, _, _, _ = createExecutorEnv()
+	executor.warmUpPlanCacheFromFile(context.Background(), path.Join(dir, "missing.json"), time.Second)
+	assert.Empty(t, executor.plans.Keys())
+}
+
+func TestPlanCacheWarmUpTimeout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plan_cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := path.Join(dir, "plans.json")
+	require.NoError(t, saveCachedQueries(file, []CachedQuery{{TabletType: "master", Query: "select 1 from dual"}}))
+
+	executor, _, _, _ := createExecutorEnv()
+	executor.SaveVSchema(nil, nil)
+	executor.warmUpPlanCacheFromFile(context.Background(), file, 10*time.Millisecond)
+	assert.False(t, executor.warmingUp.Get())
+	assert.Empty(t, executor.plans.Keys())
+}
+
+func TestWarmPlanCache(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	result := executor.WarmPlanCache(context.Background(), []CachedQuery{
+		{Keyspace: KsTestUnsharded, TabletType: "master", Query: "select * from music_user_map where id = :vtg1"},
+		{Keyspace: "TestExecutor", TabletType: "replica", Query: "select id from user where id = :vtg1"},
+		{Keyspace: "TestExecutor", TabletType: "master", Query: "select id from nonexistent"},
+		{Keyspace: "TestExecutor", TabletType: "bad", Query: "select id from user"},
+		{Keyspace: "TestExecutor", TabletType: "master", Query: "not sql"},
+	})
+	assert.Equal(t, WarmupResult{Planned: 2, Failed: 3}, result)
+	assert.Equal(t, []string{
+		"TestExecutor@replica:select id from user where id = :vtg1",
+		"TestUnsharded@master:select * from music_user_map where id = :vtg1",
+	}, sortedPlanKeys(executor))
+}
+
+func TestDebugQueryPlansExportImport(t *testing.T) {
+	source, _, _, _ := createExecutorEnv()
+	fillPlanCache(t, source)
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/debug/query_plans?export=true", nil)
+	source.ServeHTTP(resp, req)
+	var queries []CachedQuery
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &queries))
+	require.Len(t, queries, 4)
+
+	target, _, _, _ := createExecutorEnv()
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/debug/query_plans", bytes.NewReader(mustMarshal(t, queries)))
+	target.ServeHTTP(resp, req)
+	var result WarmupResult
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &result))
+	assert.Equal(t, WarmupResult{Planned: 4}, result)
+	assert.Equal(t, sortedPlanKeys(source), sortedPlanKeys(target))
+
+	// The plans are still shown by default.
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/debug/query_plans", nil)
+	target.ServeHTTP(resp, req)
+	var items []map[string]interface{}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &items))
+	assert.Len(t, items, 4)
+
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/debug/query_plans", bytes.NewReader([]byte("bad")))
+	target.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	t.Helper()
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	return b
+}
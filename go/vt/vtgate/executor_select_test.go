@@ -1367,6 +1367,75 @@ func TestStreamSelectScatterAggregate(t *testing.T) {
 	}
 }
 
+// TestSelectScatterAggregateExpression tests that expressions
+// on the results of a scatter aggregate are evaluated by vtgate.
+func TestSelectScatterAggregateExpression(t *testing.T) {
+	// Special setup: Don't use createExecutorEnv.
+	cell := "aa"
+	hc := discovery.NewFakeHealthCheck()
+	s := createSandbox("TestExecutor")
+	s.VSchema = executorVSchema
+	getSandbox(KsTestUnsharded).VSchema = unshardedVSchema
+	serv := new(sandboxTopo)
+	resolver := newTestResolver(hc, serv, cell)
+	shards := []string{"-20", "20-40", "40-60", "60-80", "80-a0", "a0-c0", "c0-e0", "e0-"}
+	var conns []*sandboxconn.SandboxConn
+	for i, shard := range shards {
+		sbc := hc.AddTestTablet(cell, shard, 1, "TestExecutor", shard, topodatapb.TabletType_MASTER, true, 1, nil)
+		sbc.SetResults([]*sqltypes.Result{{
+			Fields: []*querypb.Field{
+				{Name: "col", Type: sqltypes.Int32},
+				{Name: "sum(foo)", Type: sqltypes.Int32},
+				{Name: "count(*)", Type: sqltypes.Int64},
+			},
+			RowsAffected: 1,
+			InsertID:     0,
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewInt32(int32(i % 4)),
+				sqltypes.NewInt32(int32(i)),
+				sqltypes.NewInt64(2),
+			}},
+		}})
+		conns = append(conns, sbc)
+	}
+	executor := NewExecutor(context.Background(), serv, cell, "", resolver, false, testBufferSize, testCacheSize)
+
+	query := "select col, sum(foo) * 2 as double_foo, count(*) + 1 from user group by col"
+	gotResult, err := executorExec(executor, query, nil)
+	require.NoError(t, err)
+
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select col, sum(foo), count(*) from user group by col order by col asc",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	for _, conn := range conns {
+		if !reflect.DeepEqual(conn.Queries, wantQueries) {
+			t.Errorf("conn.Queries = %#v, want %#v", conn.Queries, wantQueries)
+		}
+	}
+
+	wantResult := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "col", Type: sqltypes.Int32},
+			{Name: "double_foo", Type: sqltypes.Int64},
+			{Name: "count(*) + 1", Type: sqltypes.Int64},
+		},
+		RowsAffected: 4,
+		InsertID:     0,
+	}
+	for i := 0; i < 4; i++ {
+		row := []sqltypes.Value{
+			sqltypes.NewInt32(int32(i)),
+			sqltypes.NewInt64(int64(i*4 + 8)),
+			sqltypes.NewInt64(5),
+		}
+		wantResult.Rows = append(wantResult.Rows, row)
+	}
+	if !reflect.DeepEqual(gotResult, wantResult) {
+		t.Errorf("scatter aggregate expression:\n%v, want\n%v", gotResult, wantResult)
+	}
+}
+
 // TestSelectScatterLimit will run a limit query (ordered for consistency) against
 // a scatter route and verify that the limit primitive works as intended.
 func TestSelectScatterLimit(t *testing.T) {
@@ -1651,7 +1720,7 @@ func TestVarJoin(t *testing.T) {
 	}
 	// We have to use string representation because bindvars type is too complex.
 	got := fmt.Sprintf("%+v", sbc2.Queries)
-	want := `[sql:"select u2.id from user as u2 where u2.id = :u1_col" bind_variables:<key:"u1_col" value:<type:INT32 value:"3" > > ]`
+	want := `[sql:"select u2.id from user as u2 where u2.id in ::__vals" bind_variables:<key:"__vals" value:<type:TUPLE values:<type:INT32 value:"3" > > > bind_variables:<key:"u1_col_list" value:<type:TUPLE values:<type:INT32 value:"3" > > > ]`
 	if got != want {
 		t.Errorf("sbc2.Queries: %s, want %s\n", got, want)
 	}
@@ -1689,7 +1758,7 @@ func TestVarJoinStream(t *testing.T) {
 	}
 	// We have to use string representation because bindvars type is too complex.
 	got := fmt.Sprintf("%+v", sbc2.Queries)
-	want := `[sql:"select u2.id from user as u2 where u2.id = :u1_col" bind_variables:<key:"u1_col" value:<type:INT32 value:"3" > > ]`
+	want := `[sql:"select u2.id from user as u2 where u2.id in ::__vals" bind_variables:<key:"__vals" value:<type:TUPLE values:<type:INT32 value:"3" > > > bind_variables:<key:"u1_col_list" value:<type:TUPLE values:<type:INT32 value:"3" > > > ]`
 	if got != want {
 		t.Errorf("sbc2.Queries: %s, want %s\n", got, want)
 	}
@@ -1806,7 +1875,7 @@ func TestEmptyJoin(t *testing.T) {
 	}, {
 		Sql: "select u2.id from user as u2 where 1 != 1",
 		BindVariables: map[string]*querypb.BindVariable{
-			"u1_col": sqltypes.NullBindVariable,
+			"u1_col_list": {Type: querypb.Type_TUPLE, Values: []*querypb.Value{{}}},
 		},
 	}}
 	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
@@ -1844,7 +1913,7 @@ func TestEmptyJoinStream(t *testing.T) {
 	}, {
 		Sql: "select u2.id from user as u2 where 1 != 1",
 		BindVariables: map[string]*querypb.BindVariable{
-			"u1_col": sqltypes.NullBindVariable,
+			"u1_col_list": {Type: querypb.Type_TUPLE, Values: []*querypb.Value{{}}},
 		},
 	}}
 	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
@@ -1889,7 +1958,7 @@ func TestEmptyJoinRecursive(t *testing.T) {
 	}, {
 		Sql: "select u3.id from user as u3 where 1 != 1",
 		BindVariables: map[string]*querypb.BindVariable{
-			"u2_col": sqltypes.NullBindVariable,
+			"u2_col_list": {Type: querypb.Type_TUPLE, Values: []*querypb.Value{{}}},
 		},
 	}}
 	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
@@ -1935,7 +2004,7 @@ func TestEmptyJoinRecursiveStream(t *testing.T) {
 	}, {
 		Sql: "select u3.id from user as u3 where 1 != 1",
 		BindVariables: map[string]*querypb.BindVariable{
-			"u2_col": sqltypes.NullBindVariable,
+			"u2_col_list": {Type: querypb.Type_TUPLE, Values: []*querypb.Value{{}}},
 		},
 	}}
 	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
@@ -1968,6 +2037,16 @@ func TestCrossShardSubquery(t *testing.T) {
 		}},
 	}}
 	sbc1.SetResults(result1)
+	// The rows of the RHS are matched on u2.id.
+	sbc2.SetResults([]*sqltypes.Result{{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int32},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt32(3),
+		}},
+	}})
 	result, err := executorExec(executor, "select id1 from (select u1.id id1, u2.id from user u1 join user u2 on u2.id = u1.col where u1.id = 1) as t", nil)
 	require.NoError(t, err)
 	wantQueries := []*querypb.BoundQuery{{
@@ -1979,7 +2058,7 @@ func TestCrossShardSubquery(t *testing.T) {
 	}
 	// We have to use string representation because bindvars type is too complex.
 	got := fmt.Sprintf("%+v", sbc2.Queries)
-	want := `[sql:"select u2.id from user as u2 where u2.id = :u1_col" bind_variables:<key:"u1_col" value:<type:INT32 value:"3" > > ]`
+	want := `[sql:"select u2.id from user as u2 where u2.id in ::__vals" bind_variables:<key:"__vals" value:<type:TUPLE values:<type:INT32 value:"3" > > > bind_variables:<key:"u1_col_list" value:<type:TUPLE values:<type:INT32 value:"3" > > > ]`
 	if got != want {
 		t.Errorf("sbc2.Queries: %s, want %s\n", got, want)
 	}
@@ -2013,6 +2092,16 @@ func TestCrossShardSubqueryStream(t *testing.T) {
 		}},
 	}}
 	sbc1.SetResults(result1)
+	// The rows of the RHS are matched on u2.id.
+	sbc2.SetResults([]*sqltypes.Result{{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int32},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt32(3),
+		}},
+	}})
 	result, err := executorStream(executor, "select id1 from (select u1.id id1, u2.id from user u1 join user u2 on u2.id = u1.col where u1.id = 1) as t")
 	require.NoError(t, err)
 	wantQueries := []*querypb.BoundQuery{{
@@ -2024,7 +2113,7 @@ func TestCrossShardSubqueryStream(t *testing.T) {
 	}
 	// We have to use string representation because bindvars type is too complex.
 	got := fmt.Sprintf("%+v", sbc2.Queries)
-	want := `[sql:"select u2.id from user as u2 where u2.id = :u1_col" bind_variables:<key:"u1_col" value:<type:INT32 value:"3" > > ]`
+	want := `[sql:"select u2.id from user as u2 where u2.id in ::__vals" bind_variables:<key:"__vals" value:<type:TUPLE values:<type:INT32 value:"3" > > > bind_variables:<key:"u1_col_list" value:<type:TUPLE values:<type:INT32 value:"3" > > > ]`
 	if got != want {
 		t.Errorf("sbc2.Queries:\n%s, want\n%s\n", got, want)
 	}
@@ -2064,7 +2153,7 @@ func TestCrossShardSubqueryGetFields(t *testing.T) {
 	}, {
 		Sql: "select u2.id from user as u2 where 1 != 1",
 		BindVariables: map[string]*querypb.BindVariable{
-			"u1_col": sqltypes.NullBindVariable,
+			"u1_col_list": {Type: querypb.Type_TUPLE, Values: []*querypb.Value{{}}},
 		},
 	}}
 	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
@@ -214,6 +214,42 @@ func TestDirectTargetRewrites(t *testing.T) {
 	}})
 }
 
+func TestExecutorSavepoint(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+	ctx := context.Background()
+
+	for _, sql := range []string{
+		"begin",
+		"select id from user where id = 1",
+		"savepoint a",
+		"select id from user where id = 3",
+		"rollback to savepoint a",
+		"release savepoint a",
+	} {
+		_, err := executor.Execute(ctx, "TestExecute", session, sql, nil)
+		require.NoError(t, err, sql)
+	}
+	require.Equal(t, []string{
+		"select id from user where id = 1",
+		"savepoint a",
+		"rollback to a",
+		"release savepoint a",
+	}, boundQueriesSQL(sbc1.Queries))
+	// sbc2 joined the transaction after the savepoint was set.
+	require.Len(t, sbc2.BatchQueries, 1)
+	require.Equal(t, []string{"savepoint a"}, boundQueriesSQL(sbc2.BatchQueries[0]))
+	require.Equal(t, []string{
+		"select id from user where id = 3",
+		"rollback to a",
+		"release savepoint a",
+	}, boundQueriesSQL(sbc2.Queries))
+	require.Empty(t, session.Savepoints)
+
+	_, err := executor.Execute(ctx, "TestExecute", session, "rollback to b", nil)
+	require.EqualError(t, err, "SAVEPOINT b does not exist")
+}
+
 func TestExecutorTransactionsAutoCommit(t *testing.T) {
 	executor, _, _, sbclookup := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
@@ -1874,6 +1910,70 @@ func TestExecutorVindexDDLNewKeyspace(t *testing.T) {
 
 }
 
+func TestExecutorRoutingRulesDDL(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	waitForRoutingRules := func(want []*vschemapb.RoutingRule) {
+		t.Helper()
+		for i := 0; i < 10; i++ {
+			if proto.Equal(executor.vm.GetCurrentSrvVschema().RoutingRules, &vschemapb.RoutingRules{Rules: want}) {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+		t.Fatalf("updated vschema did not contain routing rules %v", want)
+	}
+
+	stmt := "alter vschema add routing rule user to TestExecutor.user"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	stmt = "alter vschema add routing rule `TestXBadSharding.user@replica` to TestExecutor.user, TestUnsharded.user"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	waitForRoutingRules([]*vschemapb.RoutingRule{{
+		FromTable: "user",
+		ToTables:  []string{"TestExecutor.user"},
+	}, {
+		FromTable: "TestXBadSharding.user@replica",
+		ToTables:  []string{"TestExecutor.user", "TestUnsharded.user"},
+	}})
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema routing rules", nil)
+	require.NoError(t, err)
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("From", "To"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow("user", "TestExecutor.user"),
+			buildVarCharRow("TestXBadSharding.user@replica", "TestExecutor.user, TestUnsharded.user"),
+		},
+		RowsAffected: 2,
+	}
+	assert.Equal(t, wantqr, qr)
+
+	stmt = "alter vschema add routing rule user to TestUnsharded.user"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	assert.EqualError(t, err, "routing rule for user already exists")
+	stmt = "alter vschema add routing rule music to music"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	assert.EqualError(t, err, "table music must be qualified")
+
+	stmt = "alter vschema drop routing rule user"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	waitForRoutingRules([]*vschemapb.RoutingRule{{
+		FromTable: "TestXBadSharding.user@replica",
+		ToTables:  []string{"TestExecutor.user", "TestUnsharded.user"},
+	}})
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	assert.EqualError(t, err, "routing rule for user does not exist")
+}
+
 func TestExecutorVindexDDLACL(t *testing.T) {
 	executor, _, _, _ := createExecutorEnv()
 	ks := "TestExecutor"
@@ -0,0 +1,206 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/log"
+	"github.com/xsec-lab/go/vt/vtgate/vindexes"
+
+	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
+)
+
+// lookupCacheRetryDelay is how long the invalidator waits
+// before restarting a stream that failed.
+var lookupCacheRetryDelay = 5 * time.Second
+
+// lookupCacheInvalidator keeps the caches of the lookup vindexes
+// consistent with their lookup tables. It streams the changes of
+// the lookup tables from the masters, and invalidates the cached
+// results of the rows that were changed.
+type lookupCacheInvalidator struct {
+	ctx context.Context
+	vsm *vstreamManager
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+func newLookupCacheInvalidator(ctx context.Context, vsm *vstreamManager) *lookupCacheInvalidator {
+	return &lookupCacheInvalidator{
+		ctx: ctx,
+		vsm: vsm,
+	}
+}
+
+// startLookupCacheInvalidation makes the executor invalidate the
+// caches of the lookup vindexes of every new vschema.
+func (e *Executor) startLookupCacheInvalidation(ctx context.Context, vsm *vstreamManager) {
+	lci := newLookupCacheInvalidator(ctx, vsm)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.lookupCaches = lci
+	lci.watch(e.vschema)
+}
+
+// lookupCacheTables is a lookup table, qualified by its
+// keyspace, mapped to the caches of its vindexes.
+type lookupCacheTables map[string][]*vindexes.LookupCache
+
+// watch stops the streams of the previous vschema, and starts
+// streaming the lookup tables of the cached vindexes of the new one.
+// The caches of a new vschema start empty, because its vindexes
+// are new instances.
+func (lci *lookupCacheInvalidator) watch(vschema *vindexes.VSchema) {
+	lci.mu.Lock()
+	defer lci.mu.Unlock()
+	if lci.cancel != nil {
+		lci.cancel()
+		lci.cancel = nil
+	}
+	if vschema == nil {
+		return
+	}
+	keyspaces := lookupCachesByKeyspace(vschema)
+	if len(keyspaces) == 0 {
+		return
+	}
+	var ctx context.Context
+	ctx, lci.cancel = context.WithCancel(lci.ctx)
+	for keyspace, tables := range keyspaces {
+		go lci.stream(ctx, keyspace, tables)
+	}
+}
+
+// lookupCachesByKeyspace returns the caches of the lookup vindexes
+// of a vschema, grouped by the keyspace of their lookup tables.
+func lookupCachesByKeyspace(vschema *vindexes.VSchema) map[string]lookupCacheTables {
+	keyspaces := make(map[string]lookupCacheTables)
+	for _, ks := range vschema.Keyspaces {
+		for vname, vindex := range ks.Vindexes {
+			cl, ok := vindex.(vindexes.CachedLookup)
+			if !ok || cl.LookupCache() == nil {
+				continue
+			}
+			lc := cl.LookupCache()
+			table, _ := lc.Source()
+			keyspace := ""
+			if i := strings.Index(table, "."); i != -1 {
+				keyspace, table = table[:i], table[i+1:]
+			} else {
+				t, err := vschema.FindTable("", table)
+				if err != nil {
+					log.Warningf("Not invalidating the cache of vindex %s: %v", vname, err)
+					continue
+				}
+				keyspace = t.Keyspace.Name
+			}
+			if keyspaces[keyspace] == nil {
+				keyspaces[keyspace] = make(lookupCacheTables)
+			}
+			qualified := keyspace + "." + table
+			keyspaces[keyspace][qualified] = append(keyspaces[keyspace][qualified], lc)
+		}
+	}
+	return keyspaces
+}
+
+// stream streams the changes of the lookup tables of a keyspace
+// until the context is canceled. If the stream fails, the caches
+// are cleared, because they could have missed changes, and the
+// stream is restarted from the current position.
+func (lci *lookupCacheInvalidator) stream(ctx context.Context, keyspace string, tables lookupCacheTables) {
+	filter := &binlogdatapb.Filter{}
+	for table := range tables {
+		filter.Rules = append(filter.Rules, &binlogdatapb.Rule{
+			Match: strings.TrimPrefix(table, keyspace+"."),
+		})
+	}
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: keyspace,
+			Gtid:     "current",
+		}},
+	}
+	for {
+		fields := make(map[string][]*querypb.Field)
+		err := lci.vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, filter, func(events []*binlogdatapb.VEvent) error {
+			for _, event := range events {
+				switch event.Type {
+				case binlogdatapb.VEventType_FIELD:
+					fields[event.FieldEvent.TableName] = event.FieldEvent.Fields
+				case binlogdatapb.VEventType_ROW:
+					invalidateRows(tables[event.RowEvent.TableName], fields[event.RowEvent.TableName], event.RowEvent)
+				}
+			}
+			return nil
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warningf("Lookup cache invalidation stream of keyspace %s failed, retrying in %v: %v", keyspace, lookupCacheRetryDelay, err)
+		for _, caches := range tables {
+			for _, lc := range caches {
+				lc.Clear()
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(lookupCacheRetryDelay):
+		}
+	}
+}
+
+// invalidateRows invalidates the cached results of the
+// values of the rows before and after they were changed.
+func invalidateRows(caches []*vindexes.LookupCache, fields []*querypb.Field, rowEvent *binlogdatapb.RowEvent) {
+	for _, lc := range caches {
+		_, column := lc.Source()
+		colnum := -1
+		for i, field := range fields {
+			if strings.EqualFold(field.Name, column) {
+				colnum = i
+				break
+			}
+		}
+		if colnum == -1 {
+			// The changes can't be attributed to values.
+			lc.Clear()
+			continue
+		}
+		for _, change := range rowEvent.RowChanges {
+			for _, row := range []*querypb.Row{change.Before, change.After} {
+				if row == nil {
+					continue
+				}
+				values := sqltypes.MakeRowTrusted(fields, row)
+				if colnum < len(values) {
+					lc.Invalidate(values[colnum])
+				}
+			}
+		}
+	}
+}
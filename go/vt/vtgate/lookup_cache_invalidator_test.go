@@ -0,0 +1,147 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/discovery"
+	"github.com/xsec-lab/go/vt/vtgate/vindexes"
+
+	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
+	vschemapb "github.com/xsec-lab/go/vt/proto/vschema"
+)
+
+func TestLookupCacheInvalidation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_ = createSandbox(KsTestUnsharded)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc := hc.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	vschema, err := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			KsTestUnsharded: {
+				Vindexes: map[string]*vschemapb.Vindex{
+					"cached": {
+						Type: "lookup_hash",
+						Params: map[string]string{
+							"table":      "lkp",
+							"from":       "fromc",
+							"to":         "toc",
+							"cache_size": "10",
+						},
+					},
+					"uncached": {
+						Type: "lookup_hash",
+						Params: map[string]string{
+							"table": "lkp",
+							"from":  "fromc",
+							"to":    "toc",
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	lc := vschema.Keyspaces[KsTestUnsharded].Vindexes["cached"].(vindexes.CachedLookup).LookupCache()
+	result := &sqltypes.Result{}
+	for i := int64(1); i <= 3; i++ {
+		lc.Set(sqltypes.NewInt64(i), result, lc.Generation())
+	}
+
+	fields := []*querypb.Field{
+		{Name: "fromc", Type: sqltypes.Int64},
+		{Name: "toc", Type: sqltypes.Int64},
+	}
+	sbc.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "lkp", Fields: fields}},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{
+			TableName: "lkp",
+			RowChanges: []*binlogdatapb.RowChange{{
+				Before: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(10)}),
+				After:  sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewInt64(10)}),
+			}},
+		}},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+
+	lci := newLookupCacheInvalidator(ctx, vsm)
+	lci.watch(vschema)
+	defer lci.watch(nil)
+
+	for i := 0; ; i++ {
+		_, ok1 := lc.Get(sqltypes.NewInt64(1))
+		_, ok2 := lc.Get(sqltypes.NewInt64(2))
+		if !ok1 && !ok2 {
+			break
+		}
+		if i == 100 {
+			t.Fatal("the changed rows were not invalidated")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	_, ok := lc.Get(sqltypes.NewInt64(3))
+	assert.True(t, ok)
+}
+
+func TestLookupCachesByKeyspace(t *testing.T) {
+	vschema, err := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"qualified": {
+						Type:   "lookup",
+						Params: map[string]string{"table": "lookup.t1", "from": "c1", "to": "keyspace_id", "cache_size": "10"},
+					},
+					"unqualified": {
+						Type:   "lookup",
+						Params: map[string]string{"table": "t2", "from": "c2", "to": "keyspace_id", "cache_size": "10"},
+					},
+					"missing": {
+						Type:   "lookup",
+						Params: map[string]string{"table": "t3", "from": "c3", "to": "keyspace_id", "cache_size": "10"},
+					},
+				},
+			},
+			"lookup": {
+				Tables: map[string]*vschemapb.Table{
+					"t2": {},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	got := lookupCachesByKeyspace(vschema)
+	require.Len(t, got, 1)
+	require.Len(t, got["lookup"], 2)
+	assert.Len(t, got["lookup"]["lookup.t1"], 1)
+	assert.Len(t, got["lookup"]["lookup.t2"], 1)
+}
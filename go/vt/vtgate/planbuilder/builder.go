@@ -66,7 +66,9 @@ type builder interface {
 	PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error)
 
 	// MakeDistinct makes the primitive handle the distinct clause.
-	MakeDistinct() error
+	// It returns the current primitive or a replacement if a new
+	// one was created.
+	MakeDistinct() (builder, error)
 	// PushGroupBy makes the primitive handle the GROUP BY clause.
 	PushGroupBy(sqlparser.GroupBy) error
 
@@ -302,6 +304,8 @@ func BuildFromStmt(query string, stmt sqlparser.Statement, vschema ContextVSchem
 		return nil, errors.New("unsupported construct: commit")
 	case *sqlparser.Rollback:
 		return nil, errors.New("unsupported construct: rollback")
+	case *sqlparser.Savepoint, *sqlparser.SRollback, *sqlparser.Release:
+		return nil, errors.New("unsupported construct: savepoint")
 	default:
 		return nil, fmt.Errorf("BUG: unexpected statement type: %T", stmt)
 	}
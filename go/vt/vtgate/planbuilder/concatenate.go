@@ -0,0 +1,207 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/vtgate/engine"
+)
+
+var _ builder = (*concatenate)(nil)
+
+// concatenate is the builder for engine.Concatenate.
+// This gets built for a UNION whose parts can't be merged
+// into a single route. The result columns are the ones of
+// the left side. For a UNION DISTINCT, a distinct is built
+// on top of the concatenate.
+type concatenate struct {
+	order    int
+	lhs, rhs builder
+}
+
+// newConcatenate builds a new concatenate.
+func newConcatenate(lhs, rhs builder) (*concatenate, error) {
+	// The number of columns returned for a '*' is not known.
+	if hasStarExpr(lhs) || hasStarExpr(rhs) {
+		return nil, errors.New("unsupported: '*' expression in cross-shard query")
+	}
+	if len(lhs.ResultColumns()) != len(rhs.ResultColumns()) {
+		return nil, errors.New("the used SELECT statements have a different number of columns")
+	}
+	c := &concatenate{
+		lhs: lhs,
+		rhs: rhs,
+	}
+	c.Reorder(0)
+	return c, nil
+}
+
+// Order satisfies the builder interface.
+func (c *concatenate) Order() int {
+	return c.order
+}
+
+// Reorder satisfies the builder interface.
+func (c *concatenate) Reorder(order int) {
+	c.lhs.Reorder(order)
+	c.rhs.Reorder(c.lhs.Order())
+	c.order = c.rhs.Order() + 1
+}
+
+// Primitive satisfies the builder interface.
+// Nested concatenates are flattened into a single one.
+func (c *concatenate) Primitive() engine.Primitive {
+	var sources []engine.Primitive
+	for _, bldr := range []builder{c.lhs, c.rhs} {
+		source := bldr.Primitive()
+		if concat, ok := source.(*engine.Concatenate); ok {
+			sources = append(sources, concat.Sources...)
+			continue
+		}
+		sources = append(sources, source)
+	}
+	return &engine.Concatenate{Sources: sources}
+}
+
+// First satisfies the builder interface.
+func (c *concatenate) First() builder {
+	return c.lhs.First()
+}
+
+// ResultColumns satisfies the builder interface.
+func (c *concatenate) ResultColumns() []*resultColumn {
+	return c.lhs.ResultColumns()
+}
+
+// PushFilter satisfies the builder interface.
+func (c *concatenate) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
+	return errors.New("concatenate.PushFilter: unreachable")
+}
+
+// PushSelect satisfies the builder interface.
+func (c *concatenate) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return nil, 0, errors.New("concatenate.PushSelect: unreachable")
+}
+
+// MakeDistinct satisfies the builder interface.
+func (c *concatenate) MakeDistinct() (builder, error) {
+	return newDistinct(c), nil
+}
+
+// PushGroupBy satisfies the builder interface.
+func (c *concatenate) PushGroupBy(_ sqlparser.GroupBy) error {
+	return errors.New("concatenate.PushGroupBy: unreachable")
+}
+
+// PushOrderBy satisfies the builder interface.
+// The ORDER BY of a UNION applies to the concatenated rows.
+// So, they're sorted in memory.
+func (c *concatenate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	// Treat order by null as nil order by.
+	if len(orderBy) == 1 {
+		if _, ok := orderBy[0].Expr.(*sqlparser.NullVal); ok {
+			orderBy = nil
+		}
+	}
+	if len(orderBy) == 0 {
+		return c, nil
+	}
+	return newMemorySort(c, orderBy)
+}
+
+// SetUpperLimit satisfies the builder interface.
+// Each side does not need to return more than the
+// requested number of rows.
+func (c *concatenate) SetUpperLimit(count *sqlparser.SQLVal) {
+	c.lhs.SetUpperLimit(count)
+	c.rhs.SetUpperLimit(count)
+}
+
+// PushMisc satisfies the builder interface.
+func (c *concatenate) PushMisc(sel *sqlparser.Select) {
+	c.lhs.PushMisc(sel)
+	c.rhs.PushMisc(sel)
+}
+
+// Wireup satisfies the builder interface.
+func (c *concatenate) Wireup(bldr builder, jt *jointab) error {
+	if err := c.rhs.Wireup(bldr, jt); err != nil {
+		return err
+	}
+	return c.lhs.Wireup(bldr, jt)
+}
+
+// SupplyVar satisfies the builder interface.
+func (c *concatenate) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	if from <= c.lhs.Order() {
+		c.lhs.SupplyVar(from, to, col, varname)
+		return
+	}
+	c.rhs.SupplyVar(from, to, col, varname)
+}
+
+// SupplyCol satisfies the builder interface.
+// The columns of a UNION can't be changed after it's built.
+func (c *concatenate) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	panic("BUG: concatenate.SupplyCol: unreachable")
+}
+
+// SupplyWeightString satisfies the builder interface.
+// The weight_string is requested from both sides, which
+// must return it as the same column.
+func (c *concatenate) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	lcol, err := c.lhs.SupplyWeightString(colNumber)
+	if err != nil {
+		return 0, err
+	}
+	rcol, err := c.rhs.SupplyWeightString(colNumber)
+	if err != nil {
+		return 0, err
+	}
+	if lcol != rcol {
+		return 0, errors.New("unsupported: UNION with different weight_string columns")
+	}
+	return lcol, nil
+}
+
+// hasStarExpr returns true if the builder is a route that
+// has a '*' expression in its select list.
+func hasStarExpr(bldr builder) bool {
+	rb, ok := bldr.(*route)
+	if !ok {
+		return false
+	}
+	return selectHasStarExpr(rb.Select)
+}
+
+func selectHasStarExpr(stmt sqlparser.SelectStatement) bool {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		for _, expr := range stmt.SelectExprs {
+			if _, ok := expr.(*sqlparser.StarExpr); ok {
+				return true
+			}
+		}
+	case *sqlparser.Union:
+		return selectHasStarExpr(stmt.Left) || selectHasStarExpr(stmt.Right)
+	case *sqlparser.ParenSelect:
+		return selectHasStarExpr(stmt.Select)
+	}
+	return false
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+
+	"github.com/xsec-lab/go/vt/sqlparser"
+)
+
+// This file has functions to expand common table expressions.
+
+// cteScope is the set of common table expressions visible
+// to a statement. Inner scopes shadow the outer ones.
+type cteScope struct {
+	ctes  map[string]*sqlparser.CommonTableExpr
+	outer *cteScope
+}
+
+func (cs *cteScope) find(name sqlparser.TableIdent) *sqlparser.CommonTableExpr {
+	for ; cs != nil; cs = cs.outer {
+		if cte, ok := cs.ctes[name.String()]; ok {
+			return cte
+		}
+	}
+	return nil
+}
+
+// expandCTEs replaces every reference to a common table expression
+// with the equivalent derived table, and removes the WITH clauses.
+// This lets the rest of the planner treat a CTE like a derived table:
+// if it targets the same shard as the outer query, the two will be
+// merged into a single route.
+func expandCTEs(stmt sqlparser.SelectStatement) error {
+	return expandSelectStatement(stmt, nil)
+}
+
+func expandSelectStatement(stmt sqlparser.SelectStatement, scope *cteScope) error {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		scope, err := newCTEScope(stmt.With, scope)
+		if err != nil {
+			return err
+		}
+		stmt.With = nil
+		if err := expandTableExprs(stmt.From, scope); err != nil {
+			return err
+		}
+		// Subqueries outside the FROM clause can also reference the CTEs.
+		return expandSubqueries(scope,
+			stmt.SelectExprs,
+			stmt.Where,
+			stmt.GroupBy,
+			stmt.Having,
+			stmt.OrderBy,
+		)
+	case *sqlparser.Union:
+		scope, err := newCTEScope(stmt.With, scope)
+		if err != nil {
+			return err
+		}
+		stmt.With = nil
+		if err := expandSelectStatement(stmt.Left, scope); err != nil {
+			return err
+		}
+		if err := expandSelectStatement(stmt.Right, scope); err != nil {
+			return err
+		}
+		return expandSubqueries(scope, stmt.OrderBy)
+	case *sqlparser.ParenSelect:
+		return expandSelectStatement(stmt.Select, scope)
+	}
+	return fmt.Errorf("BUG: unexpected SELECT type: %T", stmt)
+}
+
+// newCTEScope builds the scope for the CTEs of a WITH clause. Each CTE
+// can reference the ones defined before it. CTEs are not recursive:
+// a reference to its own name is treated as a regular table.
+func newCTEScope(with sqlparser.With, outer *cteScope) (*cteScope, error) {
+	if len(with) == 0 {
+		return outer, nil
+	}
+	scope := &cteScope{
+		ctes:  make(map[string]*sqlparser.CommonTableExpr, len(with)),
+		outer: outer,
+	}
+	for _, cte := range with {
+		name := cte.Name.String()
+		if _, ok := scope.ctes[name]; ok {
+			return nil, fmt.Errorf("Not unique table/alias: '%s'", name)
+		}
+		if err := expandSelectStatement(cte.Subquery.Select, scope); err != nil {
+			return nil, err
+		}
+		if err := renameCTEColumns(cte); err != nil {
+			return nil, err
+		}
+		scope.ctes[name] = cte
+	}
+	return scope, nil
+}
+
+// renameCTEColumns applies the column list of a CTE, if any, as
+// aliases of the select expressions of the subquery.
+func renameCTEColumns(cte *sqlparser.CommonTableExpr) error {
+	if len(cte.Columns) == 0 {
+		return nil
+	}
+	sel := firstSelect(cte.Subquery.Select)
+	if len(sel.SelectExprs) != len(cte.Columns) {
+		return fmt.Errorf("column count of common table expression '%s' does not match its column list", cte.Name.String())
+	}
+	for i, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return fmt.Errorf("unsupported: column list with '%s' in common table expression '%s'", sqlparser.String(expr), cte.Name.String())
+		}
+		aliased.As = cte.Columns[i]
+	}
+	cte.Columns = nil
+	return nil
+}
+
+// expandTableExprs replaces the table references of the FROM clause
+// that name a CTE with a copy of its subquery.
+func expandTableExprs(tableExprs sqlparser.TableExprs, scope *cteScope) error {
+	for _, tableExpr := range tableExprs {
+		if err := expandTableExpr(tableExpr, scope); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func expandTableExpr(tableExpr sqlparser.TableExpr, scope *cteScope) error {
+	switch tableExpr := tableExpr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		switch expr := tableExpr.Expr.(type) {
+		case sqlparser.TableName:
+			if !expr.Qualifier.IsEmpty() {
+				return nil
+			}
+			cte := scope.find(expr.Name)
+			if cte == nil {
+				return nil
+			}
+			// Every reference gets its own copy of the subquery
+			// because the planner rewrites it in place.
+			sub, err := copySelectStatement(cte.Subquery.Select)
+			if err != nil {
+				return err
+			}
+			tableExpr.Expr = &sqlparser.Subquery{Select: sub}
+			if tableExpr.As.IsEmpty() {
+				tableExpr.As = expr.Name
+			}
+			tableExpr.Hints = nil
+			return nil
+		case *sqlparser.Subquery:
+			return expandSelectStatement(expr.Select, scope)
+		}
+		return nil
+	case *sqlparser.ParenTableExpr:
+		return expandTableExprs(tableExpr.Exprs, scope)
+	case *sqlparser.JoinTableExpr:
+		if err := expandTableExpr(tableExpr.LeftExpr, scope); err != nil {
+			return err
+		}
+		if err := expandTableExpr(tableExpr.RightExpr, scope); err != nil {
+			return err
+		}
+		return expandSubqueries(scope, tableExpr.Condition.On)
+	}
+	return fmt.Errorf("BUG: unexpected table expression type: %T", tableExpr)
+}
+
+// expandSubqueries expands the CTE references of all the
+// subqueries found in the specified nodes.
+func expandSubqueries(scope *cteScope, nodes ...sqlparser.SQLNode) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if sub, ok := node.(*sqlparser.Subquery); ok {
+			return false, expandSelectStatement(sub.Select, scope)
+		}
+		return true, nil
+	}, nodes...)
+}
+
+// copySelectStatement returns a deep copy of the statement.
+func copySelectStatement(stmt sqlparser.SelectStatement) (sqlparser.SelectStatement, error) {
+	copied, err := sqlparser.Parse(sqlparser.String(stmt))
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := copied.(sqlparser.SelectStatement)
+	if !ok {
+		return nil, fmt.Errorf("BUG: unexpected statement type: %T", copied)
+	}
+	return sel, nil
+}
+
+// firstSelect returns the first SELECT of a statement. It
+// determines the column names of a UNION.
+func firstSelect(stmt sqlparser.SelectStatement) *sqlparser.Select {
+	switch node := stmt.(type) {
+	case *sqlparser.Union:
+		return firstSelect(node.Left)
+	case *sqlparser.ParenSelect:
+		return firstSelect(node.Select)
+	}
+	return stmt.(*sqlparser.Select)
+}
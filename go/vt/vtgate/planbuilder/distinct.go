@@ -0,0 +1,126 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/vtgate/engine"
+)
+
+var _ builder = (*distinct)(nil)
+
+// distinct is the builder for engine.Distinct.
+// This gets built if the rows of a primitive that can't
+// handle the distinct clause by itself need to be
+// de-duplicated: a cross-shard join, a cross-shard
+// subquery, an aggregation, or a UNION that can't be
+// executed as a single route.
+type distinct struct {
+	resultsBuilder
+	edistinct *engine.Distinct
+}
+
+// newDistinct builds a new distinct that compares all
+// the result columns of the input.
+func newDistinct(bldr builder) *distinct {
+	edistinct := &engine.Distinct{}
+	d := &distinct{
+		resultsBuilder: newResultsBuilder(bldr, edistinct),
+		edistinct:      edistinct,
+	}
+	for i := range d.resultColumns {
+		d.edistinct.Cols = append(d.edistinct.Cols, i)
+	}
+	return d
+}
+
+// Primitive satisfies the builder interface.
+func (d *distinct) Primitive() engine.Primitive {
+	d.edistinct.Input = d.input.Primitive()
+	return d.edistinct
+}
+
+// PushFilter satisfies the builder interface.
+// The filter is applied before the rows are de-duplicated.
+// This is needed for the HAVING clause, which is pushed after
+// the distinct clause.
+func (d *distinct) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	return d.input.PushFilter(pb, filter, whereType, origin)
+}
+
+// PushSelect satisfies the builder interface.
+func (d *distinct) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return nil, 0, errors.New("distinct.PushSelect: unreachable")
+}
+
+// MakeDistinct satisfies the builder interface.
+func (d *distinct) MakeDistinct() (builder, error) {
+	return d, nil
+}
+
+// PushGroupBy satisfies the builder interface.
+// The group by clause is pushed after the distinct clause,
+// but the grouping is performed before the de-duplication.
+func (d *distinct) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	return d.input.PushGroupBy(groupBy)
+}
+
+// PushOrderBy satisfies the builder interface.
+// The engine primitive returns the rows in the order in which
+// they were received. So, the order by is pushed down.
+func (d *distinct) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	bldr, err := d.input.PushOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	d.input = bldr
+	return d, nil
+}
+
+// SetUpperLimit satisfies the builder interface.
+// This is a no-op because the limit applies to the de-duplicated
+// rows, which can't be known by the underlying primitive.
+func (d *distinct) SetUpperLimit(count *sqlparser.SQLVal) {
+}
+
+// Wireup satisfies the builder interface.
+// If text columns are detected, then the function modifies
+// the primitive to pull a corresponding weight_string from mysql and
+// compare those instead. This is because we currently don't have the
+// ability to mimic mysql's collation behavior.
+func (d *distinct) Wireup(bldr builder, jt *jointab) error {
+	for i, colNumber := range d.edistinct.Cols {
+		rc := d.resultColumns[colNumber]
+		if sqltypes.IsText(rc.column.typ) {
+			if weightcolNumber, ok := d.weightStrings[rc]; ok {
+				d.edistinct.Cols[i] = weightcolNumber
+				continue
+			}
+			weightcolNumber, err := d.input.SupplyWeightString(colNumber)
+			if err != nil {
+				return err
+			}
+			d.weightStrings[rc] = weightcolNumber
+			d.edistinct.Cols[i] = weightcolNumber
+			d.edistinct.TruncateColumnCount = len(d.resultColumns)
+		}
+	}
+	return d.input.Wireup(bldr, jt)
+}
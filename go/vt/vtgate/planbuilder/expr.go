@@ -72,10 +72,11 @@ type subqueryInfo struct {
 // external references.
 //
 // Once the target origin is identified, we have to verify that the subquery's
-// route can be merged with it. If it cannot, we fail the query. This is because
-// we don't have the ability to wire up subqueries through expression evaluation
-// primitives. Consequently, if the plan for a subquery comes out as a Join,
-// we can immediately error out.
+// route can be merged with it. If it cannot, the subquery is pulled out if it's
+// not correlated. A correlated subquery that can't be merged is executed through
+// a semi-join if allowSemiJoin is set and the expression is an EXISTS, NOT EXISTS
+// or IN construct. Otherwise, we fail the query. This is because we don't have
+// the ability to wire up subqueries through expression evaluation primitives.
 //
 // Since findOrigin can itself be called from within a subquery, it has to assume
 // that some of the external references may actually be pointing to an outer
@@ -84,7 +85,7 @@ type subqueryInfo struct {
 //
 // If an expression has no references to the current query, then the left-most
 // origin is chosen as the default.
-func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pulloutSubquery, origin builder, pushExpr sqlparser.Expr, err error) {
+func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr, allowSemiJoin bool) (pullouts []subqueryBuilder, origin builder, pushExpr sqlparser.Expr, err error) {
 	// highestOrigin tracks the highest origin referenced by the expression.
 	// Default is the First.
 	highestOrigin := pb.bldr.First()
@@ -164,6 +165,12 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 			continue
 		}
 		if sqi.origin != nil {
+			if allowSemiJoin && len(subqueries) == 1 {
+				if sj := pb.newSemiJoinForExpr(expr, sqi); sj != nil {
+					// The expression is fully handled by the semi-join.
+					return []subqueryBuilder{sj}, highestOrigin, nil, nil
+				}
+			}
 			return nil, nil, nil, errors.New("unsupported: cross-shard correlated subquery")
 		}
 
@@ -219,6 +226,38 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 	return pullouts, highestOrigin, expr, nil
 }
 
+// newSemiJoinForExpr returns a semiJoin for the correlated subquery
+// if expr is an EXISTS, NOT EXISTS or IN construct of it. For IN, the
+// left operand must be a column of the current query. It returns nil
+// if a semi-join can't be built for the expression.
+func (pb *primitiveBuilder) newSemiJoinForExpr(expr sqlparser.Expr, sqi subqueryInfo) *semiJoin {
+	switch expr := skipParenthesis(expr).(type) {
+	case *sqlparser.ExistsExpr:
+		if expr.Subquery == sqi.ast {
+			return newSemiJoin(engine.NormalSemiJoin, nil, sqi.bldr)
+		}
+	case *sqlparser.NotExpr:
+		if exists, ok := skipParenthesis(expr.Expr).(*sqlparser.ExistsExpr); ok && exists.Subquery == sqi.ast {
+			return newSemiJoin(engine.AntiSemiJoin, nil, sqi.bldr)
+		}
+	case *sqlparser.ComparisonExpr:
+		// NOT IN is not supported because of the way it treats NULL values.
+		if expr.Operator != sqlparser.InStr || expr.Right != sqi.ast {
+			return nil
+		}
+		col, ok := expr.Left.(*sqlparser.ColName)
+		if !ok {
+			return nil
+		}
+		// No error expected. The column was already resolved.
+		if _, isLocal, _ := pb.st.Find(col); !isLocal {
+			return nil
+		}
+		return newSemiJoin(engine.InSemiJoin, col, sqi.bldr)
+	}
+	return nil
+}
+
 func hasSubquery(node sqlparser.SQLNode) bool {
 	has := false
 	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
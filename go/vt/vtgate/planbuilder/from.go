@@ -38,6 +38,16 @@ func (pb *primitiveBuilder) processDMLTable(tableExprs sqlparser.TableExprs) (*r
 		return nil, errors.New("unsupported: multi-shard or vindex write statement")
 	}
 	ro := rb.routeOptions[0]
+	// Writes to a reference table go to its source.
+	if t := ro.vschemaTable; t != nil && t.Source != nil {
+		for _, cur := range rb.routeOptions {
+			if cur.vschemaTable == t.Source {
+				ro = cur
+				break
+			}
+		}
+		rb.routeOptions = []*routeOption{ro}
+	}
 	for _, sub := range ro.substitutions {
 		*sub.oldExpr = *sub.newExpr
 	}
@@ -156,7 +166,7 @@ func (pb *primitiveBuilder) processAliasedTable(tableExpr *sqlparser.AliasedTabl
 				})
 			}
 		}
-		vindexMaps, err := st.AddVSchemaTable(sqlparser.TableName{Name: tableExpr.As}, vschemaTables, rb)
+		vindexMaps, _, err := st.AddVSchemaTable(sqlparser.TableName{Name: tableExpr.As}, vschemaTables, rb)
 		if err != nil {
 			return err
 		}
@@ -209,7 +219,7 @@ func (pb *primitiveBuilder) buildTablePrimitive(tableExpr *sqlparser.AliasedTabl
 
 	rb, st := newRoute(sel)
 	pb.bldr, pb.st = rb, st
-	vindexMaps, err := st.AddVSchemaTable(alias, vschemaTables, rb)
+	vindexMaps, multiColVindexes, err := st.AddVSchemaTable(alias, vschemaTables, rb)
 	if err != nil {
 		return err
 	}
@@ -259,7 +269,7 @@ func (pb *primitiveBuilder) buildTablePrimitive(tableExpr *sqlparser.AliasedTabl
 		// set table name into route
 		eroute.TableName = vst.Name.String()
 
-		rb.routeOptions = append(rb.routeOptions, newRouteOption(rb, vst, sub, vindexMaps[i], eroute))
+		rb.routeOptions = append(rb.routeOptions, newRouteOption(rb, vst, sub, vindexMaps[i], multiColVindexes[i], eroute))
 	}
 	return nil
 }
@@ -358,7 +368,7 @@ func (pb *primitiveBuilder) mergeRoutes(rpb *primitiveBuilder, routeOptions []*r
 	if ajoin == nil {
 		return nil
 	}
-	pullouts, _, expr, err := pb.findOrigin(ajoin.Condition.On)
+	pullouts, _, expr, err := pb.findOrigin(ajoin.Condition.On, false)
 	if err != nil {
 		return err
 	}
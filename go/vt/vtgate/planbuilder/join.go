@@ -18,13 +18,18 @@ package planbuilder
 
 import (
 	"errors"
+	"flag"
 
+	"github.com/xsec-lab/go/sqltypes"
+	querypb "github.com/xsec-lab/go/vt/proto/query"
 	"github.com/xsec-lab/go/vt/sqlparser"
 	"github.com/xsec-lab/go/vt/vtgate/engine"
 )
 
 var _ builder = (*join)(nil)
 
+var joinBatchSize = flag.Int("join_batch_size", 100, "The number of LHS rows for which the RHS of a nested-loop join on an equality is executed at once, with the LHS values as an IN list. Batching is disabled if it's 0.")
+
 // join is used to build a Join primitive.
 // It's used to build a normal join or a left join
 // operation.
@@ -63,7 +68,25 @@ type join struct {
 	// Left and Right are the nodes for the join.
 	Left, Right builder
 
-	ejoin *engine.Join
+	// hashFilters are the equality conditions between the LHS and
+	// the RHS that will be used as the keys of a hash join. They are
+	// held back instead of being pushed into the RHS. If the join
+	// stops being eligible for a hash join, they are pushed down
+	// like any other filter, and a regular join is built.
+	hashFilters []*sqlparser.ComparisonExpr
+
+	// noHashJoin is set once the RHS depends on values from the LHS,
+	// which can only be supplied by a regular join.
+	noHashJoin bool
+
+	// batchFilter is the first equality condition between a column
+	// of the LHS and a column of the RHS that was pushed into the RHS.
+	// If it ends up being the only reference to the LHS, the join
+	// sends the LHS values to the RHS in batches as an IN list.
+	batchFilter *sqlparser.ComparisonExpr
+
+	ejoin  *engine.Join
+	ehjoin *engine.HashJoin
 }
 
 // newJoin makes a new join using the two planBuilder. ajoin can be nil
@@ -98,7 +121,7 @@ func newJoin(lpb, rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) error {
 			return errors.New("unsupported: join with USING(column_list) clause")
 		}
 	}
-	lpb.bldr = &join{
+	jb := &join{
 		weightStrings: make(map[*resultColumn]int),
 		Left:          lpb.bldr,
 		Right:         rpb.bldr,
@@ -107,8 +130,19 @@ func newJoin(lpb, rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) error {
 			Vars:   make(map[string]int),
 		},
 	}
+	lpb.bldr = jb
 	lpb.bldr.Reorder(0)
-	if ajoin == nil || opcode == engine.LeftJoin {
+	if ajoin == nil {
+		return nil
+	}
+	if opcode == engine.LeftJoin {
+		// The ON clause was already pushed into the RHS.
+		// It can still be used as the batch filter.
+		for _, filter := range splitAndExpression(nil, ajoin.Condition.On) {
+			if jb.setBatchFilter(filter) {
+				break
+			}
+		}
 		return nil
 	}
 	return lpb.pushFilter(ajoin.Condition.On, sqlparser.WhereStr)
@@ -129,6 +163,12 @@ func (jb *join) Reorder(order int) {
 
 // Primitive satisfies the builder interface.
 func (jb *join) Primitive() engine.Primitive {
+	if jb.ehjoin != nil {
+		jb.ehjoin.Left = jb.Left.Primitive()
+		jb.ehjoin.Right = jb.Right.Primitive()
+		jb.ehjoin.Cols = jb.ejoin.Cols
+		return jb.ehjoin
+	}
 	jb.ejoin.Left = jb.Left.Primitive()
 	jb.ejoin.Right = jb.Right.Primitive()
 	return jb.ejoin
@@ -147,12 +187,163 @@ func (jb *join) ResultColumns() []*resultColumn {
 // PushFilter satisfies the builder interface.
 func (jb *join) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
 	if jb.isOnLeft(origin.Order()) {
-		return jb.Left.PushFilter(pb, filter, whereType, origin)
+		if err := jb.Left.PushFilter(pb, filter, whereType, origin); err != nil {
+			return err
+		}
+		return jb.checkHashJoin(pb)
 	}
 	if jb.ejoin.Opcode == engine.LeftJoin {
 		return errors.New("unsupported: cross-shard left join and where clause")
 	}
-	return jb.Right.PushFilter(pb, filter, whereType, origin)
+	if whereType == sqlparser.WhereStr && jb.isHashFilter(pb, filter) {
+		jb.hashFilters = append(jb.hashFilters, filter.(*sqlparser.ComparisonExpr))
+		return nil
+	}
+	if whereType == sqlparser.WhereStr && jb.batchFilter == nil {
+		jb.setBatchFilter(filter)
+	}
+	if jb.dependsOnLeft(filter) {
+		if err := jb.disableHashJoin(pb); err != nil {
+			return err
+		}
+	}
+	if err := jb.Right.PushFilter(pb, filter, whereType, origin); err != nil {
+		return err
+	}
+	return jb.checkHashJoin(pb)
+}
+
+// isHashFilter returns true if the filter is an equality between a
+// column of the LHS and a column of the RHS that can be used as a hash
+// join key. This is only worth doing if both sides are scatter routes
+// and the filter would not improve the routing of the RHS. Otherwise,
+// sending one query per LHS row is cheaper.
+// The types of both columns must be known from the vschema, and be
+// hashable. Text values can't be hashed because their equality depends
+// on the collation, and the column types are unknown otherwise.
+func (jb *join) isHashFilter(pb *primitiveBuilder, filter sqlparser.Expr) bool {
+	if jb.noHashJoin || jb.ejoin.Opcode != engine.NormalJoin {
+		return false
+	}
+	comparison, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || comparison.Operator != sqlparser.EqualStr {
+		return false
+	}
+	lcol, rcol := jb.hashFilterCols(comparison)
+	if lcol == nil || rcol == nil {
+		return false
+	}
+	if !sqltypes.IsHashable(lcol.Metadata.(*column).typ) || !sqltypes.IsHashable(rcol.Metadata.(*column).typ) {
+		return false
+	}
+	if !isScatterRoute(jb.Left) || !isScatterRoute(jb.Right) {
+		return false
+	}
+	for _, ro := range jb.Right.(*route).routeOptions {
+		if opcode, _, _ := ro.computePlan(pb, filter); opcode != engine.SelectScatter {
+			return false
+		}
+	}
+	return true
+}
+
+// hashFilterCols returns the LHS and RHS columns of a hash filter.
+// It returns nil values if the filter does not compare a column
+// from the LHS with a column from the RHS.
+func (jb *join) hashFilterCols(comparison *sqlparser.ComparisonExpr) (lcol, rcol *sqlparser.ColName) {
+	left, ok := comparison.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	right, ok := comparison.Right.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	if !jb.isLocal(left) || !jb.isLocal(right) {
+		return nil, nil
+	}
+	leftOnLeft := jb.isOnLeft(left.Metadata.(*column).Origin().Order())
+	rightOnLeft := jb.isOnLeft(right.Metadata.(*column).Origin().Order())
+	switch {
+	case leftOnLeft && !rightOnLeft:
+		return left, right
+	case !leftOnLeft && rightOnLeft:
+		return right, left
+	}
+	return nil, nil
+}
+
+// isLocal returns true if the column originates
+// from the LHS or the RHS of the join.
+func (jb *join) isLocal(col *sqlparser.ColName) bool {
+	order := col.Metadata.(*column).Origin().Order()
+	return order >= jb.Left.First().Order() && order <= jb.Right.Order()
+}
+
+// dependsOnLeft returns true if the expression, once pushed into
+// the RHS, will need values from the LHS. Subqueries are
+// conservatively assumed to depend on the LHS.
+func (jb *join) dependsOnLeft(expr sqlparser.Expr) bool {
+	if hasSubquery(expr) {
+		return true
+	}
+	depends := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			if jb.isOnLeft(col.Metadata.(*column).Origin().Order()) {
+				depends = true
+				return false, nil
+			}
+		}
+		return true, nil
+	}, expr)
+	return depends
+}
+
+// checkHashJoin verifies that the join is still eligible for a hash
+// join after a filter was pushed down. If not, the hash filters are
+// pushed into the RHS.
+func (jb *join) checkHashJoin(pb *primitiveBuilder) error {
+	if len(jb.hashFilters) == 0 {
+		return nil
+	}
+	if isScatterRoute(jb.Left) && isScatterRoute(jb.Right) {
+		return nil
+	}
+	return jb.flushHashFilters(pb)
+}
+
+// disableHashJoin prevents the join from becoming a hash join
+// and pushes any pending hash filters into the RHS.
+func (jb *join) disableHashJoin(pb *primitiveBuilder) error {
+	jb.noHashJoin = true
+	return jb.flushHashFilters(pb)
+}
+
+func (jb *join) flushHashFilters(pb *primitiveBuilder) error {
+	filters := jb.hashFilters
+	jb.hashFilters = nil
+	for _, filter := range filters {
+		if err := jb.Right.PushFilter(pb, filter, sqlparser.WhereStr, jb.Right); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isScatterRoute returns true if the builder is a route
+// that can only be a scatter.
+func isScatterRoute(bldr builder) bool {
+	rb, ok := bldr.(*route)
+	if !ok {
+		return false
+	}
+	for _, ro := range rb.routeOptions {
+		if ro.eroute.Opcode != engine.SelectScatter {
+			return false
+		}
+	}
+	return true
 }
 
 // PushSelect satisfies the builder interface.
@@ -168,6 +359,11 @@ func (jb *join) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, or
 		if _, ok := expr.Expr.(*sqlparser.ColName); !ok && jb.ejoin.Opcode == engine.LeftJoin {
 			return nil, 0, errors.New("unsupported: cross-shard left join and column expressions")
 		}
+		if jb.dependsOnLeft(expr.Expr) {
+			if err := jb.disableHashJoin(pb); err != nil {
+				return nil, 0, err
+			}
+		}
 
 		rc, colNumber, err = jb.Right.PushSelect(pb, expr, origin)
 		if err != nil {
@@ -180,8 +376,9 @@ func (jb *join) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, or
 }
 
 // MakeDistinct satisfies the builder interface.
-func (jb *join) MakeDistinct() error {
-	return errors.New("unsupported: distinct on cross-shard join")
+// The rows of a join are de-duplicated by a distinct primitive.
+func (jb *join) MakeDistinct() (builder, error) {
+	return newDistinct(jb), nil
 }
 
 // PushGroupBy satisfies the builder interface.
@@ -283,6 +480,21 @@ func (jb *join) PushMisc(sel *sqlparser.Select) {
 
 // Wireup satisfies the builder interface.
 func (jb *join) Wireup(bldr builder, jt *jointab) error {
+	if len(jb.hashFilters) != 0 {
+		// The join columns must be supplied before the
+		// underlying queries are generated.
+		jb.ehjoin = &engine.HashJoin{Opcode: jb.ejoin.Opcode}
+		for _, filter := range jb.hashFilters {
+			lcol, rcol := jb.hashFilterCols(filter)
+			_, lnum := jb.Left.SupplyCol(lcol)
+			_, rnum := jb.Right.SupplyCol(rcol)
+			jb.ehjoin.LeftKeys = append(jb.ehjoin.LeftKeys, lnum)
+			jb.ehjoin.RightKeys = append(jb.ehjoin.RightKeys, rnum)
+		}
+	}
+	if jb.batchFilter != nil && jb.ehjoin == nil {
+		jb.setupBatch(jt)
+	}
 	err := jb.Right.Wireup(bldr, jt)
 	if err != nil {
 		return err
@@ -290,6 +502,82 @@ func (jb *join) Wireup(bldr builder, jt *jointab) error {
 	return jb.Left.Wireup(bldr, jt)
 }
 
+// setBatchFilter sets the filter as the batch filter if it's
+// an equality between a column of the LHS and a column of the RHS.
+func (jb *join) setBatchFilter(filter sqlparser.Expr) bool {
+	comparison, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || comparison.Operator != sqlparser.EqualStr {
+		return false
+	}
+	if lcol, rcol := jb.hashFilterCols(comparison); lcol == nil || rcol == nil {
+		return false
+	}
+	jb.batchFilter = comparison
+	return true
+}
+
+// isBatchableType returns false if the column type is known
+// from the vschema and its values can't be hashed.
+func isBatchableType(typ querypb.Type) bool {
+	return typ == sqltypes.Null || sqltypes.IsHashable(typ)
+}
+
+// setupBatch turns the join into a batched join if the RHS is a
+// route, and the batch filter is in its WHERE clause as the only
+// reference to the LHS.
+// The filter is changed into an IN condition on the list of LHS
+// values. If the route was using the filter to pick its shards,
+// it becomes a SelectIN. The rows are matched in memory, which
+// can't be done if the vschema type of either column isn't hashable.
+// Columns of unknown types are batched, and the engine falls back
+// to executing the RHS row by row if their values can't be hashed.
+// This must be done before the underlying queries are generated.
+func (jb *join) setupBatch(jt *jointab) {
+	if *joinBatchSize <= 0 {
+		return
+	}
+	rb, ok := jb.Right.(*route)
+	if !ok {
+		return
+	}
+	lcol, rcol := jb.hashFilterCols(jb.batchFilter)
+	if !isBatchableType(lcol.Metadata.(*column).typ) || !isBatchableType(rcol.Metadata.(*column).typ) {
+		return
+	}
+	found, onlyReference := false, true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.ComparisonExpr:
+			if node == jb.batchFilter {
+				found = true
+			}
+		case *sqlparser.ColName:
+			if !rb.isLocal(node) && node != lcol {
+				onlyReference = false
+				return false, nil
+			}
+		}
+		return true, nil
+	}, rb.Select)
+	if !found || !onlyReference {
+		return
+	}
+
+	listVar := jt.GenerateListVar(lcol)
+	jb.batchFilter.Left = rcol
+	jb.batchFilter.Operator = sqlparser.InStr
+	jb.batchFilter.Right = sqlparser.ListArg("::" + listVar)
+	rb.finalizeOptions()
+	if ro := rb.routeOptions[0]; ro.condition == sqlparser.Expr(lcol) {
+		ro.updateRoute(engine.SelectIN, ro.eroute.Vindex, jb.batchFilter)
+	}
+
+	_, jb.ejoin.LeftKey = jb.Left.SupplyCol(lcol)
+	_, jb.ejoin.RightKey = jb.Right.SupplyCol(rcol)
+	jb.ejoin.ListVar = listVar
+	jb.ejoin.BatchSize = *joinBatchSize
+}
+
 // SupplyVar satisfies the builder interface.
 func (jb *join) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
 	if !jb.isOnLeft(from) {
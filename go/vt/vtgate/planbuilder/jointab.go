@@ -47,27 +47,41 @@ func (jt *jointab) Procure(bldr builder, col *sqlparser.ColName, to int) string
 	from, joinVar := jt.Lookup(col)
 	// If joinVar is empty, generate a unique name.
 	if joinVar == "" {
-		suffix := ""
-		i := 0
-		for {
-			if !col.Qualifier.IsEmpty() {
-				joinVar = col.Qualifier.Name.CompliantName() + "_" + col.Name.CompliantName() + suffix
-			} else {
-				joinVar = col.Name.CompliantName() + suffix
-			}
-			if _, ok := jt.vars[joinVar]; !ok {
-				break
-			}
-			i++
-			suffix = strconv.Itoa(i)
-		}
-		jt.vars[joinVar] = struct{}{}
+		joinVar = jt.reserveVar(colVarName(col))
 		jt.refs[col.Metadata.(*column)] = joinVar
 	}
 	bldr.SupplyVar(from, to, col, joinVar)
 	return joinVar
 }
 
+// GenerateListVar generates a unique list var name for the
+// values of the specified column. It's used by joins that
+// send the values of multiple rows in a single query.
+func (jt *jointab) GenerateListVar(col *sqlparser.ColName) string {
+	return jt.reserveVar(colVarName(col) + "_list")
+}
+
+// reserveVar returns a name based on name that's not already
+// in use, and reserves it.
+func (jt *jointab) reserveVar(name string) string {
+	joinVar := name
+	for i := 1; ; i++ {
+		if _, ok := jt.vars[joinVar]; !ok {
+			break
+		}
+		joinVar = name + strconv.Itoa(i)
+	}
+	jt.vars[joinVar] = struct{}{}
+	return joinVar
+}
+
+func colVarName(col *sqlparser.ColName) string {
+	if !col.Qualifier.IsEmpty() {
+		return col.Qualifier.Name.CompliantName() + "_" + col.Name.CompliantName()
+	}
+	return col.Name.CompliantName()
+}
+
 // GenerateSubqueryVars generates substitution variable names for
 // a subquery. It returns two names based on: __sq, __sq_has_values.
 // The appropriate names can be used for substitution
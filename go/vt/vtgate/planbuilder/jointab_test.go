@@ -19,6 +19,8 @@ package planbuilder
 import (
 	"reflect"
 	"testing"
+
+	"github.com/xsec-lab/go/vt/sqlparser"
 )
 
 func TestGenerateSubqueryVars(t *testing.T) {
@@ -41,3 +43,19 @@ func TestGenerateSubqueryVars(t *testing.T) {
 		t.Errorf("jt.GenerateSubqueryVars: %v, want %v", combined, want)
 	}
 }
+
+func TestGenerateListVar(t *testing.T) {
+	jt := newJointab(map[string]struct{}{
+		"user_col_list": {},
+	})
+	col := &sqlparser.ColName{
+		Name:      sqlparser.NewColIdent("col"),
+		Qualifier: sqlparser.TableName{Name: sqlparser.NewTableIdent("user")},
+	}
+
+	got := []string{jt.GenerateListVar(col), jt.GenerateListVar(col)}
+	want := []string{"user_col_list1", "user_col_list2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("jt.GenerateListVar: %v, want %v", got, want)
+	}
+}
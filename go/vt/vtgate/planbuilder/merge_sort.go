@@ -70,8 +70,13 @@ func (ms *mergeSort) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExp
 }
 
 // MakeDistinct satisfies the builder interface.
-func (ms *mergeSort) MakeDistinct() error {
-	return ms.input.MakeDistinct()
+func (ms *mergeSort) MakeDistinct() (builder, error) {
+	bldr, err := ms.input.MakeDistinct()
+	if err != nil {
+		return nil, err
+	}
+	ms.input = bldr
+	return ms, nil
 }
 
 // PushGroupBy satisfies the builder interface.
@@ -53,10 +53,19 @@ var _ builder = (*orderedAggregate)(nil)
 //      Keys: []int{0, 1},
 //      Input: (Scatter Route with the order by request),
 //    }
+// If the query has the HASH_AGGREGATE comment directive, the builder
+// produces an engine.HashAggregate instead. The route is then not
+// asked to order the results, and the aggregation is done in memory.
 type orderedAggregate struct {
 	resultsBuilder
 	extraDistinct *sqlparser.ColName
 	eaggr         *engine.OrderedAggregate
+	// hash is true if the aggregation must be done
+	// by an engine.HashAggregate.
+	hash bool
+	// hashWeightStrings are the weight_string columns of the
+	// engine.HashAggregate. See its WeightStrings.
+	hashWeightStrings map[int]int
 }
 
 // checkAggregates analyzes the select expression for aggregates. If it determines
@@ -86,8 +95,12 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 	// The query has aggregates. We can proceed only
 	// if the underlying primitive is a route because
 	// we need the ability to push down group by and
-	// order by clauses.
+	// order by clauses. A distinct clause by itself
+	// is handled later by a distinct primitive.
 	if !isRoute {
+		if len(sel.GroupBy) == 0 && !nodeHasAggregates(sel.SelectExprs) {
+			return nil
+		}
 		return errors.New("unsupported: cross-shard query with aggregates")
 	}
 
@@ -134,6 +147,7 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 	pb.bldr = &orderedAggregate{
 		resultsBuilder: newResultsBuilder(rb, eaggr),
 		eaggr:          eaggr,
+		hash:           sqlparser.ExtractCommentDirectives(sel.Comments).IsSet(sqlparser.DirectiveHashAggregate),
 	}
 	pb.bldr.Reorder(0)
 	return nil
@@ -240,6 +254,16 @@ func findAlias(colname *sqlparser.ColName, selects sqlparser.SelectExprs) sqlpar
 // Primitive satisfies the builder interface.
 func (oa *orderedAggregate) Primitive() engine.Primitive {
 	oa.eaggr.Input = oa.input.Primitive()
+	if oa.hash {
+		return &engine.HashAggregate{
+			HasDistinct:         oa.eaggr.HasDistinct,
+			Aggregates:          oa.eaggr.Aggregates,
+			Keys:                oa.eaggr.Keys,
+			WeightStrings:       oa.hashWeightStrings,
+			TruncateColumnCount: oa.eaggr.TruncateColumnCount,
+			Input:               oa.eaggr.Input,
+		}
+	}
 	return oa.eaggr
 }
 
@@ -362,18 +386,27 @@ func (oa *orderedAggregate) needDistinctHandling(pb *primitiveBuilder, funcExpr
 	return !success, innerAliased, nil
 }
 
-func (oa *orderedAggregate) MakeDistinct() error {
-	for i, rc := range oa.resultColumns {
+// MakeDistinct satisfies the builder interface.
+func (oa *orderedAggregate) MakeDistinct() (builder, error) {
+	for _, rc := range oa.resultColumns {
 		// If the column origin is oa (and not the underlying route),
 		// it means that it's an aggregate function supplied by oa.
 		// So, the distinct 'operator' cannot be pushed down into the
-		// route.
+		// route. The aggregated rows are instead de-duplicated by
+		// a distinct primitive.
 		if rc.column.Origin() == oa {
-			return errors.New("unsupported: distinct cannot be combined with aggregate functions")
+			return newDistinct(oa), nil
 		}
+	}
+	for i := range oa.resultColumns {
 		oa.eaggr.Keys = append(oa.eaggr.Keys, i)
 	}
-	return oa.input.MakeDistinct()
+	bldr, err := oa.input.MakeDistinct()
+	if err != nil {
+		return nil, err
+	}
+	oa.input = bldr
+	return oa, nil
 }
 
 // PushGroupBy satisfies the builder interface.
@@ -428,6 +461,8 @@ func (oa *orderedAggregate) PushGroupBy(groupBy sqlparser.GroupBy) error {
 // 'select a, b, count(*) from t group by a, b order by b'
 // The following construct is not allowed:
 // 'select a, count(*) from t group by a order by count(*)'
+// If oa does a hash aggregation, nothing is pushed down, and the
+// requested order is applied by a memory sort after aggregation.
 func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
 	// Treat order by null as nil order by.
 	if len(orderBy) == 1 {
@@ -435,6 +470,12 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, err
 			orderBy = nil
 		}
 	}
+	if oa.hash {
+		if len(orderBy) == 0 {
+			return oa, nil
+		}
+		return newMemorySort(oa, orderBy)
+	}
 
 	// referenced tracks the keys referenced by the order by clause.
 	referenced := make([]bool, len(oa.eaggr.Keys))
@@ -507,7 +548,13 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, err
 }
 
 // SetUpperLimit satisfies the builder interface.
+// The limit cannot be pushed down for a hash aggregation because
+// the route results are not ordered by the grouping keys, which
+// could cause groups to be partially aggregated.
 func (oa *orderedAggregate) SetUpperLimit(count *sqlparser.SQLVal) {
+	if oa.hash {
+		return
+	}
 	oa.input.SetUpperLimit(count)
 }
 
@@ -521,22 +568,68 @@ func (oa *orderedAggregate) PushMisc(sel *sqlparser.Select) {
 // the primitive to pull a corresponding weight_string from mysql and
 // compare those instead. This is because we currently don't have the
 // ability to mimic mysql's collation behavior.
+// A hash aggregation also pulls the weight_string of the keys and of the
+// distinct aggregate if their type is not known to be hashable. It's
+// used for the values that turn out to be text.
 func (oa *orderedAggregate) Wireup(bldr builder, jt *jointab) error {
 	for i, colNumber := range oa.eaggr.Keys {
 		rc := oa.resultColumns[colNumber]
 		if sqltypes.IsText(rc.column.typ) {
-			if weightcolNumber, ok := oa.weightStrings[rc]; ok {
-				oa.eaggr.Keys[i] = weightcolNumber
-				continue
-			}
-			weightcolNumber, err := oa.input.SupplyWeightString(colNumber)
+			weightcolNumber, err := oa.supplyWeightString(rc, colNumber)
 			if err != nil {
 				return err
 			}
-			oa.weightStrings[rc] = weightcolNumber
 			oa.eaggr.Keys[i] = weightcolNumber
-			oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
+			continue
+		}
+		if oa.hash && !sqltypes.IsHashable(rc.column.typ) {
+			if err := oa.supplyHashWeightString(rc, colNumber); err != nil {
+				return err
+			}
+		}
+	}
+	if oa.hash {
+		for _, aggr := range oa.eaggr.Aggregates {
+			if aggr.Opcode != engine.AggregateCountDistinct && aggr.Opcode != engine.AggregateSumDistinct {
+				continue
+			}
+			rc := oa.input.ResultColumns()[aggr.Col]
+			if sqltypes.IsHashable(rc.column.typ) {
+				continue
+			}
+			if err := oa.supplyHashWeightString(rc, aggr.Col); err != nil {
+				return err
+			}
 		}
 	}
 	return oa.input.Wireup(bldr, jt)
 }
+
+// supplyWeightString returns the column of the weight_string
+// of the column, and asks the input for it if needed.
+func (oa *orderedAggregate) supplyWeightString(rc *resultColumn, colNumber int) (int, error) {
+	if weightcolNumber, ok := oa.weightStrings[rc]; ok {
+		return weightcolNumber, nil
+	}
+	weightcolNumber, err := oa.input.SupplyWeightString(colNumber)
+	if err != nil {
+		return 0, err
+	}
+	oa.weightStrings[rc] = weightcolNumber
+	oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
+	return weightcolNumber, nil
+}
+
+// supplyHashWeightString records the weight_string of the column
+// for the hash aggregation.
+func (oa *orderedAggregate) supplyHashWeightString(rc *resultColumn, colNumber int) error {
+	weightcolNumber, err := oa.supplyWeightString(rc, colNumber)
+	if err != nil {
+		return err
+	}
+	if oa.hashWeightStrings == nil {
+		oa.hashWeightStrings = make(map[int]int)
+	}
+	oa.hashWeightStrings[colNumber] = weightcolNumber
+	return nil
+}
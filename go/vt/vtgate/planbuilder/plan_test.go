@@ -162,6 +162,8 @@ func TestPlan(t *testing.T) {
 	testFile(t, "vindex_func_cases.txt", testOutputTempDir, vschema)
 	testFile(t, "wireup_cases.txt", testOutputTempDir, vschema)
 	testFile(t, "memory_sort_cases.txt", testOutputTempDir, vschema)
+	testFile(t, "window_cases.txt", testOutputTempDir, vschema)
+	testFile(t, "union_cases.txt", testOutputTempDir, vschema)
 }
 
 func TestOne(t *testing.T) {
@@ -169,6 +171,29 @@ func TestOne(t *testing.T) {
 	testFile(t, "onecase.txt", "", vschema)
 }
 
+func TestJoinBatchSize(t *testing.T) {
+	vschema := loadSchema(t, "schema_test.json")
+	defer func(size int) { *joinBatchSize = size }(*joinBatchSize)
+	query := "select user.col from user join user_extra on user.id = user_extra.col"
+
+	*joinBatchSize = 50
+	plan, err := Build(query, &vschemaWrapper{v: vschema})
+	require.NoError(t, err)
+	jn, ok := plan.Instructions.(*engine.Join)
+	require.True(t, ok, "%T is not a Join", plan.Instructions)
+	require.Equal(t, 50, jn.BatchSize)
+	require.Equal(t, "user_id_list", jn.ListVar)
+
+	// A batch size of 0 disables batching.
+	*joinBatchSize = 0
+	plan, err = Build(query, &vschemaWrapper{v: vschema})
+	require.NoError(t, err)
+	jn, ok = plan.Instructions.(*engine.Join)
+	require.True(t, ok, "%T is not a Join", plan.Instructions)
+	require.Equal(t, 0, jn.BatchSize)
+	require.Equal(t, map[string]int{"user_id": 1}, jn.Vars)
+}
+
 func loadSchema(t *testing.T, filename string) *vindexes.VSchema {
 	formal, err := vindexes.LoadFormal(locateFile(filename))
 	if err != nil {
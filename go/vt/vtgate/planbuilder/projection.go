@@ -0,0 +1,330 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/vtgate/engine"
+	"github.com/xsec-lab/go/vt/vtgate/evalengine"
+)
+
+var _ builder = (*projection)(nil)
+
+// projection is the builder for engine.Projection.
+// This gets built for select expressions that can't be pushed
+// down to a route because their inputs are produced by vtgate:
+// arithmetic on the results of a scatter aggregation, like
+// 'select count(*) * 2 from t', or expressions on the right side
+// of a cross-shard left join, like 'select coalesce(b.col, 0)'.
+// The inputs of such an expression are pushed down individually,
+// and the expression is evaluated by vtgate for every row.
+// The other select expressions are passed through.
+type projection struct {
+	resultsBuilder
+	eproj *engine.Projection
+}
+
+// newProjection builds a new projection that passes
+// through the existing result columns of the input.
+func newProjection(bldr builder) *projection {
+	eproj := &engine.Projection{}
+	pj := &projection{
+		resultsBuilder: newResultsBuilder(bldr, eproj),
+		eproj:          eproj,
+	}
+	for i, rc := range pj.resultColumns {
+		pj.eproj.Cols = append(pj.eproj.Cols, rc.alias.String())
+		pj.eproj.Exprs = append(pj.eproj.Exprs, &evalengine.Column{Offset: i})
+	}
+	return pj
+}
+
+// needsProjection returns true if the select expression can't be
+// pushed into bldr, but can be evaluated by a projection above it.
+func needsProjection(pb *primitiveBuilder, bldr builder, expr *sqlparser.AliasedExpr, origin builder) bool {
+	allowAggregates := false
+	switch bldr := bldr.(type) {
+	case *orderedAggregate:
+		if !nodeHasAggregates(expr.Expr) || isSupportedAggregate(expr.Expr) {
+			return false
+		}
+		allowAggregates = true
+	case *join:
+		if _, ok := expr.Expr.(*sqlparser.ColName); ok || !onLeftJoinRHS(bldr, origin) {
+			return false
+		}
+	default:
+		return false
+	}
+	_, err := evalengine.Convert(expr.Expr, func(node sqlparser.Expr) (int, bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			if _, isLocal, err := pb.st.Find(node); err != nil || !isLocal {
+				return 0, false, errors.New("column cannot be evaluated")
+			}
+			return 0, true, nil
+		case *sqlparser.FuncExpr:
+			if allowAggregates && node.IsAggregate() {
+				return 0, true, nil
+			}
+		}
+		return 0, false, nil
+	})
+	return err == nil
+}
+
+// isSupportedAggregate returns true if expr is an aggregate
+// function that can be computed by an orderedAggregate.
+func isSupportedAggregate(expr sqlparser.Expr) bool {
+	funcExpr, ok := expr.(*sqlparser.FuncExpr)
+	if !ok {
+		return false
+	}
+	_, ok = engine.SupportedAggregates[funcExpr.Name.Lowered()]
+	return ok
+}
+
+// onLeftJoinRHS returns true if origin is on the right
+// side of a left join within the join tree of bldr.
+func onLeftJoinRHS(bldr builder, origin builder) bool {
+	for {
+		jb, ok := bldr.(*join)
+		if !ok {
+			return false
+		}
+		if jb.isOnLeft(origin.Order()) {
+			bldr = jb.Left
+			continue
+		}
+		if jb.ejoin.Opcode == engine.LeftJoin {
+			return true
+		}
+		bldr = jb.Right
+	}
+}
+
+// Primitive satisfies the builder interface.
+func (pj *projection) Primitive() engine.Primitive {
+	pj.eproj.Input = pj.input.Primitive()
+	return pj.eproj
+}
+
+// PushFilter satisfies the builder interface.
+// The filter can't reference the evaluated expressions
+// because it's applied to the rows of the input.
+func (pj *projection) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	if origin == pj {
+		return errors.New("unsupported: filtering on results of cross-shard expressions")
+	}
+	return pj.input.PushFilter(pb, filter, whereType, origin)
+}
+
+// PushSelect satisfies the builder interface.
+// Expressions that the input can handle are pushed down and
+// passed through. The others are evaluated, after pushing down
+// the columns and the aggregate functions they reference.
+func (pj *projection) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	if !needsProjection(pb, pj.input, expr, origin) {
+		rc, colNumber, err = pj.input.PushSelect(pb, expr, origin)
+		if err != nil {
+			return nil, 0, err
+		}
+		return pj.addResultColumn(rc, &evalengine.Column{Offset: colNumber}), len(pj.resultColumns) - 1, nil
+	}
+	eexpr, err := evalengine.Convert(expr.Expr, func(node sqlparser.Expr) (int, bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			_, innerCol := pj.input.SupplyCol(node)
+			return innerCol, true, nil
+		case *sqlparser.FuncExpr:
+			if !node.IsAggregate() {
+				return 0, false, nil
+			}
+			_, innerCol, err := pj.input.PushSelect(pb, &sqlparser.AliasedExpr{Expr: node}, origin)
+			if err != nil {
+				return 0, false, err
+			}
+			return innerCol, true, nil
+		}
+		return 0, false, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rc = newResultColumn(expr, pj)
+	pj.eproj.Cols = append(pj.eproj.Cols, projectionColName(expr))
+	pj.eproj.Exprs = append(pj.eproj.Exprs, eexpr)
+	pj.resultColumns = append(pj.resultColumns, rc)
+	return rc, len(pj.resultColumns) - 1, nil
+}
+
+// projectionColName returns the name of the column
+// produced by an evaluated expression.
+func projectionColName(expr *sqlparser.AliasedExpr) string {
+	if !expr.As.IsEmpty() {
+		return expr.As.String()
+	}
+	return sqlparser.String(expr.Expr)
+}
+
+// addResultColumn adds a result column produced by eexpr.
+func (pj *projection) addResultColumn(rc *resultColumn, eexpr evalengine.Expr) *resultColumn {
+	pj.eproj.Cols = append(pj.eproj.Cols, rc.alias.String())
+	pj.eproj.Exprs = append(pj.eproj.Exprs, eexpr)
+	pj.resultColumns = append(pj.resultColumns, rc)
+	return rc
+}
+
+// inputColumn returns the column of the input that's passed
+// through as the specified column. ok is false if the column
+// is evaluated.
+func (pj *projection) inputColumn(colNumber int) (inputCol int, ok bool) {
+	col, ok := pj.eproj.Exprs[colNumber].(*evalengine.Column)
+	if !ok {
+		return 0, false
+	}
+	return col.Offset, true
+}
+
+// translateNumber translates a column number of the projection
+// into the corresponding column number of the input. ok is false
+// if the column is evaluated.
+func (pj *projection) translateNumber(val *sqlparser.SQLVal) (expr *sqlparser.SQLVal, ok bool, err error) {
+	num, err := ResultFromNumber(pj.resultColumns, val)
+	if err != nil {
+		return nil, false, err
+	}
+	inputCol, ok := pj.inputColumn(num)
+	if !ok {
+		return nil, false, nil
+	}
+	return sqlparser.NewIntVal([]byte(strconv.Itoa(inputCol + 1))), true, nil
+}
+
+// MakeDistinct satisfies the builder interface.
+// The evaluated expressions can't be de-duplicated by the input.
+func (pj *projection) MakeDistinct() (builder, error) {
+	return newDistinct(pj), nil
+}
+
+// PushGroupBy satisfies the builder interface.
+// The grouping is performed by the input, which means that
+// the evaluated expressions can't be referenced.
+func (pj *projection) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	if groupBy == nil {
+		return pj.input.PushGroupBy(nil)
+	}
+	inputGroupBy := make(sqlparser.GroupBy, 0, len(groupBy))
+	for _, expr := range groupBy {
+		switch node := expr.(type) {
+		case *sqlparser.ColName:
+			if node.Metadata.(*column).Origin() == pj {
+				return fmt.Errorf("unsupported: group by expression cannot reference a cross-shard expression: %v", sqlparser.String(node))
+			}
+		case *sqlparser.SQLVal:
+			inputExpr, ok, err := pj.translateNumber(node)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("unsupported: group by expression cannot reference a cross-shard expression: %v", sqlparser.String(node))
+			}
+			expr = inputExpr
+		}
+		inputGroupBy = append(inputGroupBy, expr)
+	}
+	return pj.input.PushGroupBy(inputGroupBy)
+}
+
+// PushOrderBy satisfies the builder interface.
+// If the order by references an evaluated expression, the rows
+// are sorted in memory after the projection. Otherwise, the
+// order by is pushed down.
+func (pj *projection) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	inputOrderBy := make(sqlparser.OrderBy, 0, len(orderBy))
+	postSort := false
+	for _, order := range orderBy {
+		switch expr := order.Expr.(type) {
+		case *sqlparser.ColName:
+			if expr.Metadata.(*column).Origin() == pj {
+				postSort = true
+			}
+		case *sqlparser.SQLVal:
+			inputExpr, ok, err := pj.translateNumber(expr)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				postSort = true
+				break
+			}
+			order = &sqlparser.Order{Expr: inputExpr, Direction: order.Direction}
+		}
+		inputOrderBy = append(inputOrderBy, order)
+	}
+	if postSort {
+		bldr, err := pj.input.PushOrderBy(nil)
+		if err != nil {
+			return nil, err
+		}
+		pj.input = bldr
+		return newMemorySort(pj, orderBy)
+	}
+	bldr, err := pj.input.PushOrderBy(inputOrderBy)
+	if err != nil {
+		return nil, err
+	}
+	pj.input = bldr
+	return pj, nil
+}
+
+// SupplyCol satisfies the builder interface.
+func (pj *projection) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	c := col.Metadata.(*column)
+	for i, rc := range pj.resultColumns {
+		if rc.column == c {
+			return rc, i
+		}
+	}
+	rc, inputCol := pj.input.SupplyCol(col)
+	pj.addResultColumn(rc, &evalengine.Column{Offset: inputCol})
+	return rc, len(pj.resultColumns) - 1
+}
+
+// SupplyWeightString satisfies the builder interface.
+func (pj *projection) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	rc := pj.resultColumns[colNumber]
+	if weightcolNumber, ok := pj.weightStrings[rc]; ok {
+		return weightcolNumber, nil
+	}
+	inputCol, ok := pj.inputColumn(colNumber)
+	if !ok {
+		return 0, errors.New("unsupported: cannot compare text results of a cross-shard expression")
+	}
+	inputWeightCol, err := pj.input.SupplyWeightString(inputCol)
+	if err != nil {
+		return 0, err
+	}
+	pj.addResultColumn(rc, &evalengine.Column{Offset: inputWeightCol})
+	weightcolNumber = len(pj.resultColumns) - 1
+	pj.weightStrings[rc] = weightcolNumber
+	return weightcolNumber, nil
+}
@@ -92,8 +92,13 @@ func (ps *pulloutSubquery) PushSelect(pb *primitiveBuilder, expr *sqlparser.Alia
 }
 
 // MakeDistinct satisfies the builder interface.
-func (ps *pulloutSubquery) MakeDistinct() error {
-	return ps.underlying.MakeDistinct()
+func (ps *pulloutSubquery) MakeDistinct() (builder, error) {
+	bldr, err := ps.underlying.MakeDistinct()
+	if err != nil {
+		return nil, err
+	}
+	ps.underlying = bldr
+	return ps, nil
 }
 
 // PushGroupBy satisfies the builder interface.
@@ -17,6 +17,7 @@ limitations under the License.
 package planbuilder
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -149,9 +150,9 @@ func (rb *route) PushAnonymous(expr sqlparser.SelectExpr) *resultColumn {
 }
 
 // MakeDistinct satisfies the builder interface.
-func (rb *route) MakeDistinct() error {
+func (rb *route) MakeDistinct() (builder, error) {
 	rb.Select.(*sqlparser.Select).Distinct = sqlparser.DistinctStr
-	return nil
+	return rb, nil
 }
 
 // PushGroupBy satisfies the builder interface.
@@ -261,6 +262,15 @@ func (rb *route) Wireup(bldr builder, jt *jointab) error {
 			}
 			ro.eroute.Values = []sqltypes.PlanValue{pv}
 			vals.Right = sqlparser.ListArg("::" + engine.ListVarName)
+		case sqlparser.ValTuple:
+			// The values of the leading columns of a multi-column vindex.
+			for _, val := range vals {
+				pv, err := rb.procureValues(bldr, jt, val)
+				if err != nil {
+					return err
+				}
+				ro.eroute.Values = append(ro.eroute.Values, pv)
+			}
 		case nil:
 			// no-op.
 		default:
@@ -423,11 +433,15 @@ func (rb *route) SupplyWeightString(colNumber int) (weightcolNumber int, err err
 	if weightcolNumber, ok := rb.weightStrings[rc]; ok {
 		return weightcolNumber, nil
 	}
+	sel, ok := rb.Select.(*sqlparser.Select)
+	if !ok {
+		return 0, errors.New("unsupported: weight_string on the results of a UNION")
+	}
 	expr := &sqlparser.AliasedExpr{
 		Expr: &sqlparser.FuncExpr{
 			Name: sqlparser.NewColIdent("weight_string"),
 			Exprs: []sqlparser.SelectExpr{
-				rb.Select.(*sqlparser.Select).SelectExprs[colNumber],
+				sel.SelectExprs[colNumber],
 			},
 		},
 	}
@@ -17,6 +17,7 @@ limitations under the License.
 package planbuilder
 
 import (
+	"github.com/xsec-lab/go/sqltypes"
 	"github.com/xsec-lab/go/vt/sqlparser"
 	"github.com/xsec-lab/go/vt/vtgate/engine"
 	"github.com/xsec-lab/go/vt/vtgate/vindexes"
@@ -39,6 +40,10 @@ type routeOption struct {
 	// for the routeOption.
 	vindexMap map[*column]vindexes.SingleColumn
 
+	// multiColVindexes are the multi-column vindexes that
+	// can be used for the routeOption.
+	multiColVindexes []*multiColVindex
+
 	// condition stores the AST condition that will be used
 	// to resolve the ERoute Values field.
 	condition sqlparser.Expr
@@ -51,6 +56,15 @@ type tableSubstitution struct {
 	newExpr, oldExpr *sqlparser.AliasedTableExpr
 }
 
+// multiColVindex is a multi-column vindex of a routeOption.
+// It accumulates the values of its columns that are
+// constrained by the equality filters.
+type multiColVindex struct {
+	vindex  vindexes.MultiColumn
+	columns []*column
+	values  []sqlparser.Expr
+}
+
 func newSimpleRouteOption(rb *route, eroute *engine.Route) *routeOption {
 	return &routeOption{
 		rb:     rb,
@@ -58,17 +72,18 @@ func newSimpleRouteOption(rb *route, eroute *engine.Route) *routeOption {
 	}
 }
 
-func newRouteOption(rb *route, vst *vindexes.Table, sub *tableSubstitution, vindexMap map[*column]vindexes.SingleColumn, eroute *engine.Route) *routeOption {
+func newRouteOption(rb *route, vst *vindexes.Table, sub *tableSubstitution, vindexMap map[*column]vindexes.SingleColumn, multiColVindexes []*multiColVindex, eroute *engine.Route) *routeOption {
 	var subs []*tableSubstitution
 	if sub != nil && sub.newExpr != nil {
 		subs = []*tableSubstitution{sub}
 	}
 	return &routeOption{
-		rb:            rb,
-		vschemaTable:  vst,
-		substitutions: subs,
-		vindexMap:     vindexMap,
-		eroute:        eroute,
+		rb:               rb,
+		vschemaTable:     vst,
+		substitutions:    subs,
+		vindexMap:        vindexMap,
+		multiColVindexes: multiColVindexes,
+		eroute:           eroute,
 	}
 }
 
@@ -95,6 +110,7 @@ func (ro *routeOption) MergeJoin(rro *routeOption, isLeftJoin bool) {
 		}
 		ro.vindexMap[c] = v
 	}
+	ro.multiColVindexes = append(ro.multiColVindexes, rro.multiColVindexes...)
 }
 
 // merge merges two routeOptions. If the LHS (ro) is a SelectReference,
@@ -136,6 +152,7 @@ func (ro *routeOption) SubqueryToTable(rb *route, vindexMap map[*column]vindexes
 	ro.rb = rb
 	ro.vschemaTable = nil
 	ro.vindexMap = vindexMap
+	ro.multiColVindexes = nil
 }
 
 func (ro *routeOption) canMerge(rro *routeOption, customCheck func() bool) bool {
@@ -203,7 +220,13 @@ func (ro *routeOption) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	case engine.SelectUnsharded, engine.SelectNext, engine.SelectDBA, engine.SelectReference:
 		return
 	}
-	opcode, vindex, values := ro.computePlan(pb, filter)
+	ro.updatePlan(ro.computePlan(pb, filter))
+	ro.updatePlan(ro.computeMultiColPlan(pb, filter))
+}
+
+// updatePlan updates the primitive with the specified
+// plan if it's an improvement.
+func (ro *routeOption) updatePlan(opcode engine.RouteOpcode, vindex vindexes.Vindex, values sqlparser.Expr) {
 	if opcode == engine.SelectScatter {
 		return
 	}
@@ -230,22 +253,43 @@ func (ro *routeOption) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 				ro.updateRoute(opcode, vindex, values)
 			}
 		}
-	case engine.SelectScatter:
+	case engine.SelectPrefix:
 		switch opcode {
 		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN:
 			ro.updateRoute(opcode, vindex, values)
+		case engine.SelectPrefix:
+			if vindex.Cost() < ro.eroute.Vindex.Cost() {
+				ro.updateRoute(opcode, vindex, values)
+			}
+		}
+	case engine.SelectRange:
+		switch opcode {
+		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectPrefix:
+			ro.updateRoute(opcode, vindex, values)
+		case engine.SelectRange:
+			// The bounds of the same vindex narrow the same range.
+			if vindex == ro.eroute.Vindex {
+				ro.updateRoute(opcode, vindex, mergeRanges(ro.condition.(sqlparser.ValTuple), values.(sqlparser.ValTuple)))
+			} else if vindex.Cost() < ro.eroute.Vindex.Cost() {
+				ro.updateRoute(opcode, vindex, values)
+			}
+		}
+	case engine.SelectScatter:
+		switch opcode {
+		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectPrefix, engine.SelectRange:
+			ro.updateRoute(opcode, vindex, values)
 		}
 	}
 }
 
-func (ro *routeOption) updateRoute(opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (ro *routeOption) updateRoute(opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	ro.eroute.Opcode = opcode
 	ro.eroute.Vindex = vindex
 	ro.condition = condition
 }
 
 // computePlan computes the plan for the specified filter.
-func (ro *routeOption) computePlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (ro *routeOption) computePlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	switch node := filter.(type) {
 	case *sqlparser.ComparisonExpr:
 		switch node.Operator {
@@ -253,6 +297,14 @@ func (ro *routeOption) computePlan(pb *primitiveBuilder, filter sqlparser.Expr)
 			return ro.computeEqualPlan(pb, node)
 		case sqlparser.InStr:
 			return ro.computeINPlan(pb, node)
+		case sqlparser.LikeStr:
+			return ro.computeLikePlan(pb, node)
+		case sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+			return ro.computeRangePlan(pb, node)
+		}
+	case *sqlparser.RangeCond:
+		if node.Operator == sqlparser.BetweenStr {
+			return ro.computeBetweenPlan(pb, node)
 		}
 	case *sqlparser.ParenExpr:
 		return ro.computePlan(pb, node.Expr)
@@ -261,7 +313,7 @@ func (ro *routeOption) computePlan(pb *primitiveBuilder, filter sqlparser.Expr)
 }
 
 // computeEqualPlan computes the plan for an equality constraint.
-func (ro *routeOption) computeEqualPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (ro *routeOption) computeEqualPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	left := comparison.Left
 	right := comparison.Right
 	vindex = ro.FindVindex(pb, left)
@@ -282,7 +334,7 @@ func (ro *routeOption) computeEqualPlan(pb *primitiveBuilder, comparison *sqlpar
 }
 
 // computeINPlan computes the plan for an IN constraint.
-func (ro *routeOption) computeINPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (ro *routeOption) computeINPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	vindex = ro.FindVindex(pb, comparison.Left)
 	if vindex == nil {
 		return engine.SelectScatter, nil, nil
@@ -301,6 +353,147 @@ func (ro *routeOption) computeINPlan(pb *primitiveBuilder, comparison *sqlparser
 	return engine.SelectScatter, nil, nil
 }
 
+// computeLikePlan computes the plan for a LIKE constraint. Only the
+// vindexes that can map a prefix to a key range can route it.
+// The prefixes are hashed as bytes, so the column must also be known
+// from the vschema to be binary. With any other collation, the LIKE
+// can match ids whose bytes start differently, like a different case.
+func (ro *routeOption) computeLikePlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
+	vindex = ro.FindVindex(pb, comparison.Left)
+	if vindex == nil {
+		return engine.SelectScatter, nil, nil
+	}
+	if _, ok := vindex.(vindexes.Prefixable); !ok {
+		return engine.SelectScatter, nil, nil
+	}
+	if col, ok := comparison.Left.(*sqlparser.ColName); !ok || !sqltypes.IsBinary(col.Metadata.(*column).typ) {
+		return engine.SelectScatter, nil, nil
+	}
+	if comparison.Escape != nil || !ro.exprIsValue(comparison.Right) {
+		return engine.SelectScatter, nil, nil
+	}
+	return engine.SelectPrefix, vindex, comparison.Right
+}
+
+// computeRangePlan computes the plan for a comparison that bounds
+// a column on one side. Only the vindexes that can map a range of
+// ids to a key range can route it. The condition is the tuple of
+// the lower and upper bounds, in which the missing bound is NULL.
+// Both bounds are treated as included, which can only widen the
+// key range.
+func (ro *routeOption) computeRangePlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
+	left := comparison.Left
+	right := comparison.Right
+	lower := comparison.Operator == sqlparser.GreaterThanStr || comparison.Operator == sqlparser.GreaterEqualStr
+	vindex = ro.FindVindex(pb, left)
+	if vindex == nil {
+		left, right = right, left
+		lower = !lower
+		vindex = ro.FindVindex(pb, left)
+		if vindex == nil {
+			return engine.SelectScatter, nil, nil
+		}
+	}
+	if _, ok := vindex.(vindexes.Rangeable); !ok {
+		return engine.SelectScatter, nil, nil
+	}
+	if !ro.exprIsValue(right) {
+		return engine.SelectScatter, nil, nil
+	}
+	if lower {
+		return engine.SelectRange, vindex, sqlparser.ValTuple{right, &sqlparser.NullVal{}}
+	}
+	return engine.SelectRange, vindex, sqlparser.ValTuple{&sqlparser.NullVal{}, right}
+}
+
+// computeBetweenPlan computes the plan for a BETWEEN constraint.
+// Only the vindexes that can map a range of ids to a key range
+// can route it.
+func (ro *routeOption) computeBetweenPlan(pb *primitiveBuilder, rangeCond *sqlparser.RangeCond) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
+	vindex = ro.FindVindex(pb, rangeCond.Left)
+	if vindex == nil {
+		return engine.SelectScatter, nil, nil
+	}
+	if _, ok := vindex.(vindexes.Rangeable); !ok {
+		return engine.SelectScatter, nil, nil
+	}
+	if !ro.exprIsValue(rangeCond.From) || !ro.exprIsValue(rangeCond.To) {
+		return engine.SelectScatter, nil, nil
+	}
+	return engine.SelectRange, vindex, sqlparser.ValTuple{rangeCond.From, rangeCond.To}
+}
+
+// mergeRanges returns the range of the bounds of both ranges. If both
+// have the same bound, the first one is kept. It can't be compared with
+// the other one before the values are known, and routing to the key
+// range of either one is correct because the query filters the rows.
+func mergeRanges(first, second sqlparser.ValTuple) sqlparser.ValTuple {
+	merged := make(sqlparser.ValTuple, len(first))
+	for i := range first {
+		merged[i] = first[i]
+		if _, ok := first[i].(*sqlparser.NullVal); ok {
+			merged[i] = second[i]
+		}
+	}
+	return merged
+}
+
+// computeMultiColPlan records the value of an equality constraint on
+// a column of a multi-column vindex, and computes the plan for the
+// values recorded so far. If all the columns have values, the vindex
+// maps them to a keyspace id. If only the leading columns have values,
+// a partial vindex maps them to a key range.
+func (ro *routeOption) computeMultiColPlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
+	if paren, ok := filter.(*sqlparser.ParenExpr); ok {
+		return ro.computeMultiColPlan(pb, paren.Expr)
+	}
+	comparison, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || comparison.Operator != sqlparser.EqualStr || len(ro.multiColVindexes) == 0 {
+		return engine.SelectScatter, nil, nil
+	}
+	left, right := comparison.Left, comparison.Right
+	c := ro.findColumn(pb, left)
+	if c == nil {
+		left, right = right, left
+		c = ro.findColumn(pb, left)
+	}
+	if c == nil || !ro.exprIsValue(right) {
+		return engine.SelectScatter, nil, nil
+	}
+
+	opcode = engine.SelectScatter
+	for _, mcv := range ro.multiColVindexes {
+		if mcv.values == nil {
+			mcv.values = make([]sqlparser.Expr, len(mcv.columns))
+		}
+		for i, col := range mcv.columns {
+			if col == c && mcv.values[i] == nil {
+				mcv.values[i] = right
+			}
+		}
+		var values sqlparser.ValTuple
+		for _, value := range mcv.values {
+			if value == nil {
+				break
+			}
+			values = append(values, value)
+		}
+		var mcvOpcode engine.RouteOpcode
+		switch {
+		case len(values) == len(mcv.columns) && mcv.vindex.IsUnique():
+			mcvOpcode = engine.SelectEqualUnique
+		case len(values) == len(mcv.columns), len(values) != 0 && mcv.vindex.PartialVindex():
+			mcvOpcode = engine.SelectEqual
+		default:
+			continue
+		}
+		if opcode == engine.SelectScatter || planCost[mcvOpcode] < planCost[opcode] {
+			opcode, vindex, condition = mcvOpcode, mcv.vindex, values
+		}
+	}
+	return opcode, vindex, condition
+}
+
 var planCost = map[engine.RouteOpcode]int{
 	engine.SelectUnsharded:   0,
 	engine.SelectNext:        0,
@@ -309,7 +502,9 @@ var planCost = map[engine.RouteOpcode]int{
 	engine.SelectEqualUnique: 1,
 	engine.SelectIN:          2,
 	engine.SelectEqual:       3,
-	engine.SelectScatter:     4,
+	engine.SelectPrefix:      4,
+	engine.SelectRange:       5,
+	engine.SelectScatter:     6,
 }
 
 func (ro *routeOption) isBetterThan(other *routeOption) bool {
@@ -320,7 +515,7 @@ func (ro *routeOption) isBetterThan(other *routeOption) bool {
 	}
 	if ropc == otherpc {
 		switch other.eroute.Opcode {
-		case engine.SelectEqualUnique, engine.SelectIN, engine.SelectEqual:
+		case engine.SelectEqualUnique, engine.SelectIN, engine.SelectEqual, engine.SelectPrefix, engine.SelectRange:
 			return ro.eroute.Vindex.Cost() < other.eroute.Vindex.Cost()
 		}
 	}
@@ -328,6 +523,16 @@ func (ro *routeOption) isBetterThan(other *routeOption) bool {
 }
 
 func (ro *routeOption) FindVindex(pb *primitiveBuilder, expr sqlparser.Expr) vindexes.SingleColumn {
+	c := ro.findColumn(pb, expr)
+	if c == nil {
+		return nil
+	}
+	return ro.vindexMap[c]
+}
+
+// findColumn returns the column of the routeOption
+// that the expression refers to, if any.
+func (ro *routeOption) findColumn(pb *primitiveBuilder, expr sqlparser.Expr) *column {
 	col, ok := expr.(*sqlparser.ColName)
 	if !ok {
 		return nil
@@ -342,7 +547,7 @@ func (ro *routeOption) FindVindex(pb *primitiveBuilder, expr sqlparser.Expr) vin
 	if c.Origin() != ro.rb {
 		return nil
 	}
-	return ro.vindexMap[c]
+	return c
 }
 
 // exprIsValue returns true if the expression can be treated as a value
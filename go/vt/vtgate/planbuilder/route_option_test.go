@@ -151,6 +151,38 @@ func TestIsBetterThan(t *testing.T) {
 		left:  engine.SelectEqual,
 		right: engine.SelectScatter,
 		out:   true,
+	}, {
+		left:  engine.SelectEqual,
+		right: engine.SelectPrefix,
+		out:   true,
+	}, {
+		left:  engine.SelectPrefix,
+		right: engine.SelectScatter,
+		out:   true,
+	}, {
+		left:      engine.SelectPrefix,
+		right:     engine.SelectPrefix,
+		leftcost:  1,
+		rightcost: 2,
+		out:       true,
+	}, {
+		left:  engine.SelectPrefix,
+		right: engine.SelectRange,
+		out:   true,
+	}, {
+		left:  engine.SelectRange,
+		right: engine.SelectScatter,
+		out:   true,
+	}, {
+		left:      engine.SelectRange,
+		right:     engine.SelectRange,
+		leftcost:  1,
+		rightcost: 2,
+		out:       true,
+	}, {
+		left:  engine.SelectScatter,
+		right: engine.SelectRange,
+		out:   false,
 	}, {
 		left:  engine.SelectScatter,
 		right: engine.SelectUnsharded,
@@ -26,6 +26,9 @@ import (
 
 // buildSelectPlan is the new function to build a Select plan.
 func buildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Primitive, error) {
+	if err := expandCTEs(sel); err != nil {
+		return nil, err
+	}
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
 	if err := pb.processSelect(sel, nil); err != nil {
 		return nil, err
@@ -102,6 +105,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
+	if err := pb.checkWindowFunctions(sel); err != nil {
+		return err
+	}
 	if err := pb.pushSelectExprs(sel); err != nil {
 		return err
 	}
@@ -127,7 +133,7 @@ func (pb *primitiveBuilder) pushFilter(in sqlparser.Expr, whereType string) erro
 	filters := splitAndExpression(nil, in)
 	reorderBySubquery(filters)
 	for _, filter := range filters {
-		pullouts, origin, expr, err := pb.findOrigin(filter)
+		pullouts, origin, expr, err := pb.findOrigin(filter, whereType == sqlparser.WhereStr)
 		if err != nil {
 			return err
 		}
@@ -162,8 +168,16 @@ func reorderBySubquery(filters []sqlparser.Expr) {
 	}
 }
 
+// subqueryBuilder is a builder that executes a subquery that
+// could not be merged, and gets layered on top of the builder
+// of the outer query: a pulloutSubquery or a semiJoin.
+type subqueryBuilder interface {
+	builder
+	setUnderlying(underlying builder)
+}
+
 // addPullouts adds the pullout subqueries to the primitiveBuilder.
-func (pb *primitiveBuilder) addPullouts(pullouts []*pulloutSubquery) {
+func (pb *primitiveBuilder) addPullouts(pullouts []subqueryBuilder) {
 	for _, pullout := range pullouts {
 		pullout.setUnderlying(pb.bldr)
 		pb.bldr = pullout
@@ -189,11 +203,15 @@ func (pb *primitiveBuilder) pushSelectRoutes(selectExprs sqlparser.SelectExprs)
 	for _, node := range selectExprs {
 		switch node := node.(type) {
 		case *sqlparser.AliasedExpr:
-			pullouts, origin, expr, err := pb.findOrigin(node.Expr)
+			pullouts, origin, expr, err := pb.findOrigin(node.Expr, false)
 			if err != nil {
 				return nil, err
 			}
 			node.Expr = expr
+			if needsProjection(pb, pb.bldr, node, origin) {
+				pb.bldr = newProjection(pb.bldr)
+				pb.bldr.Reorder(0)
+			}
 			rc, _, err := pb.bldr.PushSelect(pb, node, origin)
 			if err != nil {
 				return nil, err
@@ -0,0 +1,244 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/vtgate/engine"
+)
+
+var _ builder = (*semiJoin)(nil)
+
+// semiJoin is the builder for engine.SemiJoin.
+// This gets built for a WHERE clause that is an EXISTS,
+// NOT EXISTS or IN construct whose subquery is correlated,
+// and can't be merged with the route of the outer query.
+// The left side is the outer query, and the right side is
+// the subquery, which gets executed for every left row.
+type semiJoin struct {
+	order         int
+	resultColumns []*resultColumn
+	weightStrings map[*resultColumn]int
+
+	left, right builder
+
+	// compareCol is the column of the outer query that gets
+	// compared against the subquery results for an IN construct.
+	compareCol *sqlparser.ColName
+
+	esemiJoin *engine.SemiJoin
+}
+
+// newSemiJoin builds a new semiJoin. The left side is
+// set by setUnderlying.
+func newSemiJoin(opcode engine.SemiJoinOpcode, compareCol *sqlparser.ColName, subquery builder) *semiJoin {
+	return &semiJoin{
+		weightStrings: make(map[*resultColumn]int),
+		right:         subquery,
+		compareCol:    compareCol,
+		esemiJoin: &engine.SemiJoin{
+			Opcode: opcode,
+			Vars:   make(map[string]int),
+		},
+	}
+}
+
+// setUnderlying sets the builder of the outer query.
+func (sj *semiJoin) setUnderlying(underlying builder) {
+	sj.left = underlying
+	sj.resultColumns = append(sj.resultColumns, underlying.ResultColumns()...)
+	for i := range sj.resultColumns {
+		sj.esemiJoin.Cols = append(sj.esemiJoin.Cols, i)
+	}
+	sj.right.Reorder(sj.left.Order())
+	sj.order = sj.right.Order() + 1
+}
+
+// Order satisfies the builder interface.
+func (sj *semiJoin) Order() int {
+	return sj.order
+}
+
+// Reorder satisfies the builder interface.
+func (sj *semiJoin) Reorder(order int) {
+	sj.left.Reorder(order)
+	sj.right.Reorder(sj.left.Order())
+	sj.order = sj.right.Order() + 1
+}
+
+// Primitive satisfies the builder interface.
+func (sj *semiJoin) Primitive() engine.Primitive {
+	sj.esemiJoin.Left = sj.left.Primitive()
+	sj.esemiJoin.Right = sj.right.Primitive()
+	return sj.esemiJoin
+}
+
+// First satisfies the builder interface.
+func (sj *semiJoin) First() builder {
+	return sj.left.First()
+}
+
+// ResultColumns satisfies the builder interface.
+func (sj *semiJoin) ResultColumns() []*resultColumn {
+	return sj.resultColumns
+}
+
+// PushFilter satisfies the builder interface.
+// The symbols of the outer query all originate from the left side.
+func (sj *semiJoin) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	return sj.left.PushFilter(pb, filter, whereType, origin)
+}
+
+// PushSelect satisfies the builder interface.
+func (sj *semiJoin) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	rc, colNumber, err = sj.left.PushSelect(pb, expr, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	sj.esemiJoin.Cols = append(sj.esemiJoin.Cols, colNumber)
+	sj.resultColumns = append(sj.resultColumns, rc)
+	return rc, len(sj.resultColumns) - 1, nil
+}
+
+// MakeDistinct satisfies the builder interface.
+// The left side can't handle it because it may return
+// additional columns that are needed by the subquery.
+func (sj *semiJoin) MakeDistinct() (builder, error) {
+	return newDistinct(sj), nil
+}
+
+// PushGroupBy satisfies the builder interface.
+func (sj *semiJoin) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	if groupBy == nil {
+		return nil
+	}
+	return errors.New("unsupported: group by on cross-shard subquery")
+}
+
+// PushOrderBy satisfies the builder interface.
+// The rows of the left side are returned in the order in
+// which they were received. So, the order by is pushed down.
+func (sj *semiJoin) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	bldr, err := sj.left.PushOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	sj.left = bldr
+	rbldr, err := sj.right.PushOrderBy(nil)
+	if err != nil {
+		return nil, err
+	}
+	sj.right = rbldr
+	return sj, nil
+}
+
+// SetUpperLimit satisfies the builder interface.
+// This is a no-op because the limit applies to the filtered
+// rows, which can't be known by the left side.
+func (sj *semiJoin) SetUpperLimit(_ *sqlparser.SQLVal) {
+}
+
+// PushMisc satisfies the builder interface.
+func (sj *semiJoin) PushMisc(sel *sqlparser.Select) {
+	sj.left.PushMisc(sel)
+	sj.right.PushMisc(sel)
+}
+
+// Wireup satisfies the builder interface.
+// For an IN construct, the compared columns must be supplied
+// before the underlying queries are generated. If it's a text
+// column, the weight_strings are compared instead. This is because
+// we currently don't have the ability to mimic mysql's collation behavior.
+func (sj *semiJoin) Wireup(bldr builder, jt *jointab) error {
+	if sj.compareCol != nil {
+		rc, lcol := sj.left.SupplyCol(sj.compareCol)
+		rcol := 0
+		if sqltypes.IsText(rc.column.typ) {
+			var err error
+			if lcol, err = sj.left.SupplyWeightString(lcol); err != nil {
+				return err
+			}
+			if rcol, err = sj.right.SupplyWeightString(rcol); err != nil {
+				return err
+			}
+		}
+		sj.esemiJoin.CompareCol = lcol
+		sj.esemiJoin.SubqueryCol = rcol
+	}
+	if err := sj.right.Wireup(bldr, jt); err != nil {
+		return err
+	}
+	return sj.left.Wireup(bldr, jt)
+}
+
+// SupplyVar satisfies the builder interface.
+// The correlated columns of the subquery are supplied
+// from the rows of the left side.
+func (sj *semiJoin) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	if !sj.isOnLeft(from) {
+		sj.right.SupplyVar(from, to, col, varname)
+		return
+	}
+	if sj.isOnLeft(to) {
+		sj.left.SupplyVar(from, to, col, varname)
+		return
+	}
+	if _, ok := sj.esemiJoin.Vars[varname]; ok {
+		// Looks like somebody else already requested this.
+		return
+	}
+	_, sj.esemiJoin.Vars[varname] = sj.left.SupplyCol(col)
+}
+
+// SupplyCol satisfies the builder interface.
+func (sj *semiJoin) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	c := col.Metadata.(*column)
+	for i, rc := range sj.resultColumns {
+		if rc.column == c {
+			return rc, i
+		}
+	}
+	rc, sourceCol := sj.left.SupplyCol(col)
+	sj.esemiJoin.Cols = append(sj.esemiJoin.Cols, sourceCol)
+	sj.resultColumns = append(sj.resultColumns, rc)
+	return rc, len(sj.resultColumns) - 1
+}
+
+// SupplyWeightString satisfies the builder interface.
+func (sj *semiJoin) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	rc := sj.resultColumns[colNumber]
+	if weightcolNumber, ok := sj.weightStrings[rc]; ok {
+		return weightcolNumber, nil
+	}
+	sourceCol, err := sj.left.SupplyWeightString(sj.esemiJoin.Cols[colNumber])
+	if err != nil {
+		return 0, err
+	}
+	sj.esemiJoin.Cols = append(sj.esemiJoin.Cols, sourceCol)
+	sj.resultColumns = append(sj.resultColumns, rc)
+	sj.weightStrings[rc] = len(sj.resultColumns) - 1
+	return len(sj.resultColumns) - 1, nil
+}
+
+// isOnLeft returns true if the specified node number
+// is within the range of the left side.
+func (sj *semiJoin) isOnLeft(nodeNum int) bool {
+	return nodeNum <= sj.left.Order()
+}
@@ -108,8 +108,9 @@ func (sq *subquery) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr,
 }
 
 // MakeDistinct satisfies the builder interface.
-func (sq *subquery) MakeDistinct() error {
-	return errors.New("unsupported: distinct on cross-shard subquery")
+// The rows of a subquery are de-duplicated by a distinct primitive.
+func (sq *subquery) MakeDistinct() (builder, error) {
+	return newDistinct(sq), nil
 }
 
 // PushGroupBy satisfies the builder interface.
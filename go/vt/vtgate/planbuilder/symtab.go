@@ -86,19 +86,21 @@ func newSymtabWithRoute(rb *route) *symtab {
 
 // AddVSchemaTable takes a list of vschema tables as input and
 // creates a table with multiple route options. It returns a
-// list of vindex maps, one for each input.
-func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vindexes.Table, rb *route) (vindexMaps []map[*column]vindexes.SingleColumn, err error) {
+// list of vindex maps, and a list of multi-column vindexes,
+// one for each input.
+func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vindexes.Table, rb *route) (vindexMaps []map[*column]vindexes.SingleColumn, multiColVindexes [][]*multiColVindex, err error) {
 	t := &table{
 		alias:  alias,
 		origin: rb,
 	}
 
 	vindexMaps = make([]map[*column]vindexes.SingleColumn, len(vschemaTables))
+	multiColVindexes = make([][]*multiColVindex, len(vschemaTables))
 	for i, vst := range vschemaTables {
 		// The following logic allows the first table to be authoritative while the rest
 		// are not. But there's no need to reveal this flexibility to the user.
 		if i != 0 && vst.ColumnListAuthoritative && !t.isAuthoritative {
-			return nil, fmt.Errorf("intermixing of authoritative and non-authoritative tables not allowed: %v", vst.Name)
+			return nil, nil, fmt.Errorf("intermixing of authoritative and non-authoritative tables not allowed: %v", vst.Name)
 		}
 
 		for _, col := range vst.Columns {
@@ -107,7 +109,7 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 				st:     st,
 				typ:    col.Type,
 			}); err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		}
 		if i == 0 && vst.ColumnListAuthoritative {
@@ -117,6 +119,21 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 
 		var vindexMap map[*column]vindexes.SingleColumn
 		for _, cv := range vst.ColumnVindexes {
+			if multi, ok := cv.Vindex.(vindexes.MultiColumn); ok {
+				mcv := &multiColVindex{vindex: multi}
+				for _, cvcol := range cv.Columns {
+					col, err := t.mergeColumn(cvcol, &column{
+						origin: rb,
+						st:     st,
+					})
+					if err != nil {
+						return nil, nil, err
+					}
+					mcv.columns = append(mcv.columns, col)
+				}
+				multiColVindexes[i] = append(multiColVindexes[i], mcv)
+				continue
+			}
 			single, ok := cv.Vindex.(vindexes.SingleColumn)
 			if !ok {
 				continue
@@ -127,7 +144,7 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 					st:     st,
 				})
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				if j == 0 {
 					// For now, only the first column is used for vindex Map functions.
@@ -148,15 +165,15 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 					origin: rb,
 					st:     st,
 				}); err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 			}
 		}
 	}
 	if err := st.AddTable(t); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return vindexMaps, nil
+	return vindexMaps, multiColVindexes, nil
 }
 
 // Merge merges the new symtab into the current one.
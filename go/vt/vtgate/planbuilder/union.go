@@ -17,7 +17,6 @@ limitations under the License.
 package planbuilder
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/xsec-lab/go/vt/sqlparser"
@@ -25,6 +24,9 @@ import (
 )
 
 func buildUnionPlan(union *sqlparser.Union, vschema ContextVSchema) (engine.Primitive, error) {
+	if err := expandCTEs(union); err != nil {
+		return nil, err
+	}
 	// For unions, create a pb with anonymous scope.
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(union)))
 	if err := pb.processUnion(union, nil); err != nil {
@@ -45,8 +47,24 @@ func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab)
 		return err
 	}
 
-	if err := unionRouteMerge(union, pb.bldr, rpb.bldr); err != nil {
-		return err
+	if !unionRouteMerge(union, pb.bldr, rpb.bldr) {
+		// The parts are executed separately, and
+		// their results are concatenated by vtgate.
+		left, right := pb.bldr, rpb.bldr
+		if union.Type != sqlparser.UnionAllStr {
+			// The rows of the parts will be de-duplicated anyway.
+			left, right = unwrapUnionDistinct(left), unwrapUnionDistinct(right)
+		}
+		bldr, err := newConcatenate(left, right)
+		if err != nil {
+			return err
+		}
+		pb.bldr = bldr
+		if union.Type != sqlparser.UnionAllStr {
+			if pb.bldr, err = bldr.MakeDistinct(); err != nil {
+				return err
+			}
+		}
 	}
 	pb.st.Outer = outer
 
@@ -68,18 +86,34 @@ func (pb *primitiveBuilder) processPart(part sqlparser.SelectStatement, outer *s
 	return fmt.Errorf("BUG: unexpected SELECT type: %T", part)
 }
 
-func unionRouteMerge(union *sqlparser.Union, left, right builder) error {
+// unionRouteMerge returns true if both sides of the UNION are
+// routes that could be merged into a single route.
+func unionRouteMerge(union *sqlparser.Union, left, right builder) bool {
 	lroute, ok := left.(*route)
 	if !ok {
-		return errors.New("unsupported: SELECT of UNION is non-trivial")
+		return false
 	}
 	rroute, ok := right.(*route)
 	if !ok {
-		return errors.New("unsupported: SELECT of UNION is non-trivial")
+		return false
 	}
 	if !lroute.MergeUnion(rroute) {
-		return errors.New("unsupported: UNION cannot be executed as a single route")
+		return false
 	}
 	lroute.Select = &sqlparser.Union{Type: union.Type, Left: union.Left, Right: union.Right, Lock: union.Lock}
-	return nil
+	return true
+}
+
+// unwrapUnionDistinct returns the concatenate of a UNION DISTINCT
+// that could not be merged into a single route. Otherwise, it
+// returns the builder as is.
+func unwrapUnionDistinct(bldr builder) builder {
+	d, ok := bldr.(*distinct)
+	if !ok {
+		return bldr
+	}
+	if _, ok := d.input.(*concatenate); !ok {
+		return bldr
+	}
+	return d.input
 }
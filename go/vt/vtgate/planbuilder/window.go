@@ -0,0 +1,163 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/xsec-lab/go/vt/sqlparser"
+)
+
+// This file has functions to analyze window functions.
+
+var errCrossShardWindow = errors.New("unsupported: cross-shard query with window functions")
+
+// checkWindowFunctions analyzes the window functions of the select
+// expressions. Window functions are evaluated by MySQL, which requires
+// all the rows of a window partition to come from the same shard.
+// This is true if the query targets a single shard, or if every
+// window is partitioned by a unique vindex column. If so, the WINDOW
+// clause is pushed down into the route along with the select
+// expressions. Otherwise, an error is returned.
+func (pb *primitiveBuilder) checkWindowFunctions(sel *sqlparser.Select) error {
+	overs := findOverClauses(sel.SelectExprs, sel.OrderBy)
+	if len(overs) == 0 && len(sel.Windows) == 0 {
+		return nil
+	}
+	rb, isRoute := pb.bldr.(*route)
+	if !isRoute {
+		return errCrossShardWindow
+	}
+	if !rb.removeMultishardOptions() {
+		partitions, err := windowPartitions(overs, sel.Windows)
+		if err != nil {
+			return err
+		}
+		success := rb.removeOptions(func(ro *routeOption) bool {
+			for _, partition := range partitions {
+				if !partitionHasUniqueVindex(pb, ro, partition) {
+					return false
+				}
+			}
+			return true
+		})
+		if !success {
+			return errCrossShardWindow
+		}
+	}
+	if err := pb.resolveWindowColumns(sel.Windows); err != nil {
+		return err
+	}
+	rb.Select.(*sqlparser.Select).Windows = sel.Windows
+	return nil
+}
+
+// resolveWindowColumns resolves the column references of the WINDOW clause
+// against the symbol table. The columns of the OVER clauses are resolved
+// along with the select expressions they belong to.
+func (pb *primitiveBuilder) resolveWindowColumns(windows sqlparser.NamedWindows) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			if _, _, err := pb.st.Find(node); err != nil {
+				return false, err
+			}
+		case *sqlparser.Subquery:
+			return false, errors.New("unsupported: subquery in WINDOW clause")
+		}
+		return true, nil
+	}, windows)
+}
+
+// findOverClauses returns the OVER clauses of the window functions
+// found in the specified nodes. Subqueries are analyzed by themselves.
+func findOverClauses(nodes ...sqlparser.SQLNode) []*sqlparser.OverClause {
+	var overs []*sqlparser.OverClause
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.IsWindowFunction() {
+				overs = append(overs, node.Over)
+			}
+		case *sqlparser.Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, nodes...)
+	return overs
+}
+
+// windowPartitions returns the PARTITION BY clause of every OVER clause.
+// The references to named windows are resolved using the WINDOW clause.
+func windowPartitions(overs []*sqlparser.OverClause, windows sqlparser.NamedWindows) ([]sqlparser.Exprs, error) {
+	partitions := make([]sqlparser.Exprs, 0, len(overs))
+	for _, over := range overs {
+		spec := over.WindowSpec
+		if spec == nil {
+			spec = &sqlparser.WindowSpecification{Name: over.WindowName}
+		}
+		partition, err := resolvePartition(spec, windows)
+		if err != nil {
+			return nil, err
+		}
+		partitions = append(partitions, partition)
+	}
+	return partitions, nil
+}
+
+// resolvePartition returns the PARTITION BY clause of the window
+// specification, which can be inherited from the window it references.
+func resolvePartition(spec *sqlparser.WindowSpecification, windows sqlparser.NamedWindows) (sqlparser.Exprs, error) {
+	// A chain of references can't be longer than the number
+	// of named windows, unless it's circular.
+	for depth := 0; ; depth++ {
+		if len(spec.PartitionClause) != 0 || spec.Name.IsEmpty() {
+			return spec.PartitionClause, nil
+		}
+		if depth > len(windows) {
+			return nil, fmt.Errorf("circular window reference: '%s'", spec.Name.String())
+		}
+		named := findNamedWindow(spec.Name, windows)
+		if named == nil {
+			return nil, fmt.Errorf("window '%s' is not defined", spec.Name.String())
+		}
+		spec = named.WindowSpec
+	}
+}
+
+func findNamedWindow(name sqlparser.ColIdent, windows sqlparser.NamedWindows) *sqlparser.NamedWindow {
+	for _, window := range windows {
+		if window.Name.Equal(name) {
+			return window
+		}
+	}
+	return nil
+}
+
+// partitionHasUniqueVindex returns true if the partition references
+// a unique vindex of the route option. Every partition is then
+// contained within a single shard.
+func partitionHasUniqueVindex(pb *primitiveBuilder, ro *routeOption, partition sqlparser.Exprs) bool {
+	for _, expr := range partition {
+		vindex := ro.FindVindex(pb, expr)
+		if vindex != nil && vindex.IsUnique() {
+			return true
+		}
+	}
+	return false
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package vtgate
 
 import (
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -82,6 +83,7 @@ func NewAutocommitSession(sessn *vtgatepb.Session) *SafeSession {
 	newSession.PostSessions = nil
 	newSession.Autocommit = true
 	newSession.Warnings = nil
+	newSession.Savepoints = nil
 	return NewSafeSession(newSession)
 }
 
@@ -96,6 +98,7 @@ func (session *SafeSession) Reset() {
 	session.ShardSessions = nil
 	session.PreSessions = nil
 	session.PostSessions = nil
+	session.Savepoints = nil
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
 }
 
@@ -247,3 +250,63 @@ func (session *SafeSession) SetUserDefinedVariable(key string, value *querypb.Bi
 	}
 	session.UserDefinedVariables[key] = value
 }
+
+// StoreSavepoint records a savepoint of the current transaction.
+// Like in MySQL, an existing savepoint with the same name is replaced.
+func (session *SafeSession) StoreSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = append(session.Savepoints[:i], session.Savepoints[i+1:]...)
+	}
+	session.Savepoints = append(session.Savepoints, name)
+}
+
+// HasSavepoint returns true if the savepoint was set in the current transaction.
+func (session *SafeSession) HasSavepoint(name string) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.findSavepoint(name) != -1
+}
+
+// RollbackToSavepoint discards the savepoints that were set after
+// the specified one. The savepoint itself is retained.
+func (session *SafeSession) RollbackToSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = session.Savepoints[:i+1]
+	}
+}
+
+// ReleaseSavepoint discards the specified savepoint and
+// all the ones that were set after it.
+func (session *SafeSession) ReleaseSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = session.Savepoints[:i]
+	}
+}
+
+// ActiveSavepoints returns a copy of the savepoints of the
+// current transaction, in the order they were set.
+func (session *SafeSession) ActiveSavepoints() []string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if len(session.Savepoints) == 0 {
+		return nil
+	}
+	return append([]string(nil), session.Savepoints...)
+}
+
+// findSavepoint returns the index of the savepoint, or -1 if not found.
+// Savepoint names are case-insensitive.
+func (session *SafeSession) findSavepoint(name string) int {
+	for i, savepoint := range session.Savepoints {
+		if strings.EqualFold(savepoint, name) {
+			return i
+		}
+	}
+	return -1
+}
@@ -698,6 +698,24 @@ func (stc *ScatterConn) multiGoTransaction(
 		defer stc.endAction(startTime, allErrors, statsKey, &err, session)
 
 		shouldBegin, transactionID := transactionInfo(rs.Target, session, notInTransaction)
+		if shouldBegin && len(session.ActiveSavepoints()) != 0 {
+			// The shard joins a transaction that has savepoints: they must be
+			// set on the shard before the action runs so that a later
+			// ROLLBACK TO can undo its changes.
+			transactionID, err = stc.beginWithSavepoints(ctx, rs, session)
+			if transactionID != 0 {
+				if appendErr := session.Append(&vtgatepb.Session_ShardSession{
+					Target:        rs.Target,
+					TransactionId: transactionID,
+				}, stc.txConn.mode); appendErr != nil {
+					err = appendErr
+				}
+			}
+			if err != nil {
+				return
+			}
+			shouldBegin = false
+		}
 		transactionID, err = action(rs, i, shouldBegin, transactionID)
 		if shouldBegin && transactionID != 0 {
 			if appendErr := session.Append(&vtgatepb.Session_ShardSession{
@@ -734,6 +752,18 @@ end:
 	return allErrors
 }
 
+// beginWithSavepoints begins a transaction on the shard and
+// sets all the active savepoints of the session in it.
+func (stc *ScatterConn) beginWithSavepoints(ctx context.Context, rs *srvtopo.ResolvedShard, session *SafeSession) (int64, error) {
+	savepoints := session.ActiveSavepoints()
+	queries := make([]*querypb.BoundQuery, 0, len(savepoints))
+	for _, name := range savepoints {
+		queries = append(queries, &querypb.BoundQuery{Sql: savepointQuery(name)})
+	}
+	_, transactionID, err := rs.QueryService.BeginExecuteBatch(ctx, rs.Target, queries, false, session.Options)
+	return transactionID, err
+}
+
 // transactionInfo looks at the current session, and returns:
 // - shouldBegin: if we should call 'Begin' to get a transactionID
 // - transactionID: the transactionID to use, or 0 if not in a transaction.
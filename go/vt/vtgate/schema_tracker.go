@@ -0,0 +1,198 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/timer"
+	"github.com/xsec-lab/go/vt/log"
+	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/vtgate/vindexes"
+
+	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
+	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
+)
+
+var (
+	enableSchemaTracking   = flag.Bool("enable_schema_tracking", false, "If set, vtgate learns the tables and columns of the keyspaces from their master tablets, and merges them into the vschema. This makes the column lists of the vschema tables authoritative.")
+	schemaTrackingInterval = flag.Duration("schema_tracking_interval", 1*time.Minute, "How often the schema is reloaded from the tablets when -enable_schema_tracking is set. DDLs applied through vtgate trigger an immediate reload of their keyspace.")
+)
+
+// schemaTracker tracks the tables and columns of the keyspaces, as
+// seen by the master of their first shard. The tracked schema is
+// merged into the vschema by the VSchemaManager, which rebuilds the
+// vschema every time the tracked schema changes.
+type schemaTracker struct {
+	e     *Executor
+	timer *timer.Timer
+
+	// reloadMu serializes the reloads.
+	reloadMu sync.Mutex
+
+	mu sync.Mutex
+	// tables is keyspace -> table -> columns.
+	tables map[string]map[string][]vindexes.Column
+}
+
+func newSchemaTracker(e *Executor, interval time.Duration) *schemaTracker {
+	return &schemaTracker{
+		e:      e,
+		timer:  timer.NewTimer(interval),
+		tables: make(map[string]map[string][]vindexes.Column),
+	}
+}
+
+// startSchemaTracking enables schema tracking for the executor.
+// The schema is loaded right away, and then periodically.
+func (e *Executor) startSchemaTracking(ctx context.Context, interval time.Duration) {
+	st := newSchemaTracker(e, interval)
+	e.vm.mu.Lock()
+	e.vm.schema = st
+	e.vm.mu.Unlock()
+
+	st.timer.Start(func() {
+		st.reloadAll(ctx)
+	})
+	st.timer.Trigger()
+}
+
+// reloadAll reloads the schema of all the keyspaces of the vschema,
+// and rebuilds the vschema if anything changed.
+func (st *schemaTracker) reloadAll(ctx context.Context) {
+	st.reloadMu.Lock()
+	defer st.reloadMu.Unlock()
+
+	vschema := st.e.VSchema()
+	if vschema == nil {
+		return
+	}
+	keyspaces := make([]string, 0, len(vschema.Keyspaces))
+	for ks := range vschema.Keyspaces {
+		keyspaces = append(keyspaces, ks)
+	}
+	sort.Strings(keyspaces)
+
+	changed := st.dropKeyspacesExcept(keyspaces)
+	for _, ks := range keyspaces {
+		ksChanged, err := st.reloadKeyspace(ctx, ks)
+		if err != nil {
+			log.Warningf("Could not track the schema of keyspace %v: %v", ks, err)
+			continue
+		}
+		changed = changed || ksChanged
+	}
+	if changed {
+		st.e.vm.Rebuild()
+	}
+}
+
+// Notify reloads the schema of a keyspace in the background,
+// and rebuilds the vschema if it changed. It's called after
+// a DDL was applied to the keyspace.
+func (st *schemaTracker) Notify(keyspace string) {
+	go func() {
+		st.reloadMu.Lock()
+		defer st.reloadMu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		changed, err := st.reloadKeyspace(ctx, keyspace)
+		if err != nil {
+			log.Warningf("Could not track the schema of keyspace %v: %v", keyspace, err)
+			return
+		}
+		if changed {
+			st.e.vm.Rebuild()
+		}
+	}()
+}
+
+// reloadKeyspace loads the schema of a keyspace, and
+// returns true if it's different from the tracked one.
+func (st *schemaTracker) reloadKeyspace(ctx context.Context, keyspace string) (bool, error) {
+	rss, _, err := st.e.resolver.resolver.GetAllShards(ctx, keyspace, topodatapb.TabletType_MASTER)
+	if err != nil {
+		return false, err
+	}
+	if len(rss) == 0 {
+		return false, nil
+	}
+	// All the shards of a keyspace have the same schema.
+	rs := rss[0]
+	schema, err := rs.QueryService.GetSchema(ctx, rs.Target)
+	if err != nil {
+		return false, err
+	}
+	tables := trackedTables(schema)
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if reflect.DeepEqual(st.tables[keyspace], tables) {
+		return false, nil
+	}
+	st.tables[keyspace] = tables
+	return true, nil
+}
+
+// dropKeyspacesExcept stops tracking the keyspaces that
+// were removed from the vschema.
+func (st *schemaTracker) dropKeyspacesExcept(keyspaces []string) bool {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	changed := false
+	for ks := range st.tables {
+		i := sort.SearchStrings(keyspaces, ks)
+		if i == len(keyspaces) || keyspaces[i] != ks {
+			delete(st.tables, ks)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// addTo merges the tracked schema into the vschema.
+func (st *schemaTracker) addTo(vschema *vindexes.VSchema) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	for ks, tables := range st.tables {
+		vschema.AddTrackedTables(ks, tables)
+	}
+}
+
+// trackedTables converts the tables loaded by the schema engine
+// of a tablet into columns of the vschema.
+func trackedTables(schema []*binlogdatapb.MinimalTable) map[string][]vindexes.Column {
+	tables := make(map[string][]vindexes.Column, len(schema))
+	for _, table := range schema {
+		cols := make([]vindexes.Column, 0, len(table.Fields))
+		for _, field := range table.Fields {
+			cols = append(cols, vindexes.Column{
+				Name: sqlparser.NewColIdent(field.Name),
+				Type: field.Type,
+			})
+		}
+		tables[table.Name] = cols
+	}
+	return tables
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/vtgate/vindexes"
+
+	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+func schemaTable(name, fields, types string) *binlogdatapb.MinimalTable {
+	return &binlogdatapb.MinimalTable{
+		Name:   name,
+		Fields: sqltypes.MakeTestFields(fields, types),
+	}
+}
+
+func TestSchemaTracking(t *testing.T) {
+	executor, sbc1, _, sbclookup := createExecutorEnv()
+	st := newSchemaTracker(executor, time.Hour)
+	executor.vm.schema = st
+
+	sbc1.GetSchemaResult = []*binlogdatapb.MinimalTable{
+		schemaTable("untracked", "id", "int64"),
+		schemaTable("user", "id|name|extra", "int64|varchar|uint32"),
+	}
+	sbclookup.GetSchemaResult = []*binlogdatapb.MinimalTable{
+		schemaTable("new_table", "id|val", "int64|geometry"),
+	}
+	st.reloadAll(context.Background())
+	assert.EqualValues(t, 1, sbclookup.GetSchemaCount.Get())
+
+	vschema := executor.VSchema()
+	user := vschema.Keyspaces["TestExecutor"].Tables["user"]
+	assert.True(t, user.ColumnListAuthoritative)
+	assert.True(t, user.ColumnsTracked)
+	// The columns of the vschema come first.
+	assert.Equal(t, []vindexes.Column{
+		{Name: sqlparser.NewColIdent("textcol"), Type: sqltypes.VarChar},
+		{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Int64},
+		{Name: sqlparser.NewColIdent("name"), Type: sqltypes.VarChar},
+		{Name: sqlparser.NewColIdent("extra"), Type: sqltypes.Uint32},
+	}, user.Columns)
+	// Sharded tables need a vindex.
+	assert.Nil(t, vschema.Keyspaces["TestExecutor"].Tables["untracked"])
+	newTable := vschema.Keyspaces[KsTestUnsharded].Tables["new_table"]
+	require.NotNil(t, newTable)
+	assert.Equal(t, []vindexes.Column{
+		{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Int64},
+		{Name: sqlparser.NewColIdent("val"), Type: sqltypes.Geometry},
+	}, newTable.Columns)
+
+	// The tracked columns are used to expand '*'.
+	sbclookup.Queries = nil
+	_, err := executorExec(executor, "select * from new_table", nil)
+	require.NoError(t, err)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select id, val from new_table",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	assert.Equal(t, wantQueries, sbclookup.Queries)
+
+	// The tracked tables are shown by SHOW VSCHEMA TABLES.
+	session := NewSafeSession(masterSession)
+	session.TargetString = KsTestUnsharded
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema tables", nil)
+	require.NoError(t, err)
+	assert.Contains(t, qr.Rows, []sqltypes.Value{sqltypes.NewVarChar("new_table")})
+
+	// The vschema is not rebuilt if the schema didn't change.
+	sbc1.GetSchemaResult = []*binlogdatapb.MinimalTable{
+		schemaTable("untracked", "id", "int64"),
+		schemaTable("user", "id|name|extra", "int64|varchar|uint32"),
+	}
+	sbclookup.GetSchemaResult = []*binlogdatapb.MinimalTable{
+		schemaTable("new_table", "id|val", "int64|geometry"),
+	}
+	st.reloadAll(context.Background())
+	assert.True(t, vschema == executor.VSchema())
+
+	// A DDL triggers a reload of its keyspace.
+	sbclookup.GetSchemaResult = []*binlogdatapb.MinimalTable{
+		schemaTable("new_table", "id|val", "int64|geometry"),
+		schemaTable("other_table", "id", "int64"),
+	}
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "create table other_table(id bigint)", nil)
+	require.NoError(t, err)
+	for i := 0; ; i++ {
+		if _, ok := executor.VSchema().Keyspaces[KsTestUnsharded].Tables["other_table"]; ok {
+			break
+		}
+		if i == 100 {
+			t.Fatal("other_table was not tracked after the DDL")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Rebuilding the vschema keeps the tracked schema.
+	executor.vm.Rebuild()
+	assert.NotNil(t, executor.VSchema().Keyspaces[KsTestUnsharded].Tables["other_table"])
+}
+
+func TestTrackedTables(t *testing.T) {
+	got := trackedTables([]*binlogdatapb.MinimalTable{
+		schemaTable("t1", "a|b", "uint8|decimal"),
+		schemaTable("t2", "c", "null_type"),
+		{Name: "t3"},
+	})
+	assert.Equal(t, map[string][]vindexes.Column{
+		"t1": {
+			{Name: sqlparser.NewColIdent("a"), Type: sqltypes.Uint8},
+			{Name: sqlparser.NewColIdent("b"), Type: sqltypes.Decimal},
+		},
+		"t2": {
+			{Name: sqlparser.NewColIdent("c"), Type: sqltypes.Null},
+		},
+		"t3": {},
+	}, got)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/vt/log"
+	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/vtgate/engine"
+
+	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
+)
+
+var (
+	sequencePrefetchSize    = flag.Int64("sequence_prefetch_size", 0, "If set, vtgate fetches blocks of this many values of every sequence it uses ahead of time, in the background. Inserts that need fewer values than a block are served from the prefetched blocks instead of waiting for the sequence table.")
+	sequencePrefetchTimeout = flag.Duration("sequence_prefetch_timeout", 10*time.Second, "Timeout for fetching a block of sequence values in the background.")
+)
+
+// startSequencePrefetch makes the executor serve the values of
+// the sequences from blocks of size values fetched ahead of time.
+func (e *Executor) startSequencePrefetch(size int64, timeout time.Duration) {
+	sc := newSequenceCache(size, func(gen *engine.Generate, count int64) (int64, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		vcursor := newVCursorImpl(ctx, NewSafeSession(nil), "", topodatapb.TabletType_MASTER, sqlparser.MarginComments{}, e, nil)
+		return gen.Fetch(vcursor, count)
+	})
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.sequences = sc
+}
+
+// sequenceCache serves the values of sequences from blocks that
+// are fetched ahead of time. When less than half a block is left
+// for a sequence, the next block is fetched in the background.
+// So, inserts only wait for the sequence table if it can't keep up,
+// or if its keyspace is unavailable for longer than it takes to
+// use up a block.
+type sequenceCache struct {
+	blockSize int64
+	// fetch fetches values in the background, outside of any request.
+	fetch func(gen *engine.Generate, count int64) (int64, error)
+
+	mu        sync.Mutex
+	sequences map[string]*sequenceBlocks
+}
+
+// sequenceBlocks has the values of a sequence that are not used yet.
+// The values of a block are consecutive, but the blocks may not be.
+type sequenceBlocks struct {
+	mu       sync.Mutex
+	blocks   []*sequenceBlock
+	fetching bool
+}
+
+// sequenceBlock is the range [next, end) of values of a sequence.
+type sequenceBlock struct {
+	next, end int64
+}
+
+func newSequenceCache(blockSize int64, fetch func(gen *engine.Generate, count int64) (int64, error)) *sequenceCache {
+	return &sequenceCache{
+		blockSize: blockSize,
+		fetch:     fetch,
+		sequences: make(map[string]*sequenceBlocks),
+	}
+}
+
+// next reserves count consecutive values of the sequence of gen, and
+// returns the first one. If nothing is prefetched, a block is fetched
+// through vcursor. ok is false if count is bigger than a block, in
+// which case the values must be fetched from the sequence table.
+func (sc *sequenceCache) next(vcursor engine.VCursor, gen *engine.Generate, count int64) (insertID int64, ok bool, err error) {
+	if count > sc.blockSize {
+		return 0, false, nil
+	}
+	seq := sc.blocksFor(gen)
+
+	seq.mu.Lock()
+	defer seq.mu.Unlock()
+	// The values of an insert must be consecutive. The rest of
+	// a block that's too small is skipped: sequences can have gaps.
+	for len(seq.blocks) > 0 && seq.blocks[0].end-seq.blocks[0].next < count {
+		seq.blocks = seq.blocks[1:]
+	}
+	if len(seq.blocks) == 0 {
+		start, err := gen.Fetch(vcursor, sc.blockSize)
+		if err != nil {
+			return 0, false, err
+		}
+		seq.blocks = append(seq.blocks, &sequenceBlock{next: start, end: start + sc.blockSize})
+	}
+	block := seq.blocks[0]
+	insertID = block.next
+	block.next += count
+
+	left := int64(0)
+	for _, block := range seq.blocks {
+		left += block.end - block.next
+	}
+	if left < sc.blockSize/2 && !seq.fetching {
+		seq.fetching = true
+		go sc.prefetch(gen, seq)
+	}
+	return insertID, true, nil
+}
+
+// blocksFor returns the blocks of the sequence of gen.
+func (sc *sequenceCache) blocksFor(gen *engine.Generate) *sequenceBlocks {
+	key := gen.Keyspace.Name + ":" + gen.Query
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	seq, ok := sc.sequences[key]
+	if !ok {
+		seq = &sequenceBlocks{}
+		sc.sequences[key] = seq
+	}
+	return seq
+}
+
+// prefetch fetches the next block of the sequence of gen.
+func (sc *sequenceCache) prefetch(gen *engine.Generate, seq *sequenceBlocks) {
+	start, err := sc.fetch(gen, sc.blockSize)
+
+	seq.mu.Lock()
+	defer seq.mu.Unlock()
+	seq.fetching = false
+	if err != nil {
+		log.Warningf("Prefetching sequence values with %q in keyspace %s failed: %v", gen.Query, gen.Keyspace.Name, err)
+		return
+	}
+	seq.blocks = append(seq.blocks, &sequenceBlock{next: start, end: start + sc.blockSize})
+}
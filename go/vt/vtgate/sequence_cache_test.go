@@ -0,0 +1,181 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/key"
+	"github.com/xsec-lab/go/vt/srvtopo"
+	"github.com/xsec-lab/go/vt/vtgate/engine"
+	"github.com/xsec-lab/go/vt/vtgate/vindexes"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+// sequenceVCursor serves the values of a sequence
+// table that starts at 1.
+type sequenceVCursor struct {
+	engine.VCursor
+
+	mu      sync.Mutex
+	next    int64
+	fetches []int64
+	err     error
+}
+
+func (vc *sequenceVCursor) ResolveDestinations(keyspace string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	return []*srvtopo.ResolvedShard{{
+		Target: &querypb.Target{Keyspace: keyspace, Shard: "0"},
+	}}, nil, nil
+}
+
+func (vc *sequenceVCursor) ExecuteStandalone(query string, bindVars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	if vc.err != nil {
+		return nil, vc.err
+	}
+	n, err := sqltypes.BindVariableToValue(bindVars["n"])
+	if err != nil {
+		return nil, err
+	}
+	count, err := sqltypes.ToInt64(n)
+	if err != nil {
+		return nil, err
+	}
+	if vc.next == 0 {
+		vc.next = 1
+	}
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("nextval", "int64"), sqltypes.NewInt64(vc.next).ToString())
+	vc.fetches = append(vc.fetches, count)
+	vc.next += count
+	return result, nil
+}
+
+func (vc *sequenceVCursor) getFetches() []int64 {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	return append([]int64(nil), vc.fetches...)
+}
+
+// waitForPrefetch waits for the background fetch of the sequence to finish.
+func waitForPrefetch(t *testing.T, sc *sequenceCache, gen *engine.Generate) {
+	t.Helper()
+	seq := sc.blocksFor(gen)
+	for i := 0; i < 100; i++ {
+		seq.mu.Lock()
+		fetching := seq.fetching
+		seq.mu.Unlock()
+		if !fetching {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("prefetch did not finish")
+}
+
+func TestSequenceCache(t *testing.T) {
+	vc := &sequenceVCursor{}
+	sc := newSequenceCache(10, func(gen *engine.Generate, count int64) (int64, error) {
+		return gen.Fetch(vc, count)
+	})
+	gen := &engine.Generate{
+		Keyspace: &vindexes.Keyspace{Name: "main"},
+		Query:    "select next :n values from user_seq",
+	}
+
+	// The first block is fetched by the insert.
+	insertID, ok, err := sc.next(vc, gen, 3)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), insertID)
+	assert.Equal(t, []int64{10}, vc.getFetches())
+
+	// Less than half a block is left after this, which
+	// starts fetching the next block in the background.
+	insertID, ok, err = sc.next(vc, gen, 3)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(4), insertID)
+	waitForPrefetch(t, sc, gen)
+	assert.Equal(t, []int64{10, 10}, vc.getFetches())
+
+	// The 4 values left in the first block are skipped,
+	// because the values of an insert must be consecutive.
+	insertID, ok, err = sc.next(vc, gen, 5)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(11), insertID)
+	waitForPrefetch(t, sc, gen)
+	assert.Equal(t, []int64{10, 10}, vc.getFetches())
+
+	// Inserts of more values than a block are not served.
+	_, ok, err = sc.next(vc, gen, 11)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// The other sequences have their own blocks.
+	other := &engine.Generate{
+		Keyspace: &vindexes.Keyspace{Name: "main"},
+		Query:    "select next :n values from other_seq",
+	}
+	insertID, _, err = sc.next(vc, other, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(21), insertID)
+}
+
+func TestSequenceCacheErrors(t *testing.T) {
+	vc := &sequenceVCursor{}
+	prefetchErr := errors.New("sequence unavailable")
+	sc := newSequenceCache(10, func(gen *engine.Generate, count int64) (int64, error) {
+		return 0, prefetchErr
+	})
+	gen := &engine.Generate{
+		Keyspace: &vindexes.Keyspace{Name: "main"},
+		Query:    "select next :n values from user_seq",
+	}
+
+	vc.err = errors.New("sequence unavailable")
+	_, _, err := sc.next(vc, gen, 1)
+	assert.EqualError(t, err, "sequence unavailable")
+
+	// A failed prefetch is retried by the next insert.
+	vc.err = nil
+	_, _, err = sc.next(vc, gen, 6)
+	require.NoError(t, err)
+	waitForPrefetch(t, sc, gen)
+
+	insertID, _, err := sc.next(vc, gen, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), insertID)
+	waitForPrefetch(t, sc, gen)
+
+	// The insert fetches a block itself when nothing is left.
+	insertID, _, err = sc.next(vc, gen, 4)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), insertID)
+	waitForPrefetch(t, sc, gen)
+	assert.Equal(t, []int64{10, 10}, vc.getFetches())
+}
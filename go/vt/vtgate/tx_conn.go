@@ -25,6 +25,7 @@ import (
 	"github.com/xsec-lab/go/vt/concurrency"
 	"github.com/xsec-lab/go/vt/dtids"
 	"github.com/xsec-lab/go/vt/log"
+	"github.com/xsec-lab/go/vt/sqlparser"
 	"github.com/xsec-lab/go/vt/vterrors"
 	"github.com/xsec-lab/go/vt/vtgate/gateway"
 
@@ -194,6 +195,79 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 	})
 }
 
+// Savepoint sets a savepoint on all the shards that are part of the
+// current transaction. Shards that join the transaction later get
+// the savepoint when their transaction begins. Outside of a transaction,
+// the savepoint is a no-op, like in MySQL.
+func (txc *TxConn) Savepoint(ctx context.Context, session *SafeSession, name string) error {
+	if !session.InTransaction() {
+		return nil
+	}
+	if err := txc.runSavepointQuery(ctx, session, savepointQuery(name)); err != nil {
+		_ = txc.Rollback(ctx, session)
+		return err
+	}
+	session.StoreSavepoint(name)
+	return nil
+}
+
+// RollbackToSavepoint rolls back the current transaction to the specified
+// savepoint. Only the shards that are part of the transaction are contacted:
+// the others have no changes to undo. If the partial rollback fails on any
+// of the shards, the whole transaction is rolled back.
+func (txc *TxConn) RollbackToSavepoint(ctx context.Context, session *SafeSession, name string) error {
+	if !session.InTransaction() || !session.HasSavepoint(name) {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "SAVEPOINT %s does not exist", name)
+	}
+	query := sqlparser.String(&sqlparser.SRollback{Name: sqlparser.NewColIdent(name)})
+	if err := txc.runSavepointQuery(ctx, session, query); err != nil {
+		_ = txc.Rollback(ctx, session)
+		return err
+	}
+	session.RollbackToSavepoint(name)
+	return nil
+}
+
+// ReleaseSavepoint releases the specified savepoint on all the shards
+// that are part of the current transaction.
+func (txc *TxConn) ReleaseSavepoint(ctx context.Context, session *SafeSession, name string) error {
+	if !session.InTransaction() || !session.HasSavepoint(name) {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "SAVEPOINT %s does not exist", name)
+	}
+	query := sqlparser.String(&sqlparser.Release{Name: sqlparser.NewColIdent(name)})
+	if err := txc.runSavepointQuery(ctx, session, query); err != nil {
+		_ = txc.Rollback(ctx, session)
+		return err
+	}
+	session.ReleaseSavepoint(name)
+	return nil
+}
+
+// runSavepointQuery executes the savepoint statement in the
+// transactions of all the shard sessions.
+func (txc *TxConn) runSavepointQuery(ctx context.Context, session *SafeSession, query string) error {
+	allsessions := make([]*vtgatepb.Session_ShardSession, 0, len(session.PreSessions)+len(session.ShardSessions)+len(session.PostSessions))
+	allsessions = append(allsessions, session.PreSessions...)
+	allsessions = append(allsessions, session.ShardSessions...)
+	allsessions = append(allsessions, session.PostSessions...)
+	if len(allsessions) == 0 {
+		return nil
+	}
+
+	return txc.runSessions(allsessions, func(s *vtgatepb.Session_ShardSession) error {
+		if s.TransactionId == 0 {
+			return nil
+		}
+		_, err := txc.gateway.Execute(ctx, s.Target, query, nil, s.TransactionId, session.Options)
+		return err
+	})
+}
+
+// savepointQuery returns the statement that sets the specified savepoint.
+func savepointQuery(name string) string {
+	return sqlparser.String(&sqlparser.Savepoint{Name: sqlparser.NewColIdent(name)})
+}
+
 // Resolve resolves the specified 2PC transaction.
 func (txc *TxConn) Resolve(ctx context.Context, dtid string) error {
 	mmShard, err := dtids.ShardSession(dtid)
@@ -932,6 +932,84 @@ func TestTxConnMultiGoTargets(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTxConnSavepoint(t *testing.T) {
+	sc, sbc0, sbc1, rss0, rss1, _ := newTestTxConnEnv(t, "TestTxConn")
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	ctx := context.Background()
+
+	_, err := sc.Execute(ctx, "query1", nil, rss0, topodatapb.TabletType_MASTER, session, false, nil, false)
+	require.NoError(t, err)
+	require.NoError(t, sc.txConn.Savepoint(ctx, session, "a"))
+	require.Equal(t, []string{"query1", "savepoint a"}, boundQueriesSQL(sbc0.Queries))
+	require.Equal(t, []string{"a"}, session.Savepoints)
+
+	// A shard that joins the transaction later gets the savepoint first.
+	_, err = sc.Execute(ctx, "query2", nil, rss1, topodatapb.TabletType_MASTER, session, false, nil, false)
+	require.NoError(t, err)
+	require.Len(t, sbc1.BatchQueries, 1)
+	require.Equal(t, []string{"savepoint a"}, boundQueriesSQL(sbc1.BatchQueries[0]))
+	require.Equal(t, []string{"query2"}, boundQueriesSQL(sbc1.Queries))
+	require.EqualValues(t, 1, sbc1.BeginCount.Get())
+
+	sbc0.Queries = nil
+	sbc1.Queries = nil
+	require.NoError(t, sc.txConn.Savepoint(ctx, session, "b"))
+	require.NoError(t, sc.txConn.RollbackToSavepoint(ctx, session, "a"))
+	wantQueries := []string{"savepoint b", "rollback to a"}
+	require.Equal(t, wantQueries, boundQueriesSQL(sbc0.Queries))
+	require.Equal(t, wantQueries, boundQueriesSQL(sbc1.Queries))
+	require.Equal(t, []string{"a"}, session.Savepoints)
+	require.True(t, session.InTransaction())
+
+	require.NoError(t, sc.txConn.ReleaseSavepoint(ctx, session, "a"))
+	require.Empty(t, session.Savepoints)
+	require.Equal(t, "release savepoint a", sbc1.Queries[2].Sql)
+}
+
+func TestTxConnSavepointNotInTransaction(t *testing.T) {
+	sc, sbc0, _, _, _, _ := newTestTxConnEnv(t, "TestTxConn")
+	session := NewSafeSession(&vtgatepb.Session{})
+	ctx := context.Background()
+
+	require.NoError(t, sc.txConn.Savepoint(ctx, session, "a"))
+	require.Empty(t, session.Savepoints)
+	require.Empty(t, sbc0.Queries)
+
+	err := sc.txConn.RollbackToSavepoint(ctx, session, "a")
+	require.EqualError(t, err, "SAVEPOINT a does not exist")
+	err = sc.txConn.ReleaseSavepoint(ctx, session, "a")
+	require.EqualError(t, err, "SAVEPOINT a does not exist")
+}
+
+func TestTxConnRollbackToSavepointFail(t *testing.T) {
+	sc, sbc0, sbc1, rss0, rss1, _ := newTestTxConnEnv(t, "TestTxConn")
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	ctx := context.Background()
+
+	_, err := sc.Execute(ctx, "query1", nil, rss0, topodatapb.TabletType_MASTER, session, false, nil, false)
+	require.NoError(t, err)
+	require.NoError(t, sc.txConn.Savepoint(ctx, session, "a"))
+	_, err = sc.Execute(ctx, "query2", nil, rss1, topodatapb.TabletType_MASTER, session, false, nil, false)
+	require.NoError(t, err)
+
+	// A failed partial rollback rolls back the whole transaction.
+	sbc1.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	err = sc.txConn.RollbackToSavepoint(ctx, session, "a")
+	require.Error(t, err)
+	require.False(t, session.InTransaction())
+	require.Empty(t, session.Savepoints)
+	require.EqualValues(t, 1, sbc0.RollbackCount.Get())
+	require.EqualValues(t, 1, sbc1.RollbackCount.Get())
+}
+
+func boundQueriesSQL(queries []*querypb.BoundQuery) []string {
+	sqls := make([]string, 0, len(queries))
+	for _, query := range queries {
+		sqls = append(sqls, query.Sql)
+	}
+	return sqls
+}
+
 func newTestTxConnEnv(t *testing.T, name string) (sc *ScatterConn, sbc0, sbc1 *sandboxconn.SandboxConn, rss0, rss1, rss01 []*srvtopo.ResolvedShard) {
 	createSandbox(name)
 	hc := discovery.NewFakeHealthCheck()
@@ -144,6 +144,12 @@ func (vc *vcursorImpl) TargetString() string {
 	return vc.safeSession.TargetString
 }
 
+// InTransaction returns true if the session is in a transaction.
+// The lookup vindexes don't cache the results they see in a transaction.
+func (vc *vcursorImpl) InTransaction() bool {
+	return vc.safeSession != nil && vc.safeSession.InTransaction()
+}
+
 // Execute is part of the engine.VCursor interface.
 func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]*querypb.BindVariable, isDML bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error) {
 	session := vc.safeSession
@@ -193,6 +199,17 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 	return qr, vterrors.Aggregate(errs)
 }
 
+// PrefetchedSequenceValues is part of the engine.VCursor interface.
+func (vc *vcursorImpl) PrefetchedSequenceValues(gen *engine.Generate, count int64) (int64, bool, error) {
+	vc.executor.mu.Lock()
+	sequences := vc.executor.sequences
+	vc.executor.mu.Unlock()
+	if sequences == nil {
+		return 0, false, nil
+	}
+	return sequences.next(vc, gen, count)
+}
+
 // StreamExeculteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	atomic.AddUint32(&vc.logStats.ShardQueries, uint32(len(rss)))
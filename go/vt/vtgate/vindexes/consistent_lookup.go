@@ -40,6 +40,8 @@ var (
 	_ SingleColumn  = (*ConsistentLookup)(nil)
 	_ Lookup        = (*ConsistentLookup)(nil)
 	_ WantOwnerInfo = (*ConsistentLookup)(nil)
+	_ CachedLookup  = (*ConsistentLookupUnique)(nil)
+	_ CachedLookup  = (*ConsistentLookup)(nil)
 )
 
 func init() {
@@ -58,6 +60,10 @@ type ConsistentLookup struct {
 //   table: name of the backing table. It can be qualified by the keyspace.
 //   from: list of columns in the table that have the 'from' values of the lookup vindex.
 //   to: The 'to' column name of the table.
+//
+// The following fields are optional:
+//   cache_size: the number of lookup results to cache in vtgate.
+//   cache_ttl: how long the cached results are valid, e.g. "1m". It defaults to 10m.
 func NewConsistentLookup(name string, m map[string]string) (Vindex, error) {
 	clc, err := newCLCommon(name, m)
 	if err != nil {
@@ -123,6 +129,10 @@ type ConsistentLookupUnique struct {
 //   table: name of the backing table. It can be qualified by the keyspace.
 //   from: list of columns in the table that have the 'from' values of the lookup vindex.
 //   to: The 'to' column name of the table.
+//
+// The following fields are optional:
+//   cache_size: the number of lookup results to cache in vtgate.
+//   cache_ttl: how long the cached results are valid, e.g. "1m". It defaults to 10m.
 func NewConsistentLookupUnique(name string, m map[string]string) (Vindex, error) {
 	clc, err := newCLCommon(name, m)
 	if err != nil {
@@ -322,6 +332,11 @@ func (lu *clCommon) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []b
 	return lu.Create(vcursor, [][]sqltypes.Value{newValues}, [][]byte{ksid}, false /* ignoreMode */)
 }
 
+// LookupCache returns the cache of the lookups, or nil if it's not enabled.
+func (lu *clCommon) LookupCache() *LookupCache {
+	return lu.lkp.Cache
+}
+
 // MarshalJSON returns a JSON representation of clCommon.
 func (lu *clCommon) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lu.lkp)
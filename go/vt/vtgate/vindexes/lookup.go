@@ -31,6 +31,8 @@ var (
 	_ Lookup       = (*LookupUnique)(nil)
 	_ SingleColumn = (*LookupNonUnique)(nil)
 	_ Lookup       = (*LookupNonUnique)(nil)
+	_ CachedLookup = (*LookupUnique)(nil)
+	_ CachedLookup = (*LookupNonUnique)(nil)
 )
 
 func init() {
@@ -121,6 +123,11 @@ func (ln *LookupNonUnique) Update(vcursor VCursor, oldValues []sqltypes.Value, k
 	return ln.lkp.Update(vcursor, oldValues, ksid, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), newValues)
 }
 
+// LookupCache returns the cache of the lookups, or nil if it's not enabled.
+func (ln *LookupNonUnique) LookupCache() *LookupCache {
+	return ln.lkp.Cache
+}
+
 // MarshalJSON returns a JSON representation of LookupHash.
 func (ln *LookupNonUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(ln.lkp)
@@ -135,6 +142,8 @@ func (ln *LookupNonUnique) MarshalJSON() ([]byte, error) {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: the number of lookup results to cache in vtgate.
+//   cache_ttl: how long the cached results are valid, e.g. "1m". It defaults to 10m.
 func NewLookup(name string, m map[string]string) (Vindex, error) {
 	lookup := &LookupNonUnique{name: name}
 
@@ -182,6 +191,8 @@ type LookupUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: the number of lookup results to cache in vtgate.
+//   cache_ttl: how long the cached results are valid, e.g. "1m". It defaults to 10m.
 func NewLookupUnique(name string, m map[string]string) (Vindex, error) {
 	lu := &LookupUnique{name: name}
 
@@ -274,6 +285,11 @@ func (lu *LookupUnique) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value
 	return lu.lkp.Delete(vcursor, rowsColValues, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), vtgatepb.CommitOrder_NORMAL)
 }
 
+// LookupCache returns the cache of the lookups, or nil if it's not enabled.
+func (lu *LookupUnique) LookupCache() *LookupCache {
+	return lu.lkp.Cache
+}
+
 // MarshalJSON returns a JSON representation of LookupUnique.
 func (lu *LookupUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lu.lkp)
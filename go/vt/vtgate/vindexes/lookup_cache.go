@@ -0,0 +1,224 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/xsec-lab/go/cache"
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/sync2"
+)
+
+// CachedLookup is implemented by the lookup vindexes. LookupCache
+// returns nil if the vindex doesn't cache its results.
+type CachedLookup interface {
+	LookupCache() *LookupCache
+}
+
+// LookupCache is an LRU cache of the results of a lookup vindex,
+// keyed by the 'from' value. It's enabled by the cache_size param
+// of the vindex. Entries expire after cache_ttl, or after
+// defaultLookupCacheTTL if it's not set.
+//
+// The cache is invalidated by the vindex when it writes to its
+// lookup table. The writes performed by other vtgates, or outside
+// of vitess, must be fed through Invalidate. VTGate does this by
+// streaming the changes to the lookup table.
+//
+// The numeric values are normalized, which means that 1, 1.0 and
+// an unsigned 1 share the same entry. The other values are cached
+// by their bytes. Since the collation of text columns is not known,
+// invalidating a text value clears the whole cache. So does
+// invalidating a numeric value if values were cached by their bytes,
+// or the other way around, because mysql compares numbers and
+// strings as numbers.
+type LookupCache struct {
+	table  string
+	column string
+	ttl    time.Duration
+	lru    *cache.LRUCache
+
+	// mu protects the fields below, and makes the
+	// invalidations atomic with respect to Set.
+	mu sync.Mutex
+	// generation is incremented by every invalidation.
+	generation int64
+	// hasNumeric and hasBytes are set if the cache
+	// has values cached by their number or their bytes.
+	hasNumeric, hasBytes bool
+
+	hits   sync2.AtomicInt64
+	misses sync2.AtomicInt64
+}
+
+// defaultLookupCacheTTL is how long the cached results
+// are valid if the cache_ttl param is not set.
+const defaultLookupCacheTTL = 10 * time.Minute
+
+type lookupCacheEntry struct {
+	result  *sqltypes.Result
+	expires time.Time
+}
+
+// Size satisfies cache.Value.
+func (*lookupCacheEntry) Size() int {
+	return 1
+}
+
+// newLookupCache returns the LookupCache configured by the
+// params of a lookup vindex, or nil if it's not configured.
+func newLookupCache(m map[string]string, table, column string) (*LookupCache, error) {
+	sizeStr, ok := m["cache_size"]
+	if !ok {
+		return nil, nil
+	}
+	size, err := strconv.ParseInt(sizeStr, 10, 64)
+	if err != nil || size <= 0 {
+		return nil, fmt.Errorf("cache_size must be a positive integer: '%s'", sizeStr)
+	}
+	ttl := defaultLookupCacheTTL
+	if ttlStr, ok := m["cache_ttl"]; ok {
+		ttl, err = time.ParseDuration(ttlStr)
+		if err != nil || ttl <= 0 {
+			return nil, fmt.Errorf("cache_ttl must be a positive duration: '%s'", ttlStr)
+		}
+	}
+	return &LookupCache{
+		table:  table,
+		column: column,
+		ttl:    ttl,
+		lru:    cache.NewLRUCache(size),
+	}, nil
+}
+
+// lookupCacheKey returns the key of a value, and whether
+// the value is numeric. ok is false if the value can't be
+// cached because it's not a valid number.
+func lookupCacheKey(value sqltypes.Value) (key string, numeric, ok bool) {
+	if !value.IsIntegral() && !value.IsFloat() && value.Type() != sqltypes.Decimal {
+		return "b" + value.ToString(), false, true
+	}
+	hash, err := sqltypes.NullsafeHashcode(value)
+	if err != nil {
+		return "", true, false
+	}
+	return "n" + hash, true, true
+}
+
+// Source returns the lookup table, and its column
+// that has the values the results are cached for.
+func (lc *LookupCache) Source() (table, column string) {
+	return lc.table, lc.column
+}
+
+// Get returns the cached result for a value.
+func (lc *LookupCache) Get(value sqltypes.Value) (*sqltypes.Result, bool) {
+	key, _, ok := lookupCacheKey(value)
+	if ok {
+		if v, ok := lc.lru.Get(key); ok {
+			entry := v.(*lookupCacheEntry)
+			if time.Now().Before(entry.expires) {
+				lc.hits.Add(1)
+				return entry.result, true
+			}
+			lc.lru.Delete(key)
+		}
+	}
+	lc.misses.Add(1)
+	return nil, false
+}
+
+// Generation returns the current generation of the cache. It must be
+// read before the lookup whose result is passed to Set.
+func (lc *LookupCache) Generation() int64 {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	return lc.generation
+}
+
+// Set caches the result for a value. The result is dropped if the cache
+// was invalidated since generation: the lookup may have read a row that
+// was changed since.
+func (lc *LookupCache) Set(value sqltypes.Value, result *sqltypes.Result, generation int64) {
+	key, numeric, ok := lookupCacheKey(value)
+	if !ok {
+		return
+	}
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if generation != lc.generation {
+		return
+	}
+	if numeric {
+		lc.hasNumeric = true
+	} else {
+		lc.hasBytes = true
+	}
+	lc.lru.Set(key, &lookupCacheEntry{result: result, expires: time.Now().Add(lc.ttl)})
+}
+
+// Invalidate removes the cached result of a value, which has
+// the type of the column of the lookup table. See LookupCache
+// for the cases in which the whole cache is cleared.
+func (lc *LookupCache) Invalidate(value sqltypes.Value) {
+	key, numeric, ok := lookupCacheKey(value)
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.generation++
+	switch {
+	case !ok || sqltypes.IsText(value.Type()), numeric && lc.hasBytes, !numeric && lc.hasNumeric:
+		lc.clearLocked()
+	default:
+		lc.lru.Delete(key)
+	}
+}
+
+// Clear removes all the cached results.
+func (lc *LookupCache) Clear() {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.generation++
+	lc.clearLocked()
+}
+
+func (lc *LookupCache) clearLocked() {
+	lc.lru.Clear()
+	lc.hasNumeric = false
+	lc.hasBytes = false
+}
+
+// MarshalJSON returns the configuration and the stats of the cache.
+func (lc *LookupCache) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Size   int64  `json:"size"`
+		TTL    string `json:"ttl,omitempty"`
+		Length int64  `json:"length"`
+		Hits   int64  `json:"hits"`
+		Misses int64  `json:"misses"`
+	}{
+		Size:   lc.lru.Capacity(),
+		TTL:    lc.ttl.String(),
+		Length: lc.lru.Length(),
+		Hits:   lc.hits.Get(),
+		Misses: lc.misses.Get(),
+	})
+}
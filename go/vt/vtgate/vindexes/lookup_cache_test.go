@@ -0,0 +1,226 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xsec-lab/go/sqltypes"
+)
+
+// txVCursor is a vcursor that can be in a transaction.
+type txVCursor struct {
+	vcursor
+	inTransaction bool
+}
+
+func (vc *txVCursor) InTransaction() bool {
+	return vc.inTransaction
+}
+
+func createCachedLookup(t *testing.T, name string, params map[string]string) SingleColumn {
+	t.Helper()
+	m := map[string]string{
+		"table": "t",
+		"from":  "fromc",
+		"to":    "toc",
+	}
+	for k, v := range params {
+		m[k] = v
+	}
+	l, err := CreateVindex(name, name, m)
+	require.NoError(t, err)
+	return l.(SingleColumn)
+}
+
+func TestLookupCacheParams(t *testing.T) {
+	l := createCachedLookup(t, "lookup", nil)
+	assert.Nil(t, l.(CachedLookup).LookupCache())
+
+	l = createCachedLookup(t, "lookup_hash", map[string]string{"cache_size": "10", "cache_ttl": "1m"})
+	lc := l.(CachedLookup).LookupCache()
+	require.NotNil(t, lc)
+	table, column := lc.Source()
+	assert.Equal(t, "t", table)
+	assert.Equal(t, "fromc", column)
+
+	for _, name := range []string{"lookup", "lookup_unique", "lookup_hash", "lookup_hash_unique", "lookup_unicodeloosemd5_hash", "lookup_unicodeloosemd5_hash_unique", "consistent_lookup", "consistent_lookup_unique"} {
+		l := createCachedLookup(t, name, map[string]string{"cache_size": "10"})
+		assert.NotNil(t, l.(CachedLookup).LookupCache(), name)
+	}
+
+	_, err := CreateVindex("lookup", "lookup", map[string]string{"table": "t", "from": "fromc", "to": "toc", "cache_size": "0"})
+	assert.EqualError(t, err, "cache_size must be a positive integer: '0'")
+	_, err = CreateVindex("lookup", "lookup", map[string]string{"table": "t", "from": "fromc", "to": "toc", "cache_size": "10", "cache_ttl": "1"})
+	assert.EqualError(t, err, "cache_ttl must be a positive duration: '1'")
+}
+
+func TestLookupCache(t *testing.T) {
+	lc, err := newLookupCache(map[string]string{"cache_size": "2", "cache_ttl": "1h"}, "t", "fromc")
+	require.NoError(t, err)
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("toc", "int64"), "1")
+
+	_, ok := lc.Get(sqltypes.NewInt64(1))
+	assert.False(t, ok)
+	lc.Set(sqltypes.NewInt64(1), result, lc.Generation())
+	got, ok := lc.Get(sqltypes.NewInt64(1))
+	assert.True(t, ok)
+	assert.Equal(t, result, got)
+
+	// The numeric values are normalized.
+	_, ok = lc.Get(sqltypes.NewUint64(1))
+	assert.True(t, ok)
+	_, ok = lc.Get(sqltypes.TestValue(sqltypes.Decimal, "1.00"))
+	assert.True(t, ok)
+	_, ok = lc.Get(sqltypes.NewVarBinary("1"))
+	assert.False(t, ok)
+
+	lc.Invalidate(sqltypes.NewInt32(1))
+	_, ok = lc.Get(sqltypes.NewInt64(1))
+	assert.False(t, ok)
+
+	// Expired results are not returned.
+	lc.lru.Set("ni2", &lookupCacheEntry{result: result, expires: time.Now().Add(-time.Second)})
+	_, ok = lc.Get(sqltypes.NewInt64(2))
+	assert.False(t, ok)
+
+	lc.Set(sqltypes.NewInt64(3), result, lc.Generation())
+	lc.Clear()
+	_, ok = lc.Get(sqltypes.NewInt64(3))
+	assert.False(t, ok)
+
+	b, err := json.Marshal(lc)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"size":2,"ttl":"1h0m0s","length":0,"hits":3,"misses":5}`, string(b))
+}
+
+func TestLookupCacheDefaultTTL(t *testing.T) {
+	lc, err := newLookupCache(map[string]string{"cache_size": "2"}, "t", "fromc")
+	require.NoError(t, err)
+	assert.Equal(t, defaultLookupCacheTTL, lc.ttl)
+}
+
+func TestLookupCacheInvalidate(t *testing.T) {
+	lc, err := newLookupCache(map[string]string{"cache_size": "10"}, "t", "fromc")
+	require.NoError(t, err)
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("toc", "int64"), "1")
+	cached := func(value sqltypes.Value) bool {
+		_, ok := lc.Get(value)
+		return ok
+	}
+
+	// The binary values are invalidated by their bytes.
+	lc.Set(sqltypes.NewVarBinary("abc"), result, lc.Generation())
+	lc.Set(sqltypes.NewVarBinary("ABC"), result, lc.Generation())
+	lc.Invalidate(sqltypes.NewVarBinary("abc"))
+	assert.False(t, cached(sqltypes.NewVarBinary("abc")))
+	assert.True(t, cached(sqltypes.NewVarBinary("ABC")))
+
+	// The collation of text values is not known: 'ABC' can be
+	// equal to 'abc'. So, the whole cache is cleared.
+	lc.Set(sqltypes.NewVarBinary("xyz"), result, lc.Generation())
+	lc.Invalidate(sqltypes.NewVarChar("abc"))
+	assert.False(t, cached(sqltypes.NewVarBinary("ABC")))
+	assert.False(t, cached(sqltypes.NewVarBinary("xyz")))
+
+	// A numeric column matches the values cached by their bytes,
+	// like '01', as numbers. So, the whole cache is cleared.
+	lc.Set(sqltypes.NewVarBinary("01"), result, lc.Generation())
+	lc.Set(sqltypes.NewInt64(2), result, lc.Generation())
+	lc.Invalidate(sqltypes.NewInt64(1))
+	assert.False(t, cached(sqltypes.NewVarBinary("01")))
+	assert.False(t, cached(sqltypes.NewInt64(2)))
+
+	// Without values cached by their bytes, only
+	// the numeric value is invalidated.
+	lc.Set(sqltypes.NewInt64(1), result, lc.Generation())
+	lc.Set(sqltypes.NewInt64(2), result, lc.Generation())
+	lc.Invalidate(sqltypes.NewUint64(1))
+	assert.False(t, cached(sqltypes.NewInt64(1)))
+	assert.True(t, cached(sqltypes.NewInt64(2)))
+
+	// Likewise, a binary column matches numbers as numbers.
+	lc.Invalidate(sqltypes.NewVarBinary("2"))
+	assert.False(t, cached(sqltypes.NewInt64(2)))
+}
+
+func TestLookupCacheSetAfterInvalidate(t *testing.T) {
+	lc, err := newLookupCache(map[string]string{"cache_size": "10"}, "t", "fromc")
+	require.NoError(t, err)
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("toc", "int64"), "1")
+
+	// The row changes between the lookup and Set:
+	// the result of the lookup may be stale.
+	generation := lc.Generation()
+	lc.Invalidate(sqltypes.NewInt64(1))
+	lc.Set(sqltypes.NewInt64(1), result, generation)
+	_, ok := lc.Get(sqltypes.NewInt64(1))
+	assert.False(t, ok)
+
+	// Changes to other rows also prevent the result from being cached.
+	generation = lc.Generation()
+	lc.Invalidate(sqltypes.NewInt64(2))
+	lc.Set(sqltypes.NewInt64(1), result, generation)
+	_, ok = lc.Get(sqltypes.NewInt64(1))
+	assert.False(t, ok)
+
+	generation = lc.Generation()
+	lc.Set(sqltypes.NewInt64(1), result, generation)
+	_, ok = lc.Get(sqltypes.NewInt64(1))
+	assert.True(t, ok)
+}
+
+func TestLookupNonUniqueMapCache(t *testing.T) {
+	l := createCachedLookup(t, "lookup", map[string]string{"cache_size": "10"})
+	vc := &txVCursor{vcursor: vcursor{numRows: 1}}
+	ids := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}
+
+	want, err := l.Map(vc, ids)
+	require.NoError(t, err)
+	assert.Len(t, vc.queries, 2)
+
+	// The second lookup is served by the cache.
+	got, err := l.Map(vc, ids)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Len(t, vc.queries, 2)
+
+	// Writing to the lookup table invalidates the written values.
+	err = l.(Lookup).Create(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, [][]byte{[]byte("test1")}, false)
+	require.NoError(t, err)
+	err = l.(Lookup).Delete(vc, [][]sqltypes.Value{{sqltypes.NewInt64(2)}}, []byte("test2"))
+	require.NoError(t, err)
+	vc.queries = nil
+	_, err = l.Map(vc, ids)
+	require.NoError(t, err)
+	assert.Len(t, vc.queries, 2)
+
+	// The results seen in a transaction are not cached.
+	l.(CachedLookup).LookupCache().Clear()
+	vc.inTransaction = true
+	vc.queries = nil
+	_, err = l.Map(vc, ids)
+	require.NoError(t, err)
+	_, err = l.Map(vc, ids)
+	require.NoError(t, err)
+	assert.Len(t, vc.queries, 4)
+}
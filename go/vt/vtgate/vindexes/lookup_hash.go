@@ -31,6 +31,8 @@ var (
 	_ Lookup       = (*LookupHash)(nil)
 	_ SingleColumn = (*LookupHashUnique)(nil)
 	_ Lookup       = (*LookupHashUnique)(nil)
+	_ CachedLookup = (*LookupHash)(nil)
+	_ CachedLookup = (*LookupHashUnique)(nil)
 )
 
 func init() {
@@ -59,6 +61,8 @@ type LookupHash struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: the number of lookup results to cache in vtgate.
+//   cache_ttl: how long the cached results are valid, e.g. "1m". It defaults to 10m.
 func NewLookupHash(name string, m map[string]string) (Vindex, error) {
 	lh := &LookupHash{name: name}
 
@@ -176,6 +180,11 @@ func (lh *LookupHash) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value,
 	return lh.lkp.Delete(vcursor, rowsColValues, sqltypes.NewUint64(v), vtgatepb.CommitOrder_NORMAL)
 }
 
+// LookupCache returns the cache of the lookups, or nil if it's not enabled.
+func (lh *LookupHash) LookupCache() *LookupCache {
+	return lh.lkp.Cache
+}
+
 // MarshalJSON returns a JSON representation of LookupHash.
 func (lh *LookupHash) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lh.lkp)
@@ -215,6 +224,8 @@ type LookupHashUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: the number of lookup results to cache in vtgate.
+//   cache_ttl: how long the cached results are valid, e.g. "1m". It defaults to 10m.
 func NewLookupHashUnique(name string, m map[string]string) (Vindex, error) {
 	lhu := &LookupHashUnique{name: name}
 
@@ -330,6 +341,11 @@ func (lhu *LookupHashUnique) Update(vcursor VCursor, oldValues []sqltypes.Value,
 	return lhu.lkp.Update(vcursor, oldValues, ksid, sqltypes.NewUint64(v), newValues)
 }
 
+// LookupCache returns the cache of the lookups, or nil if it's not enabled.
+func (lhu *LookupHashUnique) LookupCache() *LookupCache {
+	return lhu.lkp.Cache
+}
+
 // MarshalJSON returns a JSON representation of LookupHashUnique.
 func (lhu *LookupHashUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lhu.lkp)
@@ -31,14 +31,21 @@ import (
 
 // lookupInternal implements the functions for the Lookup vindexes.
 type lookupInternal struct {
-	Table         string   `json:"table"`
-	FromColumns   []string `json:"from_columns"`
-	To            string   `json:"to"`
-	Autocommit    bool     `json:"autocommit,omitempty"`
-	Upsert        bool     `json:"upsert,omitempty"`
+	Table         string       `json:"table"`
+	FromColumns   []string     `json:"from_columns"`
+	To            string       `json:"to"`
+	Autocommit    bool         `json:"autocommit,omitempty"`
+	Upsert        bool         `json:"upsert,omitempty"`
+	Cache         *LookupCache `json:"cache,omitempty"`
 	sel, ver, del string
 }
 
+// transactionalVCursor is implemented by the VCursors
+// that can execute the lookups in a transaction.
+type transactionalVCursor interface {
+	InTransaction() bool
+}
+
 func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit, upsert bool) error {
 	lkp.Table = lookupQueryParams["table"]
 	lkp.To = lookupQueryParams["to"]
@@ -57,7 +64,10 @@ func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit,
 	lkp.sel = fmt.Sprintf("select %s from %s where %s = :%s", lkp.To, lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0])
 	lkp.ver = fmt.Sprintf("select %s from %s where %s = :%s and %s = :%s", lkp.FromColumns[0], lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0], lkp.To, lkp.To)
 	lkp.del = lkp.initDelStmt()
-	return nil
+
+	var err error
+	lkp.Cache, err = newLookupCache(lookupQueryParams, lkp.Table, lkp.FromColumns[0])
+	return err
 }
 
 // Lookup performs a lookup for the ids.
@@ -66,7 +76,20 @@ func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value) ([]*sql
 		return nil, fmt.Errorf("cannot perform lookup: no vcursor provided")
 	}
 	results := make([]*sqltypes.Result, 0, len(ids))
+	cacheable := lkp.cacheable(vcursor)
 	for _, id := range ids {
+		if lkp.Cache != nil {
+			if result, ok := lkp.Cache.Get(id); ok {
+				results = append(results, result)
+				continue
+			}
+		}
+		// The generation is read before the lookup, so that its
+		// result is not cached if the row changes meanwhile.
+		var generation int64
+		if cacheable {
+			generation = lkp.Cache.Generation()
+		}
 		bindVars := map[string]*querypb.BindVariable{
 			lkp.FromColumns[0]: sqltypes.ValueBindVariable(id),
 		}
@@ -80,11 +103,39 @@ func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value) ([]*sql
 		if err != nil {
 			return nil, fmt.Errorf("lookup.Map: %v", err)
 		}
+		if cacheable {
+			lkp.Cache.Set(id, result, generation)
+		}
 		results = append(results, result)
 	}
 	return results, nil
 }
 
+// cacheable returns true if the results of the lookups can be
+// cached. The lookups executed in a transaction can see rows that
+// will be rolled back, unless they're executed in autocommit mode.
+func (lkp *lookupInternal) cacheable(vcursor VCursor) bool {
+	if lkp.Cache == nil {
+		return false
+	}
+	if lkp.Autocommit {
+		return true
+	}
+	tvc, ok := vcursor.(transactionalVCursor)
+	return !ok || !tvc.InTransaction()
+}
+
+// invalidate removes the cached results of the rows
+// that are written to the lookup table.
+func (lkp *lookupInternal) invalidate(rowsColValues [][]sqltypes.Value) {
+	if lkp.Cache == nil {
+		return
+	}
+	for _, row := range rowsColValues {
+		lkp.Cache.Invalidate(row[0])
+	}
+}
+
 // Verify returns true if ids map to values.
 func (lkp *lookupInternal) Verify(vcursor VCursor, ids, values []sqltypes.Value) ([]bool, error) {
 	co := vtgatepb.CommitOrder_NORMAL
@@ -167,6 +218,7 @@ func (lkp *lookupInternal) createCustom(vcursor VCursor, rowsColValues [][]sqlty
 	if len(rowsColValues[0]) != len(lkp.FromColumns) {
 		return fmt.Errorf("lookup.Create: column vindex count does not match the columns in the lookup: %d vs %v", len(rowsColValues[0]), lkp.FromColumns)
 	}
+	lkp.invalidate(rowsColValues)
 	buf := new(bytes.Buffer)
 	if ignoreMode {
 		fmt.Fprintf(buf, "insert ignore into %s(", lkp.Table)
@@ -241,6 +293,7 @@ func (lkp *lookupInternal) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Va
 	if len(rowsColValues[0]) != len(lkp.FromColumns) {
 		return fmt.Errorf("lookup.Delete: column vindex count does not match the columns in the lookup: %d vs %v", len(rowsColValues[0]), lkp.FromColumns)
 	}
+	lkp.invalidate(rowsColValues)
 	for _, column := range rowsColValues {
 		bindVars := make(map[string]*querypb.BindVariable, len(rowsColValues))
 		for colIdx, columnValue := range column {
@@ -32,6 +32,8 @@ var (
 	_ Lookup       = (*LookupUnicodeLooseMD5Hash)(nil)
 	_ SingleColumn = (*LookupUnicodeLooseMD5HashUnique)(nil)
 	_ Lookup       = (*LookupUnicodeLooseMD5HashUnique)(nil)
+	_ CachedLookup = (*LookupUnicodeLooseMD5Hash)(nil)
+	_ CachedLookup = (*LookupUnicodeLooseMD5HashUnique)(nil)
 )
 
 func init() {
@@ -60,6 +62,8 @@ type LookupUnicodeLooseMD5Hash struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: the number of lookup results to cache in vtgate.
+//   cache_ttl: how long the cached results are valid, e.g. "1m". It defaults to 10m.
 func NewLookupUnicodeLooseMD5Hash(name string, m map[string]string) (Vindex, error) {
 	lh := &LookupUnicodeLooseMD5Hash{name: name}
 
@@ -201,6 +205,11 @@ func (lh *LookupUnicodeLooseMD5Hash) Delete(vcursor VCursor, rowsColValues [][]s
 	return lh.lkp.Delete(vcursor, rowsColValues, sqltypes.NewUint64(v), vtgatepb.CommitOrder_NORMAL)
 }
 
+// LookupCache returns the cache of the lookups, or nil if it's not enabled.
+func (lh *LookupUnicodeLooseMD5Hash) LookupCache() *LookupCache {
+	return lh.lkp.Cache
+}
+
 // MarshalJSON returns a JSON representation of LookupHash.
 func (lh *LookupUnicodeLooseMD5Hash) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lh.lkp)
@@ -227,6 +236,8 @@ type LookupUnicodeLooseMD5HashUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: the number of lookup results to cache in vtgate.
+//   cache_ttl: how long the cached results are valid, e.g. "1m". It defaults to 10m.
 func NewLookupUnicodeLooseMD5HashUnique(name string, m map[string]string) (Vindex, error) {
 	lhu := &LookupUnicodeLooseMD5HashUnique{name: name}
 
@@ -366,6 +377,11 @@ func (lhu *LookupUnicodeLooseMD5HashUnique) Update(vcursor VCursor, oldValues []
 	return lhu.lkp.Update(vcursor, oldValues, ksid, sqltypes.NewUint64(v), newValues)
 }
 
+// LookupCache returns the cache of the lookups, or nil if it's not enabled.
+func (lhu *LookupUnicodeLooseMD5HashUnique) LookupCache() *LookupCache {
+	return lhu.lkp.Cache
+}
+
 // MarshalJSON returns a JSON representation of LookupHashUnique.
 func (lhu *LookupUnicodeLooseMD5HashUnique) MarshalJSON() ([]byte, error) {
 	return json.Marshal(lhu.lkp)
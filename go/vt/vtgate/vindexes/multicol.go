@@ -0,0 +1,213 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/key"
+)
+
+var (
+	_ MultiColumn = (*MultiCol)(nil)
+)
+
+// multiColHashes are the hash functions that MultiCol can apply to a column.
+var multiColHashes = map[string]func(sqltypes.Value) ([]byte, error){
+	"hash": func(v sqltypes.Value) ([]byte, error) {
+		n, err := sqltypes.ToUint64(v)
+		if err != nil {
+			return nil, err
+		}
+		return vhash(n), nil
+	},
+	"xxhash": func(v sqltypes.Value) ([]byte, error) {
+		return vXXHash(v.ToBytes()), nil
+	},
+	"binary_md5": func(v sqltypes.Value) ([]byte, error) {
+		return binHash(v.ToBytes()), nil
+	},
+	"unicode_loose_md5": unicodeHash,
+}
+
+// MultiCol is a multi-column unique vindex. Each column is hashed
+// separately, and the keyspace id is the concatenation of the
+// leading bytes of each hash. The number of bytes that each column
+// contributes is configurable, and they add up to 8.
+// Because the leading columns make up the leading bytes of the
+// keyspace id, the values of the leading columns alone map to the
+// key range that contains all the rows that have them.
+type MultiCol struct {
+	name        string
+	hashes      []func(sqltypes.Value) ([]byte, error)
+	columnBytes []int
+}
+
+// NewMultiCol creates a MultiCol vindex.
+// The supplied map has the following required fields:
+//   column_count: the number of columns, between 1 and 8.
+//
+// The following fields are optional:
+//   column_vindex: comma separated list of the hash functions of the
+//   columns: hash, xxhash, binary_md5 or unicode_loose_md5. The default
+//   is hash for all the columns.
+//   column_bytes: comma separated list of the number of bytes that
+//   each column contributes to the keyspace id. They must add up to 8.
+//   The default is 1 for the leading columns, and the rest for the
+//   last column.
+func NewMultiCol(name string, m map[string]string) (Vindex, error) {
+	count, err := strconv.Atoi(m["column_count"])
+	if err != nil || count < 1 || count > 8 {
+		return nil, fmt.Errorf("multicol: column_count must be between 1 and 8: '%s'", m["column_count"])
+	}
+	mc := &MultiCol{
+		name:        name,
+		hashes:      make([]func(sqltypes.Value) ([]byte, error), count),
+		columnBytes: make([]int, count),
+	}
+
+	hashNames := make([]string, count)
+	if s := m["column_vindex"]; s != "" {
+		hashNames = strings.Split(s, ",")
+		if len(hashNames) != count {
+			return nil, fmt.Errorf("multicol: column_vindex must have %d values: '%s'", count, s)
+		}
+	}
+	for i, hashName := range hashNames {
+		hashName = strings.TrimSpace(hashName)
+		if hashName == "" {
+			hashName = "hash"
+		}
+		hash, ok := multiColHashes[hashName]
+		if !ok {
+			return nil, fmt.Errorf("multicol: unsupported column_vindex: '%s'", hashName)
+		}
+		mc.hashes[i] = hash
+	}
+
+	if s := m["column_bytes"]; s != "" {
+		byteStrs := strings.Split(s, ",")
+		if len(byteStrs) != count {
+			return nil, fmt.Errorf("multicol: column_bytes must have %d values: '%s'", count, s)
+		}
+		total := 0
+		for i, byteStr := range byteStrs {
+			n, err := strconv.Atoi(strings.TrimSpace(byteStr))
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("multicol: column_bytes must be positive integers: '%s'", s)
+			}
+			mc.columnBytes[i] = n
+			total += n
+		}
+		if total != 8 {
+			return nil, fmt.Errorf("multicol: column_bytes must add up to 8: '%s'", s)
+		}
+	} else {
+		for i := range mc.columnBytes {
+			mc.columnBytes[i] = 1
+		}
+		mc.columnBytes[count-1] = 8 - (count - 1)
+	}
+	return mc, nil
+}
+
+// String returns the name of the vindex.
+func (mc *MultiCol) String() string {
+	return mc.name
+}
+
+// Cost returns the cost of this index as 1.
+func (mc *MultiCol) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (mc *MultiCol) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (mc *MultiCol) NeedsVCursor() bool {
+	return false
+}
+
+// PartialVindex returns true since the values of the leading
+// columns map to a key range.
+func (mc *MultiCol) PartialVindex() bool {
+	return true
+}
+
+// Map satisfies MultiColumn. A row that has the values of all the
+// columns maps to a keyspace id. A row that has the values of only
+// the leading columns maps to the key range of the keyspace ids
+// that start with them.
+func (mc *MultiCol) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	destinations := make([]key.Destination, 0, len(rowsColValues))
+	for _, row := range rowsColValues {
+		ksid, err := mc.hash(row)
+		switch {
+		case err != nil:
+			destinations = append(destinations, key.DestinationNone{})
+		case len(row) == len(mc.hashes):
+			destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+		default:
+			destinations = append(destinations, key.DestinationKeyRange{KeyRange: prefixKeyRange(ksid)})
+		}
+	}
+	return destinations, nil
+}
+
+// Verify satisfies MultiColumn.
+func (mc *MultiCol) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	result := make([]bool, len(rowsColValues))
+	for i, row := range rowsColValues {
+		if len(row) != len(mc.hashes) {
+			continue
+		}
+		ksid, err := mc.hash(row)
+		if err != nil {
+			continue
+		}
+		result[i] = bytes.Equal(ksid, ksids[i])
+	}
+	return result, nil
+}
+
+// hash returns the leading bytes of the keyspace id
+// that are computed from the values of the row.
+func (mc *MultiCol) hash(row []sqltypes.Value) ([]byte, error) {
+	if len(row) == 0 || len(row) > len(mc.hashes) {
+		return nil, fmt.Errorf("multicol: wrong number of column values: %d", len(row))
+	}
+	ksid := make([]byte, 0, 8)
+	for i, v := range row {
+		h, err := mc.hashes[i](v)
+		if err != nil {
+			return nil, err
+		}
+		ksid = append(ksid, h[:mc.columnBytes[i]]...)
+	}
+	return ksid, nil
+}
+
+func init() {
+	Register("multicol", NewMultiCol)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/key"
+)
+
+var multiCol MultiColumn
+
+func init() {
+	vindex, err := CreateVindex("multicol", "multicol_name", map[string]string{
+		"column_count":  "3",
+		"column_vindex": "hash,xxhash,binary_md5",
+		"column_bytes":  "1,2,5",
+	})
+	if err != nil {
+		panic(err)
+	}
+	multiCol = vindex.(MultiColumn)
+}
+
+func TestMultiColInfo(t *testing.T) {
+	assert.Equal(t, 1, multiCol.Cost())
+	assert.Equal(t, "multicol_name", multiCol.String())
+	assert.True(t, multiCol.IsUnique())
+	assert.False(t, multiCol.NeedsVCursor())
+	assert.True(t, multiCol.PartialVindex())
+}
+
+func TestMultiColNew(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{},
+		err:    "multicol: column_count must be between 1 and 8: ''",
+	}, {
+		params: map[string]string{"column_count": "9"},
+		err:    "multicol: column_count must be between 1 and 8: '9'",
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash"},
+		err:    "multicol: column_vindex must have 2 values: 'hash'",
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash,lookup"},
+		err:    "multicol: unsupported column_vindex: 'lookup'",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "1,a"},
+		err:    "multicol: column_bytes must be positive integers: '1,a'",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "2,2"},
+		err:    "multicol: column_bytes must add up to 8: '2,2'",
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": ",unicode_loose_md5"},
+	}}
+	for _, tc := range testcases {
+		_, err := CreateVindex("multicol", "mc", tc.params)
+		if tc.err == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, tc.err)
+		}
+	}
+}
+
+func TestMultiColMap(t *testing.T) {
+	ksid := concatBytes(vhash(1)[:1], vXXHash([]byte("a"))[:2], binHash([]byte("b"))[:5])
+	got, err := multiCol.Map(nil, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NewVarChar("b")},
+		// Partial rows map to key ranges.
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")},
+		{sqltypes.NewInt64(1)},
+		// Invalid values.
+		{sqltypes.NewVarChar("a"), sqltypes.NewVarChar("a"), sqltypes.NewVarChar("b")},
+		{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{
+		key.DestinationKeyspaceID(ksid),
+		key.DestinationKeyRange{KeyRange: prefixKeyRange(ksid[:3])},
+		key.DestinationKeyRange{KeyRange: prefixKeyRange(ksid[:1])},
+		key.DestinationNone{},
+		key.DestinationNone{},
+	}, got)
+
+	// The rows that have the leading values are in their key range.
+	kr := got[1].(key.DestinationKeyRange).KeyRange
+	for _, last := range []string{"b", "c", "d"} {
+		ksids, err := multiCol.Map(nil, [][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NewVarChar(last)}})
+		require.NoError(t, err)
+		assert.True(t, key.KeyRangeContains(kr, ksids[0].(key.DestinationKeyspaceID)), last)
+	}
+}
+
+func TestMultiColDefaultBytes(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "mc", map[string]string{"column_count": "3"})
+	require.NoError(t, err)
+	got, err := vindex.(MultiColumn).Map(nil, [][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(3)}})
+	require.NoError(t, err)
+	assert.Equal(t, key.DestinationKeyspaceID(concatBytes(vhash(1)[:1], vhash(2)[:1], vhash(3)[:6])), got[0])
+}
+
+func TestMultiColVerify(t *testing.T) {
+	ksid := concatBytes(vhash(1)[:1], vXXHash([]byte("a"))[:2], binHash([]byte("b"))[:5])
+	got, err := multiCol.Verify(nil, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NewVarChar("b")},
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NewVarChar("c")},
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")},
+	}, [][]byte{ksid, ksid, ksid[:3]})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, got)
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/key"
+
+	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
+)
+
+var (
+	_ SingleColumn = (*PrefixHash)(nil)
+	_ Prefixable   = (*PrefixHash)(nil)
+)
+
+// PrefixHash defines a vindex that splits a string id into segments,
+// and hashes each segment separately. The keyspace id is the
+// concatenation of the hashes, so the ids that share their leading
+// segments are placed in a contiguous range of keyspace ids.
+// This allows the queries that filter on a prefix of the id, like
+// LIKE 'prefix%', to be routed to the shards that cover the range
+// instead of all the shards.
+// The ids are hashed as bytes, so the column must have a binary
+// collation, like a VARBINARY column. Otherwise, the ids that are equal
+// in the collation of the column, like 'ABC' and 'abc' in a case
+// insensitive one, can be on different shards. VTGate only routes the
+// LIKE predicates if the vschema declares the column as binary.
+// It's Unique and Prefixable.
+type PrefixHash struct {
+	name string
+	// segments are the lengths, in bytes, of the leading
+	// segments of the id. The rest of the id is the last one.
+	segments []int
+	// segmentBytes is the number of bytes of the keyspace id
+	// that are taken by each of the leading segments.
+	segmentBytes int
+}
+
+// NewPrefixHash creates a PrefixHash vindex.
+// The supplied map has the following required fields:
+//   segments: comma separated list of the lengths, in bytes, of the
+//   leading segments of the id, e.g. "4" or "2,6".
+//
+// The following fields are optional:
+//   segment_bytes: the number of bytes of the keyspace id taken by the
+//   hash of each leading segment. The default is 2. The hash of the
+//   rest of the id takes 8 bytes.
+func NewPrefixHash(name string, m map[string]string) (Vindex, error) {
+	ph := &PrefixHash{
+		name:         name,
+		segmentBytes: 2,
+	}
+	segments := m["segments"]
+	if segments == "" {
+		return nil, fmt.Errorf("prefix_hash: segments must be specified")
+	}
+	for _, s := range strings.Split(segments, ",") {
+		length, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || length <= 0 {
+			return nil, fmt.Errorf("prefix_hash: segment lengths must be positive integers: '%s'", segments)
+		}
+		ph.segments = append(ph.segments, length)
+	}
+	if s, ok := m["segment_bytes"]; ok {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 || n > 8 {
+			return nil, fmt.Errorf("prefix_hash: segment_bytes must be between 1 and 8: '%s'", s)
+		}
+		ph.segmentBytes = n
+	}
+	return ph, nil
+}
+
+// String returns the name of the vindex.
+func (ph *PrefixHash) String() string {
+	return ph.name
+}
+
+// Cost returns the cost of this index as 1.
+func (ph *PrefixHash) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (ph *PrefixHash) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (ph *PrefixHash) NeedsVCursor() bool {
+	return false
+}
+
+// Map can map ids to key.Destination objects.
+func (ph *PrefixHash) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, len(ids))
+	for i := range ids {
+		out[i] = key.DestinationKeyspaceID(ph.hash(ids[i].ToBytes()))
+	}
+	return out, nil
+}
+
+// Verify returns true if ids maps to ksids.
+func (ph *PrefixHash) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i := range ids {
+		out[i] = bytes.Equal(ph.hash(ids[i].ToBytes()), ksids[i])
+	}
+	return out, nil
+}
+
+// MapPrefix maps each prefix to the key range of the ids that start
+// with it. Only the leading segments that are complete in the prefix
+// narrow the range. If there are none, the range is the full range.
+func (ph *PrefixHash) MapPrefix(cursor VCursor, prefixes []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, len(prefixes))
+	for i := range prefixes {
+		prefix := prefixes[i].ToBytes()
+		var start []byte
+		offset := 0
+		for _, length := range ph.segments {
+			if offset+length > len(prefix) {
+				break
+			}
+			start = append(start, ph.hashSegment(prefix[offset:offset+length])...)
+			offset += length
+		}
+		out[i] = key.DestinationKeyRange{KeyRange: prefixKeyRange(start)}
+	}
+	return out, nil
+}
+
+// hash returns the keyspace id of an id. If the id is shorter than
+// the leading segments, the missing segments are empty.
+func (ph *PrefixHash) hash(id []byte) []byte {
+	ksid := make([]byte, 0, len(ph.segments)*ph.segmentBytes+8)
+	offset := 0
+	for _, length := range ph.segments {
+		end := offset + length
+		if end > len(id) {
+			end = len(id)
+		}
+		ksid = append(ksid, ph.hashSegment(id[offset:end])...)
+		offset = end
+	}
+	return append(ksid, vXXHash(id[offset:])...)
+}
+
+func (ph *PrefixHash) hashSegment(segment []byte) []byte {
+	return vXXHash(segment)[:ph.segmentBytes]
+}
+
+// prefixKeyRange returns the key range of the
+// keyspace ids that start with the prefix.
+func prefixKeyRange(prefix []byte) *topodatapb.KeyRange {
+	if len(prefix) == 0 {
+		return &topodatapb.KeyRange{}
+	}
+	end := append([]byte(nil), prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return &topodatapb.KeyRange{Start: prefix, End: end[:i+1]}
+		}
+	}
+	// The prefix is all 0xff: the range extends to the end.
+	return &topodatapb.KeyRange{Start: prefix}
+}
+
+func init() {
+	Register("prefix_hash", NewPrefixHash)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/key"
+
+	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
+)
+
+var prefixHash SingleColumn
+
+func init() {
+	vindex, err := CreateVindex("prefix_hash", "prefix_hash_name", map[string]string{"segments": "4,2"})
+	if err != nil {
+		panic(err)
+	}
+	prefixHash = vindex.(SingleColumn)
+}
+
+func concatBytes(parts ...[]byte) []byte {
+	var out []byte
+	for _, part := range parts {
+		out = append(out, part...)
+	}
+	return out
+}
+
+func TestPrefixHashInfo(t *testing.T) {
+	assert.Equal(t, 1, prefixHash.Cost())
+	assert.Equal(t, "prefix_hash_name", prefixHash.String())
+	assert.True(t, prefixHash.IsUnique())
+	assert.False(t, prefixHash.NeedsVCursor())
+}
+
+func TestPrefixHashNew(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{},
+		err:    "prefix_hash: segments must be specified",
+	}, {
+		params: map[string]string{"segments": "4,a"},
+		err:    "prefix_hash: segment lengths must be positive integers: '4,a'",
+	}, {
+		params: map[string]string{"segments": "0"},
+		err:    "prefix_hash: segment lengths must be positive integers: '0'",
+	}, {
+		params: map[string]string{"segments": "4", "segment_bytes": "9"},
+		err:    "prefix_hash: segment_bytes must be between 1 and 8: '9'",
+	}, {
+		params: map[string]string{"segments": "4, 2", "segment_bytes": "3"},
+	}}
+	for _, tc := range testcases {
+		_, err := CreateVindex("prefix_hash", "ph", tc.params)
+		if tc.err == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, tc.err)
+		}
+	}
+}
+
+func TestPrefixHashMap(t *testing.T) {
+	testcases := []struct {
+		in  sqltypes.Value
+		out []byte
+	}{{
+		in:  sqltypes.NewVarChar("acmeusrest"),
+		out: concatBytes(vXXHash([]byte("acme"))[:2], vXXHash([]byte("us"))[:2], vXXHash([]byte("rest"))),
+	}, {
+		in:  sqltypes.NewVarChar("acmeus"),
+		out: concatBytes(vXXHash([]byte("acme"))[:2], vXXHash([]byte("us"))[:2], vXXHash(nil)),
+	}, {
+		// The missing segments are empty.
+		in:  sqltypes.NewVarChar("acm"),
+		out: concatBytes(vXXHash([]byte("acm"))[:2], vXXHash(nil)[:2], vXXHash(nil)),
+	}, {
+		in:  sqltypes.NewInt64(1234567),
+		out: concatBytes(vXXHash([]byte("1234"))[:2], vXXHash([]byte("56"))[:2], vXXHash([]byte("7"))),
+	}}
+	for _, tc := range testcases {
+		got, err := prefixHash.Map(nil, []sqltypes.Value{tc.in})
+		require.NoError(t, err)
+		assert.Equal(t, key.DestinationKeyspaceID(tc.out), got[0], tc.in.String())
+	}
+}
+
+func TestPrefixHashVerify(t *testing.T) {
+	ids := []sqltypes.Value{sqltypes.NewVarChar("acmeusrest"), sqltypes.NewVarChar("acmeusother")}
+	ksid := concatBytes(vXXHash([]byte("acme"))[:2], vXXHash([]byte("us"))[:2], vXXHash([]byte("rest")))
+	got, err := prefixHash.Verify(nil, ids, [][]byte{ksid, ksid})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, got)
+}
+
+func TestPrefixHashMapPrefix(t *testing.T) {
+	acme := vXXHash([]byte("acme"))[:2]
+	us := vXXHash([]byte("us"))[:2]
+	got, err := prefixHash.(Prefixable).MapPrefix(nil, []sqltypes.Value{
+		sqltypes.NewVarChar("ac"),
+		sqltypes.NewVarChar("acmeu"),
+		sqltypes.NewVarChar("acmeusre"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}},
+		key.DestinationKeyRange{KeyRange: prefixKeyRange(acme)},
+		key.DestinationKeyRange{KeyRange: prefixKeyRange(concatBytes(acme, us))},
+	}, got)
+
+	// All the ids that start with the prefix are in its key range.
+	ranges, err := prefixHash.(Prefixable).MapPrefix(nil, []sqltypes.Value{sqltypes.NewVarChar("acmeu")})
+	require.NoError(t, err)
+	kr := ranges[0].(key.DestinationKeyRange).KeyRange
+	for _, id := range []string{"acme", "acmeus", "acmeusrest", "acmeca"} {
+		ksids, err := prefixHash.Map(nil, []sqltypes.Value{sqltypes.NewVarChar(id)})
+		require.NoError(t, err)
+		assert.True(t, key.KeyRangeContains(kr, ksids[0].(key.DestinationKeyspaceID)), id)
+	}
+}
+
+func TestPrefixKeyRange(t *testing.T) {
+	assert.Equal(t, &topodatapb.KeyRange{}, prefixKeyRange(nil))
+	assert.Equal(t, &topodatapb.KeyRange{Start: []byte{0x12, 0x34}, End: []byte{0x12, 0x35}}, prefixKeyRange([]byte{0x12, 0x34}))
+	assert.Equal(t, &topodatapb.KeyRange{Start: []byte{0x12, 0xff}, End: []byte{0x13}}, prefixKeyRange([]byte{0x12, 0xff}))
+	assert.Equal(t, &topodatapb.KeyRange{Start: []byte{0xff, 0xff}}, prefixKeyRange([]byte{0xff, 0xff}))
+}
@@ -139,3 +139,9 @@ func (rv *RegionJson) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value,
 func (rv *RegionJson) NeedsVCursor() bool {
 	return false
 }
+
+// PartialVindex returns false since the vindex
+// needs the values of all the columns.
+func (rv *RegionJson) PartialVindex() bool {
+	return false
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/key"
+
+	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
+)
+
+var (
+	_ SingleColumn = (*TimeRange)(nil)
+	_ Rangeable    = (*TimeRange)(nil)
+)
+
+// timeLayouts are the formats of the DATE, DATETIME and
+// TIMESTAMP values that the TimeRange vindex accepts.
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// epochDays is the number of days from 0001-01-01 to 1970-01-01.
+const epochDays = 719162
+
+// TimeRange defines a vindex that partitions the rows by time.
+// The time line is split into ranges, and each range is assigned
+// a key range. The keyspace id of a time is the start of the key
+// range of its time range, followed by the number of its bucket,
+// which is its day or month. So, the keyspace ids are in the same
+// order as the times, and the rows of a time range are stored in
+// the shard that contains the start of its key range.
+// This allows the queries that filter on a range of times, like
+// BETWEEN, >= or <, to be routed to the shards that cover the range
+// instead of all the shards.
+// It's Unique and Rangeable.
+type TimeRange struct {
+	name    string
+	bucket  string
+	entries []timeRangeEntry
+}
+
+// timeRangeEntry assigns keyRange to the times
+// from start up to the start of the next entry.
+type timeRangeEntry struct {
+	start    time.Time
+	keyRange *topodatapb.KeyRange
+}
+
+// NewTimeRange creates a TimeRange vindex.
+// The supplied map has the following required fields:
+//   ranges: comma separated list of time ranges, in increasing order,
+//   each specified as start=keyrange, e.g.
+//   "2019-01-01=-40,2019-07-01=40-80,2020-01-01=80-". The key ranges
+//   must be in increasing order and must not overlap. The times before
+//   the first start don't map to any keyspace id.
+//
+// The following fields are optional:
+//   bucket: the granularity of the keyspace ids, day or month. The
+//   default is month.
+func NewTimeRange(name string, m map[string]string) (Vindex, error) {
+	tr := &TimeRange{
+		name:   name,
+		bucket: "month",
+	}
+	if bucket, ok := m["bucket"]; ok {
+		switch bucket {
+		case "day", "month":
+			tr.bucket = bucket
+		default:
+			return nil, fmt.Errorf("time_range: bucket must be day or month: '%s'", bucket)
+		}
+	}
+	ranges := m["ranges"]
+	if ranges == "" {
+		return nil, fmt.Errorf("time_range: ranges must be specified")
+	}
+	for _, r := range strings.Split(ranges, ",") {
+		parts := strings.Split(strings.TrimSpace(r), "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("time_range: ranges must be specified as start=keyrange: '%s'", r)
+		}
+		start, ok := parseTime(parts[0])
+		if !ok {
+			if start, ok = parseMonth(parts[0]); !ok {
+				return nil, fmt.Errorf("time_range: invalid start time: '%s'", parts[0])
+			}
+		}
+		krs, err := key.ParseShardingSpec(parts[1])
+		if err != nil || len(krs) != 1 {
+			return nil, fmt.Errorf("time_range: invalid key range: '%s'", parts[1])
+		}
+		kr := krs[0]
+		if len(tr.entries) != 0 {
+			prev := tr.entries[len(tr.entries)-1]
+			if !start.After(prev.start) {
+				return nil, fmt.Errorf("time_range: start times must be in increasing order: '%s'", parts[0])
+			}
+			if len(prev.keyRange.End) == 0 || bytes.Compare(kr.Start, prev.keyRange.End) < 0 {
+				return nil, fmt.Errorf("time_range: key ranges must be in increasing order and must not overlap: '%s'", parts[1])
+			}
+		}
+		tr.entries = append(tr.entries, timeRangeEntry{start: start, keyRange: kr})
+	}
+	return tr, nil
+}
+
+// String returns the name of the vindex.
+func (tr *TimeRange) String() string {
+	return tr.name
+}
+
+// Cost returns the cost of this index as 1.
+func (tr *TimeRange) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (tr *TimeRange) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (tr *TimeRange) NeedsVCursor() bool {
+	return false
+}
+
+// Map can map ids to key.Destination objects.
+func (tr *TimeRange) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, len(ids))
+	for i := range ids {
+		t, ok := parseTime(ids[i].ToString())
+		if !ok {
+			out[i] = key.DestinationNone{}
+			continue
+		}
+		entry := tr.find(t)
+		if entry == nil {
+			out[i] = key.DestinationNone{}
+			continue
+		}
+		out[i] = key.DestinationKeyspaceID(tr.keyspaceID(entry, tr.bucketOf(t)))
+	}
+	return out, nil
+}
+
+// Verify returns true if ids maps to ksids.
+func (tr *TimeRange) Verify(cursor VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i := range ids {
+		t, ok := parseTime(ids[i].ToString())
+		if !ok {
+			continue
+		}
+		if entry := tr.find(t); entry != nil {
+			out[i] = bytes.Equal(tr.keyspaceID(entry, tr.bucketOf(t)), ksids[i])
+		}
+	}
+	return out, nil
+}
+
+// MapRange maps the times from from to to, both included, to the
+// key range of their keyspace ids. A NULL bound leaves the range
+// open on its side. So does a bound that's not a valid time, because
+// it can't be compared with the times reliably.
+func (tr *TimeRange) MapRange(cursor VCursor, from, to sqltypes.Value) (key.Destination, error) {
+	kr := &topodatapb.KeyRange{
+		Start: tr.entries[0].keyRange.Start,
+		End:   tr.entries[len(tr.entries)-1].keyRange.End,
+	}
+	if t, ok := parseTime(from.ToString()); ok {
+		if entry := tr.find(t); entry != nil {
+			kr.Start = tr.keyspaceID(entry, tr.bucketOf(t))
+		}
+	}
+	if t, ok := parseTime(to.ToString()); ok {
+		entry := tr.find(t)
+		if entry == nil {
+			return key.DestinationNone{}, nil
+		}
+		kr.End = tr.keyspaceID(entry, tr.bucketOf(t)+1)
+	}
+	if len(kr.End) != 0 && bytes.Compare(kr.Start, kr.End) >= 0 {
+		return key.DestinationNone{}, nil
+	}
+	return key.DestinationKeyRange{KeyRange: kr}, nil
+}
+
+// find returns the entry of the time range of t,
+// or nil if t is before the first one.
+func (tr *TimeRange) find(t time.Time) *timeRangeEntry {
+	for i := len(tr.entries) - 1; i >= 0; i-- {
+		if !t.Before(tr.entries[i].start) {
+			return &tr.entries[i]
+		}
+	}
+	return nil
+}
+
+// bucketOf returns the number of the day or month of t.
+func (tr *TimeRange) bucketOf(t time.Time) uint64 {
+	if tr.bucket == "day" {
+		return uint64((t.Unix() + epochDays*24*60*60) / (24 * 60 * 60))
+	}
+	return uint64(t.Year()*12 + int(t.Month()) - 1)
+}
+
+// keyspaceID returns the keyspace id of the bucket in the
+// time range of entry.
+func (tr *TimeRange) keyspaceID(entry *timeRangeEntry, bucket uint64) []byte {
+	ksid := make([]byte, len(entry.keyRange.Start), len(entry.keyRange.Start)+8)
+	copy(ksid, entry.keyRange.Start)
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], bucket)
+	return append(ksid, b[:]...)
+}
+
+// parseTime parses a DATE, DATETIME or TIMESTAMP value as UTC.
+func parseTime(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil && t.Year() > 0 {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseMonth parses a month like 2019-01 as its first day.
+func parseMonth(s string) (time.Time, bool) {
+	t, err := time.Parse("2006-01", strings.TrimSpace(s))
+	if err != nil || t.Year() == 0 {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func init() {
+	Register("time_range", NewTimeRange)
+}
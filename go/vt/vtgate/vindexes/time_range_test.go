@@ -0,0 +1,222 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/key"
+
+	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
+)
+
+var timeRange SingleColumn
+
+func init() {
+	vindex, err := CreateVindex("time_range", "time_range_name", map[string]string{
+		"ranges": "2019-01=-40, 2019-07-01=40-80, 2020-01-01 00:00:00=80-",
+	})
+	if err != nil {
+		panic(err)
+	}
+	timeRange = vindex.(SingleColumn)
+}
+
+// timeKeyspaceID returns the keyspace id of bucket
+// in the time range whose key range starts at start.
+func timeKeyspaceID(start []byte, bucket uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], bucket)
+	return concatBytes(start, b[:])
+}
+
+func TestTimeRangeInfo(t *testing.T) {
+	assert.Equal(t, 1, timeRange.Cost())
+	assert.Equal(t, "time_range_name", timeRange.String())
+	assert.True(t, timeRange.IsUnique())
+	assert.False(t, timeRange.NeedsVCursor())
+}
+
+func TestTimeRangeNew(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{},
+		err:    "time_range: ranges must be specified",
+	}, {
+		params: map[string]string{"ranges": "2019-01-01=-80", "bucket": "week"},
+		err:    "time_range: bucket must be day or month: 'week'",
+	}, {
+		params: map[string]string{"ranges": "2019-01-01"},
+		err:    "time_range: ranges must be specified as start=keyrange: '2019-01-01'",
+	}, {
+		params: map[string]string{"ranges": "yesterday=-80"},
+		err:    "time_range: invalid start time: 'yesterday'",
+	}, {
+		params: map[string]string{"ranges": "2019-01-01=80"},
+		err:    "time_range: invalid key range: '80'",
+	}, {
+		params: map[string]string{"ranges": "2019-01-01=80-40"},
+		err:    "time_range: invalid key range: '80-40'",
+	}, {
+		params: map[string]string{"ranges": "2019-01-01=-80,2019-01-01=80-"},
+		err:    "time_range: start times must be in increasing order: '2019-01-01'",
+	}, {
+		params: map[string]string{"ranges": "2019-01-01=-80,2019-07-01=40-"},
+		err:    "time_range: key ranges must be in increasing order and must not overlap: '40-'",
+	}, {
+		params: map[string]string{"ranges": "2019-01-01=80-,2019-07-01=-40"},
+		err:    "time_range: key ranges must be in increasing order and must not overlap: '-40'",
+	}, {
+		params: map[string]string{"ranges": "2019-01-01=-40,2019-07-01=80-", "bucket": "day"},
+	}}
+	for _, tc := range testcases {
+		_, err := CreateVindex("time_range", "tr", tc.params)
+		if tc.err == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, tc.err)
+		}
+	}
+}
+
+func TestTimeRangeMap(t *testing.T) {
+	testcases := []struct {
+		in  sqltypes.Value
+		out key.Destination
+	}{{
+		in:  sqltypes.NewVarChar("2019-03-15 10:20:30"),
+		out: key.DestinationKeyspaceID(timeKeyspaceID(nil, 2019*12+2)),
+	}, {
+		in:  sqltypes.NewVarChar("2019-07-01"),
+		out: key.DestinationKeyspaceID(timeKeyspaceID([]byte{0x40}, 2019*12+6)),
+	}, {
+		in:  sqltypes.NewVarChar("2021-12-31 23:59:59.999999"),
+		out: key.DestinationKeyspaceID(timeKeyspaceID([]byte{0x80}, 2021*12+11)),
+	}, {
+		// The times before the first range don't map to any keyspace id.
+		in:  sqltypes.NewVarChar("2018-12-31 23:59:59"),
+		out: key.DestinationNone{},
+	}, {
+		in:  sqltypes.NewVarChar("not a time"),
+		out: key.DestinationNone{},
+	}, {
+		in:  sqltypes.NULL,
+		out: key.DestinationNone{},
+	}}
+	for _, tc := range testcases {
+		got, err := timeRange.Map(nil, []sqltypes.Value{tc.in})
+		require.NoError(t, err)
+		assert.Equal(t, tc.out, got[0], tc.in.String())
+	}
+
+	vindex, err := CreateVindex("time_range", "tr", map[string]string{"ranges": "1960-01-01=-", "bucket": "day"})
+	require.NoError(t, err)
+	got, err := vindex.(SingleColumn).Map(nil, []sqltypes.Value{
+		sqltypes.NewVarChar("1969-12-31 12:00:00"),
+		sqltypes.NewVarChar("1970-01-01"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{
+		key.DestinationKeyspaceID(timeKeyspaceID(nil, epochDays-1)),
+		key.DestinationKeyspaceID(timeKeyspaceID(nil, epochDays)),
+	}, got)
+}
+
+func TestTimeRangeVerify(t *testing.T) {
+	ids := []sqltypes.Value{sqltypes.NewVarChar("2019-03-01"), sqltypes.NewVarChar("2019-04-01"), sqltypes.NewVarChar("2018-03-01")}
+	ksid := timeKeyspaceID(nil, 2019*12+2)
+	got, err := timeRange.Verify(nil, ids, [][]byte{ksid, ksid, ksid})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, got)
+}
+
+func TestTimeRangeMapRange(t *testing.T) {
+	testcases := []struct {
+		from, to sqltypes.Value
+		out      key.Destination
+	}{{
+		from: sqltypes.NewVarChar("2019-02-01"),
+		to:   sqltypes.NewVarChar("2019-03-31 23:59:59"),
+		out: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: timeKeyspaceID(nil, 2019*12+1),
+			End:   timeKeyspaceID(nil, 2019*12+3),
+		}},
+	}, {
+		// The range spans two time ranges.
+		from: sqltypes.NewVarChar("2019-06-01"),
+		to:   sqltypes.NewVarChar("2019-08-01"),
+		out: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: timeKeyspaceID(nil, 2019*12+5),
+			End:   timeKeyspaceID([]byte{0x40}, 2019*12+8),
+		}},
+	}, {
+		// The bounds before the first range.
+		from: sqltypes.NewVarChar("2018-01-01"),
+		to:   sqltypes.NewVarChar("2019-01-15"),
+		out: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			End: timeKeyspaceID(nil, 2019*12+1),
+		}},
+	}, {
+		from: sqltypes.NewVarChar("2017-01-01"),
+		to:   sqltypes.NewVarChar("2018-01-01"),
+		out:  key.DestinationNone{},
+	}, {
+		// Open ranges.
+		from: sqltypes.NewVarChar("2020-03-01"),
+		to:   sqltypes.NULL,
+		out: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: timeKeyspaceID([]byte{0x80}, 2020*12+2),
+		}},
+	}, {
+		from: sqltypes.NULL,
+		to:   sqltypes.NewVarChar("2019-09-01"),
+		out: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			End: timeKeyspaceID([]byte{0x40}, 2019*12+9),
+		}},
+	}, {
+		from: sqltypes.NewVarChar("not a time"),
+		to:   sqltypes.NULL,
+		out:  key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}},
+	}, {
+		// An empty range.
+		from: sqltypes.NewVarChar("2019-09-01"),
+		to:   sqltypes.NewVarChar("2019-08-01"),
+		out:  key.DestinationNone{},
+	}}
+	for _, tc := range testcases {
+		got, err := timeRange.(Rangeable).MapRange(nil, tc.from, tc.to)
+		require.NoError(t, err)
+		assert.Equal(t, tc.out, got, "%v-%v", tc.from, tc.to)
+	}
+
+	// All the times in the range are in its key range.
+	got, err := timeRange.(Rangeable).MapRange(nil, sqltypes.NewVarChar("2019-05-10"), sqltypes.NewVarChar("2020-02-10"))
+	require.NoError(t, err)
+	kr := got.(key.DestinationKeyRange).KeyRange
+	for _, id := range []string{"2019-05-01", "2019-06-30 23:59:59", "2019-07-01", "2020-01-01", "2020-02-29"} {
+		ksids, err := timeRange.Map(nil, []sqltypes.Value{sqltypes.NewVarChar(id)})
+		require.NoError(t, err)
+		assert.True(t, key.KeyRangeContains(kr, ksids[0].(key.DestinationKeyspaceID)), id)
+	}
+}
@@ -87,6 +87,11 @@ type MultiColumn interface {
 	Vindex
 	Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error)
 	Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error)
+
+	// PartialVindex returns true if Map can map rows that have
+	// the values of only the leading columns. Such rows map to
+	// the key range that contains all the rows that have them.
+	PartialVindex() bool
 }
 
 // A Reversible vindex is one that can perform a
@@ -99,6 +104,29 @@ type Reversible interface {
 	ReverseMap(vcursor VCursor, ks [][]byte) ([]sqltypes.Value, error)
 }
 
+// A Prefixable vindex is one that can map a prefix of
+// its ids to the key range that contains all the ids
+// that start with the prefix. VTGate uses it to route
+// the LIKE 'prefix%' predicates of the columns that the
+// vschema declares as binary.
+// Prefixable is supported only for SingleColumn vindexes.
+type Prefixable interface {
+	SingleColumn
+	MapPrefix(vcursor VCursor, prefixes []sqltypes.Value) ([]key.Destination, error)
+}
+
+// A Rangeable vindex is one that can map a range of
+// its ids to the key range that contains all the ids
+// in the range. VTGate uses it to route the BETWEEN,
+// <, <=, > and >= predicates.
+// The bounds are included in the range, and a NULL
+// bound leaves the range open on its side.
+// Rangeable is supported only for SingleColumn vindexes.
+type Rangeable interface {
+	SingleColumn
+	MapRange(vcursor VCursor, from, to sqltypes.Value) (key.Destination, error)
+}
+
 // A Lookup vindex is one that needs to lookup
 // a previously stored map to compute the keyspace
 // id from an id. This means that the creation of
@@ -91,6 +91,23 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+
+	// ColumnsTracked is set if the columns were
+	// learned from the tablets. See AddTrackedTables.
+	ColumnsTracked bool `json:"columns_tracked,omitempty"`
+
+	// Source is set for a reference table that's materialized
+	// from the table of the same name in another keyspace.
+	// Writes to the table are routed to the source.
+	Source *Table `json:"-"`
+
+	// ReferencedBy is set for the source of reference tables.
+	// It has the materialized copies of the table by keyspace.
+	ReferencedBy map[string]*Table `json:"-"`
+
+	// tracked is set if the table is not in the vschema,
+	// and was added because it was learned from the tablets.
+	tracked bool
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -131,6 +148,8 @@ type KeyspaceSchema struct {
 	Tables   map[string]*Table
 	Vindexes map[string]Vindex
 	Error    error
+
+	requireExplicitRouting bool
 }
 
 // MarshalJSON returns a JSON representation of KeyspaceSchema.
@@ -169,6 +188,7 @@ func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema, err error) {
 	}
 	buildKeyspaces(source, vschema)
 	resolveAutoIncrement(source, vschema)
+	resolveReferences(source, vschema)
 	addDual(vschema)
 	buildRoutingRule(source, vschema)
 	return vschema, nil
@@ -210,8 +230,9 @@ func buildKeyspaces(source *vschemapb.SrvVSchema, vschema *VSchema) {
 				Name:    ksname,
 				Sharded: ks.Sharded,
 			},
-			Tables:   make(map[string]*Table),
-			Vindexes: make(map[string]Vindex),
+			Tables:                 make(map[string]*Table),
+			Vindexes:               make(map[string]Vindex),
+			requireExplicitRouting: ks.RequireExplicitRouting,
 		}
 		vschema.Keyspaces[ksname] = ksvschema
 		ksvschema.Error = buildTables(ks, vschema, ksvschema)
@@ -246,13 +267,20 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 		case "", TypeReference:
 			t.Type = table.Type
 		case TypeSequence:
-			if keyspace.Sharded && table.Pinned == "" {
-				return fmt.Errorf("sequence table has to be in an unsharded keyspace or must be pinned: %s", tname)
-			}
+			// In a sharded keyspace, every shard of a sequence that's
+			// not pinned allocates the values from a disjoint range.
 			t.Type = table.Type
 		default:
 			return fmt.Errorf("unidentified table type %s", table.Type)
 		}
+		if table.Source != "" {
+			if t.Type != TypeReference {
+				return fmt.Errorf("source is only allowed for reference tables: %s", tname)
+			}
+			if table.Source == keyspace.Name {
+				return fmt.Errorf("source of reference table %s cannot be its own keyspace", tname)
+			}
+		}
 		if table.Pinned != "" {
 			decoded, err := hex.DecodeString(table.Pinned)
 			if err != nil {
@@ -261,8 +289,8 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			t.Pinned = decoded
 		}
 
-		// If keyspace is sharded, then any table that's not a reference or pinned must have vindexes.
-		if keyspace.Sharded && t.Type != TypeReference && table.Pinned == "" && len(table.ColumnVindexes) == 0 {
+		// If keyspace is sharded, then any table that's not a reference, sequence or pinned must have vindexes.
+		if keyspace.Sharded && t.Type != TypeReference && t.Type != TypeSequence && table.Pinned == "" && len(table.ColumnVindexes) == 0 {
 			return fmt.Errorf("missing primary col vindex for table: %s", tname)
 		}
 
@@ -368,6 +396,79 @@ func resolveAutoIncrement(source *vschemapb.SrvVSchema, vschema *VSchema) {
 	}
 }
 
+// resolveReferences links the reference tables to their source. If all
+// the tables of a name are a source and its copies, the name resolves
+// to the source when it's not qualified by a keyspace.
+func resolveReferences(source *vschemapb.SrvVSchema, vschema *VSchema) {
+	for ksname, ks := range source.Keyspaces {
+		ksvschema := vschema.Keyspaces[ksname]
+		for tname, table := range ks.Tables {
+			t := ksvschema.Tables[tname]
+			if t == nil || table.Source == "" {
+				continue
+			}
+			var src *Table
+			if srcks, ok := source.Keyspaces[table.Source]; ok && srcks.Tables[tname].GetSource() != "" {
+				ksvschema.Error = fmt.Errorf("source %s.%s of reference table %s is itself a reference copy", table.Source, tname, tname)
+			} else if srcvschema := vschema.Keyspaces[table.Source]; srcvschema == nil {
+				ksvschema.Error = fmt.Errorf("source keyspace %s of reference table %s not found", table.Source, tname)
+			} else if srcvschema.Keyspace.Sharded {
+				ksvschema.Error = fmt.Errorf("source keyspace %s of reference table %s is sharded", table.Source, tname)
+			} else if src = srcvschema.Tables[tname]; src == nil {
+				ksvschema.Error = fmt.Errorf("source %s.%s of reference table %s not found", table.Source, tname, tname)
+			} else if src.Type != TypeReference {
+				ksvschema.Error = fmt.Errorf("source %s.%s of reference table %s is not a reference table", table.Source, tname, tname)
+				src = nil
+			}
+			if src == nil {
+				// Better to remove the table than to route its writes to itself.
+				// If the name is ambiguous, it remains so.
+				delete(ksvschema.Tables, tname)
+				if vschema.uniqueTables[tname] == t {
+					delete(vschema.uniqueTables, tname)
+				}
+				continue
+			}
+			t.Source = src
+			if src.ReferencedBy == nil {
+				src.ReferencedBy = make(map[string]*Table)
+			}
+			src.ReferencedBy[ksname] = t
+		}
+	}
+
+	for tname, t := range vschema.uniqueTables {
+		if t != nil {
+			if t.Source != nil {
+				vschema.uniqueTables[tname] = t.Source
+			}
+			continue
+		}
+		var src *Table
+		for _, ks := range vschema.Keyspaces {
+			if ks.requireExplicitRouting {
+				continue
+			}
+			kst := ks.Tables[tname]
+			if kst == nil {
+				continue
+			}
+			cur := kst
+			if kst.Source != nil {
+				cur = kst.Source
+			}
+			if src != nil && src != cur {
+				src = nil
+				break
+			}
+			src = cur
+		}
+		if src != nil && len(src.ReferencedBy) != 0 {
+			vschema.uniqueTables[tname] = src
+		}
+	}
+}
+
 // addDual adds dual as a valid table to all keyspaces.
 // For sharded keyspaces, it gets pinned against keyspace id '0x00'.
 func addDual(vschema *VSchema) {
@@ -432,6 +533,75 @@ outer:
 	}
 }
 
+// AddTrackedTables merges the table definitions that were learned
+// from the tablets of a keyspace into the vschema. The tables of the
+// vschema that don't have an authoritative column list get the tracked
+// columns, after the ones that are listed in the vschema. The tracked
+// tables of an unsharded keyspace that are not in the vschema are added.
+// They don't take precedence over the tables of the vschema for the
+// resolution of unqualified table names.
+func (vschema *VSchema) AddTrackedTables(keyspace string, tables map[string][]Column) {
+	ks, ok := vschema.Keyspaces[keyspace]
+	if !ok || ks.Error != nil {
+		return
+	}
+	for tname, columns := range tables {
+		t, ok := ks.Tables[tname]
+		if !ok {
+			if ks.Keyspace.Sharded {
+				// A sharded table can't be added without a vindex.
+				continue
+			}
+			t = &Table{
+				Name:     sqlparser.NewTableIdent(tname),
+				Keyspace: ks.Keyspace,
+				tracked:  true,
+			}
+			ks.Tables[tname] = t
+			if !ks.requireExplicitRouting {
+				vschema.addTrackedUniqueTable(tname, t)
+			}
+		}
+		if t.ColumnListAuthoritative {
+			continue
+		}
+		t.Columns = mergeColumns(t.Columns, columns)
+		t.ColumnListAuthoritative = true
+		t.ColumnsTracked = true
+	}
+}
+
+// addTrackedUniqueTable adds a tracked table to the tables that can be
+// referenced without a keyspace. If a tracked table of another keyspace
+// has the same name, the name becomes ambiguous.
+func (vschema *VSchema) addTrackedUniqueTable(tname string, t *Table) {
+	existing, ok := vschema.uniqueTables[tname]
+	switch {
+	case !ok:
+		vschema.uniqueTables[tname] = t
+	case existing != nil && existing.tracked:
+		vschema.uniqueTables[tname] = nil
+	}
+}
+
+// mergeColumns appends the tracked columns that are not in columns.
+func mergeColumns(columns, tracked []Column) []Column {
+	merged := append([]Column(nil), columns...)
+	for _, tcol := range tracked {
+		found := false
+		for _, col := range columns {
+			if col.Name.Equal(tcol.Name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, tcol)
+		}
+	}
+	return merged
+}
+
 // findQualified finds a table t or k.t.
 func (vschema *VSchema) findQualified(name string) (*Table, error) {
 	splits := strings.Split(name, ".")
@@ -525,7 +695,36 @@ func (vschema *VSchema) findTables(keyspace, tablename string, tabletType topoda
 	if t == nil {
 		return nil, nil
 	}
-	return []*Table{t}, nil
+	return referenceTables(t), nil
+}
+
+// referenceTables returns the table followed by the other tables
+// that have the same data if it's a reference table: its source
+// and the copies in other keyspaces. This lets the planner pick
+// the copy that's in the keyspace of the tables that it joins with.
+func referenceTables(t *Table) []*Table {
+	src := t
+	if t.Source != nil {
+		src = t.Source
+	}
+	if len(src.ReferencedBy) == 0 {
+		return []*Table{t}
+	}
+	tables := []*Table{t}
+	if src != t {
+		tables = append(tables, src)
+	}
+	ksnames := make([]string, 0, len(src.ReferencedBy))
+	for ksname := range src.ReferencedBy {
+		ksnames = append(ksnames, ksname)
+	}
+	sort.Strings(ksnames)
+	for _, ksname := range ksnames {
+		if cp := src.ReferencedBy[ksname]; cp != t {
+			tables = append(tables, cp)
+		}
+	}
+	return tables
 }
 
 // FindTablesOrVindex finds a table or a Vindex by name using Find and FindVindex.
@@ -372,6 +372,103 @@ func TestVSchemaColumnListAuthoritative(t *testing.T) {
 	}
 }
 
+func TestAddTrackedTables(t *testing.T) {
+	source := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {Type: "hash"},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+						Columns:        []*vschemapb.Column{{Name: "c2", Type: sqltypes.VarChar}},
+					},
+					"t2": {
+						ColumnVindexes:          []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+						Columns:                 []*vschemapb.Column{{Name: "id"}},
+						ColumnListAuthoritative: true,
+					},
+				},
+			},
+			"unsharded1": {
+				Tables: map[string]*vschemapb.Table{
+					"t4": {},
+				},
+			},
+			"unsharded2": {},
+			"explicit": {
+				RequireExplicitRouting: true,
+			},
+		},
+	}
+	vschema, err := BuildVSchema(&source)
+	require.NoError(t, err)
+
+	id := Column{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Int64}
+	c2 := Column{Name: sqlparser.NewColIdent("c2"), Type: sqltypes.Int64}
+	vschema.AddTrackedTables("sharded", map[string][]Column{
+		"t1": {id, c2},
+		"t2": {id, c2},
+		"t3": {id},
+	})
+	vschema.AddTrackedTables("unsharded1", map[string][]Column{
+		"t3": {id},
+		"t4": {id},
+		"t5": {id},
+	})
+	vschema.AddTrackedTables("unsharded2", map[string][]Column{
+		"t1": {id},
+		"t5": {id},
+		"t6": {id},
+	})
+	vschema.AddTrackedTables("explicit", map[string][]Column{
+		"t7": {id},
+	})
+	vschema.AddTrackedTables("unknown", map[string][]Column{
+		"t8": {id},
+	})
+
+	// The vschema columns come first, and have precedence.
+	t1 := vschema.Keyspaces["sharded"].Tables["t1"]
+	assert.Equal(t, []Column{{Name: sqlparser.NewColIdent("c2"), Type: sqltypes.VarChar}, id}, t1.Columns)
+	assert.True(t, t1.ColumnListAuthoritative)
+	assert.True(t, t1.ColumnsTracked)
+
+	// An authoritative column list is not changed.
+	t2 := vschema.Keyspaces["sharded"].Tables["t2"]
+	assert.Equal(t, []Column{{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Null}}, t2.Columns)
+	assert.False(t, t2.ColumnsTracked)
+
+	// Sharded tables can't be added.
+	assert.Nil(t, vschema.Keyspaces["sharded"].Tables["t3"])
+
+	testcases := []struct {
+		keyspace, table string
+		want            string
+		err             string
+	}{
+		{table: "t1", want: "sharded"},
+		{table: "t3", want: "unsharded1"},
+		{table: "t4", want: "unsharded1"},
+		{table: "t5", err: "ambiguous table reference: t5"},
+		{table: "t6", want: "unsharded2"},
+		{table: "t7", err: "table t7 not found"},
+		{keyspace: "explicit", table: "t7", want: "explicit"},
+	}
+	for _, tc := range testcases {
+		table, err := vschema.FindTable(tc.keyspace, tc.table)
+		if tc.err != "" {
+			assert.EqualError(t, err, tc.err, tc.table)
+			continue
+		}
+		require.NoError(t, err, tc.table)
+		assert.Equal(t, tc.want, table.Keyspace.Name, tc.table)
+		assert.True(t, table.ColumnListAuthoritative, tc.table)
+	}
+}
+
 func TestVSchemaColumnsFail(t *testing.T) {
 	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -2089,8 +2186,8 @@ func TestBadSequenceName(t *testing.T) {
 	}
 }
 
-func TestBadShardedSequence(t *testing.T) {
-	bad := vschemapb.SrvVSchema{
+func TestShardedSequence(t *testing.T) {
+	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
 			"sharded": {
 				Sharded: true,
@@ -2102,14 +2199,165 @@ func TestBadShardedSequence(t *testing.T) {
 			},
 		},
 	}
-	got, _ := BuildVSchema(&bad)
-	err := got.Keyspaces["sharded"].Error
-	want := "sequence table has to be in an unsharded keyspace or must be pinned: t1"
-	if err == nil || err.Error() != want {
-		t.Errorf("BuildVSchema: %v, want %v", err, want)
+	got, _ := BuildVSchema(&good)
+	if err := got.Keyspaces["sharded"].Error; err != nil {
+		t.Fatal(err)
+	}
+	t1 := got.Keyspaces["sharded"].Tables["t1"]
+	if t1 == nil || t1.Type != TypeSequence || !t1.Keyspace.Sharded {
+		t.Errorf("BuildVSchema: t1: %v, want a sequence in a sharded keyspace", t1)
 	}
 }
 
+func TestReferenceTableSource(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"main": {
+				Tables: map[string]*vschemapb.Table{
+					"ref": {
+						Type: TypeReference,
+					},
+				},
+			},
+			"ksa": {
+				Sharded: true,
+				Tables: map[string]*vschemapb.Table{
+					"ref": {
+						Type:   TypeReference,
+						Source: "main",
+					},
+				},
+			},
+			"ksb": {
+				Sharded: true,
+				Tables: map[string]*vschemapb.Table{
+					"ref": {
+						Type:   TypeReference,
+						Source: "main",
+					},
+				},
+			},
+		},
+	}
+	vschema, err := BuildVSchema(&input)
+	require.NoError(t, err)
+	for _, ks := range vschema.Keyspaces {
+		require.NoError(t, ks.Error)
+	}
+	src := vschema.Keyspaces["main"].Tables["ref"]
+	refa := vschema.Keyspaces["ksa"].Tables["ref"]
+	refb := vschema.Keyspaces["ksb"].Tables["ref"]
+	assert.Nil(t, src.Source)
+	assert.Equal(t, src, refa.Source)
+	assert.Equal(t, src, refb.Source)
+	assert.Equal(t, map[string]*Table{"ksa": refa, "ksb": refb}, src.ReferencedBy)
+
+	// The name is not ambiguous: it resolves to the source.
+	got, err := vschema.FindTable("", "ref")
+	require.NoError(t, err)
+	assert.Equal(t, src, got)
+
+	// The source or a copy is returned first, followed by the others.
+	tables, _, err := vschema.FindTablesOrVindex("", "ref", topodatapb.TabletType_MASTER)
+	require.NoError(t, err)
+	assert.Equal(t, []*Table{src, refa, refb}, tables)
+	tables, _, err = vschema.FindTablesOrVindex("ksb", "ref", topodatapb.TabletType_MASTER)
+	require.NoError(t, err)
+	assert.Equal(t, []*Table{refb, src, refa}, tables)
+}
+
+func TestReferenceTableSourceErrors(t *testing.T) {
+	testcases := []struct {
+		name   string
+		tables map[string]*vschemapb.Table
+		err    string
+	}{{
+		name:   "not a reference table",
+		tables: map[string]*vschemapb.Table{"ref": {Source: "main", Pinned: "80"}},
+		err:    "source is only allowed for reference tables: ref",
+	}, {
+		name:   "own keyspace",
+		tables: map[string]*vschemapb.Table{"ref": {Type: TypeReference, Source: "ksa"}},
+		err:    "source of reference table ref cannot be its own keyspace",
+	}, {
+		name:   "no source keyspace",
+		tables: map[string]*vschemapb.Table{"ref": {Type: TypeReference, Source: "none"}},
+		err:    "source keyspace none of reference table ref not found",
+	}, {
+		name:   "no source table",
+		tables: map[string]*vschemapb.Table{"other": {Type: TypeReference, Source: "main"}},
+		err:    "source main.other of reference table other not found",
+	}, {
+		name:   "source is a copy",
+		tables: map[string]*vschemapb.Table{"copy": {Type: TypeReference, Source: "main"}},
+		err:    "source main.copy of reference table copy is itself a reference copy",
+	}, {
+		name:   "sharded source",
+		tables: map[string]*vschemapb.Table{"sharded": {Type: TypeReference, Source: "ksb"}},
+		err:    "source keyspace ksb of reference table sharded is sharded",
+	}, {
+		name:   "source is not a reference table",
+		tables: map[string]*vschemapb.Table{"plain": {Type: TypeReference, Source: "main"}},
+		err:    "source main.plain of reference table plain is not a reference table",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := vschemapb.SrvVSchema{
+				Keyspaces: map[string]*vschemapb.Keyspace{
+					"main": {
+						Tables: map[string]*vschemapb.Table{
+							"ref":   {Type: TypeReference},
+							"copy":  {Type: TypeReference, Source: "ksb"},
+							"plain": {},
+						},
+					},
+					"ksa": {
+						Sharded: true,
+						Tables:  tc.tables,
+					},
+					"ksb": {
+						Sharded: true,
+						Tables: map[string]*vschemapb.Table{
+							"copy":    {Type: TypeReference},
+							"sharded": {Type: TypeReference},
+						},
+					},
+				},
+			}
+			vschema, err := BuildVSchema(&input)
+			require.NoError(t, err)
+			assert.EqualError(t, vschema.Keyspaces["ksa"].Error, tc.err)
+			for tname := range tc.tables {
+				assert.Nil(t, vschema.Keyspaces["ksa"].Tables[tname])
+			}
+		})
+	}
+}
+
+func TestReferenceTableSourceErrorAmbiguous(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"main": {
+				Tables: map[string]*vschemapb.Table{
+					"plain": {},
+				},
+			},
+			"ksa": {
+				Tables: map[string]*vschemapb.Table{
+					"plain": {Type: TypeReference, Source: "main"},
+				},
+			},
+		},
+	}
+	vschema, err := BuildVSchema(&input)
+	require.NoError(t, err)
+	assert.EqualError(t, vschema.Keyspaces["ksa"].Error, "source main.plain of reference table plain is not a reference table")
+
+	// The invalid copy doesn't make the name unambiguous.
+	_, err = vschema.FindTable("", "plain")
+	assert.EqualError(t, err, "ambiguous table reference: plain")
+}
+
 func TestFindTable(t *testing.T) {
 	input := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
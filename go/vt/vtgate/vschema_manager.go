@@ -36,6 +36,10 @@ type VSchemaManager struct {
 	e                 *Executor
 	mu                sync.Mutex
 	currentSrvVschema *vschemapb.SrvVSchema
+	cell              string
+
+	// schema is set if schema tracking is enabled.
+	schema *schemaTracker
 }
 
 // GetCurrentSrvVschema returns a copy of the latest SrvVschema from the
@@ -54,6 +58,7 @@ func (vm *VSchemaManager) GetCurrentSrvVschema() *vschemapb.SrvVSchema {
 // This function will wait until the first value has either been processed
 // or triggered an error before returning.
 func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
+	vm.cell = cell
 	vm.e.serv.WatchSrvVSchema(ctx, cell, func(v *vschemapb.SrvVSchema, err error) {
 		// Create a closure to save the vschema. If the value
 		// passed is nil, it means we encountered an error and
@@ -76,46 +81,72 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 
 		// keep a copy of the latest SrvVschema
 		vm.mu.Lock()
+		defer vm.mu.Unlock()
 		vm.currentSrvVschema = v
-		vm.mu.Unlock()
-
-		// Transform the provided SrvVSchema into a VSchema.
-		var vschema *vindexes.VSchema
-		if v != nil {
-			vschema, err = vindexes.BuildVSchema(v)
-			if err != nil {
-				log.Warningf("Error creating VSchema for cell %v (will try again next update): %v", cell, err)
-				err = fmt.Errorf("error creating VSchema for cell %v: %v", cell, err)
-				if vschemaCounters != nil {
-					vschemaCounters.Add("Parsing", 1)
-				}
-			}
-		}
-		if v == nil {
-			// We encountered an error, build an empty vschema.
-			vschema, _ = vindexes.BuildVSchema(&vschemapb.SrvVSchema{})
-		}
+		vm.buildAndSaveVSchema(v, err)
+	})
+}
+
+// schemaTracker returns the schema tracker, or nil
+// if schema tracking is disabled.
+func (vm *VSchemaManager) schemaTracker() *schemaTracker {
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+	return vm.schema
+}
 
-		// Build the display version. At this point, three cases:
-		// - v is nil, vschema is empty, and err is set:
-		//     1. when the watch returned an error.
-		//     2. when BuildVSchema failed.
-		// - v is set, vschema is full, and err is nil:
-		//     3. when everything worked.
-		errorMessage := ""
+// Rebuild rebuilds the VSchema from the latest SrvVSchema. It's
+// used when the tracked schema changes.
+func (vm *VSchemaManager) Rebuild() {
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+	if vm.currentSrvVschema == nil {
+		return
+	}
+	vm.buildAndSaveVSchema(vm.currentSrvVschema, nil)
+}
+
+// buildAndSaveVSchema transforms the provided SrvVSchema into a VSchema,
+// and saves it in the executor. vm.mu must be held.
+func (vm *VSchemaManager) buildAndSaveVSchema(v *vschemapb.SrvVSchema, err error) {
+	var vschema *vindexes.VSchema
+	if v != nil {
+		vschema, err = vindexes.BuildVSchema(v)
 		if err != nil {
-			errorMessage = err.Error()
+			log.Warningf("Error creating VSchema for cell %v (will try again next update): %v", vm.cell, err)
+			err = fmt.Errorf("error creating VSchema for cell %v: %v", vm.cell, err)
+			if vschemaCounters != nil {
+				vschemaCounters.Add("Parsing", 1)
+			}
 		}
-		stats := NewVSchemaStats(vschema, errorMessage)
+	}
+	if v == nil {
+		// We encountered an error, build an empty vschema.
+		vschema, _ = vindexes.BuildVSchema(&vschemapb.SrvVSchema{})
+	}
+	if vm.schema != nil {
+		vm.schema.addTo(vschema)
+	}
 
-		// save our value. if there was an error, then keep the
-		// existing vschema instead of overwriting it.
-		if v == nil && vm.e.vschema != nil {
-			vschema = vm.e.vschema
-		}
+	// Build the display version. At this point, three cases:
+	// - v is nil, vschema is empty, and err is set:
+	//     1. when the watch returned an error.
+	//     2. when BuildVSchema failed.
+	// - v is set, vschema is full, and err is nil:
+	//     3. when everything worked.
+	errorMessage := ""
+	if err != nil {
+		errorMessage = err.Error()
+	}
+	stats := NewVSchemaStats(vschema, errorMessage)
 
-		vm.e.SaveVSchema(vschema, stats)
-	})
+	// save our value. if there was an error, then keep the
+	// existing vschema instead of overwriting it.
+	if v == nil && vm.e.vschema != nil {
+		vschema = vm.e.vschema
+	}
+
+	vm.e.SaveVSchema(vschema, stats)
 }
 
 // UpdateVSchema propagates the updated vschema to the topo. The entry for
@@ -133,6 +164,28 @@ func (vm *VSchemaManager) UpdateVSchema(ctx context.Context, ksName string, vsch
 		return err
 	}
 
+	return updateSrvVSchema(ctx, topoServer, vschema)
+}
+
+// UpdateRoutingRules propagates the updated routing rules to the topo.
+// The routing rules are updated in the global topo, and the full
+// SrvVSchema is updated in all known cells.
+func (vm *VSchemaManager) UpdateRoutingRules(ctx context.Context, vschema *vschemapb.SrvVSchema) error {
+	topoServer, err := vm.e.serv.GetTopoServer()
+	if err != nil {
+		return err
+	}
+
+	err = topoServer.SaveRoutingRules(ctx, vschema.RoutingRules)
+	if err != nil {
+		return err
+	}
+
+	return updateSrvVSchema(ctx, topoServer, vschema)
+}
+
+// updateSrvVSchema updates the SrvVSchema in all known cells.
+func updateSrvVSchema(ctx context.Context, topoServer *topo.Server, vschema *vschemapb.SrvVSchema) error {
 	cells, err := topoServer.GetKnownCells(ctx)
 	if err != nil {
 		return err
@@ -0,0 +1,563 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/mysql"
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/key"
+	"github.com/xsec-lab/go/vt/log"
+	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/srvtopo"
+	"github.com/xsec-lab/go/vt/vterrors"
+
+	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+	vtrpcpb "github.com/xsec-lab/go/vt/proto/vtrpc"
+)
+
+// needsCopy returns true if the tables of the shard must be copied
+// before its binlog events are streamed. The copy is requested with
+// an empty Gtid, which copies all the tables that match the filter,
+// or with a list of Tablepks, which copies only those tables.
+func needsCopy(sgtid *binlogdatapb.ShardGtid) bool {
+	return sgtid.Gtid == "" || len(sgtid.Tablepks) != 0
+}
+
+// copyFromTablet copies the existing rows of the tables of a shard
+// before its binlog events are streamed, like the copy phase of
+// vreplication. The tables are copied one at a time, each as of the
+// GTID position of a consistent snapshot. Before the rows of a table
+// are sent, the tables that were already copied are caught up to
+// that position by sending their binlog events. So, the stream of a
+// table always continues from the position of the rows that were sent.
+// The progress of the copy is recorded in the Tablepks of the
+// ShardGtid, which is sent with every VGTID. A client can resume
+// the copy by sending back the last VGTID it received.
+func (vs *vstream) copyFromTablet(ctx context.Context, sgtid *binlogdatapb.ShardGtid) error {
+	rss, err := vs.resolver.ResolveDestination(ctx, sgtid.Keyspace, vs.tabletType, key.DestinationShard(sgtid.Shard))
+	if err != nil {
+		return err
+	}
+	if len(rss) != 1 {
+		// Unreachable.
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected number or shards: %v", rss)
+	}
+	rs := rss[0]
+
+	if sgtid.Gtid == "current" {
+		// The tables are copied as of their own snapshots.
+		vs.mu.Lock()
+		sgtid.Gtid = ""
+		vs.mu.Unlock()
+	}
+	if sgtid.Gtid == "" {
+		for _, tablePK := range sgtid.Tablepks {
+			if tablePK.Lastpk != nil {
+				return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the copy of table %s cannot be resumed without a position: %v", tablePK.TableName, sgtid)
+			}
+		}
+		if len(sgtid.Tablepks) == 0 {
+			tables, err := vs.tablesToCopy(ctx, rs)
+			if err != nil {
+				return err
+			}
+			vs.mu.Lock()
+			for _, table := range tables {
+				sgtid.Tablepks = append(sgtid.Tablepks, &binlogdatapb.TableLastPK{TableName: table})
+			}
+			vs.mu.Unlock()
+		}
+	}
+
+	// copyTable removes the table from Tablepks once it's copied.
+	for len(sgtid.Tablepks) != 0 {
+		if err := vs.copyTable(ctx, rs, sgtid, sgtid.Tablepks[0]); err != nil {
+			return err
+		}
+	}
+
+	events := []*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_COPY_COMPLETED}}
+	if sgtid.Gtid == "" {
+		// There was nothing to copy.
+		vs.mu.Lock()
+		sgtid.Gtid = "current"
+		vs.mu.Unlock()
+	} else {
+		events = append([]*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_GTID, Gtid: sgtid.Gtid}}, events...)
+	}
+	log.Infof("Copy of %s/%s completed at %s", sgtid.Keyspace, sgtid.Shard, sgtid.Gtid)
+	return vs.sendAll(sgtid, [][]*binlogdatapb.VEvent{events})
+}
+
+// tablesToCopy returns the tables of the shard that match the filter.
+func (vs *vstream) tablesToCopy(ctx context.Context, rs *srvtopo.ResolvedShard) ([]string, error) {
+	qr, err := rs.QueryService.Execute(ctx, rs.Target, "show tables", nil, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	var tables []string
+	for _, row := range qr.Rows {
+		if len(row) == 0 {
+			continue
+		}
+		table := row[0].ToString()
+		query, err := copyQuery(vs.filter, table)
+		if err != nil {
+			return nil, err
+		}
+		if query != "" {
+			tables = append(tables, table)
+		}
+	}
+	sort.Strings(tables)
+	return tables, nil
+}
+
+// copyTable sends the rows of the table that follow its lastpk as of
+// a consistent snapshot, and marks the table as copied.
+func (vs *vstream) copyTable(ctx context.Context, rs *srvtopo.ResolvedShard, sgtid *binlogdatapb.ShardGtid, tablePK *binlogdatapb.TableLastPK) error {
+	query, err := copyQuery(vs.filter, tablePK.TableName)
+	if err != nil {
+		return err
+	}
+	if query == "" {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "table %s does not match the filter: %v", tablePK.TableName, vs.filter)
+	}
+	log.Infof("Copying table %s/%s.%s, lastpk: %v", sgtid.Keyspace, sgtid.Shard, tablePK.TableName, tablePK.Lastpk)
+
+	tableName := sgtid.Keyspace + "." + tablePK.TableName
+	var gtid string
+	var fieldEvent *binlogdatapb.VEvent
+	var pkfields []*querypb.Field
+	lastpk := tablePK.Lastpk
+	err = rs.QueryService.VStreamRows(ctx, rs.Target, query, tablePK.Lastpk, func(rows *binlogdatapb.VStreamRowsResponse) error {
+		if gtid == "" {
+			if len(rows.Fields) == 0 {
+				return fmt.Errorf("expecting field event first, got: %v", rows)
+			}
+			if err := vs.fastForward(ctx, rs, sgtid, rows.Gtid); err != nil {
+				return err
+			}
+			gtid = rows.Gtid
+			pkfields = rows.Pkfields
+			fieldEvent = &binlogdatapb.VEvent{
+				Type: binlogdatapb.VEventType_FIELD,
+				FieldEvent: &binlogdatapb.FieldEvent{
+					TableName: tableName,
+					Fields:    rows.Fields,
+				},
+			}
+		}
+		if len(rows.Rows) == 0 {
+			return nil
+		}
+		events := []*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_GTID, Gtid: gtid}}
+		if fieldEvent != nil {
+			events = append(events, fieldEvent)
+			fieldEvent = nil
+		}
+		rowEvent := &binlogdatapb.RowEvent{TableName: tableName}
+		for _, row := range rows.Rows {
+			rowEvent.RowChanges = append(rowEvent.RowChanges, &binlogdatapb.RowChange{After: row})
+		}
+		lastpk = &querypb.QueryResult{
+			Fields: pkfields,
+			Rows:   []*querypb.Row{rows.Lastpk},
+		}
+		events = append(events,
+			&binlogdatapb.VEvent{Type: binlogdatapb.VEventType_ROW, RowEvent: rowEvent},
+			&binlogdatapb.VEvent{
+				Type: binlogdatapb.VEventType_LASTPK,
+				LastpkEvent: &binlogdatapb.LastPKEvent{
+					TableLastpk: &binlogdatapb.TableLastPK{TableName: tableName, Lastpk: lastpk},
+				},
+			},
+		)
+		return vs.sendAll(sgtid, [][]*binlogdatapb.VEvent{events})
+	})
+	if err != nil {
+		return err
+	}
+	if gtid == "" {
+		// Unreachable.
+		return vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "copy of table %s ended without a position", tableName)
+	}
+	log.Infof("Copy of %s/%s.%s finished at lastpk: %v", sgtid.Keyspace, sgtid.Shard, tablePK.TableName, lastpk)
+	return vs.sendAll(sgtid, [][]*binlogdatapb.VEvent{{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: gtid},
+		{
+			Type: binlogdatapb.VEventType_LASTPK,
+			LastpkEvent: &binlogdatapb.LastPKEvent{
+				TableLastpk: &binlogdatapb.TableLastPK{TableName: tableName, Lastpk: lastpk},
+				Completed:   true,
+			},
+		},
+	}})
+}
+
+// fastForward catches up the tables that were copied to the position
+// gtid, by sending their binlog events from the current position of
+// the shard. The rows of a table that's partially copied are sent only
+// if they're at or before its lastpk. The rest will be sent by its copy.
+func (vs *vstream) fastForward(ctx context.Context, rs *srvtopo.ResolvedShard, sgtid *binlogdatapb.ShardGtid, gtid string) error {
+	if sgtid.Gtid == "" {
+		// Nothing was copied yet.
+		return nil
+	}
+	pos, err := mysql.DecodePosition(sgtid.Gtid)
+	if err != nil {
+		return err
+	}
+	target, err := mysql.DecodePosition(gtid)
+	if err != nil {
+		return err
+	}
+	if pos.AtLeast(target) {
+		return nil
+	}
+
+	// lastpks has the tables that are not fully copied. Their
+	// lastpk is nil if no rows were copied yet.
+	lastpks := make(map[string]*sqltypes.Result)
+	for _, tablePK := range sgtid.Tablepks {
+		lastpks[tablePK.TableName] = sqltypes.Proto3ToResult(tablePK.Lastpk)
+	}
+	fields := make(map[string][]*querypb.Field)
+	pc := &pkComparer{rs: rs, collations: make(map[uint32]string)}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var eventss [][]*binlogdatapb.VEvent
+	// reached is set when the stream gets to gtid, and done
+	// once the transaction of that position is sent.
+	reached, done := false, false
+	err = rs.QueryService.VStream(ctx, rs.Target, sgtid.Gtid, vs.filter, func(events []*binlogdatapb.VEvent) error {
+		sendevents := make([]*binlogdatapb.VEvent, 0, len(events))
+		for _, event := range events {
+			switch event.Type {
+			case binlogdatapb.VEventType_GTID:
+				pos, err := mysql.DecodePosition(event.Gtid)
+				if err != nil {
+					return err
+				}
+				reached = pos.AtLeast(target)
+				sendevents = append(sendevents, event)
+			case binlogdatapb.VEventType_FIELD:
+				table := event.FieldEvent.TableName
+				fields[table] = event.FieldEvent.Fields
+				if lastpk, ok := lastpks[table]; ok && lastpk == nil {
+					continue
+				}
+				ev := proto.Clone(event).(*binlogdatapb.VEvent)
+				ev.FieldEvent.TableName = sgtid.Keyspace + "." + table
+				sendevents = append(sendevents, ev)
+			case binlogdatapb.VEventType_ROW:
+				table := event.RowEvent.TableName
+				lastpk, ok := lastpks[table]
+				if ok && lastpk == nil {
+					continue
+				}
+				ev := proto.Clone(event).(*binlogdatapb.VEvent)
+				ev.RowEvent.TableName = sgtid.Keyspace + "." + table
+				if ok {
+					changes, err := pc.rowsBeforeLastPK(ctx, fields[table], lastpk, ev.RowEvent.RowChanges)
+					if err != nil {
+						return vterrors.Wrapf(err, "table %s", table)
+					}
+					if len(changes) == 0 {
+						continue
+					}
+					ev.RowEvent.RowChanges = changes
+				}
+				sendevents = append(sendevents, ev)
+			case binlogdatapb.VEventType_COMMIT, binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_OTHER:
+				sendevents = append(sendevents, event)
+				eventss = append(eventss, sendevents)
+				if err := vs.sendAll(sgtid, eventss); err != nil {
+					return err
+				}
+				eventss = nil
+				sendevents = nil
+				if reached {
+					done = true
+					return io.EOF
+				}
+			case binlogdatapb.VEventType_HEARTBEAT:
+			case binlogdatapb.VEventType_JOURNAL:
+				return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "a journal event was encountered while copying the tables of %s/%s", sgtid.Keyspace, sgtid.Shard)
+			default:
+				sendevents = append(sendevents, event)
+			}
+		}
+		if len(sendevents) != 0 {
+			eventss = append(eventss, sendevents)
+		}
+		return nil
+	})
+	// The error is not checked because it may have been wrapped.
+	if done {
+		return nil
+	}
+	if err == nil {
+		// Unreachable.
+		err = vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "vstream ended unexpectedly")
+	}
+	return err
+}
+
+// pkComparer compares primary keys with the lastpk of a table.
+type pkComparer struct {
+	rs *srvtopo.ResolvedShard
+	// collations caches the collation clauses, like
+	// "_utf8 X'%x' collate utf8_general_ci", by collation id.
+	collations map[uint32]string
+}
+
+// rowsBeforeLastPK returns the row changes for the rows that are at or
+// before lastpk. An update that moves a row across lastpk becomes an
+// insert or a delete.
+func (pc *pkComparer) rowsBeforeLastPK(ctx context.Context, fields []*querypb.Field, lastpk *sqltypes.Result, changes []*binlogdatapb.RowChange) ([]*binlogdatapb.RowChange, error) {
+	if len(lastpk.Rows) != 1 {
+		return nil, fmt.Errorf("unexpected lastpk: %v", lastpk.Rows)
+	}
+	pkColumns := make([]int, len(lastpk.Fields))
+	pkfields := make([]*querypb.Field, len(lastpk.Fields))
+	for i, pkfield := range lastpk.Fields {
+		pkColumns[i] = -1
+		for j, field := range fields {
+			if strings.EqualFold(field.Name, pkfield.Name) {
+				pkColumns[i] = j
+				pkfields[i] = field
+				break
+			}
+		}
+		if pkColumns[i] == -1 {
+			return nil, fmt.Errorf("primary key column %s not found in %v", pkfield.Name, fields)
+		}
+	}
+
+	// The before and after images of all the changes are compared at once.
+	var pks [][]sqltypes.Value
+	addPK := func(row *querypb.Row) {
+		if row == nil {
+			return
+		}
+		values := sqltypes.MakeRowTrusted(fields, row)
+		pk := make([]sqltypes.Value, len(pkColumns))
+		for i, col := range pkColumns {
+			pk[i] = values[col]
+		}
+		pks = append(pks, pk)
+	}
+	for _, change := range changes {
+		addPK(change.Before)
+		addPK(change.After)
+	}
+	isBefore, err := pc.atOrBefore(ctx, pkfields, pks, lastpk.Rows[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*binlogdatapb.RowChange
+	for _, change := range changes {
+		before, after := false, false
+		if change.Before != nil {
+			before, isBefore = isBefore[0], isBefore[1:]
+		}
+		if change.After != nil {
+			after, isBefore = isBefore[0], isBefore[1:]
+		}
+		switch {
+		case before && after:
+			out = append(out, change)
+		case before:
+			out = append(out, &binlogdatapb.RowChange{Before: change.Before})
+		case after:
+			out = append(out, &binlogdatapb.RowChange{After: change.After})
+		}
+	}
+	return out, nil
+}
+
+// atOrBefore returns, for each of the primary keys, whether it's at or
+// before lastpk. Numbers and binary values are compared by vtgate. But
+// text values must be compared in the collation of their column, which
+// MySQL uses to order the rows that are copied. So, if the primary key
+// has a text column, the comparisons are done by the MySQL of the tablet.
+func (pc *pkComparer) atOrBefore(ctx context.Context, pkfields []*querypb.Field, pks [][]sqltypes.Value, lastpk []sqltypes.Value) ([]bool, error) {
+	if len(pks) == 0 {
+		return nil, nil
+	}
+	hasText := false
+	for _, field := range pkfields {
+		if sqltypes.IsText(field.Type) {
+			hasText = true
+		}
+	}
+	out := make([]bool, len(pks))
+	if !hasText {
+		for i, pk := range pks {
+			out[i] = true
+			for j := range pk {
+				cmp, err := sqltypes.NullsafeCompare(pk[j], lastpk[j])
+				if err != nil {
+					return nil, err
+				}
+				if cmp != 0 {
+					out[i] = cmp < 0
+					break
+				}
+			}
+		}
+		return out, nil
+	}
+
+	var buf strings.Builder
+	buf.WriteString("select ")
+	lastpkTuple, err := pc.tuple(ctx, pkfields, lastpk)
+	if err != nil {
+		return nil, err
+	}
+	for i, pk := range pks {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		pkTuple, err := pc.tuple(ctx, pkfields, pk)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, "%s <= %s", pkTuple, lastpkTuple)
+	}
+	qr, err := pc.rs.QueryService.Execute(ctx, pc.rs.Target, buf.String(), nil, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != len(pks) {
+		return nil, fmt.Errorf("unexpected result for %s: %v", buf.String(), qr.Rows)
+	}
+	for i, v := range qr.Rows[0] {
+		out[i] = v.ToString() == "1"
+	}
+	return out, nil
+}
+
+// tuple returns the sql tuple of the values, with the text
+// values in the collation of their column.
+func (pc *pkComparer) tuple(ctx context.Context, fields []*querypb.Field, values []sqltypes.Value) (string, error) {
+	var buf strings.Builder
+	buf.WriteByte('(')
+	for i, v := range values {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		if v.IsNull() || !sqltypes.IsText(fields[i].Type) {
+			v.EncodeSQL(&buf)
+			continue
+		}
+		format, err := pc.collation(ctx, fields[i])
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&buf, format, v.ToBytes())
+	}
+	buf.WriteByte(')')
+	return buf.String(), nil
+}
+
+// collation returns the format of the text literals in the
+// collation of the field.
+func (pc *pkComparer) collation(ctx context.Context, field *querypb.Field) (string, error) {
+	if format, ok := pc.collations[field.Charset]; ok {
+		return format, nil
+	}
+	if field.Charset == 0 {
+		return "", fmt.Errorf("the collation of column %s is unknown", field.Name)
+	}
+	query := fmt.Sprintf("select character_set_name, collation_name from information_schema.collations where id = %d", field.Charset)
+	qr, err := pc.rs.QueryService.Execute(ctx, pc.rs.Target, query, nil, 0, nil)
+	if err != nil {
+		return "", err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		return "", fmt.Errorf("unknown collation %d of column %s: %v", field.Charset, field.Name, qr.Rows)
+	}
+	format := fmt.Sprintf("_%s X'%%x' collate %s", qr.Rows[0][0].ToString(), qr.Rows[0][1].ToString())
+	pc.collations[field.Charset] = format
+	return format, nil
+}
+
+// copyQuery returns the query that selects the rows of the table to be
+// copied, based on the first rule of the filter that matches it. It
+// returns an empty string if no rule matches the table.
+func copyQuery(filter *binlogdatapb.Filter, table string) (string, error) {
+	for _, rule := range filter.Rules {
+		switch {
+		case strings.HasPrefix(rule.Match, "/"):
+			expr := strings.Trim(rule.Match, "/")
+			result, err := regexp.MatchString(expr, table)
+			if err != nil {
+				return "", err
+			}
+			if !result {
+				continue
+			}
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table))
+			if rule.Filter != "" {
+				// The filter is a keyrange, like "-80".
+				buf.Myprintf(" where in_keyrange(%v)", sqlparser.NewStrVal([]byte(rule.Filter)))
+			}
+			return buf.String(), nil
+		case rule.Match == table:
+			if rule.Filter != "" {
+				return rule.Filter, nil
+			}
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table))
+			return buf.String(), nil
+		}
+	}
+	return "", nil
+}
+
+// updateTablepks records the progress of the copy of a table in the
+// Tablepks of the shard. A table that's completely copied is removed.
+// It must be called with the lock held.
+func updateTablepks(sgtid *binlogdatapb.ShardGtid, event *binlogdatapb.LastPKEvent) {
+	table := strings.TrimPrefix(event.TableLastpk.TableName, sgtid.Keyspace+".")
+	for i, tablePK := range sgtid.Tablepks {
+		if tablePK.TableName != table {
+			continue
+		}
+		if event.Completed {
+			sgtid.Tablepks = append(sgtid.Tablepks[:i:i], sgtid.Tablepks[i+1:]...)
+			return
+		}
+		sgtid.Tablepks[i] = &binlogdatapb.TableLastPK{TableName: table, Lastpk: event.TableLastpk.Lastpk}
+		return
+	}
+}
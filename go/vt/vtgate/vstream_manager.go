@@ -108,12 +108,12 @@ func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodat
 		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vgtid must have at least one value with a starting position")
 	}
 	// To fetch from all keyspaces, the input must contain a single ShardGtid
-	// that has an empty keyspace, and the Gtid must be "current". In the
-	// future, we'll allow the Gtid to be empty which will also support
-	// copying of existing data.
+	// that has an empty keyspace, and the Gtid must be "current" or empty.
+	// An empty Gtid copies the existing data before streaming.
 	if len(vgtid.ShardGtids) == 1 && vgtid.ShardGtids[0].Keyspace == "" {
-		if vgtid.ShardGtids[0].Gtid != "current" {
-			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "for an empty keyspace, the Gtid value must be 'current': %v", vgtid)
+		gtid := vgtid.ShardGtids[0].Gtid
+		if gtid != "current" && gtid != "" {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "for an empty keyspace, the Gtid value must be 'current' or empty: %v", vgtid)
 		}
 		keyspaces, err := vsm.toposerv.GetSrvKeyspaceNames(ctx, vsm.cell)
 		if err != nil {
@@ -123,7 +123,7 @@ func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodat
 		for _, keyspace := range keyspaces {
 			newvgtid.ShardGtids = append(newvgtid.ShardGtids, &binlogdatapb.ShardGtid{
 				Keyspace: keyspace,
-				Gtid:     "current",
+				Gtid:     gtid,
 			})
 		}
 		vgtid = newvgtid
@@ -131,8 +131,8 @@ func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodat
 	newvgtid := &binlogdatapb.VGtid{}
 	for _, sgtid := range vgtid.ShardGtids {
 		if sgtid.Shard == "" {
-			if sgtid.Gtid != "current" {
-				return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "if shards are unspecified, the Gtid value must be 'current': %v", vgtid)
+			if sgtid.Gtid != "current" && sgtid.Gtid != "" {
+				return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "if shards are unspecified, the Gtid value must be 'current' or empty: %v", vgtid)
 			}
 			// TODO(sougou): this should work with the new Migrate workflow
 			_, _, allShards, err := vsm.resolver.GetKeyspaceShards(ctx, sgtid.Keyspace, tabletType)
@@ -140,11 +140,16 @@ func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodat
 				return nil, nil, err
 			}
 			for _, shard := range allShards {
-				newvgtid.ShardGtids = append(newvgtid.ShardGtids, &binlogdatapb.ShardGtid{
+				newsgtid := &binlogdatapb.ShardGtid{
 					Keyspace: sgtid.Keyspace,
 					Shard:    shard.Name,
 					Gtid:     sgtid.Gtid,
-				})
+				}
+				// Every shard copies the requested tables.
+				for _, tablePK := range sgtid.Tablepks {
+					newsgtid.Tablepks = append(newsgtid.Tablepks, proto.Clone(tablePK).(*binlogdatapb.TableLastPK))
+				}
+				newvgtid.ShardGtids = append(newvgtid.ShardGtids, newsgtid)
 			}
 		} else {
 			newvgtid.ShardGtids = append(newvgtid.ShardGtids, sgtid)
@@ -189,6 +194,15 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 	// It will be closed when all journal events converge.
 	var journalDone chan struct{}
 
+	// The existing rows are copied before streaming, if requested.
+	// Safe to access sgtid here (because it can't change until streaming begins).
+	if needsCopy(sgtid) {
+		if err := vs.copyFromTablet(ctx, sgtid); err != nil {
+			log.Errorf("vstream copy for %s/%s error: %v", sgtid.Keyspace, sgtid.Shard, err)
+			return err
+		}
+	}
+
 	errCount := 0
 	for {
 		select {
@@ -310,6 +324,13 @@ func (vs *vstream) sendAll(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdat
 
 	// Send all chunks while holding the lock.
 	for _, events := range eventss {
+		// Record the progress of the copy first, so that the
+		// vgtids of the chunk include it.
+		for _, event := range events {
+			if event.Type == binlogdatapb.VEventType_LASTPK {
+				updateTablepks(sgtid, event.LastpkEvent)
+			}
+		}
 		// convert all gtids to vgtids. This should be done here while holding the lock.
 		for j, event := range events {
 			if event.Type == binlogdatapb.VEventType_GTID {
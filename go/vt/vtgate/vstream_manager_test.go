@@ -24,9 +24,11 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/xsec-lab/go/sqltypes"
 	"github.com/xsec-lab/go/vt/discovery"
 	"github.com/xsec-lab/go/vt/proto/binlogdata"
 	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
+	querypb "github.com/xsec-lab/go/vt/proto/query"
 	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
 	vtrpcpb "github.com/xsec-lab/go/vt/proto/vtrpc"
 	"github.com/xsec-lab/go/vt/srvtopo"
@@ -746,6 +748,308 @@ func TestVStreamJournalPartialMatch(t *testing.T) {
 	cancel()
 }
 
+func TestVStreamCopy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	pos1 := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-5"
+	pos2 := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-6"
+	pos3 := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-7"
+	pos4 := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-8"
+
+	sbc0.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("Tables_in_vt_ks", "varchar"), "t2", "t1"),
+	})
+	fields := sqltypes.MakeTestFields("id|val", "int64|varchar")
+	pkfields := fields[:1]
+	sbc0.VStreamRowsResponses = map[string][]*binlogdatapb.VStreamRowsResponse{
+		"select * from t1": {
+			{Fields: fields, Pkfields: pkfields, Gtid: pos1},
+			{Rows: []*querypb.Row{testRow(1, "a"), testRow(2, "b")}, Lastpk: testRow(2)},
+		},
+		"select * from t2": {
+			{Fields: fields, Pkfields: pkfields, Gtid: pos3},
+		},
+	}
+	// The events that catch up t1 to the snapshot of t2, followed by the ones
+	// that are streamed after the copy.
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: pos2},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "t2", Fields: fields}},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "t2"}},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: pos3},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "t1", Fields: fields}},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "t1"}},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: pos4},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "t2"}},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+
+	lastpk := &querypb.QueryResult{Fields: pkfields, Rows: []*querypb.Row{testRow(2)}}
+	vgtid := func(gtid string, tablepks ...*binlogdatapb.TableLastPK) *binlogdatapb.VEvent {
+		return &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-20",
+				Gtid:     gtid,
+				Tablepks: tablepks,
+			}},
+		}}
+	}
+	ch := startVStream(ctx, t, vsm, &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+		}},
+	})
+	verifyEvents(t, ch,
+		&binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			vgtid(pos1, &binlogdatapb.TableLastPK{TableName: "t1", Lastpk: lastpk}, &binlogdatapb.TableLastPK{TableName: "t2"}),
+			{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.t1", Fields: fields}},
+			{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{
+				TableName: "TestVStream.t1",
+				RowChanges: []*binlogdatapb.RowChange{
+					{After: testRow(1, "a")},
+					{After: testRow(2, "b")},
+				},
+			}},
+			{Type: binlogdatapb.VEventType_LASTPK, LastpkEvent: &binlogdatapb.LastPKEvent{
+				TableLastpk: &binlogdatapb.TableLastPK{TableName: "TestVStream.t1", Lastpk: lastpk},
+			}},
+		}},
+		&binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			vgtid(pos1, &binlogdatapb.TableLastPK{TableName: "t2"}),
+			{Type: binlogdatapb.VEventType_LASTPK, LastpkEvent: &binlogdatapb.LastPKEvent{
+				TableLastpk: &binlogdatapb.TableLastPK{TableName: "TestVStream.t1", Lastpk: lastpk},
+				Completed:   true,
+			}},
+		}},
+		// t2 is not copied yet: its events are dropped.
+		&binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			vgtid(pos2, &binlogdatapb.TableLastPK{TableName: "t2"}),
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}},
+		&binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			vgtid(pos3, &binlogdatapb.TableLastPK{TableName: "t2"}),
+			{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.t1", Fields: fields}},
+			{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t1"}},
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}},
+		&binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			vgtid(pos3),
+			{Type: binlogdatapb.VEventType_LASTPK, LastpkEvent: &binlogdatapb.LastPKEvent{
+				TableLastpk: &binlogdatapb.TableLastPK{TableName: "TestVStream.t2"},
+				Completed:   true,
+			}},
+		}},
+		&binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			vgtid(pos3),
+			{Type: binlogdatapb.VEventType_COPY_COMPLETED},
+		}},
+		&binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			vgtid(pos4),
+			{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t2"}},
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}},
+	)
+	assert.Equal(t, []*querypb.QueryResult{nil, nil}, sbc0.VStreamRowsLastPKs)
+}
+
+// TestVStreamCopyResume ensures that a copy resumes from the lastpk of
+// the table, and that the binlog events of the table are sent only for
+// the rows that were already copied.
+func TestVStreamCopyResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	pos1 := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-5"
+	pos2 := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-6"
+
+	fields := sqltypes.MakeTestFields("id|val", "int64|varchar")
+	pkfields := fields[:1]
+	sbc0.VStreamRowsResponses = map[string][]*binlogdatapb.VStreamRowsResponse{
+		"select * from t1": {
+			{Fields: fields, Pkfields: pkfields, Gtid: pos2},
+			{Rows: []*querypb.Row{testRow(3, "c")}, Lastpk: testRow(3)},
+		},
+	}
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: pos2},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "t1", Fields: fields}},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{
+			TableName: "t1",
+			RowChanges: []*binlogdatapb.RowChange{
+				// Copied row.
+				{After: testRow(1, "a")},
+				// Row yet to be copied.
+				{After: testRow(5, "e")},
+				// Row moved out of the copied range.
+				{Before: testRow(2, "b"), After: testRow(4, "b")},
+				// Row moved into the copied range.
+				{Before: testRow(6, "f"), After: testRow(0, "f")},
+			},
+		}},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+
+	lastpk2 := &querypb.QueryResult{Fields: pkfields, Rows: []*querypb.Row{testRow(2)}}
+	lastpk3 := &querypb.QueryResult{Fields: pkfields, Rows: []*querypb.Row{testRow(3)}}
+	vgtid := func(gtid string, tablepks ...*binlogdatapb.TableLastPK) *binlogdatapb.VEvent {
+		return &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-20",
+				Gtid:     gtid,
+				Tablepks: tablepks,
+			}},
+		}}
+	}
+	ch := startVStream(ctx, t, vsm, &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+			Gtid:     pos1,
+			Tablepks: []*binlogdatapb.TableLastPK{{TableName: "t1", Lastpk: lastpk2}},
+		}},
+	})
+	verifyEvents(t, ch,
+		&binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			vgtid(pos2, &binlogdatapb.TableLastPK{TableName: "t1", Lastpk: lastpk2}),
+			{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.t1", Fields: fields}},
+			{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{
+				TableName: "TestVStream.t1",
+				RowChanges: []*binlogdatapb.RowChange{
+					{After: testRow(1, "a")},
+					{Before: testRow(2, "b")},
+					{After: testRow(0, "f")},
+				},
+			}},
+			{Type: binlogdatapb.VEventType_COMMIT},
+		}},
+		&binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			vgtid(pos2, &binlogdatapb.TableLastPK{TableName: "t1", Lastpk: lastpk3}),
+			{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.t1", Fields: fields}},
+			{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{
+				TableName:  "TestVStream.t1",
+				RowChanges: []*binlogdatapb.RowChange{{After: testRow(3, "c")}},
+			}},
+			{Type: binlogdatapb.VEventType_LASTPK, LastpkEvent: &binlogdatapb.LastPKEvent{
+				TableLastpk: &binlogdatapb.TableLastPK{TableName: "TestVStream.t1", Lastpk: lastpk3},
+			}},
+		}},
+		&binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			vgtid(pos2),
+			{Type: binlogdatapb.VEventType_LASTPK, LastpkEvent: &binlogdatapb.LastPKEvent{
+				TableLastpk: &binlogdatapb.TableLastPK{TableName: "TestVStream.t1", Lastpk: lastpk3},
+				Completed:   true,
+			}},
+		}},
+		&binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+			vgtid(pos2),
+			{Type: binlogdatapb.VEventType_COPY_COMPLETED},
+		}},
+	)
+	assert.Equal(t, []*querypb.QueryResult{lastpk2}, sbc0.VStreamRowsLastPKs)
+}
+
+func TestRowsBeforeLastPKText(t *testing.T) {
+	ctx := context.Background()
+	hc := discovery.NewFakeHealthCheck()
+	sbc := hc.AddTestTablet("aa", "1.1.1.1", 1001, "TestVStream", "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+	pc := &pkComparer{
+		rs:         &srvtopo.ResolvedShard{Target: &querypb.Target{Keyspace: "TestVStream", Shard: "-20", TabletType: topodatapb.TabletType_MASTER}, QueryService: sbc},
+		collations: make(map[uint32]string),
+	}
+
+	fields := sqltypes.MakeTestFields("k|id|val", "varchar|int64|varchar")
+	fields[0].Charset = 33
+	lastpk := &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("k|id", "varchar|int64"),
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarChar("b"), sqltypes.NewInt64(1)}},
+	}
+	changes := []*binlogdatapb.RowChange{
+		{After: testRow("B", 1, "a")},
+		{Before: testRow("a", 2, "b"), After: testRow("c", 2, "b")},
+	}
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("character_set_name|collation_name", "varchar|varchar"), "utf8|utf8_general_ci"),
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("a|b|c", "int64|int64|int64"), "1|1|0"),
+	})
+	got, err := pc.rowsBeforeLastPK(ctx, fields, lastpk, changes)
+	require.NoError(t, err)
+	assert.Equal(t, []*binlogdatapb.RowChange{
+		{After: testRow("B", 1, "a")},
+		{Before: testRow("a", 2, "b")},
+	}, got)
+	var queries []string
+	for _, q := range sbc.Queries {
+		queries = append(queries, q.Sql)
+	}
+	assert.Equal(t, []string{
+		"select character_set_name, collation_name from information_schema.collations where id = 33",
+		"select (_utf8 X'42' collate utf8_general_ci, 1) <= (_utf8 X'62' collate utf8_general_ci, 1), " +
+			"(_utf8 X'61' collate utf8_general_ci, 2) <= (_utf8 X'62' collate utf8_general_ci, 1), " +
+			"(_utf8 X'63' collate utf8_general_ci, 2) <= (_utf8 X'62' collate utf8_general_ci, 1)",
+	}, queries)
+
+	// A column with an unknown collation can't be compared.
+	fields[0].Charset = 0
+	_, err = (&pkComparer{rs: pc.rs, collations: make(map[uint32]string)}).rowsBeforeLastPK(ctx, fields, lastpk, changes)
+	assert.EqualError(t, err, "the collation of column k is unknown")
+}
+
+func TestVStreamCopyQuery(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select id from t1 where id > 10",
+		}, {
+			Match: "t2",
+		}, {
+			Match:  "/^t3",
+			Filter: "-80",
+		}},
+	}
+	testcases := []struct {
+		table string
+		query string
+	}{{
+		table: "t1",
+		query: "select id from t1 where id > 10",
+	}, {
+		table: "t2",
+		query: "select * from t2",
+	}, {
+		table: "t3a",
+		query: "select * from t3a where in_keyrange('-80')",
+	}, {
+		table: "t4",
+	}}
+	for _, tcase := range testcases {
+		query, err := copyQuery(filter, tcase.table)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.query, query, tcase.table)
+	}
+}
+
 func TestResolveVStreamParams(t *testing.T) {
 	name := "TestVStream"
 	_ = createSandbox(name)
@@ -760,16 +1064,36 @@ func TestResolveVStreamParams(t *testing.T) {
 		err:   "vgtid must have at least one value with a starting position",
 	}, {
 		input: &binlogdatapb.VGtid{
-			ShardGtids: []*binlogdatapb.ShardGtid{{}},
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Gtid: "other",
+			}},
 		},
-		err: "for an empty keyspace, the Gtid value must be 'current'",
+		err: "for an empty keyspace, the Gtid value must be 'current' or empty",
 	}, {
 		input: &binlogdatapb.VGtid{
 			ShardGtids: []*binlogdatapb.ShardGtid{{
 				Keyspace: "TestVStream",
+				Gtid:     "other",
+			}},
+		},
+		err: "if shards are unspecified, the Gtid value must be 'current' or empty",
+	}, {
+		input: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: "TestVStream",
+				Shard:    "-20",
+				Gtid:     "",
+				Tablepks: []*binlogdatapb.TableLastPK{{TableName: "t1"}},
+			}},
+		},
+		output: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: "TestVStream",
+				Shard:    "-20",
+				Gtid:     "",
+				Tablepks: []*binlogdatapb.TableLastPK{{TableName: "t1"}},
 			}},
 		},
-		err: "if shards are unspecified, the Gtid value must be 'current'",
 	}, {
 		input: &binlogdatapb.VGtid{
 			ShardGtids: []*binlogdatapb.ShardGtid{{
@@ -871,6 +1195,22 @@ func TestResolveVStreamParams(t *testing.T) {
 	if got, want := len(vgtid.ShardGtids), 8; want >= got {
 		t.Errorf("len(vgtid.ShardGtids): %v, must be >%d", got, want)
 	}
+
+	// An empty Gtid without shards copies the tables of every shard.
+	input = &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "TestVStream",
+			Tablepks: []*binlogdatapb.TableLastPK{{TableName: "t1"}},
+		}},
+	}
+	vgtid, _, err = vsm.resolveParams(context.Background(), topodatapb.TabletType_REPLICA, input, nil)
+	require.NoError(t, err, input)
+	require.Len(t, vgtid.ShardGtids, 8)
+	for _, sgtid := range vgtid.ShardGtids {
+		assert.Equal(t, "", sgtid.Gtid)
+		assert.Equal(t, []*binlogdatapb.TableLastPK{{TableName: "t1"}}, sgtid.Tablepks)
+	}
+	assert.False(t, vgtid.ShardGtids[0].Tablepks[0] == vgtid.ShardGtids[1].Tablepks[0], "Tablepks must not be shared by the shards")
 }
 
 func newTestVStreamManager(hc discovery.HealthCheck, serv srvtopo.Server, cell string) *vstreamManager {
@@ -890,6 +1230,20 @@ func startVStream(ctx context.Context, t *testing.T, vsm *vstreamManager, vgtid
 	return ch
 }
 
+// testRow builds a row of int64 and varchar values.
+func testRow(values ...interface{}) *querypb.Row {
+	row := make([]sqltypes.Value, len(values))
+	for i, v := range values {
+		switch v := v.(type) {
+		case int:
+			row[i] = sqltypes.NewInt64(int64(v))
+		case string:
+			row[i] = sqltypes.NewVarChar(v)
+		}
+	}
+	return sqltypes.RowToProto3(row)
+}
+
 func verifyEvents(t *testing.T, ch <-chan *binlogdatapb.VStreamResponse, wants ...*binlogdatapb.VStreamResponse) {
 	t.Helper()
 	for i, want := range wants {
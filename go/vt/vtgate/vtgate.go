@@ -19,6 +19,7 @@ limitations under the License.
 package vtgate
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"net/http"
@@ -219,6 +220,16 @@ func Init(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, ce
 			f(rpcVTGate)
 		}
 	})
+	rpcVTGate.executor.startLookupCacheInvalidation(ctx, vsm)
+	if *sequencePrefetchSize > 0 {
+		rpcVTGate.executor.startSequencePrefetch(*sequencePrefetchSize, *sequencePrefetchTimeout)
+	}
+	if *enableSchemaTracking {
+		rpcVTGate.executor.startSchemaTracking(ctx, *schemaTrackingInterval)
+	}
+	if *planCacheFile != "" {
+		rpcVTGate.executor.initPlanCachePersistence(ctx, *planCacheFile, *planCacheSaveInterval, *planCacheWarmupTimeout)
+	}
 	rpcVTGate.registerDebugHealthHandler()
 	err := initQueryLogger(rpcVTGate)
 	if err != nil {
@@ -248,6 +259,9 @@ func (vtg *VTGate) registerDebugHealthHandler() {
 // IsHealthy returns nil if server is healthy.
 // Otherwise, it returns an error indicating the reason.
 func (vtg *VTGate) IsHealthy() error {
+	if vtg.executor.warmingUp.Get() {
+		return errors.New("query plan cache warm-up in progress")
+	}
 	return nil
 }
 
@@ -368,6 +368,20 @@ func (q *query) VStreamResults(request *binlogdatapb.VStreamResultsRequest, stre
 	return vterrors.ToGRPC(err)
 }
 
+// GetSchema is part of the queryservice.QueryServer interface
+func (q *query) GetSchema(ctx context.Context, request *binlogdatapb.GetSchemaRequest) (response *binlogdatapb.GetSchemaResponse, err error) {
+	defer q.server.HandlePanic(&err)
+	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	tables, err := q.server.GetSchema(ctx, request.Target)
+	if err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &binlogdatapb.GetSchemaResponse{Tables: tables}, nil
+}
+
 // Register registers the implementation on the provide gRPC Server.
 func Register(s *grpc.Server, server queryservice.QueryService) {
 	queryservicepb.RegisterQueryServer(s, &query{server})
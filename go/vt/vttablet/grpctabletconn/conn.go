@@ -718,6 +718,25 @@ func (conn *gRPCQueryClient) VStreamResults(ctx context.Context, target *querypb
 	}
 }
 
+// GetSchema returns the tables loaded by the schema engine of the tablet.
+func (conn *gRPCQueryClient) GetSchema(ctx context.Context, target *querypb.Target) ([]*binlogdatapb.MinimalTable, error) {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return nil, tabletconn.ConnClosed
+	}
+	req := &binlogdatapb.GetSchemaRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+	}
+	reply, err := conn.c.GetSchema(ctx, req)
+	if err != nil {
+		return nil, tabletconn.ErrorFromGRPC(err)
+	}
+	return reply.Tables, nil
+}
+
 // HandlePanic is a no-op.
 func (conn *gRPCQueryClient) HandlePanic(err *error) {
 }
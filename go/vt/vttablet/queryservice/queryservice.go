@@ -101,6 +101,9 @@ type QueryService interface {
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(ctx context.Context, target *querypb.Target, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error
 
+	// GetSchema returns the tables loaded by the schema engine of the tablet.
+	GetSchema(ctx context.Context, target *querypb.Target) ([]*binlogdatapb.MinimalTable, error)
+
 	// StreamHealth streams health status.
 	StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error
 
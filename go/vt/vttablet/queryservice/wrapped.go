@@ -256,6 +256,15 @@ func (ws *wrappedService) VStreamResults(ctx context.Context, target *querypb.Ta
 	})
 }
 
+func (ws *wrappedService) GetSchema(ctx context.Context, target *querypb.Target) (tables []*binlogdatapb.MinimalTable, err error) {
+	err = ws.wrapper(ctx, target, ws.impl, "GetSchema", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		var innerErr error
+		tables, innerErr = conn.GetSchema(ctx, target)
+		return canRetry(ctx, innerErr), innerErr
+	})
+	return tables, err
+}
+
 func (ws *wrappedService) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
 	return ws.wrapper(ctx, nil, ws.impl, "StreamHealth", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
 		innerErr := conn.StreamHealth(ctx, callback)
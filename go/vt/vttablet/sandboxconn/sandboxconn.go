@@ -65,6 +65,7 @@ type SandboxConn struct {
 	SetRollbackCount         sync2.AtomicInt64
 	ConcludeTransactionCount sync2.AtomicInt64
 	ReadTransactionCount     sync2.AtomicInt64
+	GetSchemaCount           sync2.AtomicInt64
 
 	// Queries stores the non-batch requests received.
 	Queries []*querypb.BoundQuery
@@ -91,6 +92,14 @@ type SandboxConn struct {
 	VStreamEvents [][]*binlogdatapb.VEvent
 	VStreamErrors []error
 
+	// VStreamRowsResponses are returned by VStreamRows for each query.
+	// VStreamRowsLastPKs records the lastpk of every VStreamRows request.
+	VStreamRowsResponses map[string][]*binlogdatapb.VStreamRowsResponse
+	VStreamRowsLastPKs   []*querypb.QueryResult
+
+	// GetSchemaResult is returned by GetSchema.
+	GetSchemaResult []*binlogdatapb.MinimalTable
+
 	// transaction id generator
 	TransactionID sync2.AtomicInt64
 }
@@ -367,7 +376,17 @@ func (sbc *SandboxConn) VStream(ctx context.Context, target *querypb.Target, sta
 
 // VStreamRows is part of the QueryService interface.
 func (sbc *SandboxConn) VStreamRows(ctx context.Context, target *querypb.Target, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
-	return fmt.Errorf("not implemented in test")
+	responses, ok := sbc.VStreamRowsResponses[query]
+	if !ok {
+		return fmt.Errorf("unexpected query: %s", query)
+	}
+	sbc.VStreamRowsLastPKs = append(sbc.VStreamRowsLastPKs, lastpk)
+	for _, response := range responses {
+		if err := send(response); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // VStreamResults is part of the QueryService interface.
@@ -375,6 +394,15 @@ func (sbc *SandboxConn) VStreamResults(ctx context.Context, target *querypb.Targ
 	return fmt.Errorf("not implemented in test")
 }
 
+// GetSchema is part of the QueryService interface.
+func (sbc *SandboxConn) GetSchema(ctx context.Context, target *querypb.Target) ([]*binlogdatapb.MinimalTable, error) {
+	sbc.GetSchemaCount.Add(1)
+	if err := sbc.getError(); err != nil {
+		return nil, err
+	}
+	return sbc.GetSchemaResult, nil
+}
+
 // HandlePanic is part of the QueryService interface.
 func (sbc *SandboxConn) HandlePanic(err *error) {
 }
@@ -698,6 +698,11 @@ func (f *FakeQueryService) VStreamResults(ctx context.Context, target *querypb.T
 	panic("not implemented")
 }
 
+// GetSchema is part of the QueryService interface.
+func (f *FakeQueryService) GetSchema(ctx context.Context, target *querypb.Target) ([]*binlogdatapb.MinimalTable, error) {
+	panic("not implemented")
+}
+
 // CreateFakeServer returns the fake server for the tests
 func CreateFakeServer(t *testing.T) *FakeQueryService {
 	return &FakeQueryService{
@@ -50,7 +50,33 @@ const (
   vrepl_id int,
   table_name varbinary(128),
   lastpk varbinary(2000),
-  primary key (vrepl_id, table_name))`
+  rows_estimated bigint not null default 0,
+  bytes_estimated bigint not null default 0,
+  rows_copied bigint not null default 0,
+  bytes_copied bigint not null default 0,
+  time_started bigint not null default 0,
+  time_updated bigint not null default 0,
+  chunk int not null default 0,
+  chunk_end varbinary(2000) default null,
+  primary key (vrepl_id, table_name, chunk))`
+
+	// alterCopyState adds the progress columns to a copy_state
+	// table that was created by an older version.
+	alterCopyState = `alter table _vt.copy_state
+  add column rows_estimated bigint not null default 0,
+  add column bytes_estimated bigint not null default 0,
+  add column rows_copied bigint not null default 0,
+  add column bytes_copied bigint not null default 0,
+  add column time_started bigint not null default 0,
+  add column time_updated bigint not null default 0`
+
+	// alterCopyStateChunks adds the columns that allow
+	// a table to be copied in multiple chunks.
+	alterCopyStateChunks = `alter table _vt.copy_state
+  add column chunk int not null default 0,
+  add column chunk_end varbinary(2000) default null,
+  drop primary key,
+  add primary key (vrepl_id, table_name, chunk)`
 )
 
 var tabletTypesStr = flag.String("vreplication_tablet_type", "REPLICA", "comma separated list of tablet types used as a source")
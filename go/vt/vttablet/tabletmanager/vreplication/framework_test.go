@@ -216,6 +216,11 @@ func (ftc *fakeTabletConn) StreamHealth(ctx context.Context, callback func(*quer
 	})
 }
 
+// Execute directly runs the query on the source database.
+func (ftc *fakeTabletConn) Execute(ctx context.Context, target *querypb.Target, query string, bindVars map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	return env.Mysqld.FetchSuperQuery(ctx, query)
+}
+
 // vstreamHook allows you to do work just before calling VStream.
 var vstreamHook func(ctx context.Context)
 
@@ -73,7 +73,7 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 		return &tplanv, nil
 	}
 	// select * construct was used. We need to use the field names.
-	tplan, err := rp.buildFromFields(prelim.TargetName, prelim.Lastpk, fieldEvent.Fields)
+	tplan, err := rp.buildFromFields(prelim, fieldEvent.Fields)
 	if err != nil {
 		return nil, err
 	}
@@ -84,10 +84,12 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 // buildFromFields builds a full TablePlan, but uses the field info as the
 // full column list. This happens when the query used was a 'select *', which
 // requires us to wait for the field info sent by the source.
-func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Result, fields []*querypb.Field) (*TablePlan, error) {
+func (rp *ReplicatorPlan) buildFromFields(prelim *TablePlan, fields []*querypb.Field) (*TablePlan, error) {
 	tpb := &tablePlanBuilder{
-		name:   sqlparser.NewTableIdent(tableName),
-		lastpk: lastpk,
+		name:     sqlparser.NewTableIdent(prelim.TargetName),
+		lastpk:   prelim.Lastpk,
+		upsert:   prelim.Upsert,
+		uncopied: prelim.Uncopied,
 	}
 	for _, field := range fields {
 		colName := sqlparser.NewColIdent(field.Name)
@@ -135,7 +137,8 @@ func (rp *ReplicatorPlan) MarshalJSON() ([]byte, error) {
 // names to build the final plan.
 // Lastpk comes from copyState. If it's set, then the generated plans
 // are significantly different because any events that fall beyond
-// Lastpk must be excluded.
+// Lastpk must be excluded. Likewise, the events that fall within the
+// Uncopied ranges must be excluded.
 // If column names were known upfront, then all fields of TablePlan
 // are built except for Fields. This member is populated only after
 // the field info is received from the stream.
@@ -152,6 +155,12 @@ type TablePlan struct {
 	// will be used for building the final plan after field info
 	// is received.
 	Lastpk *sqltypes.Result
+	// Upsert and Uncopied are set while the tables are copied in
+	// parallel, and are used like Lastpk. If Upsert is set, inserts
+	// overwrite the rows that already exist. This makes it safe to
+	// apply the events that the copied rows already contain.
+	Upsert   bool
+	Uncopied *pkRanges
 	// BulkInsertFront, BulkInsertValues and BulkInsertOnDup are used
 	// by vcopier. These three parts are combined to build bulk insert
 	// statements. This is functionally equivalent to generating
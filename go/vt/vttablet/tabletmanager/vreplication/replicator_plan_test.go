@@ -681,7 +681,7 @@ func TestBuildPlayerPlan(t *testing.T) {
 	}
 
 	for _, tcase := range testcases {
-		plan, err := buildReplicatorPlan(tcase.input, tableKeys, nil)
+		plan, err := buildReplicatorPlan(tcase.input, tableKeys, nil, nil)
 		gotPlan, _ := json.Marshal(plan)
 		wantPlan, _ := json.Marshal(tcase.plan)
 		if string(gotPlan) != string(wantPlan) {
@@ -695,7 +695,7 @@ func TestBuildPlayerPlan(t *testing.T) {
 			t.Errorf("Filter err(%v): %s, want %v", tcase.input, gotErr, tcase.err)
 		}
 
-		plan, err = buildReplicatorPlan(tcase.input, tableKeys, copyState)
+		plan, err = buildReplicatorPlan(tcase.input, tableKeys, copyState, nil)
 		if err != nil {
 			continue
 		}
@@ -721,7 +721,7 @@ func TestBuildPlayerPlanNoDup(t *testing.T) {
 			Filter: "select * from t",
 		}},
 	}
-	_, err := buildReplicatorPlan(input, tableKeys, nil)
+	_, err := buildReplicatorPlan(input, tableKeys, nil, nil)
 	want := "more than one target for source table t"
 	if err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("buildReplicatorPlan err: %v, must contain: %v", err, want)
@@ -742,7 +742,7 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 			Filter: "",
 		}},
 	}
-	plan, err := buildReplicatorPlan(input, tableKeys, nil)
+	plan, err := buildReplicatorPlan(input, tableKeys, nil, nil)
 	assert.NoError(t, err)
 
 	want := &TestReplicatorPlan{
@@ -765,3 +765,78 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 	wantPlan, _ := json.Marshal(want)
 	assert.Equal(t, string(gotPlan), string(wantPlan))
 }
+
+func TestBuildPlayerPlanParallelCopy(t *testing.T) {
+	tableKeys := map[string][]string{
+		"t1": {"c1"},
+		"t2": {"c1"},
+		"t3": {"c1"},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, c2 from t1",
+		}, {
+			Match:  "t2",
+			Filter: "select c1, count(*) as c2 from t2 group by c1",
+		}, {
+			Match:  "t3",
+			Filter: "",
+		}},
+	}
+	pcs := &parallelCopyState{
+		uncopied: map[string]*pkRanges{
+			"t1": {
+				column: "c1",
+				ranges: []pkRange{
+					{start: sqltypes.NULL, end: sqltypes.NewInt64(3)},
+					{start: sqltypes.NewInt64(5), end: sqltypes.NewInt64(7)},
+					{start: sqltypes.NewInt64(9), end: sqltypes.NULL},
+				},
+			},
+		},
+	}
+	plan, err := buildReplicatorPlan(input, tableKeys, nil, pcs)
+	assert.NoError(t, err)
+
+	// The inserts of a normal table overwrite the rows that were already
+	// copied, and the events of the uncopied ranges are excluded.
+	t1 := plan.TablePlans["t1"]
+	assert.True(t, t1.Upsert)
+	assert.Equal(t, "insert into t1(c1,c2) select :a_c1, :a_c2 from dual where :a_c1>3 and (:a_c1<=5 or :a_c1>7) and :a_c1<=9 on duplicate key update c2=values(c2)", t1.Insert.Query)
+	assert.Equal(t, "update t1 set c2=:a_c2 where c1=:b_c1 and :b_c1>3 and (:b_c1<=5 or :b_c1>7) and :b_c1<=9", t1.Update.Query)
+	assert.Equal(t, "delete from t1 where c1=:b_c1 and :b_c1>3 and (:b_c1<=5 or :b_c1>7) and :b_c1<=9", t1.Delete.Query)
+	assert.Equal(t, " on duplicate key update c2=values(c2)", t1.BulkInsertOnDup.Query)
+
+	// Aggregations can't be replayed idempotently.
+	t2 := plan.TablePlans["t2"]
+	assert.False(t, t2.Upsert)
+	assert.Equal(t, "insert into t2(c1,c2) values (:a_c1,1) on duplicate key update c2=c2+1", t2.Insert.Query)
+
+	// The upsert of a select * is built from the fields.
+	t3, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "t3",
+		Fields:    sqltypes.MakeTestFields("c1|c2", "int64|varchar"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "insert into t3(c1,c2) values (:a_c1,:a_c2) on duplicate key update c2=values(c2)", t3.Insert.Query)
+
+	// A table whose rows are all uncopied is not replicated.
+	pcs.uncopied["t3"] = &pkRanges{
+		column: "c1",
+		ranges: []pkRange{{start: sqltypes.NULL, end: sqltypes.NULL}},
+	}
+	plan, err = buildReplicatorPlan(input, tableKeys, nil, pcs)
+	assert.NoError(t, err)
+	assert.Nil(t, plan.TablePlans["t3"])
+
+	// If all the columns are in the primary key, the rows are inserted with ignore.
+	plan, err = buildReplicatorPlan(&binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1 from t1",
+		}},
+	}, tableKeys, nil, &parallelCopyState{})
+	assert.NoError(t, err)
+	assert.Equal(t, "insert ignore into t1(c1) values (:a_c1)", plan.TablePlans["t1"].Insert.Query)
+}
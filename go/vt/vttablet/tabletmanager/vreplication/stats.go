@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/xsec-lab/go/stats"
+	"github.com/xsec-lab/go/vt/binlog/binlogplayer"
 	"github.com/xsec-lab/go/vt/servenv"
 )
 
@@ -100,6 +102,49 @@ func (st *vrStats) register() {
 			return result
 		})
 
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationCopyRowsEstimated",
+		"vreplication estimated rows to copy per stream and table",
+		[]string{"stream", "table"},
+		func() map[string]int64 {
+			return st.copyProgressGauge(func(cp binlogplayer.CopyProgress) int64 { return cp.RowsEstimated })
+		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationCopyRowsCopied",
+		"vreplication rows copied per stream and table",
+		[]string{"stream", "table"},
+		func() map[string]int64 {
+			return st.copyProgressGauge(func(cp binlogplayer.CopyProgress) int64 { return cp.RowsCopied })
+		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationCopyRowsPerSecond",
+		"vreplication rows copied per second per stream and table",
+		[]string{"stream", "table"},
+		func() map[string]int64 {
+			return st.copyProgressGauge(func(cp binlogplayer.CopyProgress) int64 { return int64(cp.Rate()) })
+		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationCopyETASeconds",
+		"vreplication estimated seconds to complete the copy per stream",
+		[]string{"stream"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				progress := ct.blpStats.CopyProgress()
+				if len(progress) == 0 {
+					continue
+				}
+				tables := make([]binlogplayer.CopyProgress, 0, len(progress))
+				for _, cp := range progress {
+					tables = append(tables, cp)
+				}
+				result[fmt.Sprintf("%v", ct.id)] = int64(binlogplayer.CopyETA(tables) / time.Second)
+			}
+			return result
+		})
+
 	stats.Publish("VReplicationSource", stats.StringMapFunc(func() map[string]string {
 		st.mu.Lock()
 		defer st.mu.Unlock()
@@ -120,6 +165,20 @@ func (st *vrStats) register() {
 	}))
 }
 
+// copyProgressGauge returns the value of the copy progress
+// of every table being copied, keyed by stream and table.
+func (st *vrStats) copyProgressGauge(value func(binlogplayer.CopyProgress) int64) map[string]int64 {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	result := make(map[string]int64)
+	for _, ct := range st.controllers {
+		for table, cp := range ct.blpStats.CopyProgress() {
+			result[fmt.Sprintf("%v.%v", ct.id, table)] = value(cp)
+		}
+	}
+	return result
+}
+
 func (st *vrStats) numControllers() int64 {
 	st.mu.Lock()
 	defer st.mu.Unlock()
@@ -159,6 +218,7 @@ func (st *vrStats) status() *EngineStatus {
 			State:               ct.blpStats.State.Get(),
 			SourceTablet:        ct.sourceTablet.Get(),
 			Messages:            ct.blpStats.MessageHistory(),
+			CopyProgress:        ct.blpStats.CopyProgress(),
 		}
 		i++
 	}
@@ -185,6 +245,7 @@ type ControllerStatus struct {
 	State               string
 	SourceTablet        string
 	Messages            []string
+	CopyProgress        map[string]binlogplayer.CopyProgress
 }
 
 var vreplicationTemplate = `
@@ -200,6 +261,7 @@ var vreplicationTemplate = `
     <th>Seconds Behind Master</th>
     <th>Counts</th>
     <th>Rates</th>
+    <th>Copy Progress</th>
     <th>Last Message</th>
   </tr>
   {{range .Controllers}}<tr>
@@ -212,6 +274,7 @@ var vreplicationTemplate = `
       <td>{{.SecondsBehindMaster}}</td>
       <td>{{range $key, $value := .Counts}}<b>{{$key}}</b>: {{$value}}<br>{{end}}</td>
       <td>{{range $key, $values := .Rates}}<b>{{$key}}</b>: {{range $values}}{{.}} {{end}}<br>{{end}}</td>
+      <td>{{range $key, $value := .CopyProgress}}<b>{{$key}}</b>: {{$value}}<br>{{end}}</td>
       <td>{{range $index, $value := .Messages}}{{$value}}<br>{{end}}</td>
     </tr>{{end}}
 <div id="vreplication_qps_chart">QPS All Streams </div>
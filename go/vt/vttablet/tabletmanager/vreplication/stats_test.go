@@ -41,6 +41,7 @@ VReplication state: Open</br>
     <th>Seconds Behind Master</th>
     <th>Counts</th>
     <th>Rates</th>
+    <th>Copy Progress</th>
     <th>Last Message</th>
   </tr>
   <tr>
@@ -53,6 +54,7 @@ VReplication state: Open</br>
       <td>2</td>
       <td><b>All</b>: 0<br></td>
       <td></td>
+      <td><b>t1</b>: 50/200 rows (25.0%), 5 rows/s, ETA 30s<br></td>
       <td>Test Message2<br>Test Message1<br></td>
     </tr><tr>
       <td>2</td>
@@ -64,6 +66,7 @@ VReplication state: Open</br>
       <td>2</td>
       <td><b>All</b>: 0<br></td>
       <td></td>
+      <td><b>t1</b>: 50/200 rows (25.0%), 5 rows/s, ETA 30s<br></td>
       <td>Test Message2<br>Test Message1<br></td>
     </tr>
 </table>
@@ -80,6 +83,12 @@ func TestStatusHtml(t *testing.T) {
 	blpStats.SecondsBehindMaster.Set(2)
 	blpStats.History.Add(&binlogplayer.StatsHistoryRecord{Time: time.Now(), Message: "Test Message1"})
 	blpStats.History.Add(&binlogplayer.StatsHistoryRecord{Time: time.Now(), Message: "Test Message2"})
+	blpStats.UpdateCopyProgress("t1", binlogplayer.CopyProgress{
+		RowsEstimated: 200,
+		RowsCopied:    50,
+		TimeStarted:   1000,
+		TimeUpdated:   1010,
+	})
 
 	testStats := &vrStats{}
 	testStats.isOpen = true
@@ -48,6 +48,10 @@ type tablePlanBuilder struct {
 	onInsert   insertType
 	pkCols     []*colExpr
 	lastpk     *sqltypes.Result
+	// upsert and uncopied are set while tables are
+	// copied in parallel. See parallelCopyState.
+	upsert   bool
+	uncopied *pkRanges
 }
 
 // colExpr describes the processing to be performed to
@@ -111,10 +115,13 @@ const (
 // that was copied.  If so, only replication events < lastpk are applied.
 // If the entry is nil, then copying of the table has not started yet. If so,
 // no events are applied.
+// pcs is set if tables are copied in parallel. If so, the events that fall
+// within the uncopied ranges of the chunked tables are not applied, and the
+// statements are made idempotent.
 // The TablePlan built is a partial plan. The full plan for a table is built
 // when we receive field information from events or rows sent by the source.
 // buildExecutionPlan is the function that builds the full plan.
-func buildReplicatorPlan(filter *binlogdatapb.Filter, tableKeys map[string][]string, copyState map[string]*sqltypes.Result) (*ReplicatorPlan, error) {
+func buildReplicatorPlan(filter *binlogdatapb.Filter, tableKeys map[string][]string, copyState map[string]*sqltypes.Result, pcs *parallelCopyState) (*ReplicatorPlan, error) {
 	plan := &ReplicatorPlan{
 		VStreamFilter: &binlogdatapb.Filter{FieldEventMode: filter.FieldEventMode},
 		TargetTables:  make(map[string]*TablePlan),
@@ -127,6 +134,10 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, tableKeys map[string][]str
 			// Don't replicate uncopied tables.
 			continue
 		}
+		uncopied := pcs.uncopiedRanges(tableName)
+		if uncopied.all() {
+			continue
+		}
 		rule, err := MatchTable(tableName, filter)
 		if err != nil {
 			return nil, err
@@ -134,7 +145,7 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, tableKeys map[string][]str
 		if rule == nil {
 			continue
 		}
-		tablePlan, err := buildTablePlan(tableName, rule.Filter, tableKeys, lastpk)
+		tablePlan, err := buildTablePlan(tableName, rule.Filter, tableKeys, lastpk, pcs != nil, uncopied)
 		if err != nil {
 			return nil, err
 		}
@@ -173,7 +184,7 @@ func MatchTable(tableName string, filter *binlogdatapb.Filter) (*binlogdatapb.Ru
 	return nil, nil
 }
 
-func buildTablePlan(tableName, filter string, tableKeys map[string][]string, lastpk *sqltypes.Result) (*TablePlan, error) {
+func buildTablePlan(tableName, filter string, tableKeys map[string][]string, lastpk *sqltypes.Result, upsert bool, uncopied *pkRanges) (*TablePlan, error) {
 	query := filter
 	// generate equivalent select statement if filter is empty or a keyrange.
 	switch {
@@ -210,6 +221,8 @@ func buildTablePlan(tableName, filter string, tableKeys map[string][]string, las
 			TargetName: tableName,
 			SendRule:   sendRule,
 			Lastpk:     lastpk,
+			Upsert:     upsert,
+			Uncopied:   uncopied,
 		}
 		return tablePlan, nil
 	}
@@ -222,6 +235,8 @@ func buildTablePlan(tableName, filter string, tableKeys map[string][]string, las
 		},
 		selColumns: make(map[string]bool),
 		lastpk:     lastpk,
+		upsert:     upsert,
+		uncopied:   uncopied,
 	}
 
 	if err := tpb.analyzeExprs(sel.SelectExprs); err != nil {
@@ -239,9 +254,18 @@ func buildTablePlan(tableName, filter string, tableKeys map[string][]string, las
 			tpb.addCol(sqlparser.NewColIdent(f.Name))
 		}
 	}
+	if tpb.uncopied != nil {
+		tpb.addCol(sqlparser.NewColIdent(tpb.uncopied.column))
+	}
 	if err := tpb.analyzeGroupBy(sel.GroupBy); err != nil {
 		return nil, err
 	}
+	// Replaying the events of aggregations would count
+	// them twice. So, their statements can't be made
+	// idempotent. The vcopier copies them one at a time.
+	if tpb.onInsert != insertNormal {
+		tpb.upsert = false
+	}
 	if err := tpb.analyzePK(tableKeys); err != nil {
 		return nil, err
 	}
@@ -264,6 +288,9 @@ func (tpb *tablePlanBuilder) generate(tableKeys map[string][]string) *TablePlan
 			refmap[f.Name] = true
 		}
 	}
+	if tpb.uncopied != nil {
+		refmap[tpb.uncopied.column] = true
+	}
 	pkrefs := make([]string, 0, len(refmap))
 	for k := range refmap {
 		pkrefs = append(pkrefs, k)
@@ -275,6 +302,8 @@ func (tpb *tablePlanBuilder) generate(tableKeys map[string][]string) *TablePlan
 	return &TablePlan{
 		TargetName:       tpb.name.String(),
 		Lastpk:           tpb.lastpk,
+		Upsert:           tpb.upsert,
+		Uncopied:         tpb.uncopied,
 		BulkInsertFront:  tpb.generateInsertPart(sqlparser.NewTrackedBuffer(bvf.formatter)),
 		BulkInsertValues: tpb.generateValuesPart(sqlparser.NewTrackedBuffer(bvf.formatter), bvf),
 		BulkInsertOnDup:  tpb.generateOnDupPart(sqlparser.NewTrackedBuffer(bvf.formatter)),
@@ -483,7 +512,7 @@ func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
 
 	tpb.generateInsertPart(buf)
-	if tpb.lastpk == nil {
+	if !tpb.hasCopyConstraint() {
 		// If there's no lastpk, generate straight values.
 		buf.Myprintf(" values ", tpb.name)
 		tpb.generateValuesPart(buf, bvf)
@@ -498,7 +527,7 @@ func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 }
 
 func (tpb *tablePlanBuilder) generateInsertPart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
-	if tpb.onInsert == insertIgnore {
+	if tpb.onInsert == insertIgnore || (tpb.upsert && !tpb.hasNonPKCols()) {
 		buf.Myprintf("insert ignore into %v(", tpb.name)
 	} else {
 		buf.Myprintf("insert into %v(", tpb.name)
@@ -549,12 +578,15 @@ func (tpb *tablePlanBuilder) generateSelectPart(buf *sqlparser.TrackedBuffer, bv
 		}
 	}
 	buf.WriteString(" from dual where ")
-	tpb.generatePKConstraint(buf, bvf)
+	tpb.generateCopyConstraint(buf, bvf)
 	return buf.ParsedQuery()
 }
 
 func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
-	if tpb.onInsert != insertOnDup {
+	// An upsert overwrites the row if it was already copied.
+	// Otherwise, it's an insertNormal that's generated with
+	// values(col) for all the non-pk columns.
+	if tpb.onInsert != insertOnDup && !(tpb.upsert && tpb.hasNonPKCols()) {
 		return nil
 	}
 	buf.Myprintf(" on duplicate key update ")
@@ -663,10 +695,59 @@ func (tpb *tablePlanBuilder) generateWhere(buf *sqlparser.TrackedBuffer, bvf *bi
 		}
 		separator = " and "
 	}
-	if tpb.lastpk != nil {
+	if tpb.hasCopyConstraint() {
 		buf.WriteString(" and ")
+		tpb.generateCopyConstraint(buf, bvf)
+	}
+}
+
+// hasCopyConstraint returns true if the events must be restricted
+// to the rows that were copied.
+func (tpb *tablePlanBuilder) hasCopyConstraint() bool {
+	return tpb.lastpk != nil || (tpb.uncopied != nil && len(tpb.uncopied.ranges) != 0)
+}
+
+// generateCopyConstraint generates the conditions that exclude the rows
+// beyond the lastpk, and the rows within the uncopied ranges.
+func (tpb *tablePlanBuilder) generateCopyConstraint(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter) {
+	separator := ""
+	if tpb.lastpk != nil {
 		tpb.generatePKConstraint(buf, bvf)
+		separator = " and "
+	}
+	if tpb.uncopied == nil {
+		return
+	}
+	col := &sqlparser.ColName{Name: sqlparser.NewColIdent(tpb.uncopied.column)}
+	for _, r := range tpb.uncopied.ranges {
+		buf.WriteString(separator)
+		separator = " and "
+		switch {
+		case r.start.IsNull():
+			buf.Myprintf("%v>", col)
+			r.end.EncodeSQL(buf)
+		case r.end.IsNull():
+			buf.Myprintf("%v<=", col)
+			r.start.EncodeSQL(buf)
+		default:
+			buf.Myprintf("(%v<=", col)
+			r.start.EncodeSQL(buf)
+			buf.Myprintf(" or %v>", col)
+			r.end.EncodeSQL(buf)
+			buf.WriteString(")")
+		}
+	}
+}
+
+// hasNonPKCols returns true if some of the columns
+// are not part of the primary key.
+func (tpb *tablePlanBuilder) hasNonPKCols() bool {
+	for _, cexpr := range tpb.colExprs {
+		if !cexpr.isPK {
+			return true
+		}
 	}
+	return false
 }
 
 func (tpb *tablePlanBuilder) generatePKConstraint(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter) {
@@ -18,8 +18,10 @@ package vreplication
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -30,6 +32,7 @@ import (
 	"github.com/xsec-lab/go/mysql"
 	"github.com/xsec-lab/go/sqltypes"
 	"github.com/xsec-lab/go/vt/binlog/binlogplayer"
+	"github.com/xsec-lab/go/vt/key"
 	"github.com/xsec-lab/go/vt/log"
 	"github.com/xsec-lab/go/vt/sqlparser"
 
@@ -49,13 +52,17 @@ func newVCopier(vr *vreplicator) *vcopier {
 }
 
 // initTablesForCopy (phase 1) identifies the list of tables to be copied and inserts
-// them into copy_state. If there are no tables to copy, it explicitly stops
+// them into copy_state, along with their estimated size. If there are no tables to copy, it explicitly stops
 // the stream. Otherwise, the copy phase (phase 2) may think that all tables are copied.
 // This will cause us to go into the replication phase (phase 3) without a starting position.
 func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 	defer vc.vr.dbClient.Rollback()
 
-	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.tableKeys, nil)
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.tableKeys, nil, nil)
+	if err != nil {
+		return err
+	}
+	concurrency, err := vc.copyConcurrency()
 	if err != nil {
 		return err
 	}
@@ -64,14 +71,26 @@ func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 	}
 	// Insert the table list only if at least one table matches.
 	if len(plan.TargetTables) != 0 {
-		var buf strings.Builder
-		buf.WriteString("insert into _vt.copy_state(vrepl_id, table_name) values ")
-		prefix := ""
-		for name := range plan.TargetTables {
-			fmt.Fprintf(&buf, "%s(%d, %s)", prefix, vc.vr.id, encodeString(name))
-			prefix = ", "
+		sizes := vc.tableSizes(ctx)
+		var query string
+		if concurrency > 1 {
+			// The large tables are split into chunks
+			// that can be copied in parallel.
+			if query, err = vc.insertChunks(ctx, plan, sizes); err != nil {
+				return err
+			}
+		} else {
+			var buf strings.Builder
+			buf.WriteString("insert into _vt.copy_state(vrepl_id, table_name, rows_estimated, bytes_estimated) values ")
+			prefix := ""
+			for name, tablePlan := range plan.TargetTables {
+				size := vc.estimateSize(name, sizes[tablePlan.SendRule.Match])
+				fmt.Fprintf(&buf, "%s(%d, %s, %d, %d)", prefix, vc.vr.id, encodeString(name), size.Rows, size.Bytes)
+				prefix = ", "
+			}
+			query = buf.String()
 		}
-		if _, err := vc.vr.dbClient.Execute(buf.String()); err != nil {
+		if _, err := vc.vr.dbClient.Execute(query); err != nil {
 			return err
 		}
 		if err := vc.vr.setState(binlogplayer.VReplicationCopying, ""); err != nil {
@@ -85,6 +104,55 @@ func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 	return vc.vr.dbClient.Commit()
 }
 
+// tableSizes returns the estimated sizes of the source tables.
+// The estimates are only informational. So, a failure to fetch
+// them is logged and does not prevent the copy.
+func (vc *vcopier) tableSizes(ctx context.Context) map[string]TableSize {
+	if err := vc.vr.sourceVStreamer.Open(ctx); err != nil {
+		log.Warningf("Could not estimate table sizes: error opening vsclient: %v", err)
+		return nil
+	}
+	defer vc.vr.sourceVStreamer.Close(ctx)
+	sizes, err := vc.vr.sourceVStreamer.TableSizes(ctx)
+	if err != nil {
+		log.Warningf("Could not estimate table sizes: %v", err)
+		return nil
+	}
+	return sizes
+}
+
+// estimateSize returns the expected size of the copy of a table. If the
+// filter of the table is a keyrange, only the rows within the keyrange get
+// copied. Assuming that the keyspace ids are evenly distributed, the
+// estimate is proportional to the fraction of the keyspace it covers.
+func (vc *vcopier) estimateSize(tableName string, size TableSize) TableSize {
+	rule, err := MatchTable(tableName, vc.vr.source.Filter)
+	if err != nil || rule == nil || !key.IsKeyRange(rule.Filter) {
+		return size
+	}
+	krs, err := key.ParseShardingSpec(rule.Filter)
+	if err != nil || len(krs) != 1 {
+		return size
+	}
+	fraction := keyspaceIDFraction(krs[0].End, 1) - keyspaceIDFraction(krs[0].Start, 0)
+	return TableSize{
+		Rows:  int64(float64(size.Rows) * fraction),
+		Bytes: int64(float64(size.Bytes) * fraction),
+	}
+}
+
+// keyspaceIDFraction returns the position of a keyspace id within
+// the keyspace as a fraction. An empty keyspace id is unbounded,
+// and its position is specified by the caller.
+func keyspaceIDFraction(id []byte, unbounded float64) float64 {
+	if len(id) == 0 {
+		return unbounded
+	}
+	var buf [8]byte
+	copy(buf[:], id)
+	return float64(binary.BigEndian.Uint64(buf[:])) / math.Pow(2, 64)
+}
+
 // copyNext performs a multi-step process on each iteration.
 // Step 1: catchup: During this step, it replicates from the source from the last position.
 // This is a partial replication: events are applied only to tables or subsets of tables
@@ -102,41 +170,106 @@ func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 // copyNext also builds the copyState metadata that contains the tables and their last
 // primary key that was copied. A nil Result means that nothing has been copied.
 // A table that was fully copied is removed from copyState.
+// The copy progress of the remaining tables is published in the stats.
+// If the workflow copies tables in parallel, copyNextParallel is invoked instead.
 func (vc *vcopier) copyNext(ctx context.Context, settings binlogplayer.VRSettings) error {
-	qr, err := vc.vr.dbClient.Execute(fmt.Sprintf("select table_name, lastpk from _vt.copy_state where vrepl_id=%d", vc.vr.id))
+	items, err := vc.readCopyState()
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("unexpected: there are no tables to copy")
+	}
+	concurrency, err := vc.copyConcurrency()
 	if err != nil {
 		return err
 	}
-	var tableToCopy string
+	// Tables that were split into chunks can only be copied
+	// by copyNextParallel, even if the concurrency was lowered.
+	if concurrency > 1 || hasChunks(items) {
+		return vc.copyNextParallel(ctx, items, concurrency)
+	}
 	copyState := make(map[string]*sqltypes.Result)
+	progress := make(map[string]binlogplayer.CopyProgress)
+	for _, item := range items {
+		copyState[item.table] = item.lastpk
+		progress[item.table] = item.progress
+	}
+	vc.vr.stats.SetCopyProgress(progress)
+	if err := vc.catchup(ctx, copyState, nil); err != nil {
+		return err
+	}
+	return vc.copyTable(ctx, items[0].table, copyState)
+}
+
+// readCopyState reads the tables, or chunks of tables,
+// that remain to be copied from copy_state.
+func (vc *vcopier) readCopyState() ([]*copyItem, error) {
+	qr, err := vc.vr.dbClient.Execute(fmt.Sprintf("select table_name, lastpk, rows_estimated, bytes_estimated, rows_copied, bytes_copied, time_started, time_updated, chunk, chunk_end from _vt.copy_state where vrepl_id=%d", vc.vr.id))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*copyItem, 0, len(qr.Rows))
 	for _, row := range qr.Rows {
-		tableName := row[0].ToString()
-		lastpk := row[1].ToString()
-		if tableToCopy == "" {
-			tableToCopy = tableName
+		item := &copyItem{
+			table: row[0].ToString(),
 		}
-		copyState[tableName] = nil
-		if lastpk != "" {
-			var r querypb.QueryResult
-			if err := proto.UnmarshalText(lastpk, &r); err != nil {
-				return err
+		if item.lastpk, err = decodePK(row[1].ToString()); err != nil {
+			return nil, err
+		}
+		var values [7]int64
+		for i := range values {
+			if values[i], err = sqltypes.ToInt64(row[i+2]); err != nil {
+				return nil, err
 			}
-			copyState[tableName] = sqltypes.Proto3ToResult(&r)
 		}
+		item.progress = binlogplayer.CopyProgress{
+			RowsEstimated:  values[0],
+			BytesEstimated: values[1],
+			RowsCopied:     values[2],
+			BytesCopied:    values[3],
+			TimeStarted:    values[4],
+			TimeUpdated:    values[5],
+		}
+		item.chunk = values[6]
+		if item.end, err = decodePK(row[9].ToString()); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
 	}
-	if len(copyState) == 0 {
-		return fmt.Errorf("unexpected: there are no tables to copy")
+	return items, nil
+}
+
+// decodePK decodes a primary key value that was saved in copy_state.
+// It returns nil if there is no value.
+func decodePK(text string) (*sqltypes.Result, error) {
+	if text == "" {
+		return nil, nil
 	}
-	if err := vc.catchup(ctx, copyState); err != nil {
-		return err
+	var r querypb.QueryResult
+	if err := proto.UnmarshalText(text, &r); err != nil {
+		return nil, err
 	}
-	return vc.copyTable(ctx, tableToCopy, copyState)
+	return sqltypes.Proto3ToResult(&r), nil
+}
+
+// encodePK encodes a primary key value to be saved in copy_state.
+func encodePK(fields []*querypb.Field, row *querypb.Row) ([]byte, error) {
+	var buf bytes.Buffer
+	err := proto.CompactText(&buf, &querypb.QueryResult{
+		Fields: fields,
+		Rows:   []*querypb.Row{row},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
 }
 
 // catchup replays events to the subset of the tables that have been copied
 // until replication is caught up. In order to stop, the seconds behind master has
 // to fall below replicationLagTolerance.
-func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.Result) error {
+func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.Result, pcs *parallelCopyState) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -153,7 +286,7 @@ func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.R
 	// Start vreplication.
 	errch := make(chan error, 1)
 	go func() {
-		errch <- newVPlayer(vc.vr, settings, copyState, mysql.Position{}).play(ctx)
+		errch <- newVPlayer(vc.vr, settings, copyState, pcs, mysql.Position{}).play(ctx)
 	}()
 
 	// Wait for catchup.
@@ -191,7 +324,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 
 	log.Infof("Copying table %s, lastpk: %v", tableName, copyState[tableName])
 
-	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.tableKeys, nil)
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.tableKeys, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -215,6 +348,11 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 		lastpkpb = sqltypes.ResultToProto3(lastpkqr)
 	}
 
+	progress := vc.vr.stats.CopyProgress()[tableName]
+	if progress.TimeStarted == 0 {
+		progress.TimeStarted = time.Now().Unix()
+	}
+
 	var pkfields []*querypb.Field
 	var updateCopyState *sqlparser.ParsedQuery
 	var bv map[string]*querypb.BindVariable
@@ -228,7 +366,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			if len(rows.Fields) == 0 {
 				return fmt.Errorf("expecting field event first, got: %v", rows)
 			}
-			if err := vc.fastForward(ctx, copyState, rows.Gtid); err != nil {
+			if err := vc.fastForward(ctx, copyState, nil, rows.Gtid); err != nil {
 				return err
 			}
 			fieldEvent := &binlogdatapb.FieldEvent{
@@ -241,7 +379,8 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			}
 			pkfields = rows.Pkfields
 			buf := sqlparser.NewTrackedBuffer(nil)
-			buf.Myprintf("update _vt.copy_state set lastpk=%a where vrepl_id=%s and table_name=%s", ":lastpk", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+			buf.Myprintf("update _vt.copy_state set lastpk=%a, rows_copied=%a, bytes_copied=%a, time_started=%a, time_updated=%a where vrepl_id=%s and table_name=%s",
+				":lastpk", ":rows_copied", ":bytes_copied", ":time_started", ":time_updated", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
 			updateCopyState = buf.ParsedQuery()
 		}
 		if len(rows.Rows) == 0 {
@@ -263,19 +402,25 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			return err
 		}
 
-		var buf bytes.Buffer
-		err = proto.CompactText(&buf, &querypb.QueryResult{
-			Fields: pkfields,
-			Rows:   []*querypb.Row{rows.Lastpk},
-		})
+		lastpk, err := encodePK(pkfields, rows.Lastpk)
 		if err != nil {
 			return err
 		}
+		newProgress := progress
+		newProgress.RowsCopied += int64(len(rows.Rows))
+		for _, row := range rows.Rows {
+			newProgress.BytesCopied += int64(len(row.Values))
+		}
+		newProgress.TimeUpdated = time.Now().Unix()
 		bv = map[string]*querypb.BindVariable{
 			"lastpk": {
 				Type:  sqltypes.VarBinary,
-				Value: buf.Bytes(),
+				Value: lastpk,
 			},
+			"rows_copied":  sqltypes.Int64BindVariable(newProgress.RowsCopied),
+			"bytes_copied": sqltypes.Int64BindVariable(newProgress.BytesCopied),
+			"time_started": sqltypes.Int64BindVariable(newProgress.TimeStarted),
+			"time_updated": sqltypes.Int64BindVariable(newProgress.TimeUpdated),
 		}
 		updateState, err := updateCopyState.GenerateQuery(bv, nil)
 		if err != nil {
@@ -288,6 +433,8 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 		if err := vc.vr.dbClient.Commit(); err != nil {
 			return err
 		}
+		progress = newProgress
+		vc.vr.stats.UpdateCopyProgress(tableName, progress)
 		return nil
 	})
 	// If there was a timeout, return without an error.
@@ -306,10 +453,11 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	if _, err := vc.vr.dbClient.Execute(buf.String()); err != nil {
 		return err
 	}
+	vc.vr.stats.DeleteCopyProgress(tableName)
 	return nil
 }
 
-func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltypes.Result, gtid string) error {
+func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltypes.Result, pcs *parallelCopyState, gtid string) error {
 	pos, err := mysql.DecodePosition(gtid)
 	if err != nil {
 		return err
@@ -323,5 +471,5 @@ func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltyp
 		_, err := vc.vr.dbClient.Execute(update)
 		return err
 	}
-	return newVPlayer(vc.vr, settings, copyState, pos).play(ctx)
+	return newVPlayer(vc.vr, settings, copyState, pcs, pos).play(ctx)
 }
@@ -0,0 +1,810 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/mysql"
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/binlog/binlogplayer"
+	"github.com/xsec-lab/go/vt/log"
+	"github.com/xsec-lab/go/vt/sqlparser"
+
+	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+var copyChunkRows = flag.Int64("vreplication_copy_chunk_rows", 1000000, "tables that are copied in parallel are split into chunks of about this many rows")
+
+// maxCopyChunks is the maximum number of chunks a table is split into.
+const maxCopyChunks = 256
+
+// copyItem is a table, or a chunk of a table, that remains to be copied.
+// A table is split into chunks by ranges of its primary key. The chunks
+// are numbered from 0, and the range of a chunk goes from its initial
+// lastpk (excluded) to its end (included).
+type copyItem struct {
+	table string
+	chunk int64
+	// lastpk is nil if nothing was copied yet.
+	lastpk *sqltypes.Result
+	// end is nil for the tables that are not chunked,
+	// and for the last chunk of a table.
+	end      *sqltypes.Result
+	progress binlogplayer.CopyProgress
+}
+
+// chunked returns true if the item is a chunk of a table.
+func (item *copyItem) chunked() bool {
+	return item.chunk != 0 || item.end != nil
+}
+
+func hasChunks(items []*copyItem) bool {
+	for _, item := range items {
+		if item.chunked() {
+			return true
+		}
+	}
+	return false
+}
+
+// parallelCopyState is used by buildReplicatorPlan while tables are copied
+// in parallel. The rows copied by each stream are a snapshot as of its own
+// GTID, which may be ahead of the position of vreplication. So, the events
+// applied after the copy may already be contained in the copied rows. For
+// this reason, the generated inserts overwrite the existing rows, which
+// makes replaying events idempotent.
+// The chunks of a table are copied independently. So, the rows that were
+// copied can't be described by a single lastpk, like in copyState. Instead,
+// the ranges that remain to be copied are listed in uncopied.
+type parallelCopyState struct {
+	uncopied map[string]*pkRanges
+}
+
+// pkRanges is a list of ranges of a single column primary key.
+type pkRanges struct {
+	column string
+	ranges []pkRange
+}
+
+// pkRange is a range of primary key values that excludes start and
+// includes end. A null start or end leaves the range unbounded.
+type pkRange struct {
+	start, end sqltypes.Value
+}
+
+// uncopiedRanges returns the ranges of the table that are not copied yet.
+func (pcs *parallelCopyState) uncopiedRanges(tableName string) *pkRanges {
+	if pcs == nil {
+		return nil
+	}
+	return pcs.uncopied[tableName]
+}
+
+// all returns true if none of the rows are copied yet.
+func (pkr *pkRanges) all() bool {
+	if pkr == nil {
+		return false
+	}
+	for _, r := range pkr.ranges {
+		if r.start.IsNull() && r.end.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// buildParallelCopyState builds the copyState of the tables that are not
+// chunked, and the parallelCopyState of the ones that are.
+func buildParallelCopyState(items []*copyItem) (map[string]*sqltypes.Result, *parallelCopyState) {
+	copyState := make(map[string]*sqltypes.Result)
+	pcs := &parallelCopyState{
+		uncopied: make(map[string]*pkRanges),
+	}
+	for _, item := range items {
+		if !item.chunked() {
+			copyState[item.table] = item.lastpk
+			continue
+		}
+		r := pkRange{start: sqltypes.NULL, end: sqltypes.NULL}
+		var column string
+		if item.lastpk != nil {
+			column = item.lastpk.Fields[0].Name
+			r.start = item.lastpk.Rows[0][0]
+		}
+		if item.end != nil {
+			column = item.end.Fields[0].Name
+			r.end = item.end.Rows[0][0]
+		}
+		pkr, ok := pcs.uncopied[item.table]
+		if !ok {
+			pkr = &pkRanges{column: column}
+			pcs.uncopied[item.table] = pkr
+		}
+		pkr.ranges = append(pkr.ranges, r)
+	}
+	return copyState, pcs
+}
+
+// copyConcurrency returns the number of tables, or chunks of tables, that
+// are copied in parallel. The events of aggregations can't be replayed
+// idempotently. So, workflows that have any are copied one table at a time.
+func (vc *vcopier) copyConcurrency() (int, error) {
+	concurrency := vc.vr.source.CopyConcurrency
+	if concurrency < 2 {
+		return 1, nil
+	}
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.tableKeys, nil, &parallelCopyState{})
+	if err != nil {
+		return 0, err
+	}
+	for name, tablePlan := range plan.TargetTables {
+		if !tablePlan.Upsert {
+			log.Infof("Copying tables one at a time because %s is an aggregation", name)
+			return 1, nil
+		}
+	}
+	return int(concurrency), nil
+}
+
+// insertChunks generates the statement that inserts the tables to copy
+// into copy_state. The large tables are split into chunks.
+func (vc *vcopier) insertChunks(ctx context.Context, plan *ReplicatorPlan, sizes map[string]TableSize) (string, error) {
+	if err := vc.vr.sourceVStreamer.Open(ctx); err != nil {
+		return "", fmt.Errorf("error opening vsclient: %v", err)
+	}
+	defer vc.vr.sourceVStreamer.Close(ctx)
+
+	var buf strings.Builder
+	buf.WriteString("insert into _vt.copy_state(vrepl_id, table_name, lastpk, rows_estimated, bytes_estimated, chunk, chunk_end) values ")
+	prefix := ""
+	for name, tablePlan := range plan.TargetTables {
+		size := vc.estimateSize(name, sizes[tablePlan.SendRule.Match])
+		for _, item := range vc.splitTable(ctx, name, tablePlan, size) {
+			lastpk, end := "null", "null"
+			if item.lastpk != nil {
+				text, err := encodePK(item.lastpk.Fields, sqltypes.RowToProto3(item.lastpk.Rows[0]))
+				if err != nil {
+					return "", err
+				}
+				lastpk = encodeString(string(text))
+			}
+			if item.end != nil {
+				text, err := encodePK(item.end.Fields, sqltypes.RowToProto3(item.end.Rows[0]))
+				if err != nil {
+					return "", err
+				}
+				end = encodeString(string(text))
+			}
+			fmt.Fprintf(&buf, "%s(%d, %s, %s, %d, %d, %d, %s)", prefix, vc.vr.id, encodeString(name), lastpk, item.progress.RowsEstimated, item.progress.BytesEstimated, item.chunk, end)
+			prefix = ", "
+		}
+	}
+	return buf.String(), nil
+}
+
+// splitTable splits a table into chunks of about copyChunkRows rows. The
+// chunks are ranges of equal length between the smallest and the largest
+// values of the primary key, which must be a single integral column. The
+// table can't be split if the rows are transformed, because the primary
+// key may not be streamed as is. Like the sizes, the split is best effort:
+// a table that can't be split is copied in one piece.
+func (vc *vcopier) splitTable(ctx context.Context, tableName string, tablePlan *TablePlan, size TableSize) []*copyItem {
+	whole := []*copyItem{{
+		table:    tableName,
+		progress: binlogplayer.CopyProgress{RowsEstimated: size.Rows, BytesEstimated: size.Bytes},
+	}}
+	pkcols := vc.vr.tableKeys[tableName]
+	if tablePlan.Insert != nil || len(pkcols) != 1 || *copyChunkRows <= 0 {
+		return whole
+	}
+	n := (size.Rows + *copyChunkRows - 1) / *copyChunkRows
+	if n > maxCopyChunks {
+		n = maxCopyChunks
+	}
+	if n < 2 {
+		return whole
+	}
+	min, max, err := vc.vr.sourceVStreamer.PKBounds(ctx, tablePlan.SendRule.Match, pkcols[0])
+	if err != nil {
+		log.Warningf("Could not split table %s: %v", tableName, err)
+		return whole
+	}
+	bounds, err := chunkBounds(min, max, n)
+	if err != nil {
+		log.Warningf("Could not split table %s: %v", tableName, err)
+		return whole
+	}
+	if len(bounds) == 0 {
+		return whole
+	}
+	fields := []*querypb.Field{{Name: pkcols[0], Type: min.Type()}}
+	n = int64(len(bounds) + 1)
+	items := make([]*copyItem, 0, n)
+	for i := int64(0); i < n; i++ {
+		item := &copyItem{
+			table: tableName,
+			chunk: i,
+			progress: binlogplayer.CopyProgress{
+				RowsEstimated:  size.Rows / n,
+				BytesEstimated: size.Bytes / n,
+			},
+		}
+		if i != 0 {
+			item.lastpk = &sqltypes.Result{Fields: fields, Rows: [][]sqltypes.Value{{bounds[i-1]}}}
+		}
+		if i != n-1 {
+			item.end = &sqltypes.Result{Fields: fields, Rows: [][]sqltypes.Value{{bounds[i]}}}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// chunkBounds returns the values that split the range from min to max
+// into n ranges of equal length. There can be fewer ranges if there
+// are not enough values in between.
+func chunkBounds(min, max sqltypes.Value, n int64) ([]sqltypes.Value, error) {
+	if !min.IsIntegral() || !max.IsIntegral() {
+		return nil, fmt.Errorf("primary key is not an integer: %v, %v", min, max)
+	}
+	var lo, hi uint64
+	if min.IsSigned() {
+		l, err := sqltypes.ToInt64(min)
+		if err != nil {
+			return nil, err
+		}
+		h, err := sqltypes.ToInt64(max)
+		if err != nil {
+			return nil, err
+		}
+		if h < l {
+			return nil, nil
+		}
+		// The difference of the unsigned values
+		// is the length of the range.
+		lo, hi = uint64(l), uint64(h)
+	} else {
+		var err error
+		if lo, err = sqltypes.ToUint64(min); err != nil {
+			return nil, err
+		}
+		if hi, err = sqltypes.ToUint64(max); err != nil {
+			return nil, err
+		}
+		if hi < lo {
+			return nil, nil
+		}
+	}
+	step := (hi - lo) / uint64(n)
+	if step == 0 {
+		step = 1
+	}
+	var bounds []sqltypes.Value
+	for bound := lo + step; bound-lo < hi-lo && int64(len(bounds)) < n-1; bound += step {
+		var val []byte
+		if min.IsSigned() {
+			val = strconv.AppendInt(nil, int64(bound), 10)
+		} else {
+			val = strconv.AppendUint(nil, bound, 10)
+		}
+		bounds = append(bounds, sqltypes.MakeTrusted(min.Type(), val))
+	}
+	return bounds, nil
+}
+
+// copyNextParallel copies the items of copy_state, up to concurrency at a
+// time. It's the counterpart of copyNext. The items are taken from a queue:
+// as soon as an item is done, its slot takes the next one. It performs the
+// following steps:
+// Step 1: catchup, like copyNext.
+// Step 2: Start streaming the next item. The stream returns the GTID as of
+// which its snapshot is being streamed.
+// Step 3: copy the rows of the item, if its GTID is at or after the position
+// of vreplication. The rows already contain the events in between. If the
+// GTID is behind the position, the stream is restarted to get a new snapshot.
+// Steps 2 and 3 are repeated until all the items are copied, or the copy
+// times out.
+// Step 4: fastForward to the largest GTID. This applies the events to the rows
+// copied so far, including the ones they already contain. This is safe because
+// the statements are idempotent. See parallelCopyState.
+// Step 5: remove the items that were completely copied from copy_state. This
+// is done after step 4 because the events of a table that is not in copy_state
+// are never excluded.
+// Replaying events is not idempotent for the tables that have a unique key
+// other than the primary key: an old version of a row may take the key of
+// another row. So, like copyTable does, the items of these tables are copied
+// only after vreplication is fast-forwarded to their GTID, which requires that
+// no other item is being copied. This is also done for the first item if
+// vreplication has no position yet.
+func (vc *vcopier) copyNextParallel(ctx context.Context, items []*copyItem, concurrency int) error {
+	progress := &copyProgress{stats: vc.vr.stats, items: items}
+	progress.publish()
+
+	copyState, pcs := buildParallelCopyState(items)
+	if err := vc.catchup(ctx, copyState, pcs); err != nil {
+		return err
+	}
+	settings, err := binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id)
+	if err != nil {
+		return err
+	}
+
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.tableKeys, nil, &parallelCopyState{})
+	if err != nil {
+		return err
+	}
+
+	if err := vc.vr.sourceVStreamer.Open(ctx); err != nil {
+		return fmt.Errorf("error opening vsclient: %v", err)
+	}
+	defer vc.vr.sourceVStreamer.Close(ctx)
+
+	copyCtx, cancel := context.WithTimeout(ctx, copyTimeout)
+	defer cancel()
+
+	cq := &copyQueue{
+		vc:        vc,
+		plan:      plan,
+		progress:  progress,
+		items:     items,
+		pending:   append([]*copyItem(nil), items...),
+		done:      make(map[*copyItem]bool),
+		pos:       settings.StartPos,
+		copyCtx:   copyCtx,
+		cancel:    cancel,
+		snapshots: make(chan *itemCopier),
+		finished:  make(chan *itemCopier),
+	}
+	if err := cq.run(ctx, concurrency); err != nil {
+		return err
+	}
+
+	// The copied rows are now part of the replicated state.
+	if !cq.maxPos.IsZero() {
+		if err := cq.fastForward(ctx, cq.maxPos); err != nil {
+			return err
+		}
+	}
+	for _, item := range items {
+		if !cq.done[item] {
+			continue
+		}
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("delete from _vt.copy_state where vrepl_id=%s and table_name=%s and chunk=%s", strconv.Itoa(int(vc.vr.id)), encodeString(item.table), strconv.FormatInt(item.chunk, 10))
+		if _, err := vc.vr.dbClient.Execute(buf.String()); err != nil {
+			return err
+		}
+	}
+	progress.remove(cq.remaining())
+	return nil
+}
+
+// copyQueue schedules the copiers of copyNextParallel. Its functions
+// are called by a single goroutine, which also owns the position of
+// vreplication: it's moved only while no rows are being copied.
+type copyQueue struct {
+	vc       *vcopier
+	plan     *ReplicatorPlan
+	progress *copyProgress
+	items    []*copyItem
+
+	// pending are the items that remain to be started.
+	pending []*copyItem
+	// done are the items that were completely copied.
+	done map[*copyItem]bool
+	// pos is the position of vreplication. maxPos is
+	// the largest GTID of the rows that were copied.
+	pos, maxPos mysql.Position
+
+	// copyCtx is canceled by the copy timeout, or by cancel.
+	copyCtx context.Context
+	cancel  context.CancelFunc
+	// running is the number of copiers that haven't finished.
+	running int
+	// serial is set while an item that must be copied alone
+	// waits for its snapshot. No other item is started meanwhile.
+	serial    bool
+	snapshots chan *itemCopier
+	finished  chan *itemCopier
+}
+
+// run copies the items until all of them are copied, or the copy times out.
+func (cq *copyQueue) run(ctx context.Context, concurrency int) error {
+	for {
+		if err := cq.start(concurrency); err != nil {
+			return cq.abort(err)
+		}
+		if cq.running == 0 {
+			return nil
+		}
+		select {
+		case ic := <-cq.snapshots:
+			if err := cq.snapshot(ctx, ic); err != nil {
+				return cq.abort(err)
+			}
+		case ic := <-cq.finished:
+			cq.running--
+			if err := cq.finish(ic); err != nil {
+				return cq.abort(err)
+			}
+		}
+	}
+}
+
+// start starts copying the pending items while there are free slots.
+func (cq *copyQueue) start(concurrency int) error {
+	for len(cq.pending) != 0 && cq.running < concurrency && !cq.serial && cq.copyCtx.Err() == nil {
+		item := cq.pending[0]
+		serial := cq.vc.vr.uniqueKeys[item.table]
+		if serial && cq.running != 0 {
+			// Wait for the other items to be done.
+			return nil
+		}
+		initialPlan, ok := cq.plan.TargetTables[item.table]
+		if !ok {
+			return fmt.Errorf("plan not found for table: %s, current plans are: %#v", item.table, cq.plan.TargetTables)
+		}
+		cq.pending = cq.pending[1:]
+		ic := &itemCopier{
+			vc:          cq.vc,
+			item:        item,
+			plan:        cq.plan,
+			initialPlan: initialPlan,
+			progress:    cq.progress,
+			serial:      serial,
+			proceed:     make(chan bool, 1),
+		}
+		cq.serial = serial
+		cq.running++
+		go func() {
+			ic.err = ic.copy(cq.copyCtx, cq.snapshots)
+			cq.finished <- ic
+		}()
+	}
+	return nil
+}
+
+// snapshot lets the copier copy the rows of its snapshot, after moving the
+// position of vreplication to it if needed. If the snapshot is behind the
+// position, the events in between were skipped for the rows of the item.
+// So, the copier stops, and the item is started again.
+func (cq *copyQueue) snapshot(ctx context.Context, ic *itemCopier) error {
+	if ic.serial {
+		cq.serial = false
+	}
+	gtid, err := mysql.DecodePosition(ic.gtid)
+	if err != nil {
+		return err
+	}
+	switch {
+	case !cq.pos.IsZero() && !gtid.AtLeast(cq.pos):
+		log.Infof("Restarting the copy of %v, chunk %d: its snapshot %v is behind %v", ic.item.table, ic.item.chunk, gtid, cq.pos)
+		ic.restart = true
+		ic.proceed <- false
+		return nil
+	case ic.serial || cq.pos.IsZero():
+		// No other copier has started copying rows.
+		if err := cq.fastForward(ctx, gtid); err != nil {
+			return err
+		}
+	}
+	if cq.maxPos.IsZero() || gtid.AtLeast(cq.maxPos) {
+		cq.maxPos = gtid
+	}
+	ic.proceed <- true
+	return nil
+}
+
+// finish handles a copier that returned.
+func (cq *copyQueue) finish(ic *itemCopier) error {
+	if ic.serial {
+		cq.serial = false
+	}
+	switch {
+	case ic.err != nil:
+		return ic.err
+	case ic.gtid == "" && cq.copyCtx.Err() == nil:
+		return fmt.Errorf("stream of %s ended without a snapshot", ic.item.table)
+	case ic.restart:
+		cq.pending = append([]*copyItem{ic.item}, cq.pending...)
+	case ic.done:
+		log.Infof("Copy of %v, chunk %d finished", ic.item.table, ic.item.chunk)
+		cq.done[ic.item] = true
+	}
+	return nil
+}
+
+// abort stops the copiers, and waits for them to return.
+func (cq *copyQueue) abort(err error) error {
+	cq.cancel()
+	for cq.running != 0 {
+		select {
+		case <-cq.snapshots:
+			// The copier returns because copyCtx is canceled.
+		case <-cq.finished:
+			cq.running--
+		}
+	}
+	return err
+}
+
+// fastForward moves the position of vreplication to pos. The events
+// of the items that were completely copied are not excluded anymore.
+func (cq *copyQueue) fastForward(ctx context.Context, pos mysql.Position) error {
+	copyState, pcs := buildParallelCopyState(cq.remaining())
+	if err := cq.vc.fastForward(ctx, copyState, pcs, mysql.EncodePosition(pos)); err != nil {
+		return err
+	}
+	cq.pos = pos
+	return nil
+}
+
+// remaining returns the items that are not completely copied.
+func (cq *copyQueue) remaining() []*copyItem {
+	var remaining []*copyItem
+	for _, item := range cq.items {
+		if !cq.done[item] {
+			remaining = append(remaining, item)
+		}
+	}
+	return remaining
+}
+
+// copyProgress publishes the progress of the items, aggregated by table,
+// as their copiers concurrently report it.
+type copyProgress struct {
+	mu    sync.Mutex
+	stats *binlogplayer.Stats
+	items []*copyItem
+}
+
+func (cp *copyProgress) publish() {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	tables := make(map[string]binlogplayer.CopyProgress)
+	for _, item := range cp.items {
+		tables[item.table] = tables[item.table].Add(item.progress)
+	}
+	cp.stats.SetCopyProgress(tables)
+}
+
+func (cp *copyProgress) update(item *copyItem, progress binlogplayer.CopyProgress) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	item.progress = progress
+	var table binlogplayer.CopyProgress
+	for _, other := range cp.items {
+		if other.table == item.table {
+			table = table.Add(other.progress)
+		}
+	}
+	cp.stats.UpdateCopyProgress(item.table, table)
+}
+
+// remove stops publishing the progress of the items that were copied.
+func (cp *copyProgress) remove(remaining []*copyItem) {
+	cp.mu.Lock()
+	cp.items = remaining
+	cp.mu.Unlock()
+	cp.publish()
+}
+
+// itemCopier copies the rows of a copyItem for copyNextParallel.
+type itemCopier struct {
+	vc          *vcopier
+	item        *copyItem
+	plan        *ReplicatorPlan
+	initialPlan *TablePlan
+	progress    *copyProgress
+	// serial is set if the item can't be copied along with others.
+	serial bool
+	// proceed receives whether the rows of the snapshot can be copied.
+	proceed chan bool
+
+	// gtid is set before the copier reports its snapshot.
+	gtid string
+	// restart, done and err are set when the copier returns.
+	restart bool
+	done    bool
+	err     error
+}
+
+// copy streams the rows of the item. It sends the copier to snapshots once
+// the GTID of the stream is known, and then waits for proceed before copying
+// the rows. Like copyTable, the rows of each packet are committed along with
+// the lastpk. The copy stops at the end of the chunk, or at the timeout.
+func (ic *itemCopier) copy(ctx context.Context, snapshots chan<- *itemCopier) error {
+	dbClient := newVDBClient(ic.vc.vr.vre.dbClientFactory(), ic.vc.vr.stats)
+	if err := dbClient.Connect(); err != nil {
+		return fmt.Errorf("error connecting to the database: %v", err)
+	}
+	defer dbClient.Close()
+	defer dbClient.Rollback()
+
+	item := ic.item
+	log.Infof("Copying table %s, chunk %d, lastpk: %v, end: %v", item.table, item.chunk, item.lastpk, item.end)
+
+	var lastpkpb *querypb.QueryResult
+	if item.lastpk != nil {
+		lastpkpb = sqltypes.ResultToProto3(item.lastpk)
+	}
+	end := sqltypes.NULL
+	if item.end != nil {
+		end = item.end.Rows[0][0]
+	}
+
+	progress := item.progress
+	if progress.TimeStarted == 0 {
+		progress.TimeStarted = time.Now().Unix()
+	}
+
+	var tablePlan *TablePlan
+	var pkfields []*querypb.Field
+	// pkIndex is the index of the primary key in the rows
+	// of a chunk. It's used to find the end of the chunk.
+	pkIndex := -1
+	var updateCopyState *sqlparser.ParsedQuery
+	reachedEnd := false
+	err := ic.vc.vr.sourceVStreamer.VStreamRows(ctx, ic.initialPlan.SendRule.Filter, lastpkpb, func(rows *binlogdatapb.VStreamRowsResponse) error {
+		select {
+		case <-ctx.Done():
+			return io.EOF
+		default:
+		}
+		if tablePlan == nil {
+			if len(rows.Fields) == 0 {
+				return fmt.Errorf("expecting field event first, got: %v", rows)
+			}
+			ic.gtid = rows.Gtid
+			snapshots <- ic
+			select {
+			case proceed := <-ic.proceed:
+				if !proceed {
+					return io.EOF
+				}
+			case <-ctx.Done():
+				return io.EOF
+			}
+			fieldEvent := &binlogdatapb.FieldEvent{
+				TableName: ic.initialPlan.SendRule.Match,
+				Fields:    rows.Fields,
+			}
+			var err error
+			tablePlan, err = ic.plan.buildExecutionPlan(fieldEvent)
+			if err != nil {
+				return err
+			}
+			pkfields = rows.Pkfields
+			if !end.IsNull() {
+				for i, field := range rows.Fields {
+					if len(pkfields) == 1 && field.Name == pkfields[0].Name {
+						pkIndex = i
+					}
+				}
+				if pkIndex == -1 {
+					return fmt.Errorf("primary key of chunk %d of %s not found in %v", item.chunk, item.table, rows.Pkfields)
+				}
+			}
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("update _vt.copy_state set lastpk=%a, rows_copied=%a, bytes_copied=%a, time_started=%a, time_updated=%a where vrepl_id=%s and table_name=%s and chunk=%s",
+				":lastpk", ":rows_copied", ":bytes_copied", ":time_started", ":time_updated", strconv.Itoa(int(ic.vc.vr.id)), encodeString(item.table), strconv.FormatInt(item.chunk, 10))
+			updateCopyState = buf.ParsedQuery()
+		}
+		lastpkRow := rows.Lastpk
+		if pkIndex != -1 {
+			// The rows beyond the end of the chunk belong to the next one.
+			for i, row := range rows.Rows {
+				pk := sqltypes.MakeRowTrusted(tablePlan.Fields, row)[pkIndex]
+				cmp, err := sqltypes.NullsafeCompare(pk, end)
+				if err != nil {
+					return err
+				}
+				if cmp > 0 {
+					if i > 0 {
+						lastpkRow = sqltypes.RowToProto3([]sqltypes.Value{sqltypes.MakeRowTrusted(tablePlan.Fields, rows.Rows[i-1])[pkIndex]})
+					}
+					rows = &binlogdatapb.VStreamRowsResponse{Rows: rows.Rows[:i]}
+					reachedEnd = true
+					break
+				}
+			}
+		}
+		if len(rows.Rows) == 0 {
+			if reachedEnd {
+				return io.EOF
+			}
+			return nil
+		}
+		if err := dbClient.Begin(); err != nil {
+			return err
+		}
+		if _, err := tablePlan.applyBulkInsert(rows, func(sql string) (*sqltypes.Result, error) {
+			return dbClient.ExecuteWithRetry(ctx, sql)
+		}); err != nil {
+			return err
+		}
+		lastpk, err := encodePK(pkfields, lastpkRow)
+		if err != nil {
+			return err
+		}
+		newProgress := progress
+		newProgress.RowsCopied += int64(len(rows.Rows))
+		for _, row := range rows.Rows {
+			newProgress.BytesCopied += int64(len(row.Values))
+		}
+		newProgress.TimeUpdated = time.Now().Unix()
+		bv := map[string]*querypb.BindVariable{
+			"lastpk": {
+				Type:  sqltypes.VarBinary,
+				Value: lastpk,
+			},
+			"rows_copied":  sqltypes.Int64BindVariable(newProgress.RowsCopied),
+			"bytes_copied": sqltypes.Int64BindVariable(newProgress.BytesCopied),
+			"time_started": sqltypes.Int64BindVariable(newProgress.TimeStarted),
+			"time_updated": sqltypes.Int64BindVariable(newProgress.TimeUpdated),
+		}
+		updateState, err := updateCopyState.GenerateQuery(bv, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := dbClient.Execute(updateState); err != nil {
+			return err
+		}
+		if err := dbClient.Commit(); err != nil {
+			return err
+		}
+		progress = newProgress
+		item.lastpk = sqltypes.Proto3ToResult(&querypb.QueryResult{
+			Fields: pkfields,
+			Rows:   []*querypb.Row{lastpkRow},
+		})
+		ic.progress.update(item, progress)
+		if reachedEnd {
+			return io.EOF
+		}
+		return nil
+	})
+	if reachedEnd {
+		ic.done = true
+		return nil
+	}
+	if ic.restart {
+		return nil
+	}
+	// If there was a timeout, return without an error.
+	select {
+	case <-ctx.Done():
+		log.Infof("Copy of %v, chunk %d stopped at lastpk: %v", item.table, item.chunk, item.lastpk)
+		return nil
+	default:
+	}
+	if err != nil {
+		return err
+	}
+	ic.done = true
+	return nil
+}
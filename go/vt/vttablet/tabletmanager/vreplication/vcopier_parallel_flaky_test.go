@@ -0,0 +1,371 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xsec-lab/go/vt/binlog/binlogplayer"
+	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
+	"github.com/xsec-lab/go/vt/vttablet/tabletserver/vstreamer"
+	"golang.org/x/net/context"
+)
+
+// startParallelCopy creates a stream that copies the tables of filter
+// with the concurrency. It returns a function that deletes the stream.
+func startParallelCopy(t *testing.T, filter *binlogdatapb.Filter, concurrency int64) func() {
+	t.Helper()
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace:        env.KeyspaceName,
+		Shard:           env.ShardName,
+		Filter:          filter,
+		OnDdl:           binlogdatapb.OnDDLAction_IGNORE,
+		CopyConcurrency: concurrency,
+	}
+	query := binlogplayer.CreateVReplicationState("test", bls, "", binlogplayer.VReplicationInit, playerEngine.dbName)
+	qr, err := playerEngine.Exec(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return func() {
+		query := fmt.Sprintf("delete from _vt.vreplication where id = %d", qr.InsertID)
+		if _, err := playerEngine.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+		expectDeleteQueries(t)
+	}
+}
+
+// drainQueries returns the queries of the db clients up to the first
+// one that matches re. The items are copied concurrently. So, unlike
+// in expectDBClientQueries, the order of the queries is not known.
+func drainQueries(t *testing.T, re string) []string {
+	t.Helper()
+	var queries []string
+	for {
+		select {
+		case query := <-globalDBQueries:
+			queries = append(queries, query)
+			if regexp.MustCompile(re).MatchString(query) {
+				return queries
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("no query matched %s, got: %v", re, queries)
+		}
+	}
+}
+
+// queryIndex returns the index of the first query that matches re, or -1.
+func queryIndex(queries []string, re string) int {
+	for i, query := range queries {
+		if regexp.MustCompile(re).MatchString(query) {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestPlayerCopyParallel(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	savedChunkRows := *copyChunkRows
+	*copyChunkRows = 3
+	defer func() { *copyChunkRows = savedChunkRows }()
+
+	execStatements(t, []string{
+		"create table src1(id int, val varbinary(128), primary key(id))",
+		"insert into src1 values(1,'a'), (2,'b'), (3,'c'), (4,'d'), (5,'e'), (6,'f'), (7,'g'), (8,'h'), (9,'i'), (10,'j'), (11,'k'), (12,'l')",
+		"analyze table src1",
+		fmt.Sprintf("create table %s.dst1(id int, val varbinary(128), primary key(id))", vrepldb),
+		"create table src2(id int, val varbinary(128), primary key(id))",
+		"insert into src2 values(1,'a'), (2,'b')",
+		fmt.Sprintf("create table %s.dst2(id int, val varbinary(128), primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table src1",
+		fmt.Sprintf("drop table %s.dst1", vrepldb),
+		"drop table src2",
+		fmt.Sprintf("drop table %s.dst2", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	// Change the rows once the first snapshot is taken. The events are
+	// applied after the copy, including to the rows of the snapshots
+	// that already contain them.
+	var once sync.Once
+	vstreamRowsSendHook = func(context.Context) {
+		once.Do(func() {
+			execStatements(t, []string{
+				// Insert before the first chunk and after the last one.
+				"insert into src1 values(0,'z'), (13,'m')",
+				"update src1 set val='bb' where id=2",
+				"update src1 set val='kk' where id=11",
+				"delete from src1 where id in (5,9)",
+				// Move a row to another chunk.
+				"update src1 set id=14 where id=6",
+				"update src2 set val='aa' where id=1",
+			})
+		})
+	}
+	defer func() { vstreamRowsSendHook = nil }()
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "dst1",
+			Filter: "select * from src1",
+		}, {
+			Match:  "dst2",
+			Filter: "select * from src2",
+		}},
+	}
+	defer startParallelCopy(t, filter, 4)()
+
+	queries := drainQueries(t, "^update _vt.vreplication set state='Running'")
+	// src1 was split into chunks.
+	if i := queryIndex(queries, `^insert into _vt.copy_state.*'dst1', null, \d+, \d+, 0, 'fields`); i == -1 {
+		t.Errorf("dst1 was not split into chunks: %v", queries)
+	}
+	// There was no position yet. So, it's set to the first snapshot
+	// before any rows are copied.
+	pos := queryIndex(queries, "^update _vt.vreplication set pos=")
+	insert := queryIndex(queries, "^insert into dst")
+	if pos == -1 || insert == -1 || pos > insert {
+		t.Errorf("position not set before the copy: %v", queries)
+	}
+	// The copies of the items overwrite the rows that the events
+	// already inserted.
+	if i := queryIndex(queries, "^insert into dst1.* on duplicate key update val=values\\(val\\)"); i == -1 {
+		t.Errorf("rows of dst1 are not upserted: %v", queries)
+	}
+	expectData(t, "dst1", [][]string{
+		{"0", "z"},
+		{"1", "a"},
+		{"2", "bb"},
+		{"3", "c"},
+		{"4", "d"},
+		{"7", "g"},
+		{"8", "h"},
+		{"10", "j"},
+		{"11", "kk"},
+		{"12", "l"},
+		{"13", "m"},
+		{"14", "f"},
+	})
+	expectData(t, "dst2", [][]string{
+		{"1", "aa"},
+		{"2", "b"},
+	})
+}
+
+// TestPlayerCopyParallelResume ensures that the chunks that were not
+// completely copied at the timeout are resumed from their lastpk.
+func TestPlayerCopyParallelResume(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	savedChunkRows := *copyChunkRows
+	*copyChunkRows = 4
+	defer func() { *copyChunkRows = savedChunkRows }()
+
+	savedPacketSize := *vstreamer.PacketSize
+	// PacketSize of 1 byte will send at most one row at a time.
+	*vstreamer.PacketSize = 1
+	defer func() { *vstreamer.PacketSize = savedPacketSize }()
+
+	savedCopyTimeout := copyTimeout
+	copyTimeout = 500 * time.Millisecond
+	defer func() { copyTimeout = savedCopyTimeout }()
+
+	savedWaitRetryTime := waitRetryTime
+	waitRetryTime = 10 * time.Millisecond
+	defer func() { waitRetryTime = savedWaitRetryTime }()
+
+	execStatements(t, []string{
+		"create table src(id int, val varbinary(128), primary key(id))",
+		"insert into src values(1,'a'), (2,'b'), (3,'c'), (4,'d'), (5,'e'), (6,'f'), (7,'g'), (8,'h')",
+		"analyze table src",
+		fmt.Sprintf("create table %s.dst(id int, val varbinary(128), primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table src",
+		fmt.Sprintf("drop table %s.dst", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	// The copier that sends the third packet waits for the timeout.
+	// The others stop at the timeout too, after copying their rows.
+	var mu sync.Mutex
+	count := 0
+	vstreamRowsSendHook = func(ctx context.Context) {
+		mu.Lock()
+		count++
+		wait := count == 3
+		mu.Unlock()
+		if wait {
+			<-ctx.Done()
+		}
+	}
+	defer func() { vstreamRowsSendHook = nil }()
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "dst",
+			Filter: "select * from src",
+		}},
+	}
+	defer startParallelCopy(t, filter, 2)()
+
+	queries := drainQueries(t, "^update _vt.vreplication set state='Running'")
+	// Every row is copied once: the copy resumes after the lastpk.
+	copied := make(map[string]bool)
+	re := regexp.MustCompile(`^insert into dst\(id,val\) values \((\d+),`)
+	for _, query := range queries {
+		match := re.FindStringSubmatch(query)
+		if match == nil {
+			continue
+		}
+		if copied[match[1]] {
+			t.Errorf("row %s copied twice: %v", match[1], queries)
+		}
+		copied[match[1]] = true
+	}
+	if len(copied) != 8 {
+		t.Errorf("copied rows: %v, want 8", copied)
+	}
+	if i := queryIndex(queries, `^update _vt.copy_state set lastpk=.* and chunk=1$`); i == -1 {
+		t.Errorf("chunk 1 was not copied: %v", queries)
+	}
+	expectData(t, "dst", [][]string{
+		{"1", "a"},
+		{"2", "b"},
+		{"3", "c"},
+		{"4", "d"},
+		{"5", "e"},
+		{"6", "f"},
+		{"7", "g"},
+		{"8", "h"},
+	})
+}
+
+// TestPlayerCopyParallelUniqueKey ensures that the tables with a secondary
+// unique key are copied alone, after fast-forwarding to their snapshot.
+// Replaying the events on their rows could make them collide.
+func TestPlayerCopyParallelUniqueKey(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	execStatements(t, []string{
+		"create table src1(id int, val varbinary(128), primary key(id))",
+		"insert into src1 values(1,'a'), (2,'b')",
+		fmt.Sprintf("create table %s.dst1(id int, val varbinary(128), primary key(id))", vrepldb),
+		"create table srcuk(id int, val varbinary(128), primary key(id), unique key(val))",
+		"insert into srcuk values(1,'a')",
+		fmt.Sprintf("create table %s.dstuk(id int, val varbinary(128), primary key(id), unique key(val))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table src1",
+		fmt.Sprintf("drop table %s.dst1", vrepldb),
+		"drop table srcuk",
+		fmt.Sprintf("drop table %s.dstuk", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	// Replaying the insert of (2,'c') over the copied rows would
+	// collide with the row 1.
+	var once sync.Once
+	vstreamRowsSendHook = func(context.Context) {
+		once.Do(func() {
+			execStatements(t, []string{
+				"insert into srcuk values(2,'c')",
+				"update srcuk set val='d' where id=2",
+				"update srcuk set val='c' where id=1",
+			})
+		})
+	}
+	defer func() { vstreamRowsSendHook = nil }()
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "dst1",
+			Filter: "select * from src1",
+		}, {
+			Match:  "dstuk",
+			Filter: "select * from srcuk",
+		}},
+	}
+	defer startParallelCopy(t, filter, 2)()
+
+	queries := drainQueries(t, "^update _vt.vreplication set state='Running'")
+	// dstuk is copied after dst1, and after a fast-forward.
+	dst1 := queryIndex(queries, "^insert into dst1")
+	dstuk := queryIndex(queries, "^insert into dstuk")
+	if dst1 == -1 || dstuk == -1 || dstuk < dst1 {
+		t.Errorf("dstuk not copied after dst1: %v", queries)
+	} else if queryIndex(queries[dst1:dstuk], "^update _vt.vreplication set pos=") == -1 {
+		t.Errorf("no fast-forward before the copy of dstuk: %v", queries)
+	}
+	expectData(t, "dst1", [][]string{
+		{"1", "a"},
+		{"2", "b"},
+	})
+	expectData(t, "dstuk", [][]string{
+		{"1", "c"},
+		{"2", "d"},
+	})
+}
+
+// TestPlayerCopyParallelAbort ensures that the error of a copier
+// stops the other ones, and is reported.
+func TestPlayerCopyParallelAbort(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	execStatements(t, []string{
+		"create table src1(id int, val varbinary(128), primary key(id))",
+		"insert into src1 values(1,'a'), (2,'b')",
+		fmt.Sprintf("create table %s.dst1(id int, val varbinary(128), primary key(id))", vrepldb),
+		fmt.Sprintf("create table %s.dst2(id int, val varbinary(128), primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table src1",
+		fmt.Sprintf("drop table %s.dst1", vrepldb),
+		fmt.Sprintf("drop table %s.dst2", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	// The stream of dst1 is blocked until the copy is aborted.
+	// The stream of dst2 fails before sending anything.
+	vstreamRowsSendHook = func(ctx context.Context) {
+		<-ctx.Done()
+	}
+	defer func() { vstreamRowsSendHook = nil }()
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "dst1",
+			Filter: "select * from src1",
+		}, {
+			Match:  "dst2",
+			Filter: "select * from nosrc",
+		}},
+	}
+	defer startParallelCopy(t, filter, 2)()
+
+	drainQueries(t, "^update _vt.vreplication set message='.*nosrc")
+	expectData(t, "dst1", [][]string{})
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/xsec-lab/go/sqltypes"
+)
+
+func TestChunkBounds(t *testing.T) {
+	testcases := []struct {
+		min, max sqltypes.Value
+		n        int64
+		out      []string
+		err      string
+	}{{
+		min: sqltypes.NewInt64(1),
+		max: sqltypes.NewInt64(100),
+		n:   4,
+		out: []string{"25", "49", "73"},
+	}, {
+		min: sqltypes.NewInt64(-100),
+		max: sqltypes.NewInt64(100),
+		n:   4,
+		out: []string{"-50", "0", "50"},
+	}, {
+		min: sqltypes.NewUint64(0),
+		max: sqltypes.NewUint64(18446744073709551615),
+		n:   2,
+		out: []string{"9223372036854775807"},
+	}, {
+		// Not enough values for all the chunks.
+		min: sqltypes.NewInt64(1),
+		max: sqltypes.NewInt64(3),
+		n:   10,
+		out: []string{"2"},
+	}, {
+		min: sqltypes.NewInt64(5),
+		max: sqltypes.NewInt64(5),
+		n:   4,
+	}, {
+		// Empty table.
+		min: sqltypes.NULL,
+		max: sqltypes.NULL,
+		n:   4,
+		err: "primary key is not an integer: NULL, NULL",
+	}, {
+		min: sqltypes.NewVarChar("a"),
+		max: sqltypes.NewVarChar("z"),
+		n:   4,
+		err: "primary key is not an integer: VARCHAR(\"a\"), VARCHAR(\"z\")",
+	}}
+	for _, tcase := range testcases {
+		bounds, err := chunkBounds(tcase.min, tcase.max, tcase.n)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("chunkBounds(%v, %v, %d) err: %v, want %s", tcase.min, tcase.max, tcase.n, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("chunkBounds(%v, %v, %d) err: %v", tcase.min, tcase.max, tcase.n, err)
+			continue
+		}
+		var got []string
+		for _, bound := range bounds {
+			if bound.Type() != tcase.min.Type() {
+				t.Errorf("chunkBounds(%v, %v, %d): %v, want type %v", tcase.min, tcase.max, tcase.n, bound, tcase.min.Type())
+			}
+			got = append(got, bound.ToString())
+		}
+		if !reflect.DeepEqual(got, tcase.out) {
+			t.Errorf("chunkBounds(%v, %v, %d): %v, want %v", tcase.min, tcase.max, tcase.n, got, tcase.out)
+		}
+	}
+}
+
+func TestBuildParallelCopyState(t *testing.T) {
+	pk := func(val int64) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), fmt.Sprintf("%d", val))
+	}
+	items := []*copyItem{{
+		table:  "t1",
+		lastpk: pk(10),
+	}, {
+		table: "t2",
+	}, {
+		table: "t3",
+		chunk: 0,
+		end:   pk(100),
+	}, {
+		table:  "t3",
+		chunk:  1,
+		lastpk: pk(150),
+		end:    pk(200),
+	}, {
+		table:  "t3",
+		chunk:  2,
+		lastpk: pk(200),
+	}}
+	copyState, pcs := buildParallelCopyState(items)
+	wantCopyState := map[string]*sqltypes.Result{
+		"t1": pk(10),
+		"t2": nil,
+	}
+	if !reflect.DeepEqual(copyState, wantCopyState) {
+		t.Errorf("copyState: %v, want %v", copyState, wantCopyState)
+	}
+	wantRanges := &pkRanges{
+		column: "id",
+		ranges: []pkRange{
+			{start: sqltypes.NULL, end: sqltypes.NewInt64(100)},
+			{start: sqltypes.NewInt64(150), end: sqltypes.NewInt64(200)},
+			{start: sqltypes.NewInt64(200), end: sqltypes.NULL},
+		},
+	}
+	got := pcs.uncopiedRanges("t3")
+	if !reflect.DeepEqual(got, wantRanges) {
+		t.Errorf("uncopiedRanges(t3): %+v, want %+v", got, wantRanges)
+	}
+	if got.all() {
+		t.Errorf("uncopiedRanges(t3).all(): true, want false")
+	}
+	if got := pcs.uncopiedRanges("t1"); got != nil {
+		t.Errorf("uncopiedRanges(t1): %+v, want nil", got)
+	}
+	var nilState *parallelCopyState
+	if got := nilState.uncopiedRanges("t3"); got != nil {
+		t.Errorf("uncopiedRanges on nil: %+v, want nil", got)
+	}
+
+	// A chunked table that wasn't copied at all.
+	_, pcs = buildParallelCopyState([]*copyItem{{table: "t3", end: pk(100)}, {table: "t3", chunk: 1, lastpk: pk(100)}})
+	if pcs.uncopiedRanges("t3").all() {
+		t.Errorf("all() of unbounded chunks: true, want false")
+	}
+}
+
+func TestHasUniqueKey(t *testing.T) {
+	testcases := []struct {
+		schema string
+		want   bool
+	}{{
+		schema: "CREATE TABLE `t1` (\n  `id` int(11) NOT NULL,\n  `val` varbinary(128) DEFAULT NULL,\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `val` (`val`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8",
+		want:   true,
+	}, {
+		schema: "CREATE TABLE `t1` (\n  `id` int(11) NOT NULL,\n  `val` varbinary(128) DEFAULT NULL,\n  PRIMARY KEY (`id`),\n  KEY `val` (`val`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8",
+		want:   false,
+	}, {
+		schema: "not a create statement",
+		want:   true,
+	}}
+	for _, tcase := range testcases {
+		if got := hasUniqueKey("t1", tcase.schema); got != tcase.want {
+			t.Errorf("hasUniqueKey(%s): %v, want %v", tcase.schema, got, tcase.want)
+		}
+	}
+}
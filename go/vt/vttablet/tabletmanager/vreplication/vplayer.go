@@ -40,6 +40,7 @@ type vplayer struct {
 	stopPos   mysql.Position
 	saveStop  bool
 	copyState map[string]*sqltypes.Result
+	pcs       *parallelCopyState
 
 	replicatorPlan *ReplicatorPlan
 	tablePlans     map[string]*TablePlan
@@ -67,9 +68,10 @@ type vplayer struct {
 // copyState: if set, contains the list of tables yet to be copied, or in the process
 //   of being copied. If copyState is non-nil, the plans generated make sure that
 //   replication is only applied to parts that have been copied so far.
+// pcs: if set, the tables are being copied in parallel. See parallelCopyState.
 // pausePos: if set, replication will stop at that position without updating the state to "Stopped".
 //   This is used by the fastForward function during copying.
-func newVPlayer(vr *vreplicator, settings binlogplayer.VRSettings, copyState map[string]*sqltypes.Result, pausePos mysql.Position) *vplayer {
+func newVPlayer(vr *vreplicator, settings binlogplayer.VRSettings, copyState map[string]*sqltypes.Result, pcs *parallelCopyState, pausePos mysql.Position) *vplayer {
 	saveStop := true
 	if !pausePos.IsZero() {
 		settings.StopPos = pausePos
@@ -82,6 +84,7 @@ func newVPlayer(vr *vreplicator, settings binlogplayer.VRSettings, copyState map
 		stopPos:       settings.StopPos,
 		saveStop:      saveStop,
 		copyState:     copyState,
+		pcs:           pcs,
 		timeLastSaved: time.Now(),
 		tablePlans:    make(map[string]*TablePlan),
 	}
@@ -97,7 +100,7 @@ func (vp *vplayer) play(ctx context.Context) error {
 		return nil
 	}
 
-	plan, err := buildReplicatorPlan(vp.vr.source.Filter, vp.vr.tableKeys, vp.copyState)
+	plan, err := buildReplicatorPlan(vp.vr.source.Filter, vp.vr.tableKeys, vp.copyState, vp.pcs)
 	if err != nil {
 		return err
 	}
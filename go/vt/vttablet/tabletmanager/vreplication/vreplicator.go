@@ -28,6 +28,7 @@ import (
 	"github.com/xsec-lab/go/vt/binlog/binlogplayer"
 	"github.com/xsec-lab/go/vt/log"
 	"github.com/xsec-lab/go/vt/mysqlctl"
+	"github.com/xsec-lab/go/vt/sqlparser"
 
 	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
 )
@@ -57,6 +58,9 @@ type vreplicator struct {
 	// mysqld is used to fetch the local schema.
 	mysqld    mysqlctl.MysqlDaemon
 	tableKeys map[string][]string
+	// uniqueKeys lists the tables that have a unique key other than the
+	// primary key. They're not copied in parallel. See copyNextParallel.
+	uniqueKeys map[string]bool
 }
 
 // newVReplicator creates a new vreplicator. The valid fields from the source are:
@@ -71,8 +75,11 @@ type vreplicator struct {
 //   "select * from t where in_keyrange(col1, 'hash', '-80')",
 //   "select col1, col2 from t where...",
 //   "select col1, keyspace_id() as ksid from t where...",
-//   "select id, count(*), sum(price) from t group by id".
-//   Only "in_keyrange" expressions are supported in the where clause.
+//   "select id, count(*), sum(price) from t group by id",
+//   "select * from t where in_keyrange('-80') and tenant_id = 42".
+//   The where clause is evaluated by the source vstreamer. It can contain
+//   an "in_keyrange" expression and conditions on the source columns,
+//   except comparisons on text columns.
 //   The select expressions can be any valid non-aggregate expressions,
 //   or count(*), or sum(col).
 //   If the target column name does not match the source expression, an
@@ -94,12 +101,13 @@ func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreame
 // Replicate starts a vreplication stream. It can be in one of three phases:
 // 1. Init: If a request is issued with no starting position, we assume that the
 // contents of the tables must be copied first. During this phase, the list of
-// tables to be copied is inserted into the copy_state table. A successful insert
-// gets us out of this phase.
+// tables to be copied is inserted into the copy_state table, along with their
+// estimated size. A successful insert gets us out of this phase.
 // 2. Copy: If the copy_state table has rows, then we are in this phase. During this
-// phase, we repeatedly invoke copyNext until all the tables are copied. After each
-// table is successfully copied, it's removed from the copy_state table. We exit this
-// phase when there are no rows left in copy_state.
+// phase, we repeatedly invoke copyNext until all the tables are copied. The lastpk
+// and the number of rows copied are recorded in copy_state as rows get copied. After
+// each table is successfully copied, it's removed from the copy_state table. We exit
+// this phase when there are no rows left in copy_state.
 // 3. Replicate: In this phase, we replicate binlog events indefinitely, unless
 // a stop position was requested. This phase differs from the Init phase because
 // there is a replication position.
@@ -123,11 +131,15 @@ func (vr *vreplicator) Replicate(ctx context.Context) error {
 }
 
 func (vr *vreplicator) replicate(ctx context.Context) error {
-	tableKeys, err := vr.buildTableKeys()
+	tableKeys, uniqueKeys, err := vr.buildTableKeys()
 	if err != nil {
 		return err
 	}
 	vr.tableKeys = tableKeys
+	vr.uniqueKeys = uniqueKeys
+	if err := vr.upgradeCopyState(); err != nil {
+		return err
+	}
 
 	for {
 		// This rollback is a no-op. It's here for safety
@@ -159,25 +171,50 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 			if err := vr.setState(binlogplayer.BlpRunning, ""); err != nil {
 				return err
 			}
-			return newVPlayer(vr, settings, nil, mysql.Position{}).play(ctx)
+			return newVPlayer(vr, settings, nil, nil, mysql.Position{}).play(ctx)
 		}
 	}
 }
 
-func (vr *vreplicator) buildTableKeys() (map[string][]string, error) {
+func (vr *vreplicator) buildTableKeys() (map[string][]string, map[string]bool, error) {
 	schema, err := vr.mysqld.GetSchema(vr.dbClient.DBName(), []string{"/.*/"}, nil, false)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	tableKeys := make(map[string][]string)
+	uniqueKeys := make(map[string]bool)
 	for _, td := range schema.TableDefinitions {
 		if len(td.PrimaryKeyColumns) != 0 {
 			tableKeys[td.Name] = td.PrimaryKeyColumns
 		} else {
 			tableKeys[td.Name] = td.Columns
 		}
+		if hasUniqueKey(td.Name, td.Schema) {
+			uniqueKeys[td.Name] = true
+		}
+	}
+	return tableKeys, uniqueKeys, nil
+}
+
+// hasUniqueKey returns true if the create statement of the table
+// has a unique key other than the primary key. A statement that
+// can't be parsed is assumed to have one.
+func hasUniqueKey(tableName, schema string) bool {
+	stmt, err := sqlparser.Parse(schema)
+	if err != nil {
+		log.Warningf("Could not parse the schema of %s: %v", tableName, err)
+		return true
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.TableSpec == nil {
+		return true
+	}
+	for _, index := range ddl.TableSpec.Indexes {
+		if index.Info.Unique && !index.Info.Primary {
+			return true
+		}
 	}
-	return tableKeys, nil
+	return false
 }
 
 func (vr *vreplicator) readSettings(ctx context.Context) (settings binlogplayer.VRSettings, numTablesToCopy int64, err error) {
@@ -215,6 +252,43 @@ func (vr *vreplicator) readSettings(ctx context.Context) (settings binlogplayer.
 	return settings, numTablesToCopy, nil
 }
 
+// copyStateUpgrades are the changes made to _vt.copy_state after its
+// first version. Each change is detected by the presence of its column.
+var copyStateUpgrades = []struct {
+	column, alter string
+}{
+	{"rows_estimated", alterCopyState},
+	{"chunk", alterCopyStateChunks},
+}
+
+// upgradeCopyState applies the changes that are missing from
+// a _vt.copy_state table that was created by an older version.
+// It's called once, when the stream starts. If the table doesn't
+// exist yet, readSettings creates it with all the columns.
+func (vr *vreplicator) upgradeCopyState() error {
+	for _, upgrade := range copyStateUpgrades {
+		_, err := vr.dbClient.Execute(fmt.Sprintf("select %s from _vt.copy_state where 1 != 1", upgrade.column))
+		merr, isSQLErr := err.(*mysql.SQLError)
+		if err == nil {
+			continue
+		}
+		if isSQLErr && (merr.Num == mysql.ERNoSuchTable || merr.Num == mysql.ERBadDb) {
+			return nil
+		}
+		if !isSQLErr || merr.Num != mysql.ERBadFieldError {
+			return err
+		}
+		log.Infof("Adding the %s column to _vt.copy_state", upgrade.column)
+		if _, err := vr.dbClient.Execute(upgrade.alter); err != nil {
+			// Another stream may have added it first.
+			if merr, isSQLErr := err.(*mysql.SQLError); !isSQLErr || merr.Num != mysql.ERDupFieldName {
+				return fmt.Errorf("failed to add the %s column to _vt.copy_state: %v", upgrade.column, err)
+			}
+		}
+	}
+	return nil
+}
+
 func (vr *vreplicator) setMessage(message string) error {
 	vr.stats.History.Add(&binlogplayer.StatsHistoryRecord{
 		Time:    time.Now(),
@@ -26,6 +26,7 @@ import (
 	"github.com/xsec-lab/go/sqltypes"
 	"github.com/xsec-lab/go/vt/dbconfigs"
 	"github.com/xsec-lab/go/vt/grpcclient"
+	"github.com/xsec-lab/go/vt/sqlparser"
 	"github.com/xsec-lab/go/vt/vttablet/queryservice"
 	"github.com/xsec-lab/go/vt/vttablet/tabletconn"
 	"github.com/xsec-lab/go/vt/vttablet/tabletserver/connpool"
@@ -56,6 +57,49 @@ type VStreamerClient interface {
 
 	// VStreamRows streams rows of a table from the specified starting point.
 	VStreamRows(ctx context.Context, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error
+
+	// TableSizes returns the estimated size of the tables of the source database.
+	TableSizes(ctx context.Context) (map[string]TableSize, error)
+
+	// PKBounds returns the smallest and largest values of
+	// a primary key column of a table of the source database.
+	PKBounds(ctx context.Context, table, column string) (min, max sqltypes.Value, err error)
+}
+
+// TableSize is the estimated size of a table, as reported
+// by the information_schema of its database.
+type TableSize struct {
+	Rows  int64
+	Bytes int64
+}
+
+const tableSizesQuery = "select table_name, table_rows, data_length from information_schema.tables where table_schema = database()"
+
+func parseTableSizes(qr *sqltypes.Result) (map[string]TableSize, error) {
+	sizes := make(map[string]TableSize, len(qr.Rows))
+	for _, row := range qr.Rows {
+		if len(row) != 3 {
+			return nil, fmt.Errorf("unexpected row for table sizes: %v", row)
+		}
+		// Views have no size.
+		rows, _ := sqltypes.ToInt64(row[1])
+		bytes, _ := sqltypes.ToInt64(row[2])
+		sizes[row[0].ToString()] = TableSize{Rows: rows, Bytes: bytes}
+	}
+	return sizes, nil
+}
+
+func pkBoundsQuery(table, column string) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select min(%v), max(%v) from %v", sqlparser.NewColIdent(column), sqlparser.NewColIdent(column), sqlparser.NewTableIdent(table))
+	return buf.String()
+}
+
+func parsePKBounds(qr *sqltypes.Result) (min, max sqltypes.Value, err error) {
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		return sqltypes.NULL, sqltypes.NULL, fmt.Errorf("unexpected result for primary key bounds: %v", qr.Rows)
+	}
+	return qr.Rows[0][0], qr.Rows[0][1], nil
 }
 
 // TabletVStreamerClient a vstream client backed by vttablet
@@ -133,6 +177,30 @@ func (vsClient *TabletVStreamerClient) VStreamRows(ctx context.Context, query st
 	return vsClient.tsQueryService.VStreamRows(ctx, vsClient.target, query, lastpk, send)
 }
 
+// TableSizes part of the VStreamerClient interface
+func (vsClient *TabletVStreamerClient) TableSizes(ctx context.Context) (map[string]TableSize, error) {
+	if !vsClient.isOpen {
+		return nil, errors.New("can't get table sizes without opening client")
+	}
+	qr, err := vsClient.tsQueryService.Execute(ctx, vsClient.target, tableSizesQuery, nil, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseTableSizes(qr)
+}
+
+// PKBounds part of the VStreamerClient interface
+func (vsClient *TabletVStreamerClient) PKBounds(ctx context.Context, table, column string) (min, max sqltypes.Value, err error) {
+	if !vsClient.isOpen {
+		return sqltypes.NULL, sqltypes.NULL, errors.New("can't get primary key bounds without opening client")
+	}
+	qr, err := vsClient.tsQueryService.Execute(ctx, vsClient.target, pkBoundsQuery(table, column), nil, 0, nil)
+	if err != nil {
+		return sqltypes.NULL, sqltypes.NULL, err
+	}
+	return parsePKBounds(qr)
+}
+
 // NewMySQLVStreamerClient is a vstream client that allows you to stream directly from MySQL.
 // In order to achieve this, the following creates a vstreamer Engine with a dummy in memorytopo.
 func NewMySQLVStreamerClient() *MySQLVStreamerClient {
@@ -206,6 +274,40 @@ func (vsClient *MySQLVStreamerClient) VStreamRows(ctx context.Context, query str
 	return streamer.Stream()
 }
 
+// TableSizes part of the VStreamerClient interface
+func (vsClient *MySQLVStreamerClient) TableSizes(ctx context.Context) (map[string]TableSize, error) {
+	if !vsClient.isOpen {
+		return nil, errors.New("can't get table sizes without opening client")
+	}
+	conn, err := vsClient.sourceConnParams.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	qr, err := conn.ExecuteFetch(tableSizesQuery, 10000, false)
+	if err != nil {
+		return nil, err
+	}
+	return parseTableSizes(qr)
+}
+
+// PKBounds part of the VStreamerClient interface
+func (vsClient *MySQLVStreamerClient) PKBounds(ctx context.Context, table, column string) (min, max sqltypes.Value, err error) {
+	if !vsClient.isOpen {
+		return sqltypes.NULL, sqltypes.NULL, errors.New("can't get primary key bounds without opening client")
+	}
+	conn, err := vsClient.sourceConnParams.Connect(ctx)
+	if err != nil {
+		return sqltypes.NULL, sqltypes.NULL, err
+	}
+	defer conn.Close()
+	qr, err := conn.ExecuteFetch(pkBoundsQuery(table, column), 1, false)
+	if err != nil {
+		return sqltypes.NULL, sqltypes.NULL, err
+	}
+	return parsePKBounds(qr)
+}
+
 // InitVStreamerClient initializes config for vstreamer client
 func InitVStreamerClient(cfg *dbconfigs.DBConfigs) {
 	dbcfgs = cfg
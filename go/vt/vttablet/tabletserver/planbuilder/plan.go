@@ -180,6 +180,10 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table) (*Pla
 		plan, err = &Plan{PlanID: PlanOtherRead}, nil
 	case *sqlparser.OtherAdmin:
 		plan, err = &Plan{PlanID: PlanOtherAdmin}, nil
+	case *sqlparser.Savepoint, *sqlparser.SRollback, *sqlparser.Release:
+		// Savepoint statements are sent by vtgate within a transaction,
+		// and must be passed through to MySQL as is.
+		plan, err = &Plan{PlanID: PlanOtherAdmin}, nil
 	default:
 		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "invalid SQL")
 	}
@@ -393,7 +393,13 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 	defer t.SequenceInfo.Unlock()
 	if t.SequenceInfo.NextVal == 0 || t.SequenceInfo.NextVal+inc > t.SequenceInfo.LastVal {
 		_, err := qre.execAsTransaction(func(conn *TxConnection) (*sqltypes.Result, error) {
+			// A sequence that's sharded has a max_id column, which is the
+			// end of the range of values that its shard can allocate.
+			hasMaxID := t.FindColumn(sqlparser.NewColIdent("max_id")) >= 0
 			query := fmt.Sprintf("select next_id, cache from %s where id = 0 for update", sqlparser.String(tableName))
+			if hasMaxID {
+				query = fmt.Sprintf("select next_id, cache, max_id from %s where id = 0 for update", sqlparser.String(tableName))
+			}
 			qr, err := qre.execSQL(conn, query, false)
 			if err != nil {
 				return nil, err
@@ -427,6 +433,18 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 			for newLast < t.SequenceInfo.NextVal+inc {
 				newLast += cache
 			}
+			if hasMaxID {
+				maxID, err := sqltypes.ToInt64(qr.Rows[0][2])
+				if err != nil {
+					return nil, vterrors.Wrapf(err, "error loading sequence %s", tableName)
+				}
+				if t.SequenceInfo.NextVal+inc-1 > maxID {
+					return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "sequence %s is exhausted: cannot allocate %d values after %d, max_id is %d", tableName, inc, t.SequenceInfo.NextVal-1, maxID)
+				}
+				if newLast > maxID+1 {
+					newLast = maxID + 1
+				}
+			}
 			query = fmt.Sprintf("update %s set next_id = %d where id = 0", sqlparser.String(tableName), newLast)
 			conn.RecordQuery(query)
 			_, err = qre.execSQL(conn, query, false)
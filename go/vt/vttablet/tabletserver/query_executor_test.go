@@ -580,6 +580,61 @@ func TestQueryExecutorPlanNextval(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPlanNextvalMaxID(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("select * from seq where 1 != 1", &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int32},
+			{Name: "next_id", Type: sqltypes.Int64},
+			{Name: "cache", Type: sqltypes.Int64},
+			{Name: "max_id", Type: sqltypes.Int64},
+		},
+	})
+	selQuery := "select next_id, cache, max_id from seq where id = 0 for update"
+	db.AddQuery(selQuery, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(1),
+			sqltypes.NewInt64(10),
+			sqltypes.NewInt64(4),
+		}},
+		RowsAffected: 1,
+	})
+	// The cache is capped at max_id.
+	db.AddQuery("update seq set next_id = 5 where id = 0", &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	qre := newTestQueryExecutor(ctx, tsv, "select next 3 values from seq", 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.NewInt64(1), got.Rows[0][0])
+
+	// Value 5 is beyond max_id.
+	db.AddQuery(selQuery, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(5),
+			sqltypes.NewInt64(10),
+			sqltypes.NewInt64(4),
+		}},
+		RowsAffected: 1,
+	})
+	qre = newTestQueryExecutor(ctx, tsv, "select next 2 values from seq", 0)
+	_, err = qre.Execute()
+	assert.EqualError(t, err, "sequence seq is exhausted: cannot allocate 2 values after 3, max_id is 4")
+}
+
 func TestQueryExecutorMessageStreamACL(t *testing.T) {
 	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
 	tableacl.Register(aclName, &simpleacl.Factory{})
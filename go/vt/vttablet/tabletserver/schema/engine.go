@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -38,6 +39,7 @@ import (
 	"github.com/xsec-lab/go/vt/vttablet/tabletserver/connpool"
 	"github.com/xsec-lab/go/vt/vttablet/tabletserver/tabletenv"
 
+	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
 	vtrpcpb "github.com/xsec-lab/go/vt/proto/vtrpc"
 )
 
@@ -60,8 +62,9 @@ type Engine struct {
 
 	// The following fields have their own synchronization
 	// and do not require locking mu.
-	conns *connpool.Pool
-	ticks *timer.Timer
+	conns     *connpool.Pool
+	ticks     *timer.Timer
+	historian *historian
 }
 
 var schemaOnce sync.Once
@@ -77,6 +80,7 @@ func NewEngine(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *En
 		ticks:      timer.NewTimer(reloadTime),
 		reloadTime: reloadTime,
 	}
+	se.historian = newHistorian(config.TrackSchemaVersions, se.conns)
 	schemaOnce.Do(func() {
 		_ = stats.NewGaugeDurationFunc("SchemaReloadTime", "vttablet keeps table schemas in its own memory and periodically refreshes it from MySQL. This config controls the reload time.", se.ticks.Interval)
 
@@ -146,6 +150,7 @@ func (se *Engine) Close() {
 		return
 	}
 	se.ticks.Stop()
+	se.historian.reset()
 	se.conns.Close()
 	se.tables = make(map[string]*Table)
 	se.lastChange = 0
@@ -346,6 +351,14 @@ func (se *Engine) GetTable(tableName sqlparser.TableIdent) *Table {
 	return se.tables[tableName.String()]
 }
 
+// GetTableForPos returns the definition of the table as of pos,
+// from the schema versions recorded by the Tracker. It returns nil
+// if the current definition of the table applies, or if the schema
+// versions are not tracked.
+func (se *Engine) GetTableForPos(ctx context.Context, tableName sqlparser.TableIdent, pos mysql.Position) (*binlogdatapb.MinimalTable, error) {
+	return se.historian.getTableForPos(ctx, tableName.String(), pos)
+}
+
 // GetSchema returns the current The Tables are a shared
 // data structure and must be treated as read-only.
 func (se *Engine) GetSchema() map[string]*Table {
@@ -358,6 +371,22 @@ func (se *Engine) GetSchema() map[string]*Table {
 	return tables
 }
 
+// GetMinimalSchema returns the definitions of the current tables,
+// sorted by name, in the form recorded in the schema versions.
+func (se *Engine) GetMinimalSchema() *binlogdatapb.MinimalSchema {
+	schema := &binlogdatapb.MinimalSchema{}
+	for name, table := range se.GetSchema() {
+		if name == "dual" {
+			continue
+		}
+		schema.Tables = append(schema.Tables, minimalTable(table))
+	}
+	sort.Slice(schema.Tables, func(i, j int) bool {
+		return schema.Tables[i].Name < schema.Tables[j].Name
+	})
+	return schema
+}
+
 func (se *Engine) ServeHTTP(response http.ResponseWriter, request *http.Request) {
 	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
 		acl.SendError(response, err)
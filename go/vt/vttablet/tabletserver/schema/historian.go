@@ -0,0 +1,142 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/mysql"
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/vterrors"
+	"github.com/xsec-lab/go/vt/vttablet/tabletserver/connpool"
+
+	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
+	vtrpcpb "github.com/xsec-lab/go/vt/proto/vtrpc"
+)
+
+const getSchemaVersions = "select id, pos, schemax from _vt.schema_version where id > %d order by id asc"
+
+// historian keeps the versions of the schema that were recorded
+// by the Tracker in _vt.schema_version. The versions are loaded
+// lazily, when a position after the last known version is requested.
+type historian struct {
+	enabled bool
+	conns   *connpool.Pool
+
+	// mu protects the following fields.
+	mu       sync.Mutex
+	lastID   int64
+	versions []*schemaVersion
+}
+
+// schemaVersion is the definition of the tables as of pos.
+type schemaVersion struct {
+	pos    mysql.Position
+	tables map[string]*binlogdatapb.MinimalTable
+}
+
+func newHistorian(enabled bool, conns *connpool.Pool) *historian {
+	return &historian{
+		enabled: enabled,
+		conns:   conns,
+	}
+}
+
+// reset forgets the loaded versions.
+func (h *historian) reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.lastID = 0
+	h.versions = nil
+}
+
+// getTableForPos returns the definition of the table as of pos.
+// It returns nil if the schema didn't change after pos, because the
+// current definition of the table is then more accurate than the last
+// recorded one: a DDL that was just applied may not be recorded yet.
+// It also returns nil if pos is older than the recorded versions.
+func (h *historian) getTableForPos(ctx context.Context, tableName string, pos mysql.Position) (*binlogdatapb.MinimalTable, error) {
+	if !h.enabled {
+		return nil, nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.versions) == 0 || pos.AtLeast(h.versions[len(h.versions)-1].pos) {
+		// Newer versions may have been recorded.
+		if err := h.loadNewVersions(ctx); err != nil {
+			return nil, err
+		}
+	}
+	for i := len(h.versions) - 1; i >= 0; i-- {
+		if !pos.AtLeast(h.versions[i].pos) {
+			continue
+		}
+		if i == len(h.versions)-1 {
+			return nil, nil
+		}
+		return h.versions[i].tables[tableName], nil
+	}
+	return nil, nil
+}
+
+// loadNewVersions loads the versions that were recorded
+// after the last loaded one. It must be called with the lock held.
+func (h *historian) loadNewVersions(ctx context.Context) error {
+	conn, err := h.conns.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+
+	qr, err := conn.Exec(ctx, fmt.Sprintf(getSchemaVersions, h.lastID), 10000, false)
+	if err != nil {
+		if merr, ok := err.(*mysql.SQLError); ok && (merr.Num == mysql.ERNoSuchTable || merr.Num == mysql.ERBadDb) {
+			// No version was recorded yet.
+			return nil
+		}
+		return err
+	}
+	for _, row := range qr.Rows {
+		id, err := sqltypes.ToInt64(row[0])
+		if err != nil {
+			return err
+		}
+		pos, err := mysql.DecodePosition(row[1].ToString())
+		if err != nil {
+			return vterrors.Wrapf(err, "schema version %d", id)
+		}
+		schema := &binlogdatapb.MinimalSchema{}
+		if err := proto.Unmarshal(row[2].ToBytes(), schema); err != nil {
+			return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "could not unmarshal schema version %d: %v", id, err)
+		}
+		version := &schemaVersion{
+			pos:    pos,
+			tables: make(map[string]*binlogdatapb.MinimalTable, len(schema.Tables)),
+		}
+		for _, table := range schema.Tables {
+			version.tables[table.Name] = table
+		}
+		h.versions = append(h.versions, version)
+		h.lastID = id
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/mysql"
+	"github.com/xsec-lab/go/mysql/fakesqldb"
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/vttablet/tabletserver/schema/schematest"
+
+	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+)
+
+// schemaVersionsResult returns the rows of _vt.schema_version for
+// the versions, which are numbered from firstID.
+func schemaVersionsResult(t *testing.T, firstID int, versions map[string][]*binlogdatapb.MinimalTable, positions ...string) *sqltypes.Result {
+	t.Helper()
+	result := &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("id|pos|schemax", "int64|varbinary|blob"),
+	}
+	for i, pos := range positions {
+		blob, err := proto.Marshal(&binlogdatapb.MinimalSchema{Tables: versions[pos]})
+		require.NoError(t, err)
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.NewInt64(int64(firstID + i)),
+			sqltypes.NewVarBinary(pos),
+			sqltypes.MakeTrusted(sqltypes.Blob, blob),
+		})
+	}
+	return result
+}
+
+func TestHistorian(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	se := newEngine(10, 10*time.Second, 10*time.Second, true, db)
+	se.historian.enabled = true
+	require.NoError(t, se.Open())
+	defer se.Close()
+
+	pos := func(gtids string) mysql.Position {
+		p, err := mysql.DecodePosition("MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:" + gtids)
+		require.NoError(t, err)
+		return p
+	}
+	tableName := sqlparser.NewTableIdent("t1")
+	table1 := &binlogdatapb.MinimalTable{
+		Name:      "t1",
+		Fields:    []*querypb.Field{{Name: "id", Type: sqltypes.Int64}},
+		PKColumns: []int64{0},
+	}
+	table2 := &binlogdatapb.MinimalTable{
+		Name:      "t1",
+		Fields:    []*querypb.Field{{Name: "id", Type: sqltypes.Int64}, {Name: "val", Type: sqltypes.VarBinary}},
+		PKColumns: []int64{0},
+	}
+	pos1 := mysql.EncodePosition(pos("1-5"))
+	pos2 := mysql.EncodePosition(pos("1-10"))
+	pos3 := mysql.EncodePosition(pos("1-20"))
+	versions := map[string][]*binlogdatapb.MinimalTable{
+		pos1: {table1},
+		pos2: {table2},
+		pos3: {},
+	}
+
+	// No versions were recorded yet.
+	db.AddRejectedQuery(fmt.Sprintf(getSchemaVersions, 0), mysql.NewSQLError(mysql.ERNoSuchTable, mysql.SSUnknownSQLState, "table doesn't exist"))
+	got, err := se.GetTableForPos(context.Background(), tableName, pos("1-7"))
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	db.DeleteRejectedQuery(fmt.Sprintf(getSchemaVersions, 0))
+	db.AddQuery(fmt.Sprintf(getSchemaVersions, 0), schemaVersionsResult(t, 1, versions, pos1, pos2))
+	db.AddQuery(fmt.Sprintf(getSchemaVersions, 2), schemaVersionsResult(t, 3, versions))
+	testcases := []struct {
+		pos  string
+		want *binlogdatapb.MinimalTable
+	}{{
+		// Before the first version.
+		pos: "1-3",
+	}, {
+		pos:  "1-5",
+		want: table1,
+	}, {
+		pos:  "1-7",
+		want: table1,
+	}, {
+		// The last version: the current schema applies.
+		pos: "1-10",
+	}, {
+		pos: "1-15",
+	}}
+	for _, tcase := range testcases {
+		got, err := se.GetTableForPos(context.Background(), tableName, pos(tcase.pos))
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(tcase.want, got), "%s: %v, want %v", tcase.pos, got, tcase.want)
+	}
+
+	// A new version is loaded when a later position is requested.
+	db.AddQuery(fmt.Sprintf(getSchemaVersions, 2), schemaVersionsResult(t, 3, versions, pos3))
+	got, err = se.GetTableForPos(context.Background(), tableName, pos("1-15"))
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(table2, got), "got: %v, want %v", got, table2)
+	assert.Equal(t, 1, db.GetQueryCalledNum(fmt.Sprintf(getSchemaVersions, 0)))
+
+	// The table did not exist yet.
+	got, err = se.GetTableForPos(context.Background(), sqlparser.NewTableIdent("t2"), pos("1-15"))
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestHistorianDisabled(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	se := newEngine(10, 10*time.Second, 10*time.Second, true, db)
+	require.NoError(t, se.Open())
+	defer se.Close()
+
+	got, err := se.GetTableForPos(context.Background(), sqlparser.NewTableIdent("t1"), mysql.Position{})
+	require.NoError(t, err)
+	assert.Nil(t, got)
+	assert.Equal(t, 0, db.GetQueryCalledNum(fmt.Sprintf(getSchemaVersions, 0)))
+}
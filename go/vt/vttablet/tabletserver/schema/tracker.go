@@ -0,0 +1,229 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/mysql"
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/log"
+	"github.com/xsec-lab/go/vt/vttablet/tabletserver/tabletenv"
+
+	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
+)
+
+const (
+	createSchemaVersion = `create table if not exists _vt.schema_version (
+  id int auto_increment,
+  pos varbinary(10000) not null,
+  time_updated bigint(12) not null,
+  ddl varbinary(1000) default null,
+  schemax longblob not null,
+  primary key (id)
+) engine=InnoDB`
+
+	lastSchemaVersion   = "select pos from _vt.schema_version order by id desc limit 1"
+	insertSchemaVersion = "insert into _vt.schema_version (pos, time_updated, ddl, schemax) values (%v, %d, %v, %v)"
+)
+
+// VStreamer defines the functions of VStreamer
+// that the Tracker needs.
+type VStreamer interface {
+	Stream(ctx context.Context, startPos string, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error
+}
+
+// Tracker is a tabletserver service that records the schema of the
+// tables in _vt.schema_version every time a DDL is applied, along with
+// the position of the DDL. It runs only on the master. The versions
+// are replicated to the replicas, where the schema Engine uses them to
+// look up the schema of a table as of a binlog position.
+type Tracker struct {
+	enabled bool
+	se      *Engine
+	vs      VStreamer
+
+	// mu protects cancel.
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewTracker creates a new Tracker.
+func NewTracker(se *Engine, vs VStreamer, config tabletenv.TabletConfig) *Tracker {
+	return &Tracker{
+		enabled: config.TrackSchemaVersions,
+		se:      se,
+		vs:      vs,
+	}
+}
+
+// Open starts the Tracker service.
+func (tr *Tracker) Open() {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.cancel != nil || !tr.enabled {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(tabletenv.LocalContext())
+	tr.cancel = cancel
+	tr.wg.Add(1)
+	go tr.process(ctx)
+}
+
+// Close stops the Tracker service.
+func (tr *Tracker) Close() {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.cancel == nil {
+		return
+	}
+	tr.cancel()
+	tr.cancel = nil
+	tr.wg.Wait()
+}
+
+// process tracks the DDLs until the context is canceled.
+func (tr *Tracker) process(ctx context.Context) {
+	defer tr.wg.Done()
+	defer tabletenv.LogError()
+
+	for {
+		err := tr.track(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+		log.Infof("Schema tracker ended: %v, retrying", err)
+	}
+}
+
+// track records the current schema if no version was recorded yet,
+// and then records a new version for every DDL of the binlog stream.
+// The stream starts at the position of the last recorded version,
+// so that the DDLs applied while the tracker wasn't running are
+// also recorded. The versions recorded while catching up hold the
+// current schema, because the past schema can't be read back.
+func (tr *Tracker) track(ctx context.Context) error {
+	pos, err := tr.init(ctx)
+	if err != nil {
+		return err
+	}
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+	var gtid string
+	return tr.vs.Stream(ctx, pos, filter, func(events []*binlogdatapb.VEvent) error {
+		for _, event := range events {
+			switch event.Type {
+			case binlogdatapb.VEventType_GTID:
+				gtid = event.Gtid
+			case binlogdatapb.VEventType_DDL:
+				// The vstreamer reloads the schema Engine before sending
+				// a DDL. So, its tables already reflect the change.
+				if err := tr.saveVersion(ctx, gtid, event.Ddl, event.Timestamp); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// init creates the _vt.schema_version table, and records the
+// current schema as the first version if the table is empty.
+// It returns the position of the last recorded version.
+func (tr *Tracker) init(ctx context.Context) (string, error) {
+	conn, err := tr.se.cp.Connect(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	for _, query := range []string{"create database if not exists _vt", createSchemaVersion} {
+		if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+			return "", err
+		}
+	}
+	qr, err := conn.ExecuteFetch(lastSchemaVersion, 1, false)
+	if err != nil {
+		return "", err
+	}
+	if len(qr.Rows) != 0 {
+		return qr.Rows[0][0].ToString(), nil
+	}
+	pos, err := conn.MasterPosition()
+	if err != nil {
+		return "", err
+	}
+	gtid := mysql.EncodePosition(pos)
+	if err := tr.saveVersion(ctx, gtid, "", time.Now().Unix()); err != nil {
+		return "", err
+	}
+	return gtid, nil
+}
+
+// saveVersion records the current schema as of gtid.
+func (tr *Tracker) saveVersion(ctx context.Context, gtid, ddl string, timestamp int64) error {
+	schema := tr.se.GetMinimalSchema()
+	blob, err := proto.Marshal(schema)
+	if err != nil {
+		return err
+	}
+
+	conn, err := tr.se.cp.Connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	query := fmt.Sprintf(insertSchemaVersion, encodeString(gtid), timestamp, encodeString(ddl), encodeString(string(blob)))
+	if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+		return err
+	}
+	log.Infof("Recorded schema version at %s for: %s", gtid, ddl)
+	return nil
+}
+
+// minimalTable returns the definition of the table
+// that's recorded in a schema version.
+func minimalTable(table *Table) *binlogdatapb.MinimalTable {
+	pkColumns := make([]int64, len(table.PKColumns))
+	for i, col := range table.PKColumns {
+		pkColumns[i] = int64(col)
+	}
+	return &binlogdatapb.MinimalTable{
+		Name:      table.Name.String(),
+		Fields:    table.Fields,
+		PKColumns: pkColumns,
+	}
+}
+
+func encodeString(in string) string {
+	var buf strings.Builder
+	sqltypes.NewVarBinary(in).EncodeSQL(&buf)
+	return buf.String()
+}
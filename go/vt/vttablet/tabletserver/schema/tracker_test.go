@@ -0,0 +1,179 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/mysql/fakesqldb"
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/vttablet/tabletserver/schema/schematest"
+	"github.com/xsec-lab/go/vt/vttablet/tabletserver/tabletenv"
+
+	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
+)
+
+type fakeVStreamer struct {
+	events [][]*binlogdatapb.VEvent
+
+	mu       sync.Mutex
+	startPos string
+}
+
+func (f *fakeVStreamer) Stream(ctx context.Context, startPos string, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
+	f.mu.Lock()
+	f.startPos = startPos
+	f.mu.Unlock()
+	for _, events := range f.events {
+		if err := send(events); err != nil {
+			return err
+		}
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// schemaVersionInsert returns the query that records
+// the current schema of se as a version.
+func schemaVersionInsert(t *testing.T, se *Engine, gtid, ddl string, timestamp int64) string {
+	t.Helper()
+	schema := &binlogdatapb.MinimalSchema{}
+	for name, table := range se.GetSchema() {
+		if name != "dual" {
+			schema.Tables = append(schema.Tables, minimalTable(table))
+		}
+	}
+	sort.Slice(schema.Tables, func(i, j int) bool {
+		return schema.Tables[i].Name < schema.Tables[j].Name
+	})
+	blob, err := proto.Marshal(schema)
+	require.NoError(t, err)
+	return fmt.Sprintf(insertSchemaVersion, encodeString(gtid), timestamp, encodeString(ddl), encodeString(string(blob)))
+}
+
+func waitForQuery(t *testing.T, db *fakesqldb.DB, query string) {
+	t.Helper()
+	for start := time.Now(); db.GetQueryCalledNum(query) == 0; time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("query was not executed: %s", query)
+		}
+	}
+}
+
+func TestTracker(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQuery("create database if not exists _vt", &sqltypes.Result{})
+	db.AddQuery(createSchemaVersion, &sqltypes.Result{})
+	db.AddQuery(lastSchemaVersion, &sqltypes.Result{})
+	db.AddQuery("SELECT @@GLOBAL.gtid_executed", sqltypes.MakeTestResult(sqltypes.MakeTestFields("gtid_executed", "varchar"), "16b1039f-22b6-11ed-b765-0a43f95f28a3:1-3"))
+
+	se := newEngine(10, 10*time.Second, 10*time.Second, true, db)
+	require.NoError(t, se.Open())
+	defer se.Close()
+
+	pos := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-4"
+	ddl := "alter table test_table_01 add column val int"
+	vs := &fakeVStreamer{
+		events: [][]*binlogdatapb.VEvent{{
+			{Type: binlogdatapb.VEventType_GTID, Gtid: pos},
+			{Type: binlogdatapb.VEventType_DDL, Ddl: ddl, Timestamp: 1427325876},
+		}},
+	}
+	// The time of the initial version is not known in advance.
+	initial := "insert into _vt.schema_version (pos, time_updated, ddl, schemax) values ('MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-3', "
+	db.AddQueryPattern(regexp.QuoteMeta(initial)+".*", &sqltypes.Result{})
+	insert := schemaVersionInsert(t, se, pos, ddl, 1427325876)
+	db.AddQuery(insert, &sqltypes.Result{})
+
+	config := tabletenv.DefaultQsConfig
+	config.TrackSchemaVersions = true
+	tracker := NewTracker(se, vs, config)
+	tracker.Open()
+	defer tracker.Close()
+	waitForQuery(t, db, insert)
+	require.Equal(t, 1, db.GetQueryCalledNum(lastSchemaVersion))
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+	require.Equal(t, "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-3", vs.startPos)
+}
+
+func TestTrackerResume(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQuery("create database if not exists _vt", &sqltypes.Result{})
+	db.AddQuery(createSchemaVersion, &sqltypes.Result{})
+	last := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-5"
+	db.AddQuery(lastSchemaVersion, sqltypes.MakeTestResult(sqltypes.MakeTestFields("pos", "varbinary"), last))
+
+	se := newEngine(10, 10*time.Second, 10*time.Second, true, db)
+	require.NoError(t, se.Open())
+	defer se.Close()
+
+	// The DDL was applied while the tracker was not running.
+	pos := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-6"
+	ddl := "alter table test_table_01 add column val int"
+	vs := &fakeVStreamer{
+		events: [][]*binlogdatapb.VEvent{{
+			{Type: binlogdatapb.VEventType_GTID, Gtid: pos},
+			{Type: binlogdatapb.VEventType_DDL, Ddl: ddl, Timestamp: 1427325876},
+		}},
+	}
+	insert := schemaVersionInsert(t, se, pos, ddl, 1427325876)
+	db.AddQuery(insert, &sqltypes.Result{})
+
+	config := tabletenv.DefaultQsConfig
+	config.TrackSchemaVersions = true
+	tracker := NewTracker(se, vs, config)
+	tracker.Open()
+	defer tracker.Close()
+	waitForQuery(t, db, insert)
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+	require.Equal(t, last, vs.startPos)
+}
+
+func TestTrackerDisabled(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	se := newEngine(10, 10*time.Second, 10*time.Second, true, db)
+	require.NoError(t, se.Open())
+	defer se.Close()
+
+	tracker := NewTracker(se, &fakeVStreamer{}, tabletenv.DefaultQsConfig)
+	tracker.Open()
+	tracker.Close()
+	require.Equal(t, 0, db.GetQueryCalledNum(createSchemaVersion))
+}
@@ -113,6 +113,7 @@ func init() {
 	flag.BoolVar(&Config.EnableConsolidator, "enable-consolidator", DefaultQsConfig.EnableConsolidator, "This option enables the query consolidator.")
 	flag.BoolVar(&Config.EnableConsolidatorReplicas, "enable-consolidator-replicas", DefaultQsConfig.EnableConsolidatorReplicas, "This option enables the query consolidator only on replicas.")
 	flag.BoolVar(&Config.EnableQueryPlanFieldCaching, "enable-query-plan-field-caching", DefaultQsConfig.EnableQueryPlanFieldCaching, "This option fetches & caches fields (columns) when storing query plans")
+	flag.BoolVar(&Config.TrackSchemaVersions, "track_schema_versions", DefaultQsConfig.TrackSchemaVersions, "When enabled, vttablet (if master) records the schema of the tables in _vt.schema_version whenever a DDL is applied, and vstreamer uses these versions to decode the binlog events with the schema that was in effect at their position.")
 }
 
 // Init must be called after flag.Parse, and before doing any other operations.
@@ -189,6 +190,8 @@ type TabletConfig struct {
 	EnableConsolidator          bool
 	EnableConsolidatorReplicas  bool
 	EnableQueryPlanFieldCaching bool
+
+	TrackSchemaVersions bool
 }
 
 // TransactionLimitConfig captures configuration of transaction pool slots
@@ -268,6 +271,8 @@ var DefaultQsConfig = TabletConfig{
 	EnableConsolidator:          true,
 	EnableConsolidatorReplicas:  false,
 	EnableQueryPlanFieldCaching: true,
+
+	TrackSchemaVersions: false,
 }
 
 // defaultTxThrottlerConfig formats the default throttlerdata.Configuration
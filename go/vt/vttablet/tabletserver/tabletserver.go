@@ -167,6 +167,7 @@ type TabletServer struct {
 	watcher   *ReplicationWatcher
 	vstreamer *vstreamer.Engine
 	messager  *messager.Engine
+	tracker   *schema.Tracker
 
 	// checkMySQLThrottler is used to throttle the number of
 	// requests sent to CheckMySQL.
@@ -248,6 +249,7 @@ func NewTabletServer(config tabletenv.TabletConfig, topoServer *topo.Server, ali
 	tsv.vstreamer = vstreamer.NewEngine(srvTopoServer, tsv.se)
 	tsv.watcher = NewReplicationWatcher(tsv.vstreamer, config)
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer, config)
+	tsv.tracker = schema.NewTracker(tsv.se, tsv.vstreamer, config)
 	return tsv
 }
 
@@ -523,11 +525,13 @@ func (tsv *TabletServer) serveNewType() (err error) {
 			return err
 		}
 		tsv.messager.Open()
+		tsv.tracker.Open()
 		tsv.hr.Close()
 		tsv.hw.Open()
 	} else {
 		tsv.te.AcceptReadOnly()
 		tsv.messager.Close()
+		tsv.tracker.Close()
 		tsv.hr.Open()
 		tsv.hw.Close()
 		tsv.watcher.Open()
@@ -582,6 +586,7 @@ func (tsv *TabletServer) waitForShutdown() {
 	// will be allowed. They will enable the conclusion of outstanding
 	// transactions.
 	tsv.messager.Close()
+	tsv.tracker.Close()
 	tsv.te.StopGently()
 	tsv.qe.streamQList.TerminateAll()
 	tsv.watcher.Close()
@@ -593,6 +598,7 @@ func (tsv *TabletServer) waitForShutdown() {
 // It forcibly shuts down everything.
 func (tsv *TabletServer) closeAll() {
 	tsv.messager.Close()
+	tsv.tracker.Close()
 	tsv.watcher.Close()
 	tsv.vstreamer.Close()
 	tsv.hr.Close()
@@ -1328,6 +1334,14 @@ func (tsv *TabletServer) VStreamResults(ctx context.Context, target *querypb.Tar
 	return tsv.vstreamer.StreamResults(ctx, query, send)
 }
 
+// GetSchema returns the tables loaded by the schema engine.
+func (tsv *TabletServer) GetSchema(ctx context.Context, target *querypb.Target) ([]*binlogdatapb.MinimalTable, error) {
+	if err := tsv.verifyTarget(ctx, target); err != nil {
+		return nil, err
+	}
+	return tsv.se.GetMinimalSchema().Tables, nil
+}
+
 // execRequest performs verifications, sets up the necessary environments
 // and calls the supplied function for executing the request.
 func (tsv *TabletServer) execRequest(
@@ -25,6 +25,7 @@ import (
 	"github.com/xsec-lab/go/sqltypes"
 	"github.com/xsec-lab/go/vt/key"
 	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/vtgate/evalengine"
 	"github.com/xsec-lab/go/vt/vtgate/vindexes"
 
 	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
@@ -46,6 +47,11 @@ type Plan struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Filters are the conditions of the where clause other than
+	// in_keyrange. The row is sent only if all of them are true.
+	// Like VindexColumns, they reference the columns of the table.
+	Filters []evalengine.Expr
 }
 
 // ColExpr represents a column expression.
@@ -60,6 +66,10 @@ type ColExpr struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 
+	// Expr, if set, is evaluated against the columns of the table
+	// to compute the value. If so, ColNum is ignored.
+	Expr evalengine.Expr
+
 	// Alias is usually the column name, but it can be changed
 	// if the select expression aliases with an "AS" expression.
 	// Also, "keyspace_id()" will be aliased as "keyspace_id".
@@ -99,24 +109,71 @@ func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error
 		}
 	}
 
+	// env is built only if the plan has expressions.
+	var env evalengine.ExpressionEnv
+	if len(plan.Filters) != 0 || plan.hasExprs() {
+		env.Row = evalRow(values)
+	}
+	for _, filter := range plan.Filters {
+		ok, err := evalengine.EvaluateBool(filter, env)
+		if err != nil {
+			return false, nil, err
+		}
+		if !ok {
+			return false, nil, nil
+		}
+	}
+
 	result := make([]sqltypes.Value, len(plan.ColExprs))
 	for i, colExpr := range plan.ColExprs {
 		if colExpr.ColNum >= len(values) {
 			return false, nil, fmt.Errorf("index out of range, colExpr.ColNum: %d, len(values): %d", colExpr.ColNum, len(values))
 		}
-		if colExpr.Vindex == nil {
-			result[i] = values[colExpr.ColNum]
-		} else {
+		switch {
+		case colExpr.Vindex != nil:
 			ksid, err := getKeyspaceID(values, colExpr.Vindex, colExpr.VindexColumns)
 			if err != nil {
 				return false, nil, err
 			}
 			result[i] = sqltypes.MakeTrusted(sqltypes.VarBinary, []byte(ksid))
+		case colExpr.Expr != nil:
+			val, err := colExpr.Expr.Evaluate(env)
+			if err != nil {
+				return false, nil, err
+			}
+			result[i] = val
+		default:
+			result[i] = values[colExpr.ColNum]
 		}
 	}
 	return true, result, nil
 }
 
+func (plan *Plan) hasExprs() bool {
+	for _, colExpr := range plan.ColExprs {
+		if colExpr.Expr != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// evalRow returns the row against which the expressions of a plan
+// are evaluated. The values of text columns are passed as binary,
+// which evalengine knows how to handle. Comparisons of text columns,
+// which would then not follow the collation of the column, are
+// rejected by convertExpr.
+func evalRow(values []sqltypes.Value) []sqltypes.Value {
+	row := make([]sqltypes.Value, len(values))
+	for i, val := range values {
+		if val.IsText() {
+			val = sqltypes.MakeTrusted(sqltypes.VarBinary, val.ToBytes())
+		}
+		row[i] = val
+	}
+	return row
+}
+
 func getKeyspaceID(values []sqltypes.Value, vindex vindexes.Vindex, vindexColumns []int) (key.DestinationKeyspaceID, error) {
 	vindexValues := make([]sqltypes.Value, 0, len(vindexColumns))
 	for _, col := range vindexColumns {
@@ -280,15 +337,7 @@ func buildTablePlan(ti *Table, vschema *localVSchema, query string) (*Plan, erro
 	if sel.Where == nil {
 		return plan, nil
 	}
-
-	funcExpr, ok := sel.Where.Expr.(*sqlparser.FuncExpr)
-	if !ok {
-		return nil, fmt.Errorf("unsupported where clause: %v", sqlparser.String(sel.Where))
-	}
-	if !funcExpr.Name.EqualString("in_keyrange") {
-		return nil, fmt.Errorf("unsupported where clause: %v", sqlparser.String(sel.Where))
-	}
-	if err := plan.analyzeInKeyRange(vschema, funcExpr.Exprs); err != nil {
+	if err := plan.analyzeWhere(vschema, sel.Where.Expr); err != nil {
 		return nil, err
 	}
 	return plan, nil
@@ -365,7 +414,7 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 		}, nil
 	case *sqlparser.FuncExpr:
 		if inner.Name.Lowered() != "keyspace_id" {
-			return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			break
 		}
 		if len(inner.Exprs) != 0 {
 			return ColExpr{}, fmt.Errorf("unexpected: %v", sqlparser.String(inner))
@@ -384,9 +433,92 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			Alias:         sqlparser.NewColIdent("keyspace_id"),
 			Type:          sqltypes.VarBinary,
 		}, nil
-	default:
-		return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
 	}
+	// Other expressions are computed from the columns of the row.
+	expr, err := plan.convertExpr(aliased.Expr)
+	if err != nil {
+		return ColExpr{}, err
+	}
+	as := aliased.As
+	if as.IsEmpty() {
+		as = sqlparser.NewColIdent(sqlparser.String(aliased.Expr))
+	}
+	return ColExpr{
+		Expr:  expr,
+		Alias: as,
+		Type:  expr.Type(plan.Table.Fields),
+	}, nil
+}
+
+// analyzeWhere splits the where clause into its AND conditions.
+// An in_keyrange condition sets the Vindex and KeyRange of the plan.
+// The other conditions are added to the Filters. Examples:
+// "where in_keyrange('-80') and tenant_id = 42",
+// "where deleted = 0 and region in (1, 2) and created >= '2019-01-01'".
+func (plan *Plan) analyzeWhere(vschema *localVSchema, where sqlparser.Expr) error {
+	for _, expr := range sqlparser.SplitAndExpression(nil, where) {
+		if funcExpr, ok := expr.(*sqlparser.FuncExpr); ok && funcExpr.Name.EqualString("in_keyrange") {
+			if plan.Vindex != nil {
+				return fmt.Errorf("unsupported: more than one in_keyrange: %v", sqlparser.String(where))
+			}
+			if err := plan.analyzeInKeyRange(vschema, funcExpr.Exprs); err != nil {
+				return err
+			}
+			continue
+		}
+		filter, err := plan.convertExpr(expr)
+		if err != nil {
+			return err
+		}
+		plan.Filters = append(plan.Filters, filter)
+	}
+	return nil
+}
+
+// convertExpr converts expr into an expression that's
+// evaluated against the columns of the table.
+// Comparisons on text columns are not supported: MySQL compares
+// text in the collation of the column, like "name = 'abc'" matching
+// 'ABC' in a case insensitive collation, but evalengine only compares
+// bytes. Binary columns, and the other types, can be compared.
+func (plan *Plan) convertExpr(expr sqlparser.Expr) (evalengine.Expr, error) {
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		cmp, ok := node.(*sqlparser.ComparisonExpr)
+		if !ok {
+			return true, nil
+		}
+		return false, sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+			col, ok := node.(*sqlparser.ColName)
+			if !ok {
+				return true, nil
+			}
+			colnum, err := findColumn(plan.Table, col.Name)
+			if err != nil {
+				return false, err
+			}
+			if sqltypes.IsText(plan.Table.Fields[colnum].Type) {
+				return false, fmt.Errorf("unsupported: comparison on text column %s: %v", sqlparser.String(col), sqlparser.String(cmp))
+			}
+			return true, nil
+		}, cmp)
+	}, expr)
+	if err != nil {
+		return nil, err
+	}
+	return evalengine.Convert(expr, func(node sqlparser.Expr) (int, bool, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return 0, false, nil
+		}
+		if !col.Qualifier.IsEmpty() {
+			return 0, false, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(col))
+		}
+		colnum, err := findColumn(plan.Table, col.Name)
+		if err != nil {
+			return 0, false, err
+		}
+		return colnum, true, nil
+	})
 }
 
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
@@ -25,6 +25,7 @@ import (
 	"github.com/xsec-lab/go/mysql"
 	"github.com/xsec-lab/go/sqltypes"
 	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/vtgate/evalengine"
 	"github.com/xsec-lab/go/vt/vtgate/vindexes"
 
 	binlogdatapb "github.com/xsec-lab/go/vt/proto/binlogdata"
@@ -319,6 +320,78 @@ func TestPlanbuilder(t *testing.T) {
 			}},
 			VindexColumns: []int{0},
 		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id = 1"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Alias:  sqlparser.NewColIdent("id"),
+				Type:   sqltypes.Int64,
+			}, {
+				ColNum: 1,
+				Alias:  sqlparser.NewColIdent("val"),
+				Type:   sqltypes.VarBinary,
+			}},
+			Filters: []evalengine.Expr{
+				&evalengine.ComparisonExpr{
+					Op:    evalengine.Equal,
+					Left:  &evalengine.Column{Offset: 0},
+					Right: &evalengine.Literal{Val: sqltypes.NewInt64(1)},
+				},
+			},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id from t1 where val in ('a', 'b') and in_keyrange('-80') and id > 10"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Alias:  sqlparser.NewColIdent("id"),
+				Type:   sqltypes.Int64,
+			}},
+			VindexColumns: []int{0},
+			Filters: []evalengine.Expr{
+				&evalengine.InExpr{
+					Left: &evalengine.Column{Offset: 1},
+					Values: []evalengine.Expr{
+						&evalengine.Literal{Val: sqltypes.NewVarBinary("a")},
+						&evalengine.Literal{Val: sqltypes.NewVarBinary("b")},
+					},
+				},
+				&evalengine.ComparisonExpr{
+					Op:    evalengine.GreaterThan,
+					Left:  &evalengine.Column{Offset: 0},
+					Right: &evalengine.Literal{Val: sqltypes.NewInt64(10)},
+				},
+			},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id + 1, val as v, id * 2 as id2 from t1"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				Expr: &evalengine.ArithmeticExpr{
+					Op:    evalengine.Add,
+					Left:  &evalengine.Column{Offset: 0},
+					Right: &evalengine.Literal{Val: sqltypes.NewInt64(1)},
+				},
+				Alias: sqlparser.NewColIdent("id + 1"),
+				Type:  sqltypes.Int64,
+			}, {
+				ColNum: 1,
+				Alias:  sqlparser.NewColIdent("v"),
+				Type:   sqltypes.VarBinary,
+			}, {
+				Expr: &evalengine.ArithmeticExpr{
+					Op:    evalengine.Multiply,
+					Left:  &evalengine.Column{Offset: 0},
+					Right: &evalengine.Literal{Val: sqltypes.NewInt64(2)},
+				},
+				Alias: sqlparser.NewColIdent("id2"),
+				Type:  sqltypes.Int64,
+			}},
+		},
 	}, {
 		inTable: t2,
 		inRule:  &binlogdatapb.Rule{Match: "/t1/"},
@@ -402,12 +475,24 @@ func TestPlanbuilder(t *testing.T) {
 		outErr:  `unsupported: *, id`,
 	}, {
 		inTable: t1,
-		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id=1"},
-		outErr:  `unsupported where clause:  where id = 1`,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id like 'a%'"},
+		outErr:  `unsupported: expression id like 'a%' cannot be evaluated`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where max(id)"},
-		outErr:  `unsupported where clause:  where max(id)`,
+		outErr:  `unsupported: function max(id) cannot be evaluated`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where none = 1"},
+		outErr:  `column none not found in table t1`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where t1.id = 1"},
+		outErr:  `unsupported qualifier for column: t1.id`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange('-80') and in_keyrange('80-')"},
+		outErr:  `unsupported: more than one in_keyrange: in_keyrange('-80') and in_keyrange('80-')`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange(id)"},
@@ -444,11 +529,15 @@ func TestPlanbuilder(t *testing.T) {
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val, max(val) from t1"},
-		outErr:  `unsupported function: max(val)`,
+		outErr:  `unsupported: function max(val) cannot be evaluated`,
 	}, {
 		inTable: t1,
-		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1"},
-		outErr:  `unsupported: id + 1`,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val, (select 1 from dual) from t1"},
+		outErr:  `unsupported: expression (select 1 from dual) cannot be evaluated`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id + none from t1"},
+		outErr:  `column none not found in table t1`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select t1.id, val from t1"},
@@ -488,3 +577,102 @@ func TestPlanbuilder(t *testing.T) {
 
 	}
 }
+
+func TestPlanFilter(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "tenant_id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "name",
+			Type: sqltypes.VarChar,
+		}, {
+			Name: "code",
+			Type: sqltypes.VarBinary,
+		}},
+	}
+	row := func(id, tenant int64, name string) []sqltypes.Value {
+		return []sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewInt64(tenant), sqltypes.NewVarChar(name), sqltypes.NewVarBinary(name)}
+	}
+	testcases := []struct {
+		filter string
+		in     []sqltypes.Value
+		out    []sqltypes.Value
+	}{{
+		filter: "select * from t1 where tenant_id = 42",
+		in:     row(1, 42, "a"),
+		out:    row(1, 42, "a"),
+	}, {
+		filter: "select * from t1 where tenant_id = 42",
+		in:     row(1, 43, "a"),
+	}, {
+		filter: "select id from t1 where tenant_id in (1, 2) and id >= 10",
+		in:     row(10, 2, "a"),
+		out:    []sqltypes.Value{sqltypes.NewInt64(10)},
+	}, {
+		filter: "select id from t1 where tenant_id in (1, 2) and id >= 10",
+		in:     row(9, 2, "a"),
+	}, {
+		// Binary columns are compared by their bytes.
+		filter: "select id from t1 where code = 'abc'",
+		in:     row(1, 1, "abc"),
+		out:    []sqltypes.Value{sqltypes.NewInt64(1)},
+	}, {
+		filter: "select id from t1 where code = 'abc'",
+		in:     row(1, 1, "ABC"),
+	}, {
+		// NULL does not match.
+		filter: "select id from t1 where code != 'abc'",
+		in:     []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(1), sqltypes.NULL, sqltypes.NULL},
+	}, {
+		filter: "select id, tenant_id * 100 + id as seq, name from t1",
+		in:     row(3, 2, "a"),
+		out:    []sqltypes.Value{sqltypes.NewInt64(3), sqltypes.NewInt64(203), sqltypes.NewVarChar("a")},
+	}}
+	for _, tcase := range testcases {
+		plan, err := buildTablePlan(t1, testLocalVSchema, tcase.filter)
+		if err != nil {
+			t.Errorf("buildTablePlan(%s): %v", tcase.filter, err)
+			continue
+		}
+		ok, got, err := plan.filter(tcase.in)
+		if err != nil {
+			t.Errorf("filter(%s, %v): %v", tcase.filter, tcase.in, err)
+			continue
+		}
+		if !ok {
+			if tcase.out != nil {
+				t.Errorf("filter(%s, %v): no match, want %v", tcase.filter, tcase.in, tcase.out)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(got, tcase.out) {
+			t.Errorf("filter(%s, %v): %v, want %v", tcase.filter, tcase.in, got, tcase.out)
+		}
+	}
+
+	// Text columns can't be compared in their collation.
+	errcases := []struct {
+		filter string
+		err    string
+	}{{
+		filter: "select id from t1 where name = 'abc'",
+		err:    "unsupported: comparison on text column name: name = 'abc'",
+	}, {
+		filter: "select id from t1 where tenant_id = 1 and lower(name) in ('a', 'b')",
+		err:    "unsupported: comparison on text column name: lower(name) in ('a', 'b')",
+	}, {
+		filter: "select id, name > 'm' as late from t1",
+		err:    "unsupported: comparison on text column name: name > 'm'",
+	}}
+	for _, tcase := range errcases {
+		_, err := buildTablePlan(t1, testLocalVSchema, tcase.filter)
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("buildTablePlan(%s): %v, want %s", tcase.filter, err, tcase.err)
+		}
+	}
+}
@@ -101,15 +101,20 @@ type streamerPlan struct {
 // startPos: a flavor compliant position to stream from. This can also contain the special
 //   value "current", which means start from the current position.
 // filter: the list of filtering rules. If a rule has a select expressinon for its filter,
-//   the select list can only reference direct columns. No other experssions are allowed.
-//   The select expression is allowed to contain the special 'keyspace_id()' function which
-//   will return the keyspace id of the row. Examples:
+//   the select list can reference columns, or expressions on columns that can be evaluated
+//   by the evalengine. The select expression is allowed to contain the special 'keyspace_id()'
+//   function which will return the keyspace id of the row. Examples:
 //   "select * from t", same as an empty Filter,
 //   "select * from t where in_keyrange('-80')", same as "-80",
 //   "select * from t where in_keyrange(col1, 'hash', '-80')",
+//   "select * from t where tenant_id = 42 and deleted = 0",
 //   "select col1, col2 from t where...",
+//   "select col1, col2 * 100 as col3 from t where...",
 //   "select col1, keyspace_id() from t where...".
-//   Only "in_keyrange" expressions are supported in the where clause.
+//   The where clause can be an AND of one "in_keyrange" expression and of other
+//   conditions on the columns, like comparisons or IN lists. The values are compared
+//   as numbers or as bytes, so comparisons on text columns, which MySQL would do in the
+//   collation of the column, are not supported.
 //   Other constructs like joins, group by, etc. are not supported.
 // vschema: the current vschema. This value can later be changed through the SetVSchema method.
 // send: callback function to send events.
@@ -617,33 +622,55 @@ func (vs *vstreamer) buildTableColumns(id uint64, tm *mysql.TableMap) ([]*queryp
 		})
 	}
 
-	st := vs.se.GetTable(sqlparser.NewTableIdent(tm.Name))
-	if st == nil {
+	stFields, err := vs.tableFieldsForPos(tm.Name)
+	if err != nil {
+		return nil, err
+	}
+	if stFields == nil {
 		if vs.filter.FieldEventMode == binlogdatapb.Filter_ERR_ON_MISMATCH {
 			return nil, fmt.Errorf("unknown table %v in schema", tm.Name)
 		}
 		return fields, nil
 	}
 
-	if len(st.Fields) < len(tm.Types) {
+	if len(stFields) < len(tm.Types) {
 		if vs.filter.FieldEventMode == binlogdatapb.Filter_ERR_ON_MISMATCH {
-			return nil, fmt.Errorf("cannot determine table columns for %s: event has %v, schema as %v", tm.Name, tm.Types, st.Fields)
+			return nil, fmt.Errorf("cannot determine table columns for %s: event has %v, schema as %v", tm.Name, tm.Types, stFields)
 		}
 		return fields, nil
 	}
 
 	// check if the schema returned by schema.Engine matches with row.
 	for i := range tm.Types {
-		if !sqltypes.AreTypesEquivalent(fields[i].Type, st.Fields[i].Type) {
+		if !sqltypes.AreTypesEquivalent(fields[i].Type, stFields[i].Type) {
 			return fields, nil
 		}
 	}
 
 	// Columns should be truncated to match those in tm.
-	fields = st.Fields[:len(tm.Types)]
+	fields = stFields[:len(tm.Types)]
 	return fields, nil
 }
 
+// tableFieldsForPos returns the fields of the table as of the current
+// position. The schema versions recorded in _vt.schema_version are used
+// if the table changed since then. Otherwise, the current schema is used.
+// It returns nil if the table is not found.
+func (vs *vstreamer) tableFieldsForPos(tableName string) ([]*querypb.Field, error) {
+	mt, err := vs.se.GetTableForPos(vs.ctx, sqlparser.NewTableIdent(tableName), vs.pos)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "could not get the schema of %s as of %v", tableName, vs.pos)
+	}
+	if mt != nil {
+		return mt.Fields, nil
+	}
+	st := vs.se.GetTable(sqlparser.NewTableIdent(tableName))
+	if st == nil {
+		return nil, nil
+	}
+	return st.Fields, nil
+}
+
 func (vs *vstreamer) processJounalEvent(vevents []*binlogdatapb.VEvent, plan *streamerPlan, rows mysql.Rows) ([]*binlogdatapb.VEvent, error) {
 	// Get DbName
 	params, err := vs.cp.MysqlParams()
@@ -0,0 +1,711 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/key"
+	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/topo"
+	"github.com/xsec-lab/go/vt/vterrors"
+	"github.com/xsec-lab/go/vt/vtgate/engine"
+	"github.com/xsec-lab/go/vt/vtgate/vindexes"
+)
+
+// recheckMaxRows is the maximum number of owner or lookup rows
+// that a from value can have when it's read again before a repair.
+const recheckMaxRows = 10000
+
+// lookupVerifier contains the metadata for verifying one owned lookup vindex.
+// It streams the owner table and the lookup table, and compares the
+// keyspace ids computed from the owner rows with the ones stored in
+// the lookup table. The rows are compared by their 'from' values:
+// each from value is one row of the DiffReport.
+type lookupVerifier struct {
+	wr             *Wrangler
+	vindexName     string
+	ownerKeyspace  string
+	ownerTable     string
+	lookupKeyspace string
+	lookupTable    string
+	fromCols       []string
+	toCol          string
+
+	// ownerCols are the columns of the owner table that
+	// the lookup vindex is defined on.
+	ownerCols []string
+	// primary is the primary vindex of the owner table. It
+	// computes the keyspace ids of the owner rows. Its columns
+	// follow the from columns in the owner query.
+	primary *vindexes.ColumnVindex
+	// lookupPrimary is the primary vindex of the lookup table.
+	// It's nil if the lookup keyspace is not sharded. It's used to
+	// find the lookup shard that a repair must be written to.
+	lookupPrimary *vindexes.ColumnVindex
+
+	// ownerPKs and lookupPKs are the columns of the owner and
+	// lookup rows that the from values are compared on.
+	ownerPKs  []int
+	lookupPKs []int
+
+	ownerQuery  string
+	lookupQuery string
+
+	// The key for owners and lookups is the shard name.
+	owners       map[string]*shardStreamer
+	lookups      map[string]*shardStreamer
+	lookupShards []*topo.ShardInfo
+}
+
+// VerifyLookupVindex compares an owned lookup vindex with its owner table,
+// and reports the from values that are missing from the lookup table,
+// the ones that have no owner row, and the ones that map to different
+// keyspace ids. If repair is set, the lookup table is updated to match
+// the owner table.
+// The owner and lookup tables are not streamed from a consistent snapshot.
+// The writes that happen during the verification can show up as differences.
+// This is why every difference is read again from the masters, with point
+// queries on the owner and lookup tables, before being repaired.
+func (wr *Wrangler) VerifyLookupVindex(ctx context.Context, keyspace, vindexName, cell, tabletTypesStr string, repair bool,
+	healthcheckTopologyRefresh, healthcheckRetryDelay, healthcheckTimeout time.Duration) (*DiffReport, error) {
+	if cell == "" {
+		cells, err := wr.ts.GetCellInfoNames(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(cells) == 0 {
+			// Unreachable
+			return nil, fmt.Errorf("there are no cells in the topo")
+		}
+		cell = cells[0]
+	}
+
+	lv, err := wr.buildLookupVerifier(ctx, keyspace, vindexName)
+	if err != nil {
+		return nil, err
+	}
+	err = forAll(lv.owners, func(shard string, owner *shardStreamer) error {
+		tablet, err := pickStreamingTablet(ctx, wr.ts, cell, lv.ownerKeyspace, shard, tabletTypesStr, healthcheckTopologyRefresh, healthcheckRetryDelay, healthcheckTimeout)
+		owner.tablet = tablet
+		return err
+	})
+	if err != nil {
+		return nil, vterrors.Wrap(err, "selectTablets(owners)")
+	}
+	err = forAll(lv.lookups, func(shard string, lookup *shardStreamer) error {
+		tablet, err := pickStreamingTablet(ctx, wr.ts, cell, lv.lookupKeyspace, shard, tabletTypesStr, healthcheckTopologyRefresh, healthcheckRetryDelay, healthcheckTimeout)
+		lookup.tablet = tablet
+		return err
+	})
+	if err != nil {
+		return nil, vterrors.Wrap(err, "selectTablets(lookups)")
+	}
+
+	// We need a cancelable context to abort all running streams
+	// if one stream returns an error.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ownerExecutor := newPrimitiveExecutor(ctx, lv.startStreams(ctx, lv.ownerKeyspace, lv.owners, lv.ownerQuery, lv.ownerPKs))
+	lookupExecutor := newPrimitiveExecutor(ctx, lv.startStreams(ctx, lv.lookupKeyspace, lv.lookups, lv.lookupQuery, lv.lookupPKs))
+	dr, err := lv.diff(ctx, &rowGrouper{pe: ownerExecutor, cols: lv.ownerPKs}, &rowGrouper{pe: lookupExecutor, cols: lv.lookupPKs}, repair)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "diff")
+	}
+	wr.Logger().Printf("Summary for %v.%v: %+v\n", keyspace, vindexName, *dr)
+	return dr, nil
+}
+
+// buildLookupVerifier validates the vindex and builds the queries
+// for the owner and lookup tables.
+func (wr *Wrangler) buildLookupVerifier(ctx context.Context, keyspace, vindexName string) (*lookupVerifier, error) {
+	vschema, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	vindex, ok := vschema.Vindexes[vindexName]
+	if !ok {
+		return nil, fmt.Errorf("vindex %s not found in keyspace %s", vindexName, keyspace)
+	}
+	if !strings.Contains(vindex.Type, "lookup") {
+		return nil, fmt.Errorf("vindex %s is not a lookup type", vindexName)
+	}
+	if vindex.Owner == "" {
+		return nil, fmt.Errorf("vindex %s has no owner", vindexName)
+	}
+	strs := strings.Split(vindex.Params["table"], ".")
+	if len(strs) != 2 {
+		return nil, fmt.Errorf("vindex 'table' must be <keyspace>.<table>: %v", vindex)
+	}
+	lv := &lookupVerifier{
+		wr:             wr,
+		vindexName:     vindexName,
+		ownerKeyspace:  keyspace,
+		ownerTable:     vindex.Owner,
+		lookupKeyspace: strs[0],
+		lookupTable:    strs[1],
+		toCol:          vindex.Params["to"],
+		owners:         make(map[string]*shardStreamer),
+		lookups:        make(map[string]*shardStreamer),
+	}
+	for _, col := range strings.Split(vindex.Params["from"], ",") {
+		lv.fromCols = append(lv.fromCols, strings.TrimSpace(col))
+	}
+
+	ownerKSSchema, err := vindexes.BuildKeyspaceSchema(vschema, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	owner := ownerKSSchema.Tables[lv.ownerTable]
+	if owner == nil || len(owner.ColumnVindexes) == 0 {
+		return nil, fmt.Errorf("owner table %s has no primary vindex", lv.ownerTable)
+	}
+	lv.primary = owner.ColumnVindexes[0]
+	if lv.primary.Vindex.NeedsVCursor() {
+		return nil, fmt.Errorf("primary vindex %s of table %s cannot be a lookup vindex", lv.primary.Name, lv.ownerTable)
+	}
+	for _, cv := range owner.ColumnVindexes {
+		if cv.Name != vindexName {
+			continue
+		}
+		for _, col := range cv.Columns {
+			lv.ownerCols = append(lv.ownerCols, col.String())
+		}
+	}
+	if len(lv.ownerCols) != len(lv.fromCols) {
+		return nil, fmt.Errorf("columns of vindex %s in table %s do not match its 'from' columns: %v vs %v", vindexName, lv.ownerTable, lv.ownerCols, lv.fromCols)
+	}
+
+	lookupVSchema, err := wr.ts.GetVSchema(ctx, lv.lookupKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	if lookupVSchema.Sharded {
+		lookupKSSchema, err := vindexes.BuildKeyspaceSchema(lookupVSchema, lv.lookupKeyspace)
+		if err != nil {
+			return nil, err
+		}
+		lookup := lookupKSSchema.Tables[lv.lookupTable]
+		if lookup == nil || len(lookup.ColumnVindexes) == 0 {
+			return nil, fmt.Errorf("lookup table %s has no primary vindex", lv.lookupTable)
+		}
+		lv.lookupPrimary = lookup.ColumnVindexes[0]
+		if lv.lookupPrimary.Vindex.NeedsVCursor() {
+			return nil, fmt.Errorf("primary vindex %s of table %s cannot be a lookup vindex", lv.lookupPrimary.Name, lv.lookupTable)
+		}
+		for _, col := range lv.lookupPrimary.Columns {
+			if lv.fromIndex(col.String()) == -1 {
+				return nil, fmt.Errorf("primary vindex column %v of table %s is not a 'from' column", col, lv.lookupTable)
+			}
+		}
+	}
+
+	ownerShards, err := wr.ts.GetServingShards(ctx, lv.ownerKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	for _, si := range ownerShards {
+		if si.MasterAlias == nil {
+			return nil, fmt.Errorf("owner shard has no master: %v", si.ShardName())
+		}
+		master, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+		if err != nil {
+			return nil, err
+		}
+		lv.owners[si.ShardName()] = &shardStreamer{master: master}
+	}
+	lv.lookupShards, err = wr.ts.GetServingShards(ctx, lv.lookupKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	for _, si := range lv.lookupShards {
+		if si.MasterAlias == nil {
+			return nil, fmt.Errorf("lookup shard has no master: %v", si.ShardName())
+		}
+		master, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+		if err != nil {
+			return nil, err
+		}
+		lv.lookups[si.ShardName()] = &shardStreamer{master: master}
+	}
+
+	schm, err := wr.GetSchema(ctx, ownerShards[0].MasterAlias, []string{lv.ownerTable}, nil, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "GetSchema")
+	}
+	if len(schm.TableDefinitions) != 1 {
+		return nil, fmt.Errorf("unexpected number of tables returned from schema: %v", schm.TableDefinitions)
+	}
+	fields := make(map[string]bool)
+	for _, field := range schm.TableDefinitions[0].Fields {
+		fields[strings.ToLower(field.Name)] = sqltypes.IsText(field.Type)
+	}
+	isText := make([]bool, len(lv.ownerCols))
+	for i, col := range lv.ownerCols {
+		text, ok := fields[strings.ToLower(col)]
+		if !ok {
+			return nil, fmt.Errorf("column %v not found in table %v", col, lv.ownerTable)
+		}
+		isText[i] = text
+	}
+	lv.buildQueries(isText)
+	return lv, nil
+}
+
+// buildQueries builds the owner and lookup queries. The owner query is:
+//   select <owner cols> as <from cols>, <primary vindex cols> from <owner> order by <from cols>
+// The lookup query is:
+//   select <from cols>, <to> from <lookup> order by <from cols>
+// For text columns, the weight strings of the from values are
+// additionally selected, and the rows are compared on them.
+func (lv *lookupVerifier) buildQueries(isText []bool) {
+	ownerSelect := &sqlparser.Select{}
+	lookupSelect := &sqlparser.Select{}
+	var orderby sqlparser.OrderBy
+	for i, col := range lv.fromCols {
+		ownerSelect.SelectExprs = append(ownerSelect.SelectExprs, &sqlparser.AliasedExpr{
+			Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(lv.ownerCols[i])},
+			As:   sqlparser.NewColIdent(col),
+		})
+		lookupSelect.SelectExprs = append(lookupSelect.SelectExprs, &sqlparser.AliasedExpr{
+			Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(col)},
+		})
+		orderby = append(orderby, &sqlparser.Order{
+			Expr:      &sqlparser.ColName{Name: sqlparser.NewColIdent(col)},
+			Direction: sqlparser.AscScr,
+		})
+	}
+	for _, col := range lv.primary.Columns {
+		ownerSelect.SelectExprs = append(ownerSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: col}})
+	}
+	lookupSelect.SelectExprs = append(lookupSelect.SelectExprs, &sqlparser.AliasedExpr{
+		Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(lv.toCol)},
+	})
+
+	lv.ownerPKs = make([]int, len(lv.fromCols))
+	lv.lookupPKs = make([]int, len(lv.fromCols))
+	for i := range lv.fromCols {
+		lv.ownerPKs[i] = i
+		lv.lookupPKs[i] = i
+		if isText[i] {
+			ownerSelect.SelectExprs = append(ownerSelect.SelectExprs, wrapWeightString(ownerSelect.SelectExprs[i]))
+			lookupSelect.SelectExprs = append(lookupSelect.SelectExprs, wrapWeightString(lookupSelect.SelectExprs[i]))
+			lv.ownerPKs[i] = len(ownerSelect.SelectExprs) - 1
+			lv.lookupPKs[i] = len(lookupSelect.SelectExprs) - 1
+		}
+	}
+
+	ownerSelect.From = sqlparser.TableExprs{
+		&sqlparser.AliasedTableExpr{Expr: &sqlparser.TableName{Name: sqlparser.NewTableIdent(lv.ownerTable)}},
+	}
+	lookupSelect.From = sqlparser.TableExprs{
+		&sqlparser.AliasedTableExpr{Expr: &sqlparser.TableName{Name: sqlparser.NewTableIdent(lv.lookupTable)}},
+	}
+	ownerSelect.OrderBy = orderby
+	lookupSelect.OrderBy = orderby
+	lv.ownerQuery = sqlparser.String(ownerSelect)
+	lv.lookupQuery = sqlparser.String(lookupSelect)
+}
+
+// startStreams starts the query streams of the participants, and returns
+// the primitive that merges their results.
+func (lv *lookupVerifier) startStreams(ctx context.Context, keyspace string, participants map[string]*shardStreamer, query string, comparePKs []int) engine.Primitive {
+	for shard, participant := range participants {
+		participant.result = make(chan *sqltypes.Result, 1)
+		// The snapshot gtid is not used.
+		go streamOne(ctx, keyspace, shard, participant, query, make(chan string, 1))
+	}
+	return newMergeSorter(participants, comparePKs)
+}
+
+// diff compares the owner and lookup rows, one from value at a time.
+func (lv *lookupVerifier) diff(ctx context.Context, owners, lookups *rowGrouper, repair bool) (*DiffReport, error) {
+	dr := &DiffReport{}
+	var ownerRows, lookupRows [][]sqltypes.Value
+	var err error
+	advanceOwner := true
+	advanceLookup := true
+	for {
+		if advanceOwner {
+			if ownerRows, err = owners.next(); err != nil {
+				return nil, err
+			}
+		}
+		if advanceLookup {
+			if lookupRows, err = lookups.next(); err != nil {
+				return nil, err
+			}
+		}
+		if ownerRows == nil && lookupRows == nil {
+			return dr, nil
+		}
+		advanceOwner = true
+		advanceLookup = true
+
+		// ownerGroup and lookupGroup are the rows of the from value being compared.
+		ownerGroup, lookupGroup := ownerRows, lookupRows
+		c := 0
+		switch {
+		case ownerRows == nil:
+			c = 1
+		case lookupRows == nil:
+			c = -1
+		default:
+			if c, err = compareRows(ownerRows[0], lv.ownerPKs, lookupRows[0], lv.lookupPKs); err != nil {
+				return nil, err
+			}
+		}
+		switch {
+		case c < 0:
+			advanceLookup = false
+			lookupGroup = nil
+		case c > 0:
+			advanceOwner = false
+			ownerGroup = nil
+		}
+		if ownerGroup != nil && hasNull(ownerGroup[0][:len(lv.fromCols)]) {
+			// Null values are not stored in the lookup table.
+			continue
+		}
+
+		dr.ProcessedRows++
+		switch {
+		case c < 0:
+			if dr.ExtraRowsSource < 10 {
+				lv.wr.Logger().Errorf("[vindex=%v] Missing lookup row %v: %v", lv.vindexName, dr.ExtraRowsSource, ownerGroup[0][:len(lv.fromCols)])
+			}
+			dr.ExtraRowsSource++
+		case c > 0:
+			if dr.ExtraRowsTarget < 10 {
+				lv.wr.Logger().Errorf("[vindex=%v] Extra lookup row %v: %v", lv.vindexName, dr.ExtraRowsTarget, lookupGroup[0][:len(lv.fromCols)])
+			}
+			dr.ExtraRowsTarget++
+		}
+
+		want, err := lv.ownerKeyspaceIDs(ownerGroup)
+		if err != nil {
+			return nil, err
+		}
+		have := lv.lookupKeyspaceIDs(lookupGroup)
+		if c == 0 {
+			if equalKeyspaceIDs(want, have) {
+				dr.MatchingRows++
+				continue
+			}
+			if dr.MismatchedRows < 10 {
+				lv.wr.Logger().Errorf("[vindex=%v] Different keyspace ids %v for %v: %v != %v", lv.vindexName, dr.MismatchedRows, ownerGroup[0][:len(lv.fromCols)], want, have)
+			}
+			dr.MismatchedRows++
+		}
+		if !repair {
+			continue
+		}
+		fromGroup := ownerGroup
+		if fromGroup == nil {
+			fromGroup = lookupGroup
+		}
+		if err := lv.repair(ctx, fromGroup[0][:len(lv.fromCols)]); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ownerKeyspaceIDs computes the keyspace ids of the owner rows.
+func (lv *lookupVerifier) ownerKeyspaceIDs(rows [][]sqltypes.Value) (map[string]bool, error) {
+	ksids := make(map[string]bool)
+	if len(rows) == 0 {
+		return ksids, nil
+	}
+	start := len(lv.fromCols)
+	rowsColValues := make([][]sqltypes.Value, 0, len(rows))
+	for _, row := range rows {
+		rowsColValues = append(rowsColValues, row[start:start+len(lv.primary.Columns)])
+	}
+	destinations, err := vindexes.Map(lv.primary.Vindex, nil, rowsColValues)
+	if err != nil {
+		return nil, err
+	}
+	for _, dest := range destinations {
+		ksid, ok := dest.(key.DestinationKeyspaceID)
+		if !ok {
+			return nil, fmt.Errorf("primary vindex %s of table %s did not map to a keyspace id: %v", lv.primary.Name, lv.ownerTable, dest)
+		}
+		ksids[string(ksid)] = true
+	}
+	return ksids, nil
+}
+
+// lookupKeyspaceIDs returns the keyspace ids stored in the lookup rows.
+func (lv *lookupVerifier) lookupKeyspaceIDs(rows [][]sqltypes.Value) map[string]bool {
+	ksids := make(map[string]bool)
+	for _, row := range rows {
+		ksids[row[len(lv.fromCols)].ToString()] = true
+	}
+	return ksids
+}
+
+// repair deletes the lookup rows of the from values that do not
+// map to a wanted keyspace id, and inserts the missing ones. The
+// owner and lookup rows are read again from the masters first,
+// and nothing is written if they don't differ anymore.
+func (lv *lookupVerifier) repair(ctx context.Context, from []sqltypes.Value) error {
+	master, err := lv.lookupMaster(from)
+	if err != nil {
+		return err
+	}
+	want, have, err := lv.recheck(ctx, from, master)
+	if err != nil {
+		return err
+	}
+	if equalKeyspaceIDs(want, have) {
+		lv.wr.Logger().Infof("[vindex=%v] Lookup rows for %v match the owner rows on the masters, not repaired", lv.vindexName, from)
+		return nil
+	}
+	var queries []string
+	for _, ksid := range sortedKeyspaceIDs(have) {
+		if want[ksid] {
+			continue
+		}
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("delete from %v where ", sqlparser.NewTableIdent(lv.lookupTable))
+		for i, col := range lv.fromCols {
+			buf.Myprintf("%v = ", sqlparser.NewColIdent(col))
+			from[i].EncodeSQL(buf)
+			buf.Myprintf(" and ")
+		}
+		buf.Myprintf("%v = ", sqlparser.NewColIdent(lv.toCol))
+		sqltypes.MakeTrusted(sqltypes.VarBinary, []byte(ksid)).EncodeSQL(buf)
+		queries = append(queries, buf.String())
+	}
+	for _, ksid := range sortedKeyspaceIDs(want) {
+		if have[ksid] {
+			continue
+		}
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("insert into %v(", sqlparser.NewTableIdent(lv.lookupTable))
+		for _, col := range lv.fromCols {
+			buf.Myprintf("%v, ", sqlparser.NewColIdent(col))
+		}
+		buf.Myprintf("%v) values (", sqlparser.NewColIdent(lv.toCol))
+		for i := range lv.fromCols {
+			from[i].EncodeSQL(buf)
+			buf.Myprintf(", ")
+		}
+		sqltypes.MakeTrusted(sqltypes.VarBinary, []byte(ksid)).EncodeSQL(buf)
+		buf.Myprintf(")")
+		queries = append(queries, buf.String())
+	}
+	for _, query := range queries {
+		if _, err := lv.wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), 0, false, false); err != nil {
+			return vterrors.Wrapf(err, "repair %s", query)
+		}
+	}
+	return nil
+}
+
+// recheck reads the owner and lookup rows of the from values from the
+// masters, and returns the keyspace ids that they map to. The owner
+// rows can be in any shard of the owner keyspace.
+func (lv *lookupVerifier) recheck(ctx context.Context, from []sqltypes.Value, lookupMaster *topo.TabletInfo) (want, have map[string]bool, err error) {
+	ownerSelect := append(append([]string(nil), lv.ownerCols...), columnNames(lv.primary.Columns)...)
+	ownerQuery := pointQuery(lv.ownerTable, ownerSelect, lv.ownerCols, from)
+	var ownerRows [][]sqltypes.Value
+	for _, owner := range lv.owners {
+		qr, err := lv.fetch(ctx, owner.master, ownerQuery)
+		if err != nil {
+			return nil, nil, err
+		}
+		ownerRows = append(ownerRows, qr.Rows...)
+	}
+	if want, err = lv.ownerKeyspaceIDs(ownerRows); err != nil {
+		return nil, nil, err
+	}
+
+	lookupSelect := append(append([]string(nil), lv.fromCols...), lv.toCol)
+	qr, err := lv.fetch(ctx, lookupMaster, pointQuery(lv.lookupTable, lookupSelect, lv.fromCols, from))
+	if err != nil {
+		return nil, nil, err
+	}
+	return want, lv.lookupKeyspaceIDs(qr.Rows), nil
+}
+
+func (lv *lookupVerifier) fetch(ctx context.Context, master *topo.TabletInfo, query string) (*sqltypes.Result, error) {
+	qr, err := lv.wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), recheckMaxRows, false, false)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "recheck %s", query)
+	}
+	return sqltypes.Proto3ToResult(qr), nil
+}
+
+// pointQuery returns the query that selects the selectCols of the
+// rows of table whose whereCols are equal to the values.
+func pointQuery(table string, selectCols, whereCols []string, values []sqltypes.Value) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	for i, col := range selectCols {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(col))
+	}
+	buf.Myprintf(" from %v where ", sqlparser.NewTableIdent(table))
+	for i, col := range whereCols {
+		if i != 0 {
+			buf.Myprintf(" and ")
+		}
+		buf.Myprintf("%v = ", sqlparser.NewColIdent(col))
+		values[i].EncodeSQL(buf)
+	}
+	return buf.String()
+}
+
+func columnNames(cols []sqlparser.ColIdent) []string {
+	names := make([]string, 0, len(cols))
+	for _, col := range cols {
+		names = append(names, col.String())
+	}
+	return names
+}
+
+// lookupMaster returns the master of the lookup shard that stores the from values.
+func (lv *lookupVerifier) lookupMaster(from []sqltypes.Value) (*topo.TabletInfo, error) {
+	if lv.lookupPrimary == nil {
+		// The lookup keyspace is not sharded.
+		return lv.lookups[lv.lookupShards[0].ShardName()].master, nil
+	}
+	colValues := make([]sqltypes.Value, 0, len(lv.lookupPrimary.Columns))
+	for _, col := range lv.lookupPrimary.Columns {
+		colValues = append(colValues, from[lv.fromIndex(col.String())])
+	}
+	destinations, err := vindexes.Map(lv.lookupPrimary.Vindex, nil, [][]sqltypes.Value{colValues})
+	if err != nil {
+		return nil, err
+	}
+	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return nil, fmt.Errorf("primary vindex %s of table %s did not map to a keyspace id: %v", lv.lookupPrimary.Name, lv.lookupTable, destinations[0])
+	}
+	for _, si := range lv.lookupShards {
+		if key.KeyRangeContains(si.KeyRange, ksid) {
+			return lv.lookups[si.ShardName()].master, nil
+		}
+	}
+	return nil, fmt.Errorf("no lookup shard found for keyspace id %v", ksid)
+}
+
+func (lv *lookupVerifier) fromIndex(col string) int {
+	for i, from := range lv.fromCols {
+		if strings.EqualFold(from, col) {
+			return i
+		}
+	}
+	return -1
+}
+
+func hasNull(values []sqltypes.Value) bool {
+	for _, v := range values {
+		if v.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+func equalKeyspaceIDs(ksids1, ksids2 map[string]bool) bool {
+	if len(ksids1) != len(ksids2) {
+		return false
+	}
+	for ksid := range ksids1 {
+		if !ksids2[ksid] {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeyspaceIDs(ksids map[string]bool) []string {
+	out := make([]string, 0, len(ksids))
+	for ksid := range ksids {
+		out = append(out, ksid)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// compareRows compares the values of row1 and row2 in the specified columns.
+func compareRows(row1 []sqltypes.Value, cols1 []int, row2 []sqltypes.Value, cols2 []int) (int, error) {
+	for i := range cols1 {
+		c, err := sqltypes.NullsafeCompare(row1[cols1[i]], row2[cols2[i]])
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+//-----------------------------------------------------------------
+// rowGrouper
+
+// rowGrouper reads the rows of a primitiveExecutor in groups
+// of consecutive rows that have the same values in cols.
+type rowGrouper struct {
+	pe   *primitiveExecutor
+	cols []int
+	// pending is the first row of the next group.
+	pending []sqltypes.Value
+}
+
+// next returns the next group of rows, or nil if there are no more rows.
+func (rg *rowGrouper) next() ([][]sqltypes.Value, error) {
+	if rg.pending == nil {
+		row, err := rg.pe.next()
+		if err != nil || row == nil {
+			return nil, err
+		}
+		rg.pending = row
+	}
+	rows := [][]sqltypes.Value{rg.pending}
+	rg.pending = nil
+	for {
+		row, err := rg.pe.next()
+		if err != nil {
+			return nil, err
+		}
+		if row == nil {
+			return rows, nil
+		}
+		c, err := compareRows(rows[0], rg.cols, row, rg.cols)
+		if err != nil {
+			return nil, err
+		}
+		if c != 0 {
+			rg.pending = row
+			return rows, nil
+		}
+		rows = append(rows, row)
+	}
+}
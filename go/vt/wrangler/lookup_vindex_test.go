@@ -0,0 +1,208 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/key"
+	"github.com/xsec-lab/go/vt/sqlparser"
+	"github.com/xsec-lab/go/vt/vtgate/vindexes"
+
+	tabletmanagerdatapb "github.com/xsec-lab/go/vt/proto/tabletmanagerdata"
+	vschemapb "github.com/xsec-lab/go/vt/proto/vschema"
+)
+
+var lookupVerifyVSchema = &vschemapb.Keyspace{
+	Sharded: true,
+	Vindexes: map[string]*vschemapb.Vindex{
+		"id_hash": {
+			Type: "hash",
+		},
+		"lkp": {
+			Type: "lookup_unique",
+			Params: map[string]string{
+				"table": "target.lkp",
+				"from":  "c1",
+				"to":    "keyspace_id",
+			},
+			Owner: "t1",
+		},
+	},
+	Tables: map[string]*vschemapb.Table{
+		"t1": {
+			ColumnVindexes: []*vschemapb.ColumnVindex{{
+				Column: "id",
+				Name:   "id_hash",
+			}, {
+				Column: "c1",
+				Name:   "lkp",
+			}},
+		},
+	},
+}
+
+// hashKsid returns the hash keyspace id of id, and its sql encoding.
+func hashKsid(t *testing.T, id int64) (sqltypes.Value, string) {
+	t.Helper()
+	hash, err := vindexes.CreateVindex("hash", "hash", nil)
+	require.NoError(t, err)
+	dests, err := hash.(vindexes.SingleColumn).Map(nil, []sqltypes.Value{sqltypes.NewInt64(id)})
+	require.NoError(t, err)
+	v := sqltypes.MakeTrusted(sqltypes.VarBinary, dests[0].(key.DestinationKeyspaceID))
+	buf := &bytes.Buffer{}
+	v.EncodeSQL(buf)
+	return v, buf.String()
+}
+
+func TestVerifyLookupVindex(t *testing.T) {
+	env := newTestVDiffEnv([]string{"-80", "80-"}, []string{"0"}, "select * from t1", nil)
+	defer env.close()
+
+	ctx := context.Background()
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "source", lookupVerifyVSchema))
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "target", &vschemapb.Keyspace{}))
+	env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "t1",
+			Fields: sqltypes.MakeTestFields("id|c1", "int64|int64"),
+		}},
+	}
+
+	ksid1, _ := hashKsid(t, 1)
+	_, encoded2 := hashKsid(t, 2)
+	_, encoded3 := hashKsid(t, 3)
+	ksid4, _ := hashKsid(t, 4)
+	ksid5, _ := hashKsid(t, 5)
+	ksid9, encoded9 := hashKsid(t, 9)
+
+	ownerQuery := "select c1 as c1, id from t1 order by c1 asc"
+	ownerFields := sqltypes.MakeTestFields("c1|id", "int64|int64")
+	env.tablets[101].setResults(ownerQuery, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields,
+		"1|1",
+		"3|3",
+	))
+	env.tablets[111].setResults(ownerQuery, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields,
+		// A null value is not expected in the lookup table.
+		"null|6",
+		"2|2",
+		"4|4",
+	))
+	lookupFields := sqltypes.MakeTestFields("c1|keyspace_id", "int64|varbinary")
+	env.tablets[201].setResults("select c1, keyspace_id from lkp order by c1 asc", vdiffTargetMasterPosition, []*sqltypes.Result{{
+		Fields: lookupFields,
+	}, {
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt64(1), ksid1},
+			// Mismatched.
+			{sqltypes.NewInt64(2), ksid9},
+			{sqltypes.NewInt64(4), ksid4},
+			// Extra.
+			{sqltypes.NewInt64(5), ksid5},
+		},
+	}})
+
+	dr, err := env.wr.VerifyLookupVindex(ctx, "source", "lkp", env.cell, "replica", false, 1*time.Second, 1*time.Second, 1*time.Minute)
+	require.NoError(t, err)
+	want := &DiffReport{
+		ProcessedRows:   5,
+		MatchingRows:    2,
+		MismatchedRows:  1,
+		ExtraRowsSource: 1,
+		ExtraRowsTarget: 1,
+	}
+	assert.Equal(t, want, dr)
+	assert.Empty(t, env.tmc.dbaQueries)
+
+	// The differences are read again from the masters before being repaired.
+	env.tmc.setDBAResults(110, "select c1, id from t1 where c1 = 2", sqltypes.MakeTestResult(ownerFields, "2|2"))
+	env.tmc.setDBAResults(200, "select c1, keyspace_id from lkp where c1 = 2", &sqltypes.Result{
+		Fields: lookupFields,
+		Rows:   [][]sqltypes.Value{{sqltypes.NewInt64(2), ksid9}},
+	})
+	env.tmc.setDBAResults(100, "select c1, id from t1 where c1 = 3", sqltypes.MakeTestResult(ownerFields, "3|3"))
+	// The owner row of 5 was inserted after the owner shard was streamed.
+	env.tmc.setDBAResults(110, "select c1, id from t1 where c1 = 5", sqltypes.MakeTestResult(ownerFields, "5|5"))
+	env.tmc.setDBAResults(200, "select c1, keyspace_id from lkp where c1 = 5", &sqltypes.Result{
+		Fields: lookupFields,
+		Rows:   [][]sqltypes.Value{{sqltypes.NewInt64(5), ksid5}},
+	})
+
+	dr, err = env.wr.VerifyLookupVindex(ctx, "source", "lkp", env.cell, "replica", true, 1*time.Second, 1*time.Second, 1*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, want, dr)
+	// The repairs are written to the master of the lookup shard.
+	// 5 is not repaired since it doesn't differ anymore.
+	assert.Equal(t, map[int][]string{
+		100: {
+			"select c1, id from t1 where c1 = 2",
+			"select c1, id from t1 where c1 = 3",
+			"select c1, id from t1 where c1 = 5",
+		},
+		110: {
+			"select c1, id from t1 where c1 = 2",
+			"select c1, id from t1 where c1 = 3",
+			"select c1, id from t1 where c1 = 5",
+		},
+		200: {
+			"select c1, keyspace_id from lkp where c1 = 2",
+			fmt.Sprintf("delete from lkp where c1 = 2 and keyspace_id = %s", encoded9),
+			fmt.Sprintf("insert into lkp(c1, keyspace_id) values (2, %s)", encoded2),
+			"select c1, keyspace_id from lkp where c1 = 3",
+			fmt.Sprintf("insert into lkp(c1, keyspace_id) values (3, %s)", encoded3),
+			"select c1, keyspace_id from lkp where c1 = 5",
+		},
+	}, env.tmc.dbaQueries)
+}
+
+func TestVerifyLookupVindexErrors(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "select * from t1", nil)
+	defer env.close()
+
+	ctx := context.Background()
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "source", lookupVerifyVSchema))
+	_, err := env.wr.VerifyLookupVindex(ctx, "source", "none", env.cell, "replica", false, 1*time.Second, 1*time.Second, 1*time.Minute)
+	assert.EqualError(t, err, "vindex none not found in keyspace source")
+	_, err = env.wr.VerifyLookupVindex(ctx, "source", "id_hash", env.cell, "replica", false, 1*time.Second, 1*time.Second, 1*time.Minute)
+	assert.EqualError(t, err, "vindex id_hash is not a lookup type")
+}
+
+func TestLookupVerifierQueries(t *testing.T) {
+	lv := &lookupVerifier{
+		ownerTable:  "t1",
+		lookupTable: "lkp",
+		fromCols:    []string{"name", "c2"},
+		toCol:       "keyspace_id",
+		ownerCols:   []string{"textcol", "c2"},
+		primary: &vindexes.ColumnVindex{
+			Columns: []sqlparser.ColIdent{sqlparser.NewColIdent("id")},
+		},
+	}
+	lv.buildQueries([]bool{true, false})
+	assert.Equal(t, "select textcol as name, c2 as c2, id, weight_string(textcol) from t1 order by name asc, c2 asc", lv.ownerQuery)
+	assert.Equal(t, "select name, c2, keyspace_id, weight_string(name) from lkp order by name asc, c2 asc", lv.lookupQuery)
+	assert.Equal(t, []int{3, 1}, lv.ownerPKs)
+	assert.Equal(t, []int{3, 1}, lv.lookupPKs)
+}
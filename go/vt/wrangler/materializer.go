@@ -18,6 +18,7 @@ package wrangler
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"text/template"
@@ -49,7 +50,7 @@ type materializer struct {
 }
 
 // Migrate initiates a table migration.
-func (wr *Wrangler) Migrate(ctx context.Context, workflow, sourceKeyspace, targetKeyspace, tableSpecs, cell, tabletTypes string) error {
+func (wr *Wrangler) Migrate(ctx context.Context, workflow, sourceKeyspace, targetKeyspace, tableSpecs, cell, tabletTypes string, copyConcurrency int64) error {
 	var tables []string
 	var vschema *vschemapb.Keyspace
 	if strings.HasPrefix(tableSpecs, "{") {
@@ -98,11 +99,12 @@ func (wr *Wrangler) Migrate(ctx context.Context, workflow, sourceKeyspace, targe
 	}
 
 	ms := &vtctldatapb.MaterializeSettings{
-		Workflow:       workflow,
-		SourceKeyspace: sourceKeyspace,
-		TargetKeyspace: targetKeyspace,
-		Cell:           cell,
-		TabletTypes:    tabletTypes,
+		Workflow:        workflow,
+		SourceKeyspace:  sourceKeyspace,
+		TargetKeyspace:  targetKeyspace,
+		Cell:            cell,
+		TabletTypes:     tabletTypes,
+		CopyConcurrency: copyConcurrency,
 	}
 	for _, table := range tables {
 		buf := sqlparser.NewTrackedBuffer(nil)
@@ -524,6 +526,130 @@ func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName s
 	return wr.ts.SaveVSchema(ctx, sourceKeyspace, sourceVSchema)
 }
 
+// MaterializeReferenceTables sets up a Materialize workflow from the source
+// keyspace for every reference table with that source. If targetKeyspaces is
+// not empty, only those keyspaces are set up. The workflow of each table is
+// named reference_<table>. The tables whose workflow already exists are
+// skipped, which allows the tables added later to be set up.
+func (wr *Wrangler) MaterializeReferenceTables(ctx context.Context, sourceKeyspace string, targetKeyspaces []string, cell, tabletTypes string) error {
+	if len(targetKeyspaces) == 0 {
+		var err error
+		targetKeyspaces, err = wr.ts.GetKeyspaces(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	found := false
+	for _, targetKeyspace := range targetKeyspaces {
+		if targetKeyspace == sourceKeyspace {
+			continue
+		}
+		vschema, err := wr.ts.GetVSchema(ctx, targetKeyspace)
+		if err != nil {
+			if topo.IsErrType(err, topo.NoNode) {
+				continue
+			}
+			return err
+		}
+		var tables []string
+		for name, table := range vschema.Tables {
+			if table.Type == vindexes.TypeReference && table.Source == sourceKeyspace {
+				tables = append(tables, name)
+			}
+		}
+		sort.Strings(tables)
+
+		for _, table := range tables {
+			found = true
+			workflow := "reference_" + table
+			exists, err := wr.workflowExists(ctx, targetKeyspace, workflow)
+			if err != nil {
+				return err
+			}
+			if exists {
+				continue
+			}
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table))
+			ms := &vtctldatapb.MaterializeSettings{
+				Workflow:       workflow,
+				SourceKeyspace: sourceKeyspace,
+				TargetKeyspace: targetKeyspace,
+				Cell:           cell,
+				TabletTypes:    tabletTypes,
+				TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+					TargetTable:      table,
+					SourceExpression: buf.String(),
+					CreateDdl:        "copy",
+				}},
+			}
+			if err := wr.Materialize(ctx, ms); err != nil {
+				return vterrors.Wrapf(err, "Materialize(%s.%s)", targetKeyspace, table)
+			}
+			wr.Logger().Infof("Materializing reference table %s from %s to %s", table, sourceKeyspace, targetKeyspace)
+		}
+	}
+	if !found {
+		return fmt.Errorf("no reference tables with source %s found", sourceKeyspace)
+	}
+	return nil
+}
+
+// MaterializeReferenceTablesOf sets up the missing Materialize workflows
+// of the reference tables of the keyspace whose source is another keyspace.
+// It's called by ApplyVSchema, so that the copies are kept in sync as soon
+// as they're declared.
+func (wr *Wrangler) MaterializeReferenceTablesOf(ctx context.Context, keyspace, cell, tabletTypes string) error {
+	vschema, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	sources := make(map[string]bool)
+	for _, table := range vschema.Tables {
+		if table.Type == vindexes.TypeReference && table.Source != "" {
+			sources[table.Source] = true
+		}
+	}
+	var sourceKeyspaces []string
+	for source := range sources {
+		sourceKeyspaces = append(sourceKeyspaces, source)
+	}
+	sort.Strings(sourceKeyspaces)
+	for _, sourceKeyspace := range sourceKeyspaces {
+		if err := wr.MaterializeReferenceTables(ctx, sourceKeyspace, []string{keyspace}, cell, tabletTypes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// workflowExists returns true if the workflow has
+// streams on any master of the keyspace.
+func (wr *Wrangler) workflowExists(ctx context.Context, keyspace, workflow string) (bool, error) {
+	shards, err := wr.ts.GetServingShards(ctx, keyspace)
+	if err != nil {
+		return false, err
+	}
+	for _, si := range shards {
+		if si.MasterAlias == nil {
+			return false, fmt.Errorf("shard has no master: %v", si.ShardName())
+		}
+		master, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+		if err != nil {
+			return false, err
+		}
+		query := fmt.Sprintf("select 1 from _vt.vreplication where db_name=%s and workflow=%s", encodeString(master.DbName()), encodeString(workflow))
+		qr, err := wr.tmc.VReplicationExec(ctx, master.Tablet, query)
+		if err != nil {
+			return false, err
+		}
+		if len(qr.Rows) != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // Materialize performs the steps needed to materialize a list of tables based on the materialization specs.
 func (wr *Wrangler) Materialize(ctx context.Context, ms *vtctldatapb.MaterializeSettings) error {
 	if err := wr.validateNewWorkflow(ctx, ms.TargetKeyspace, ms.Workflow); err != nil {
@@ -633,10 +759,11 @@ func (mz *materializer) generateInserts(ctx context.Context) (string, error) {
 
 	for _, source := range mz.sourceShards {
 		bls := &binlogdatapb.BinlogSource{
-			Keyspace:      mz.ms.SourceKeyspace,
-			Shard:         source.ShardName(),
-			Filter:        &binlogdatapb.Filter{},
-			StopAfterCopy: mz.ms.StopAfterCopy,
+			Keyspace:        mz.ms.SourceKeyspace,
+			Shard:           source.ShardName(),
+			Filter:          &binlogdatapb.Filter{},
+			StopAfterCopy:   mz.ms.StopAfterCopy,
+			CopyConcurrency: mz.ms.CopyConcurrency,
 		}
 		for _, ts := range mz.ms.TableSettings {
 			rule := &binlogdatapb.Rule{
@@ -54,7 +54,7 @@ func TestMigrateTables(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.Migrate(ctx, "workflow", "sourceks", "targetks", "t1", "", "")
+	err := env.wr.Migrate(ctx, "workflow", "sourceks", "targetks", "t1", "", "", 0)
 	assert.NoError(t, err)
 	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
 	assert.NoError(t, err)
@@ -86,7 +86,7 @@ func TestMigrateVSchema(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.Migrate(ctx, "workflow", "sourceks", "targetks", `{"t1":{}}`, "", "")
+	err := env.wr.Migrate(ctx, "workflow", "sourceks", "targetks", `{"t1":{}}`, "", "", 0)
 	assert.NoError(t, err)
 	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
 	assert.NoError(t, err)
@@ -1862,6 +1862,29 @@ func TestMaterializerStopAfterCopy(t *testing.T) {
 	env.tmc.verifyQueries(t)
 }
 
+func TestMaterializerCopyConcurrency(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:        "workflow",
+		SourceKeyspace:  "sourceks",
+		TargetKeyspace:  "targetks",
+		CopyConcurrency: 4,
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "t1ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, insertPrefix+`.*copy_concurrency:4`, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	assert.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
 func TestMaterializerNoTargetVSchema(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -2146,3 +2169,64 @@ func TestMaterializerNoVindexInExpression(t *testing.T) {
 	err := env.wr.Materialize(context.Background(), ms)
 	assert.EqualError(t, err, "could not find vindex column c1")
 }
+
+func TestMaterializeReferenceTables(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "reference_t1",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "copy",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	vs := &vschemapb.Keyspace{
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				Type:   "reference",
+				Source: "sourceks",
+			},
+			"t2": {
+				Type: "reference",
+			},
+			"t3": {
+				Type:   "reference",
+				Source: "sourceks",
+			},
+		},
+	}
+	if err := env.topoServ.SaveVSchema(context.Background(), "targetks", vs); err != nil {
+		t.Fatal(err)
+	}
+	exists := sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1")
+
+	// t1 has no workflow yet. The workflow of t3 already exists.
+	env.expectValidation()
+	env.tmc.expectVRQuery(
+		200,
+		insertPrefix+
+			`\('reference_t1', 'keyspace:\\"sourceks\\" shard:\\"0\\" filter:<rules:<match:\\"t1\\" filter:\\"select \* from t1\\" > > ', '', [0-9]*, [0-9]*, '', '', [0-9]*, 0, 'Stopped', 'vt_targetks'\)`+
+			eol,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(200, "update _vt.vreplication set state='Running' where db_name='vt_targetks' and workflow='reference_t1'", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, "select 1 from _vt.vreplication where db_name='vt_targetks' and workflow='reference_t3'", exists)
+
+	err := env.wr.MaterializeReferenceTables(context.Background(), "sourceks", nil, "", "")
+	assert.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	// The existing workflows are skipped.
+	env.tmc.expectVRQuery(200, "select 1 from _vt.vreplication where db_name='vt_targetks' and workflow='reference_t1'", exists)
+	env.tmc.expectVRQuery(200, "select 1 from _vt.vreplication where db_name='vt_targetks' and workflow='reference_t3'", exists)
+	err = env.wr.MaterializeReferenceTablesOf(context.Background(), "targetks", "", "")
+	assert.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	err = env.wr.MaterializeReferenceTables(context.Background(), "otherks", []string{"targetks"}, "", "")
+	assert.EqualError(t, err, "no reference tables with source otherks found")
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"html/template"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -31,11 +32,15 @@ import (
 	"github.com/xsec-lab/go/vt/concurrency"
 	"github.com/xsec-lab/go/vt/log"
 	"github.com/xsec-lab/go/vt/mysqlctl/tmutils"
+	"github.com/xsec-lab/go/vt/sqlparser"
 	"github.com/xsec-lab/go/vt/topo"
 	"github.com/xsec-lab/go/vt/topo/topoproto"
+	"github.com/xsec-lab/go/vt/vtgate/vindexes"
 
+	querypb "github.com/xsec-lab/go/vt/proto/query"
 	tabletmanagerdatapb "github.com/xsec-lab/go/vt/proto/tabletmanagerdata"
 	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
+	vschemapb "github.com/xsec-lab/go/vt/proto/vschema"
 )
 
 const (
@@ -267,6 +272,273 @@ func (wr *Wrangler) ValidateSchemaKeyspace(ctx context.Context, keyspace string,
 	return nil
 }
 
+// integralVindexes are the vindex types that can only map integral values.
+var integralVindexes = map[string]bool{
+	"hash":               true,
+	"numeric":            true,
+	"numeric_static_map": true,
+	"reverse_bits":       true,
+}
+
+// shardColumns has the tables of the master of a shard, with the
+// types of their columns. A type is NULL_TYPE if it's not known.
+type shardColumns struct {
+	shard  string
+	tables map[string]map[string]querypb.Type
+}
+
+// ValidateVSchema cross-checks the vschema of a keyspace with the schema
+// of the master of each shard. If vschema is nil, the vschema of the
+// keyspace is read from the topo. Every table and column named in the
+// vschema must exist, the vindex columns must have types that are
+// compatible with their vindex and with each other, and the lookup
+// tables of owned vindexes must exist. All the inconsistencies are
+// reported in the returned error.
+func (wr *Wrangler) ValidateVSchema(ctx context.Context, keyspace string, vschema *vschemapb.Keyspace) error {
+	if vschema == nil {
+		var err error
+		vschema, err = wr.ts.GetVSchema(ctx, keyspace)
+		if err != nil {
+			return fmt.Errorf("GetVSchema(%v) failed: %v", keyspace, err)
+		}
+	}
+	ksSchema, err := vindexes.BuildKeyspaceSchema(vschema, keyspace)
+	if err != nil {
+		return err
+	}
+
+	er := concurrency.AllErrorRecorder{}
+	schemas := make(map[string][]*shardColumns)
+	getSchemas := func(keyspace string) []*shardColumns {
+		if sc, ok := schemas[keyspace]; ok {
+			return sc
+		}
+		sc, err := wr.masterColumns(ctx, keyspace)
+		if err != nil {
+			er.RecordError(err)
+		}
+		schemas[keyspace] = sc
+		return sc
+	}
+
+	tableNames := make([]string, 0, len(ksSchema.Tables))
+	for name := range ksSchema.Tables {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+
+	// vindexColumns has the first column seen for each vindex,
+	// which the other columns of the vindex are compared with.
+	vindexColumns := make(map[string]string)
+	vindexTypes := make(map[string]querypb.Type)
+	for _, shard := range getSchemas(keyspace) {
+		for _, name := range tableNames {
+			table := ksSchema.Tables[name]
+			columns, ok := shard.tables[name]
+			if !ok {
+				er.RecordError(fmt.Errorf("table %v not found on %v/%v", name, keyspace, shard.shard))
+				continue
+			}
+			checkColumn := func(col sqlparser.ColIdent) (querypb.Type, bool) {
+				typ, ok := columns[col.Lowered()]
+				if !ok {
+					er.RecordError(fmt.Errorf("column %v.%v not found on %v/%v", name, col.String(), keyspace, shard.shard))
+				}
+				return typ, ok && typ != querypb.Type_NULL_TYPE
+			}
+			for _, cv := range table.ColumnVindexes {
+				for _, col := range cv.Columns {
+					typ, ok := checkColumn(col)
+					if !ok {
+						continue
+					}
+					if integralVindexes[cv.Type] && !sqltypes.IsIntegral(typ) {
+						er.RecordError(fmt.Errorf("column %v.%v of vindex %v has type %v on %v/%v, but %v vindexes require an integral type", name, col.String(), cv.Name, typ, keyspace, shard.shard, cv.Type))
+					}
+					if len(cv.Columns) != 1 {
+						continue
+					}
+					if first, ok := vindexColumns[cv.Name]; !ok {
+						vindexColumns[cv.Name] = name + "." + col.String()
+						vindexTypes[cv.Name] = typ
+					} else if !compatibleTypes(typ, vindexTypes[cv.Name]) {
+						er.RecordError(fmt.Errorf("column %v.%v of vindex %v has type %v on %v/%v, which is not compatible with type %v of %v", name, col.String(), cv.Name, typ, keyspace, shard.shard, vindexTypes[cv.Name], first))
+					}
+				}
+			}
+			for _, col := range table.Columns {
+				checkColumn(col.Name)
+			}
+			if ai := vschema.Tables[name].GetAutoIncrement(); ai != nil {
+				checkColumn(sqlparser.NewColIdent(ai.Column))
+			}
+		}
+	}
+
+	// Check the sequence tables. The keyspace of an unqualified
+	// sequence is not known without the vschema of the other keyspaces.
+	for _, name := range tableNames {
+		ai := vschema.Tables[name].GetAutoIncrement()
+		if ai == nil {
+			continue
+		}
+		strs := strings.Split(ai.Sequence, ".")
+		if len(strs) != 2 {
+			continue
+		}
+		for _, shard := range getSchemas(strs[0]) {
+			if _, ok := shard.tables[strs[1]]; !ok {
+				er.RecordError(fmt.Errorf("sequence table %v of table %v not found on %v/%v", strs[1], name, strs[0], shard.shard))
+			}
+		}
+	}
+
+	// Check the lookup tables of the owned vindexes.
+	vindexNames := make([]string, 0, len(vschema.Vindexes))
+	for name := range vschema.Vindexes {
+		vindexNames = append(vindexNames, name)
+	}
+	sort.Strings(vindexNames)
+	for _, name := range vindexNames {
+		vindex := vschema.Vindexes[name]
+		if vindex.Owner == "" || vindex.Params["table"] == "" {
+			continue
+		}
+		strs := strings.Split(vindex.Params["table"], ".")
+		if len(strs) != 2 {
+			er.RecordError(fmt.Errorf("table %v of vindex %v must be <keyspace>.<table>", vindex.Params["table"], name))
+			continue
+		}
+		var lookupCols []string
+		for _, col := range strings.Split(vindex.Params["from"], ",") {
+			lookupCols = append(lookupCols, strings.ToLower(strings.TrimSpace(col)))
+		}
+		if to := vindex.Params["to"]; to != "" {
+			lookupCols = append(lookupCols, strings.ToLower(to))
+		}
+		var ownerCols []sqlparser.ColIdent
+		if owner := ksSchema.Tables[vindex.Owner]; owner != nil {
+			for _, cv := range owner.ColumnVindexes {
+				if cv.Name == name {
+					ownerCols = cv.Columns
+				}
+			}
+		}
+		for _, shard := range getSchemas(strs[0]) {
+			columns, ok := shard.tables[strs[1]]
+			if !ok {
+				er.RecordError(fmt.Errorf("lookup table %v of vindex %v not found on %v/%v", strs[1], name, strs[0], shard.shard))
+				continue
+			}
+			for i, col := range lookupCols {
+				typ, ok := columns[col]
+				if !ok {
+					er.RecordError(fmt.Errorf("column %v.%v of vindex %v not found on %v/%v", strs[1], col, name, strs[0], shard.shard))
+					continue
+				}
+				if i >= len(ownerCols) || typ == querypb.Type_NULL_TYPE {
+					continue
+				}
+				if ownerType := ownerColumnType(schemas[keyspace], vindex.Owner, ownerCols[i]); !compatibleTypes(typ, ownerType) {
+					er.RecordError(fmt.Errorf("column %v.%v of vindex %v has type %v on %v/%v, which is not compatible with type %v of %v.%v", strs[1], col, name, typ, strs[0], shard.shard, ownerType, vindex.Owner, ownerCols[i].String()))
+				}
+			}
+		}
+	}
+
+	if er.HasErrors() {
+		return fmt.Errorf("vschema inconsistencies: %v", er.Error().Error())
+	}
+	return nil
+}
+
+// masterColumns returns the tables and columns of the master of
+// every shard of the keyspace, sorted by shard.
+func (wr *Wrangler) masterColumns(ctx context.Context, keyspace string) ([]*shardColumns, error) {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, fmt.Errorf("GetShardNames(%v) failed: %v", keyspace, err)
+	}
+	if len(shards) == 0 {
+		return nil, fmt.Errorf("no shards in keyspace %v", keyspace)
+	}
+	sort.Strings(shards)
+
+	result := make([]*shardColumns, len(shards))
+	er := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for i, shard := range shards {
+		wg.Add(1)
+		go func(i int, shard string) {
+			defer wg.Done()
+			si, err := wr.ts.GetShard(ctx, keyspace, shard)
+			if err != nil {
+				er.RecordError(fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shard, err))
+				return
+			}
+			if !si.HasMaster() {
+				er.RecordError(fmt.Errorf("no master in shard %v/%v", keyspace, shard))
+				return
+			}
+			sd, err := wr.GetSchema(ctx, si.MasterAlias, nil, nil, true)
+			if err != nil {
+				er.RecordError(fmt.Errorf("GetSchema(%v, nil, nil, true) failed: %v", si.MasterAlias, err))
+				return
+			}
+			sc := &shardColumns{
+				shard:  shard,
+				tables: make(map[string]map[string]querypb.Type),
+			}
+			for _, td := range sd.TableDefinitions {
+				columns := make(map[string]querypb.Type)
+				for _, col := range td.Columns {
+					columns[strings.ToLower(col)] = querypb.Type_NULL_TYPE
+				}
+				for _, field := range td.Fields {
+					columns[strings.ToLower(field.Name)] = field.Type
+				}
+				sc.tables[td.Name] = columns
+			}
+			result[i] = sc
+		}(i, shard)
+	}
+	wg.Wait()
+
+	// Skip the shards that failed.
+	valid := result[:0]
+	for _, sc := range result {
+		if sc != nil {
+			valid = append(valid, sc)
+		}
+	}
+	return valid, er.Error()
+}
+
+// ownerColumnType returns the type of the column of the owner table in
+// the first shard that has it, or NULL_TYPE if it's not known.
+func ownerColumnType(schemas []*shardColumns, table string, col sqlparser.ColIdent) querypb.Type {
+	for _, shard := range schemas {
+		if typ := shard.tables[table][col.Lowered()]; typ != querypb.Type_NULL_TYPE {
+			return typ
+		}
+	}
+	return querypb.Type_NULL_TYPE
+}
+
+// compatibleTypes returns true if values of the two types map to
+// the same keyspace ids. Unknown types are compatible with any type.
+func compatibleTypes(t1, t2 querypb.Type) bool {
+	switch {
+	case t1 == querypb.Type_NULL_TYPE || t2 == querypb.Type_NULL_TYPE:
+		return true
+	case sqltypes.IsIntegral(t1):
+		return sqltypes.IsIntegral(t2)
+	case sqltypes.IsText(t1) || sqltypes.IsBinary(t1):
+		return sqltypes.IsText(t2) || sqltypes.IsBinary(t2)
+	}
+	return t1 == t2
+}
+
 // PreflightSchema will try a schema change on the remote tablet.
 func (wr *Wrangler) PreflightSchema(ctx context.Context, tabletAlias *topodatapb.TabletAlias, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error) {
 	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
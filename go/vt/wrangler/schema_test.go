@@ -0,0 +1,199 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/vt/logutil"
+	"github.com/xsec-lab/go/vt/topo"
+	"github.com/xsec-lab/go/vt/topo/memorytopo"
+	"github.com/xsec-lab/go/vt/vttablet/tmclient"
+
+	querypb "github.com/xsec-lab/go/vt/proto/query"
+	tabletmanagerdatapb "github.com/xsec-lab/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/xsec-lab/go/vt/proto/topodata"
+	vschemapb "github.com/xsec-lab/go/vt/proto/vschema"
+)
+
+// testSchemaTMClient returns the schema of the shard of the tablet.
+type testSchemaTMClient struct {
+	tmclient.TabletManagerClient
+	schemas map[string]*tabletmanagerdatapb.SchemaDefinition
+}
+
+func (tmc *testSchemaTMClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	return tmc.schemas[tablet.Keyspace+"/"+tablet.Shard], nil
+}
+
+func newTestSchemaWrangler(t *testing.T, shards map[string][]string) (*Wrangler, *testSchemaTMClient) {
+	t.Helper()
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell")
+	tmc := &testSchemaTMClient{schemas: make(map[string]*tabletmanagerdatapb.SchemaDefinition)}
+	wr := New(logutil.NewConsoleLogger(), ts, tmc)
+	uid := uint32(100)
+	for keyspace, names := range shards {
+		for _, shard := range names {
+			tablet := &topodatapb.Tablet{
+				Alias:    &topodatapb.TabletAlias{Cell: "cell", Uid: uid},
+				Keyspace: keyspace,
+				Shard:    shard,
+				Type:     topodatapb.TabletType_MASTER,
+			}
+			uid++
+			if err := wr.InitTablet(ctx, tablet, false /* allowMasterOverride */, true /* createShardAndKeyspace */, false /* allowUpdate */); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := ts.UpdateShardFields(ctx, keyspace, shard, func(si *topo.ShardInfo) error {
+				si.MasterAlias = tablet.Alias
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return wr, tmc
+}
+
+func testTableDefinition(name string, fields ...*querypb.Field) *tabletmanagerdatapb.TableDefinition {
+	td := &tabletmanagerdatapb.TableDefinition{
+		Name:   name,
+		Fields: fields,
+	}
+	for _, field := range fields {
+		td.Columns = append(td.Columns, field.Name)
+	}
+	return td
+}
+
+func TestValidateVSchema(t *testing.T) {
+	wr, tmc := newTestSchemaWrangler(t, map[string][]string{
+		"ks":       {"-80", "80-"},
+		"lookupks": {"0"},
+	})
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+			"name_idx": {
+				Type: "lookup_unique",
+				Params: map[string]string{
+					"table": "lookupks.name_idx",
+					"from":  "name",
+					"to":    "keyspace_id",
+				},
+				Owner: "t1",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: "id",
+					Name:   "hash",
+				}, {
+					Column: "name",
+					Name:   "name_idx",
+				}},
+				AutoIncrement: &vschemapb.AutoIncrement{
+					Column:   "id",
+					Sequence: "lookupks.t1_seq",
+				},
+			},
+			"t2": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: "t1_id",
+					Name:   "hash",
+				}},
+				Columns: []*vschemapb.Column{{
+					Name: "val",
+				}},
+			},
+		},
+	}
+	ctx := context.Background()
+	if err := wr.ts.SaveVSchema(ctx, "ks", vs); err != nil {
+		t.Fatal(err)
+	}
+
+	ksSchema := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			testTableDefinition("t1",
+				&querypb.Field{Name: "id", Type: querypb.Type_INT64},
+				&querypb.Field{Name: "name", Type: querypb.Type_VARCHAR},
+			),
+			testTableDefinition("t2",
+				&querypb.Field{Name: "t1_id", Type: querypb.Type_INT32},
+				&querypb.Field{Name: "VAL", Type: querypb.Type_BLOB},
+			),
+		},
+	}
+	tmc.schemas["ks/-80"] = ksSchema
+	tmc.schemas["ks/80-"] = ksSchema
+	tmc.schemas["lookupks/0"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			testTableDefinition("t1_seq",
+				&querypb.Field{Name: "id", Type: querypb.Type_INT64},
+			),
+			testTableDefinition("name_idx",
+				&querypb.Field{Name: "name", Type: querypb.Type_VARBINARY},
+				&querypb.Field{Name: "keyspace_id", Type: querypb.Type_VARBINARY},
+			),
+		},
+	}
+	assert.NoError(t, wr.ValidateVSchema(ctx, "ks", nil))
+
+	// Break the schema of one shard, and the lookup table.
+	broken := proto.Clone(ksSchema).(*tabletmanagerdatapb.SchemaDefinition)
+	broken.TableDefinitions[0].Fields[0].Type = querypb.Type_VARCHAR
+	broken.TableDefinitions[1] = testTableDefinition("t2",
+		&querypb.Field{Name: "t1_id", Type: querypb.Type_INT32},
+	)
+	tmc.schemas["ks/80-"] = broken
+	tmc.schemas["lookupks/0"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			testTableDefinition("name_idx",
+				&querypb.Field{Name: "name", Type: querypb.Type_INT64},
+			),
+		},
+	}
+	err := wr.ValidateVSchema(ctx, "ks", nil)
+	want := "vschema inconsistencies: " +
+		"column t1.id of vindex hash has type VARCHAR on ks/80-, but hash vindexes require an integral type;" +
+		"column t1.id of vindex hash has type VARCHAR on ks/80-, which is not compatible with type INT64 of t1.id;" +
+		"column t2.val not found on ks/80-;" +
+		"sequence table t1_seq of table t1 not found on lookupks/0;" +
+		"column name_idx.name of vindex name_idx has type INT64 on lookupks/0, which is not compatible with type VARCHAR of t1.name;" +
+		"column name_idx.keyspace_id of vindex name_idx not found on lookupks/0"
+	assert.EqualError(t, err, want)
+
+	// A vschema that's not saved yet can be validated.
+	vs.Tables["t3"] = &vschemapb.Table{
+		ColumnVindexes: []*vschemapb.ColumnVindex{{
+			Column: "id",
+			Name:   "hash",
+		}},
+	}
+	err = wr.ValidateVSchema(ctx, "ks", vs)
+	assert.Contains(t, err.Error(), "table t3 not found on ks/-80;")
+}
@@ -409,14 +409,8 @@ func (df *vdiff) selectTablets(ctx context.Context, healthcheckTopologyRefresh,
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err1 = df.forAll(df.sources, func(shard string, source *shardStreamer) error {
-			tp, err := discovery.NewTabletPicker(ctx, df.mi.wr.ts, df.sourceCell, df.mi.sourceKeyspace, shard, df.tabletTypesStr, healthcheckTopologyRefresh, healthcheckRetryDelay, healthcheckTimeout)
-			if err != nil {
-				return err
-			}
-			defer tp.Close()
-
-			tablet, err := tp.PickForStreaming(ctx)
+		err1 = forAll(df.sources, func(shard string, source *shardStreamer) error {
+			tablet, err := pickStreamingTablet(ctx, df.mi.wr.ts, df.sourceCell, df.mi.sourceKeyspace, shard, df.tabletTypesStr, healthcheckTopologyRefresh, healthcheckRetryDelay, healthcheckTimeout)
 			if err != nil {
 				return err
 			}
@@ -428,14 +422,8 @@ func (df *vdiff) selectTablets(ctx context.Context, healthcheckTopologyRefresh,
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err2 = df.forAll(df.targets, func(shard string, target *shardStreamer) error {
-			tp, err := discovery.NewTabletPicker(ctx, df.mi.wr.ts, df.targetCell, df.mi.targetKeyspace, shard, df.tabletTypesStr, healthcheckTopologyRefresh, healthcheckRetryDelay, healthcheckTimeout)
-			if err != nil {
-				return err
-			}
-			defer tp.Close()
-
-			tablet, err := tp.PickForStreaming(ctx)
+		err2 = forAll(df.targets, func(shard string, target *shardStreamer) error {
+			tablet, err := pickStreamingTablet(ctx, df.mi.wr.ts, df.targetCell, df.mi.targetKeyspace, shard, df.tabletTypesStr, healthcheckTopologyRefresh, healthcheckRetryDelay, healthcheckTimeout)
 			if err != nil {
 				return err
 			}
@@ -451,11 +439,21 @@ func (df *vdiff) selectTablets(ctx context.Context, healthcheckTopologyRefresh,
 	return err2
 }
 
+// pickStreamingTablet picks a tablet of the shard that can serve a query stream.
+func pickStreamingTablet(ctx context.Context, ts *topo.Server, cell, keyspace, shard, tabletTypesStr string, healthcheckTopologyRefresh, healthcheckRetryDelay, healthcheckTimeout time.Duration) (*topodatapb.Tablet, error) {
+	tp, err := discovery.NewTabletPicker(ctx, ts, cell, keyspace, shard, tabletTypesStr, healthcheckTopologyRefresh, healthcheckRetryDelay, healthcheckTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer tp.Close()
+	return tp.PickForStreaming(ctx)
+}
+
 // stopTargets stops all the targets and records their source positions.
 func (df *vdiff) stopTargets(ctx context.Context) error {
 	var mu sync.Mutex
 
-	err := df.forAll(df.targets, func(shard string, target *shardStreamer) error {
+	err := forAll(df.targets, func(shard string, target *shardStreamer) error {
 		query := fmt.Sprintf("update _vt.vreplication set state='Stopped', message='for vdiff' where db_name=%s and workflow=%s", encodeString(target.master.DbName()), encodeString(df.mi.workflow))
 		_, err := df.mi.wr.tmc.VReplicationExec(ctx, target.master.Tablet, query)
 		if err != nil {
@@ -506,7 +504,7 @@ func (df *vdiff) stopTargets(ctx context.Context) error {
 func (df *vdiff) startQueryStreams(ctx context.Context, keyspace string, participants map[string]*shardStreamer, query string, filteredReplicationWaitTime time.Duration) error {
 	waitCtx, cancel := context.WithTimeout(ctx, filteredReplicationWaitTime)
 	defer cancel()
-	return df.forAll(participants, func(shard string, participant *shardStreamer) error {
+	return forAll(participants, func(shard string, participant *shardStreamer) error {
 		// Iteration for each participant.
 		if err := df.mi.wr.tmc.WaitForPosition(waitCtx, participant.tablet, mysql.EncodePosition(participant.position)); err != nil {
 			return vterrors.Wrapf(err, "WaitForPosition for tablet %v", topoproto.TabletAliasString(participant.tablet.Alias))
@@ -515,7 +513,7 @@ func (df *vdiff) startQueryStreams(ctx context.Context, keyspace string, partici
 		gtidch := make(chan string, 1)
 
 		// Start the stream in a separate goroutine.
-		go df.streamOne(ctx, keyspace, shard, participant, query, gtidch)
+		go streamOne(ctx, keyspace, shard, participant, query, gtidch)
 
 		// Wait for the gtid to be sent. If it's not received, there was an error
 		// which would be stored in participant.err.
@@ -535,7 +533,7 @@ func (df *vdiff) startQueryStreams(ctx context.Context, keyspace string, partici
 // Before returning, it sets participant.err, and closes all channels.
 // If any channel is closed, then participant.err can be checked if there was an error.
 // The shardStreamer's StreamExecute consumes the result channel.
-func (df *vdiff) streamOne(ctx context.Context, keyspace, shard string, participant *shardStreamer, query string, gtidch chan string) {
+func streamOne(ctx context.Context, keyspace, shard string, participant *shardStreamer, query string, gtidch chan string) {
 	defer close(participant.result)
 	defer close(gtidch)
 
@@ -599,7 +597,7 @@ func (df *vdiff) syncTargets(ctx context.Context, filteredReplicationWaitTime ti
 		return err
 	}
 
-	err = df.forAll(df.targets, func(shard string, target *shardStreamer) error {
+	err = forAll(df.targets, func(shard string, target *shardStreamer) error {
 		pos, err := df.mi.wr.tmc.MasterPosition(ctx, target.master.Tablet)
 		if err != nil {
 			return err
@@ -616,14 +614,14 @@ func (df *vdiff) syncTargets(ctx context.Context, filteredReplicationWaitTime ti
 
 // restartTargets restarts the stopped target vreplication streams.
 func (df *vdiff) restartTargets(ctx context.Context) error {
-	return df.forAll(df.targets, func(shard string, target *shardStreamer) error {
+	return forAll(df.targets, func(shard string, target *shardStreamer) error {
 		query := fmt.Sprintf("update _vt.vreplication set state='Running', message='', stop_pos='' where db_name=%s and workflow=%s", encodeString(target.master.DbName()), encodeString(df.mi.workflow))
 		_, err := df.mi.wr.tmc.VReplicationExec(ctx, target.master.Tablet, query)
 		return err
 	})
 }
 
-func (df *vdiff) forAll(participants map[string]*shardStreamer, f func(string, *shardStreamer) error) error {
+func forAll(participants map[string]*shardStreamer, f func(string, *shardStreamer) error) error {
 	var wg sync.WaitGroup
 	allErrors := &concurrency.AllErrorRecorder{}
 	for shard, participant := range participants {
@@ -59,6 +59,9 @@ type testVDiffEnv struct {
 
 	mu      sync.Mutex
 	tablets map[int]*testVDiffTablet
+
+	// tabletProtocol is restored on close.
+	tabletProtocol string
 }
 
 // vdiffEnv has to be a global for RegisterDialer to work.
@@ -76,7 +79,6 @@ func init() {
 // testVDiffEnv
 
 func newTestVDiffEnv(sourceShards, targetShards []string, query string, positions map[string]string) *testVDiffEnv {
-	flag.Set("tablet_protocol", "VDiffTest")
 	env := &testVDiffEnv{
 		workflow:   "vdiffTest",
 		tablets:    make(map[int]*testVDiffTablet),
@@ -84,7 +86,10 @@ func newTestVDiffEnv(sourceShards, targetShards []string, query string, position
 		cell:       "cell",
 		tabletType: topodatapb.TabletType_REPLICA,
 		tmc:        newTestVDiffTMClient(),
+
+		tabletProtocol: flag.Lookup("tablet_protocol").Value.String(),
 	}
+	flag.Set("tablet_protocol", "VDiffTest")
 	env.wr = New(logutil.NewConsoleLogger(), env.topoServ, env.tmc)
 
 	tabletID := 100
@@ -174,6 +179,7 @@ func (env *testVDiffEnv) close() {
 		env.topoServ.DeleteTablet(context.Background(), t.tablet.Alias)
 	}
 	env.tablets = nil
+	flag.Set("tablet_protocol", env.tabletProtocol)
 }
 
 func (env *testVDiffEnv) addTablet(id int, keyspace, shard string, tabletType topodatapb.TabletType) *testVDiffTablet {
@@ -275,6 +281,10 @@ type testVDiffTMClient struct {
 	waitpos   map[int]string
 	vrpos     map[int]string
 	pos       map[int]string
+	// dbaQueries records the queries executed by ExecuteFetchAsDba.
+	// dbaResults are the results it returns. The default is empty.
+	dbaQueries map[int][]string
+	dbaResults map[int]map[string]*querypb.QueryResult
 }
 
 func newTestVDiffTMClient() *testVDiffTMClient {
@@ -283,6 +293,9 @@ func newTestVDiffTMClient() *testVDiffTMClient {
 		waitpos:   make(map[int]string),
 		vrpos:     make(map[int]string),
 		pos:       make(map[int]string),
+
+		dbaQueries: make(map[int][]string),
+		dbaResults: make(map[int]map[string]*querypb.QueryResult),
 	}
 }
 
@@ -338,3 +351,20 @@ func (tmc *testVDiffTMClient) MasterPosition(ctx context.Context, tablet *topoda
 	}
 	return pos, nil
 }
+
+func (tmc *testVDiffTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	tmc.dbaQueries[int(tablet.Alias.Uid)] = append(tmc.dbaQueries[int(tablet.Alias.Uid)], string(query))
+	if result, ok := tmc.dbaResults[int(tablet.Alias.Uid)][string(query)]; ok {
+		return result, nil
+	}
+	return &querypb.QueryResult{}, nil
+}
+
+func (tmc *testVDiffTMClient) setDBAResults(uid int, query string, result *sqltypes.Result) {
+	queries, ok := tmc.dbaResults[uid]
+	if !ok {
+		queries = make(map[string]*querypb.QueryResult)
+		tmc.dbaResults[uid] = queries
+	}
+	queries[query] = sqltypes.ResultToProto3(result)
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/binlog/binlogplayer"
+)
+
+// WorkflowProgress is the copy progress of a workflow.
+type WorkflowProgress struct {
+	Streams []*StreamProgress
+	// Tables is the progress of each table, aggregated across all streams.
+	Tables map[string]binlogplayer.CopyProgress
+	// ETA is the estimated time to complete the copy of all streams.
+	// It's zero if it can't be estimated.
+	ETA time.Duration
+}
+
+// StreamProgress is the copy progress of one vreplication stream.
+// The tables that were completely copied are not listed.
+type StreamProgress struct {
+	Shard   string
+	ID      uint32
+	State   string
+	Message string
+	Tables  map[string]binlogplayer.CopyProgress
+	ETA     time.Duration
+}
+
+// WorkflowProgress reports the progress of the copy phase of the
+// streams of a workflow on all target shards. The row counts are
+// based on the estimates of the source tables, and are approximate.
+func (wr *Wrangler) WorkflowProgress(ctx context.Context, targetKeyspace, workflow string) (*WorkflowProgress, error) {
+	targets, _, err := wr.buildMigrationTargets(ctx, targetKeyspace, workflow)
+	if err != nil {
+		return nil, err
+	}
+	wp := &WorkflowProgress{
+		Tables: make(map[string]binlogplayer.CopyProgress),
+	}
+	for shard, target := range targets {
+		streams, err := wr.streamProgress(ctx, shard, target)
+		if err != nil {
+			return nil, err
+		}
+		wp.Streams = append(wp.Streams, streams...)
+	}
+	sort.Slice(wp.Streams, func(i, j int) bool {
+		if wp.Streams[i].Shard != wp.Streams[j].Shard {
+			return wp.Streams[i].Shard < wp.Streams[j].Shard
+		}
+		return wp.Streams[i].ID < wp.Streams[j].ID
+	})
+	for _, sp := range wp.Streams {
+		// The streams copy in parallel. So, the workflow
+		// completes when the slowest stream does.
+		if sp.ETA > wp.ETA {
+			wp.ETA = sp.ETA
+		}
+		for table, cp := range sp.Tables {
+			wp.Tables[table] = wp.Tables[table].Add(cp)
+		}
+	}
+	wr.printWorkflowProgress(targetKeyspace, workflow, wp)
+	return wp, nil
+}
+
+// streamProgress reads the progress of the streams of a target.
+func (wr *Wrangler) streamProgress(ctx context.Context, shard string, target *miTarget) ([]*StreamProgress, error) {
+	var ids []uint32
+	for id := range target.sources {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	idStrs := make([]string, len(ids))
+	for i, id := range ids {
+		idStrs[i] = fmt.Sprintf("%d", id)
+	}
+	idList := strings.Join(idStrs, ", ")
+
+	streams := make(map[uint32]*StreamProgress)
+	p3qr, err := wr.tmc.VReplicationExec(ctx, target.master.Tablet, fmt.Sprintf("select id, state, message from _vt.vreplication where id in (%s)", idList))
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+		id, err := sqltypes.ToInt64(row[0])
+		if err != nil {
+			return nil, err
+		}
+		streams[uint32(id)] = &StreamProgress{
+			Shard:   shard,
+			ID:      uint32(id),
+			State:   row[1].ToString(),
+			Message: row[2].ToString(),
+			Tables:  make(map[string]binlogplayer.CopyProgress),
+		}
+	}
+
+	query := fmt.Sprintf("select vrepl_id, table_name, rows_estimated, bytes_estimated, rows_copied, bytes_copied, time_started, time_updated from _vt.copy_state where vrepl_id in (%s)", idList)
+	p3qr, err = wr.tmc.VReplicationExec(ctx, target.master.Tablet, query)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+		id, err := sqltypes.ToInt64(row[0])
+		if err != nil {
+			return nil, err
+		}
+		sp, ok := streams[uint32(id)]
+		if !ok {
+			continue
+		}
+		var values [6]int64
+		for i := range values {
+			if values[i], err = sqltypes.ToInt64(row[i+2]); err != nil {
+				return nil, err
+			}
+		}
+		// A table that's copied in chunks has one row per chunk.
+		table := row[1].ToString()
+		sp.Tables[table] = sp.Tables[table].Add(binlogplayer.CopyProgress{
+			RowsEstimated:  values[0],
+			BytesEstimated: values[1],
+			RowsCopied:     values[2],
+			BytesCopied:    values[3],
+			TimeStarted:    values[4],
+			TimeUpdated:    values[5],
+		})
+	}
+
+	result := make([]*StreamProgress, 0, len(streams))
+	for _, sp := range streams {
+		var tables []binlogplayer.CopyProgress
+		for _, cp := range sp.Tables {
+			tables = append(tables, cp)
+		}
+		sp.ETA = binlogplayer.CopyETA(tables)
+		result = append(result, sp)
+	}
+	return result, nil
+}
+
+func (wr *Wrangler) printWorkflowProgress(targetKeyspace, workflow string, wp *WorkflowProgress) {
+	wr.Logger().Printf("Copy progress of %v.%v:\n", targetKeyspace, workflow)
+	for _, sp := range wp.Streams {
+		if sp.Message != "" {
+			wr.Logger().Printf("  Shard %v, stream %v: %v (%v)\n", sp.Shard, sp.ID, sp.State, sp.Message)
+		} else {
+			wr.Logger().Printf("  Shard %v, stream %v: %v\n", sp.Shard, sp.ID, sp.State)
+		}
+		switch {
+		case sp.State == binlogplayer.VReplicationInit:
+			wr.Logger().Printf("    Copy not started\n")
+		case len(sp.Tables) == 0:
+			wr.Logger().Printf("    No tables left to copy\n")
+		}
+		for _, table := range sortedTables(sp.Tables) {
+			wr.Logger().Printf("    %v: %v\n", table, sp.Tables[table])
+		}
+	}
+	wr.Logger().Printf("Total:\n")
+	for _, table := range sortedTables(wp.Tables) {
+		wr.Logger().Printf("  %v: %v\n", table, wp.Tables[table])
+	}
+	if wp.ETA != 0 {
+		wr.Logger().Printf("ETA: %v\n", wp.ETA)
+	}
+}
+
+func sortedTables(tables map[string]binlogplayer.CopyProgress) []string {
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/xsec-lab/go/sqltypes"
+	"github.com/xsec-lab/go/vt/binlog/binlogplayer"
+)
+
+func TestWorkflowProgress(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestShardMigrater(ctx, t, []string{"-40", "40-"}, []string{"-80", "80-"})
+	defer tme.stopTablets(t)
+
+	stateFields := sqltypes.MakeTestFields("id|state|message", "int64|varbinary|varbinary")
+	progressFields := sqltypes.MakeTestFields(
+		"vrepl_id|table_name|rows_estimated|bytes_estimated|rows_copied|bytes_copied|time_started|time_updated",
+		"int64|varbinary|int64|int64|int64|int64|int64|int64")
+
+	// Target -80 replicates from -40 and 40-, and 80- from 40- only.
+	tme.dbTargetClients[0].addQuery("select id, state, message from _vt.vreplication where id in (1, 2)", sqltypes.MakeTestResult(stateFields, "1|Copying|", "2|Running|"), nil)
+	tme.dbTargetClients[0].addQuery(
+		"select vrepl_id, table_name, rows_estimated, bytes_estimated, rows_copied, bytes_copied, time_started, time_updated from _vt.copy_state where vrepl_id in (1, 2)",
+		sqltypes.MakeTestResult(progressFields,
+			"1|t1|100|1000|50|500|1000|1010",
+			"1|t2|200|2000|0|0|0|0",
+		), nil)
+	tme.dbTargetClients[1].addQuery("select id, state, message from _vt.vreplication where id in (2)", sqltypes.MakeTestResult(stateFields, "2|Copying|"), nil)
+	tme.dbTargetClients[1].addQuery(
+		"select vrepl_id, table_name, rows_estimated, bytes_estimated, rows_copied, bytes_copied, time_started, time_updated from _vt.copy_state where vrepl_id in (2)",
+		sqltypes.MakeTestResult(progressFields,
+			"2|t1|100|1000|90|900|1005|1020",
+		), nil)
+
+	wp, err := tme.wr.WorkflowProgress(ctx, tme.targetKeyspace, "test")
+	require.NoError(t, err)
+	verifyQueries(t, tme.allDBClients)
+
+	require.Len(t, wp.Streams, 3)
+	assert.Equal(t, "-80", wp.Streams[0].Shard)
+	assert.Equal(t, uint32(1), wp.Streams[0].ID)
+	assert.Equal(t, "Copying", wp.Streams[0].State)
+	// 250 rows remain to be copied at 5 rows/s.
+	assert.Equal(t, 50*time.Second, wp.Streams[0].ETA)
+	// The copy is complete.
+	assert.Equal(t, uint32(2), wp.Streams[1].ID)
+	assert.Equal(t, "Running", wp.Streams[1].State)
+	assert.Empty(t, wp.Streams[1].Tables)
+	assert.Equal(t, time.Duration(0), wp.Streams[1].ETA)
+	assert.Equal(t, "80-", wp.Streams[2].Shard)
+	// 10 rows remain to be copied at 6 rows/s.
+	assert.Equal(t, time.Second, wp.Streams[2].ETA)
+
+	want := map[string]binlogplayer.CopyProgress{
+		"t1": {RowsEstimated: 200, BytesEstimated: 2000, RowsCopied: 140, BytesCopied: 1400, TimeStarted: 1000, TimeUpdated: 1020},
+		"t2": {RowsEstimated: 200, BytesEstimated: 2000},
+	}
+	assert.Equal(t, want, wp.Tables)
+	assert.Equal(t, 50*time.Second, wp.ETA)
+}